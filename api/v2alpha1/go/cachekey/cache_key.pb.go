@@ -46,6 +46,10 @@ type CacheKey struct {
 	OutputParametersSpec map[string]string                        `protobuf:"bytes,4,rep,name=outputParametersSpec,proto3" json:"outputParametersSpec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ContainerSpec        *ContainerSpec                           `protobuf:"bytes,5,opt,name=containerSpec,proto3" json:"containerSpec,omitempty"`
 	InputParameterValues map[string]*structpb.Value               `protobuf:"bytes,6,rep,name=input_parameter_values,json=inputParameterValues,proto3" json:"input_parameter_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Content digests of the input artifacts, keyed by input name. When every
+	// artifact of an input has a recorded digest, the input is keyed by its
+	// digests instead of its artifact names.
+	InputArtifactDigests map[string]*ArtifactDigestList `protobuf:"bytes,7,rep,name=input_artifact_digests,json=inputArtifactDigests,proto3" json:"input_artifact_digests,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -123,6 +127,13 @@ func (x *CacheKey) GetInputParameterValues() map[string]*structpb.Value {
 	return nil
 }
 
+func (x *CacheKey) GetInputArtifactDigests() map[string]*ArtifactDigestList {
+	if x != nil {
+		return x.InputArtifactDigests
+	}
+	return nil
+}
+
 type ContainerSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
@@ -219,18 +230,63 @@ func (x *ArtifactNameList) GetArtifactNames() []string {
 	return nil
 }
 
+type ArtifactDigestList struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactDigests []string               `protobuf:"bytes,1,rep,name=artifact_digests,json=artifactDigests,proto3" json:"artifact_digests,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ArtifactDigestList) Reset() {
+	*x = ArtifactDigestList{}
+	mi := &file_cache_key_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtifactDigestList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactDigestList) ProtoMessage() {}
+
+func (x *ArtifactDigestList) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_key_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactDigestList.ProtoReflect.Descriptor instead.
+func (*ArtifactDigestList) Descriptor() ([]byte, []int) {
+	return file_cache_key_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArtifactDigestList) GetArtifactDigests() []string {
+	if x != nil {
+		return x.ArtifactDigests
+	}
+	return nil
+}
+
 var File_cache_key_proto protoreflect.FileDescriptor
 
 const file_cache_key_proto_rawDesc = "" +
 	"\n" +
-	"\x0fcache_key.proto\x12\fml_pipelines\x1a\x1cgoogle/protobuf/struct.proto\x1a\x13pipeline_spec.proto\"\x8a\b\n" +
+	"\x0fcache_key.proto\x12\fml_pipelines\x1a\x1cgoogle/protobuf/struct.proto\x1a\x13pipeline_spec.proto\"\xdd\t\n" +
 	"\bCacheKey\x12^\n" +
 	"\x12inputArtifactNames\x18\x01 \x03(\v2..ml_pipelines.CacheKey.InputArtifactNamesEntryR\x12inputArtifactNames\x12Y\n" +
 	"\x0finputParameters\x18\x02 \x03(\v2+.ml_pipelines.CacheKey.InputParametersEntryB\x02\x18\x01R\x0finputParameters\x12a\n" +
 	"\x13outputArtifactsSpec\x18\x03 \x03(\v2/.ml_pipelines.CacheKey.OutputArtifactsSpecEntryR\x13outputArtifactsSpec\x12d\n" +
 	"\x14outputParametersSpec\x18\x04 \x03(\v20.ml_pipelines.CacheKey.OutputParametersSpecEntryR\x14outputParametersSpec\x12A\n" +
 	"\rcontainerSpec\x18\x05 \x01(\v2\x1b.ml_pipelines.ContainerSpecR\rcontainerSpec\x12f\n" +
-	"\x16input_parameter_values\x18\x06 \x03(\v20.ml_pipelines.CacheKey.InputParameterValuesEntryR\x14inputParameterValues\x1ae\n" +
+	"\x16input_parameter_values\x18\x06 \x03(\v20.ml_pipelines.CacheKey.InputParameterValuesEntryR\x14inputParameterValues\x12f\n" +
+	"\x16input_artifact_digests\x18\a \x03(\v20.ml_pipelines.CacheKey.InputArtifactDigestsEntryR\x14inputArtifactDigests\x1ae\n" +
 	"\x17InputArtifactNamesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
 	"\x05value\x18\x02 \x01(\v2\x1e.ml_pipelines.ArtifactNameListR\x05value:\x028\x01\x1aW\n" +
@@ -245,12 +301,17 @@ const file_cache_key_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a_\n" +
 	"\x19InputParameterValuesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"?\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\x1ai\n" +
+	"\x19InputArtifactDigestsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .ml_pipelines.ArtifactDigestListR\x05value:\x028\x01\"?\n" +
 	"\rContainerSpec\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12\x18\n" +
 	"\acmdArgs\x18\x02 \x03(\tR\acmdArgs\"8\n" +
 	"\x10ArtifactNameList\x12$\n" +
-	"\rartifactNames\x18\x01 \x03(\tR\rartifactNamesB8Z6github.com/kubeflow/pipelines/api/v2alpha1/go/cachekeyb\x06proto3"
+	"\rartifactNames\x18\x01 \x03(\tR\rartifactNames\"?\n" +
+	"\x12ArtifactDigestList\x12)\n" +
+	"\x10artifact_digests\x18\x01 \x03(\tR\x0fartifactDigestsB8Z6github.com/kubeflow/pipelines/api/v2alpha1/go/cachekeyb\x06proto3"
 
 var (
 	file_cache_key_proto_rawDescOnce sync.Once
@@ -264,36 +325,40 @@ func file_cache_key_proto_rawDescGZIP() []byte {
 	return file_cache_key_proto_rawDescData
 }
 
-var file_cache_key_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_cache_key_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_cache_key_proto_goTypes = []any{
 	(*CacheKey)(nil),                     // 0: ml_pipelines.CacheKey
 	(*ContainerSpec)(nil),                // 1: ml_pipelines.ContainerSpec
 	(*ArtifactNameList)(nil),             // 2: ml_pipelines.ArtifactNameList
-	nil,                                  // 3: ml_pipelines.CacheKey.InputArtifactNamesEntry
-	nil,                                  // 4: ml_pipelines.CacheKey.InputParametersEntry
-	nil,                                  // 5: ml_pipelines.CacheKey.OutputArtifactsSpecEntry
-	nil,                                  // 6: ml_pipelines.CacheKey.OutputParametersSpecEntry
-	nil,                                  // 7: ml_pipelines.CacheKey.InputParameterValuesEntry
-	(*pipelinespec.Value)(nil),           // 8: ml_pipelines.Value
-	(*pipelinespec.RuntimeArtifact)(nil), // 9: ml_pipelines.RuntimeArtifact
-	(*structpb.Value)(nil),               // 10: google.protobuf.Value
+	(*ArtifactDigestList)(nil),           // 3: ml_pipelines.ArtifactDigestList
+	nil,                                  // 4: ml_pipelines.CacheKey.InputArtifactNamesEntry
+	nil,                                  // 5: ml_pipelines.CacheKey.InputParametersEntry
+	nil,                                  // 6: ml_pipelines.CacheKey.OutputArtifactsSpecEntry
+	nil,                                  // 7: ml_pipelines.CacheKey.OutputParametersSpecEntry
+	nil,                                  // 8: ml_pipelines.CacheKey.InputParameterValuesEntry
+	nil,                                  // 9: ml_pipelines.CacheKey.InputArtifactDigestsEntry
+	(*pipelinespec.Value)(nil),           // 10: ml_pipelines.Value
+	(*pipelinespec.RuntimeArtifact)(nil), // 11: ml_pipelines.RuntimeArtifact
+	(*structpb.Value)(nil),               // 12: google.protobuf.Value
 }
 var file_cache_key_proto_depIdxs = []int32{
-	3,  // 0: ml_pipelines.CacheKey.inputArtifactNames:type_name -> ml_pipelines.CacheKey.InputArtifactNamesEntry
-	4,  // 1: ml_pipelines.CacheKey.inputParameters:type_name -> ml_pipelines.CacheKey.InputParametersEntry
-	5,  // 2: ml_pipelines.CacheKey.outputArtifactsSpec:type_name -> ml_pipelines.CacheKey.OutputArtifactsSpecEntry
-	6,  // 3: ml_pipelines.CacheKey.outputParametersSpec:type_name -> ml_pipelines.CacheKey.OutputParametersSpecEntry
+	4,  // 0: ml_pipelines.CacheKey.inputArtifactNames:type_name -> ml_pipelines.CacheKey.InputArtifactNamesEntry
+	5,  // 1: ml_pipelines.CacheKey.inputParameters:type_name -> ml_pipelines.CacheKey.InputParametersEntry
+	6,  // 2: ml_pipelines.CacheKey.outputArtifactsSpec:type_name -> ml_pipelines.CacheKey.OutputArtifactsSpecEntry
+	7,  // 3: ml_pipelines.CacheKey.outputParametersSpec:type_name -> ml_pipelines.CacheKey.OutputParametersSpecEntry
 	1,  // 4: ml_pipelines.CacheKey.containerSpec:type_name -> ml_pipelines.ContainerSpec
-	7,  // 5: ml_pipelines.CacheKey.input_parameter_values:type_name -> ml_pipelines.CacheKey.InputParameterValuesEntry
-	2,  // 6: ml_pipelines.CacheKey.InputArtifactNamesEntry.value:type_name -> ml_pipelines.ArtifactNameList
-	8,  // 7: ml_pipelines.CacheKey.InputParametersEntry.value:type_name -> ml_pipelines.Value
-	9,  // 8: ml_pipelines.CacheKey.OutputArtifactsSpecEntry.value:type_name -> ml_pipelines.RuntimeArtifact
-	10, // 9: ml_pipelines.CacheKey.InputParameterValuesEntry.value:type_name -> google.protobuf.Value
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	8,  // 5: ml_pipelines.CacheKey.input_parameter_values:type_name -> ml_pipelines.CacheKey.InputParameterValuesEntry
+	9,  // 6: ml_pipelines.CacheKey.input_artifact_digests:type_name -> ml_pipelines.CacheKey.InputArtifactDigestsEntry
+	2,  // 7: ml_pipelines.CacheKey.InputArtifactNamesEntry.value:type_name -> ml_pipelines.ArtifactNameList
+	10, // 8: ml_pipelines.CacheKey.InputParametersEntry.value:type_name -> ml_pipelines.Value
+	11, // 9: ml_pipelines.CacheKey.OutputArtifactsSpecEntry.value:type_name -> ml_pipelines.RuntimeArtifact
+	12, // 10: ml_pipelines.CacheKey.InputParameterValuesEntry.value:type_name -> google.protobuf.Value
+	3,  // 11: ml_pipelines.CacheKey.InputArtifactDigestsEntry.value:type_name -> ml_pipelines.ArtifactDigestList
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cache_key_proto_init() }
@@ -307,7 +372,7 @@ func file_cache_key_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cache_key_proto_rawDesc), len(file_cache_key_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
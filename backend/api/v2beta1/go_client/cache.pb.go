@@ -0,0 +1,522 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: backend/api/v2beta1/cache.proto
+
+package go_client
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A cached task result that can be reused by tasks with the same fingerprint.
+type CacheEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output. The fingerprint of the task inputs, outputs and container spec.
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Output. The namespace the cached task ran in.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Output. The name of the pipeline the cached task belongs to.
+	PipelineName string `protobuf:"bytes,3,opt,name=pipeline_name,json=pipelineName,proto3" json:"pipeline_name,omitempty"`
+	// Output. The ID of the run that produced the cached result.
+	RunId string `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Output. The ML Metadata execution ID holding the cached outputs.
+	ExecutionId string `protobuf:"bytes,5,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Output. Creation time of the cache entry.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheEntry) Reset() {
+	*x = CacheEntry{}
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEntry) ProtoMessage() {}
+
+func (x *CacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEntry.ProtoReflect.Descriptor instead.
+func (*CacheEntry) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_cache_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CacheEntry) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetPipelineName() string {
+	if x != nil {
+		return x.PipelineName
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCacheEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Which namespace to list the cache entries in.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Optional. Only lists the entries with this fingerprint.
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Optional. Only lists the entries produced by this run.
+	RunId string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Optional. Only lists the entries of the pipeline with this name.
+	PipelineName string `protobuf:"bytes,4,opt,name=pipeline_name,json=pipelineName,proto3" json:"pipeline_name,omitempty"`
+	// A page token to request the next page of results. The token is acquired
+	// from the nextPageToken field of the response from the previous
+	// ListCacheEntries call or can be omitted when fetching the first page.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The number of cache entries to be listed per page. If there are more
+	// entries than this number, the response message will contain a
+	// nextPageToken field you can use to fetch the next page.
+	PageSize int32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Can be format of "field_name", "field_name asc" or "field_name desc"
+	// Ascending by default.
+	SortBy        string `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheEntriesRequest) Reset() {
+	*x = ListCacheEntriesRequest{}
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheEntriesRequest) ProtoMessage() {}
+
+func (x *ListCacheEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_cache_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCacheEntriesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListCacheEntriesRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *ListCacheEntriesRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ListCacheEntriesRequest) GetPipelineName() string {
+	if x != nil {
+		return x.PipelineName
+	}
+	return ""
+}
+
+func (x *ListCacheEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCacheEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCacheEntriesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+type ListCacheEntriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A list of cache entries returned.
+	CacheEntries []*CacheEntry `protobuf:"bytes,1,rep,name=cache_entries,json=cacheEntries,proto3" json:"cache_entries,omitempty"`
+	// The number of cache entries for the given query.
+	TotalSize int32 `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The token to list the next page of cache entries.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheEntriesResponse) Reset() {
+	*x = ListCacheEntriesResponse{}
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheEntriesResponse) ProtoMessage() {}
+
+func (x *ListCacheEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCacheEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_cache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCacheEntriesResponse) GetCacheEntries() []*CacheEntry {
+	if x != nil {
+		return x.CacheEntries
+	}
+	return nil
+}
+
+func (x *ListCacheEntriesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *ListCacheEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type InvalidateCacheEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Which namespace to invalidate the cache entries in.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Invalidates the entries with this fingerprint.
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Invalidates the entries produced by this run.
+	RunId string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Invalidates the entries of the pipeline with this name.
+	// At least one of fingerprint, run_id and pipeline_name must be set. When
+	// several are set, only entries matching all of them are invalidated.
+	PipelineName  string `protobuf:"bytes,4,opt,name=pipeline_name,json=pipelineName,proto3" json:"pipeline_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateCacheEntriesRequest) Reset() {
+	*x = InvalidateCacheEntriesRequest{}
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateCacheEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheEntriesRequest) ProtoMessage() {}
+
+func (x *InvalidateCacheEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCacheEntriesRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_cache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvalidateCacheEntriesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InvalidateCacheEntriesRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *InvalidateCacheEntriesRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *InvalidateCacheEntriesRequest) GetPipelineName() string {
+	if x != nil {
+		return x.PipelineName
+	}
+	return ""
+}
+
+type InvalidateCacheEntriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of cache entries invalidated.
+	InvalidatedCount int32 `protobuf:"varint,1,opt,name=invalidated_count,json=invalidatedCount,proto3" json:"invalidated_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InvalidateCacheEntriesResponse) Reset() {
+	*x = InvalidateCacheEntriesResponse{}
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateCacheEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheEntriesResponse) ProtoMessage() {}
+
+func (x *InvalidateCacheEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_cache_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCacheEntriesResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_cache_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InvalidateCacheEntriesResponse) GetInvalidatedCount() int32 {
+	if x != nil {
+		return x.InvalidatedCount
+	}
+	return 0
+}
+
+var File_backend_api_v2beta1_cache_proto protoreflect.FileDescriptor
+
+const file_backend_api_v2beta1_cache_proto_rawDesc = "" +
+	"\n" +
+	"\x1fbackend/api/v2beta1/cache.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe6\x01\n" +
+	"\n" +
+	"CacheEntry\x12 \n" +
+	"\vfingerprint\x18\x01 \x01(\tR\vfingerprint\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12#\n" +
+	"\rpipeline_name\x18\x03 \x01(\tR\fpipelineName\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\x12!\n" +
+	"\fexecution_id\x18\x05 \x01(\tR\vexecutionId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xea\x01\n" +
+	"\x17ListCacheEntriesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12#\n" +
+	"\rpipeline_name\x18\x04 \x01(\tR\fpipelineName\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x17\n" +
+	"\asort_by\x18\a \x01(\tR\x06sortBy\"\xba\x01\n" +
+	"\x18ListCacheEntriesResponse\x12W\n" +
+	"\rcache_entries\x18\x01 \x03(\v22.kubeflow.pipelines.backend.api.v2beta1.CacheEntryR\fcacheEntries\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x02 \x01(\x05R\ttotalSize\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x9b\x01\n" +
+	"\x1dInvalidateCacheEntriesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12#\n" +
+	"\rpipeline_name\x18\x04 \x01(\tR\fpipelineName\"M\n" +
+	"\x1eInvalidateCacheEntriesResponse\x12+\n" +
+	"\x11invalidated_count\x18\x01 \x01(\x05R\x10invalidatedCount2\xa8\x03\n" +
+	"\fCacheService\x12\xba\x01\n" +
+	"\x10ListCacheEntries\x12?.kubeflow.pipelines.backend.api.v2beta1.ListCacheEntriesRequest\x1a@.kubeflow.pipelines.backend.api.v2beta1.ListCacheEntriesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/apis/v2beta1/cache/entries\x12\xda\x01\n" +
+	"\x16InvalidateCacheEntries\x12E.kubeflow.pipelines.backend.api.v2beta1.InvalidateCacheEntriesRequest\x1aF.kubeflow.pipelines.backend.api.v2beta1.InvalidateCacheEntriesResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/apis/v2beta1/cache/entries:invalidateB\x98\x01\x92AX*\x02\x01\x02R#\n" +
+	"\adefault\x12\x18\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusZ\x1f\n" +
+	"\x1d\n" +
+	"\x06Bearer\x12\x13\b\x02\x1a\rauthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00Z;github.com/kubeflow/pipelines/backend/api/v2beta1/go_clientb\x06proto3"
+
+var (
+	file_backend_api_v2beta1_cache_proto_rawDescOnce sync.Once
+	file_backend_api_v2beta1_cache_proto_rawDescData []byte
+)
+
+func file_backend_api_v2beta1_cache_proto_rawDescGZIP() []byte {
+	file_backend_api_v2beta1_cache_proto_rawDescOnce.Do(func() {
+		file_backend_api_v2beta1_cache_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backend_api_v2beta1_cache_proto_rawDesc), len(file_backend_api_v2beta1_cache_proto_rawDesc)))
+	})
+	return file_backend_api_v2beta1_cache_proto_rawDescData
+}
+
+var file_backend_api_v2beta1_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_backend_api_v2beta1_cache_proto_goTypes = []any{
+	(*CacheEntry)(nil),                     // 0: kubeflow.pipelines.backend.api.v2beta1.CacheEntry
+	(*ListCacheEntriesRequest)(nil),        // 1: kubeflow.pipelines.backend.api.v2beta1.ListCacheEntriesRequest
+	(*ListCacheEntriesResponse)(nil),       // 2: kubeflow.pipelines.backend.api.v2beta1.ListCacheEntriesResponse
+	(*InvalidateCacheEntriesRequest)(nil),  // 3: kubeflow.pipelines.backend.api.v2beta1.InvalidateCacheEntriesRequest
+	(*InvalidateCacheEntriesResponse)(nil), // 4: kubeflow.pipelines.backend.api.v2beta1.InvalidateCacheEntriesResponse
+	(*timestamppb.Timestamp)(nil),          // 5: google.protobuf.Timestamp
+}
+var file_backend_api_v2beta1_cache_proto_depIdxs = []int32{
+	5, // 0: kubeflow.pipelines.backend.api.v2beta1.CacheEntry.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: kubeflow.pipelines.backend.api.v2beta1.ListCacheEntriesResponse.cache_entries:type_name -> kubeflow.pipelines.backend.api.v2beta1.CacheEntry
+	1, // 2: kubeflow.pipelines.backend.api.v2beta1.CacheService.ListCacheEntries:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListCacheEntriesRequest
+	3, // 3: kubeflow.pipelines.backend.api.v2beta1.CacheService.InvalidateCacheEntries:input_type -> kubeflow.pipelines.backend.api.v2beta1.InvalidateCacheEntriesRequest
+	2, // 4: kubeflow.pipelines.backend.api.v2beta1.CacheService.ListCacheEntries:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListCacheEntriesResponse
+	4, // 5: kubeflow.pipelines.backend.api.v2beta1.CacheService.InvalidateCacheEntries:output_type -> kubeflow.pipelines.backend.api.v2beta1.InvalidateCacheEntriesResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_backend_api_v2beta1_cache_proto_init() }
+func file_backend_api_v2beta1_cache_proto_init() {
+	if File_backend_api_v2beta1_cache_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_api_v2beta1_cache_proto_rawDesc), len(file_backend_api_v2beta1_cache_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_api_v2beta1_cache_proto_goTypes,
+		DependencyIndexes: file_backend_api_v2beta1_cache_proto_depIdxs,
+		MessageInfos:      file_backend_api_v2beta1_cache_proto_msgTypes,
+	}.Build()
+	File_backend_api_v2beta1_cache_proto = out.File
+	file_backend_api_v2beta1_cache_proto_goTypes = nil
+	file_backend_api_v2beta1_cache_proto_depIdxs = nil
+}
@@ -23,9 +23,11 @@ package go_client
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -108,8 +110,18 @@ type Experiment struct {
 	StorageState Experiment_StorageState `protobuf:"varint,6,opt,name=storage_state,json=storageState,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.Experiment_StorageState" json:"storage_state,omitempty"`
 	// Output. The creation time of the last run in this experiment.
 	LastRunCreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_run_created_at,json=lastRunCreatedAt,proto3" json:"last_run_created_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Optional input field. User-defined labels of the experiment. Keys and
+	// values must be valid Kubernetes label keys and values.
+	Labels map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional input field. Webhooks notified about state transitions of the
+	// runs in this experiment.
+	Notifications []*NotificationSubscription `protobuf:"bytes,9,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	// In case any error happens retrieving an experiment field, only experiment ID
+	// and the error message is returned. Client has the flexibility of choosing
+	// how to handle the error. This is especially useful during listing call.
+	Error         *status.Status `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Experiment) Reset() {
@@ -191,6 +203,27 @@ func (x *Experiment) GetLastRunCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Experiment) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Experiment) GetNotifications() []*NotificationSubscription {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *Experiment) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 type CreateExperimentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The experiment to be created.
@@ -281,6 +314,61 @@ func (x *GetExperimentRequest) GetExperimentId() string {
 	return ""
 }
 
+type UpdateExperimentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The experiment to be updated. The experiment_id field is required.
+	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	// The fields of the experiment to update. Supported paths are
+	// "description", "labels" and "notifications".
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExperimentRequest) Reset() {
+	*x = UpdateExperimentRequest{}
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExperimentRequest) ProtoMessage() {}
+
+func (x *UpdateExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExperimentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateExperimentRequest) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+func (x *UpdateExperimentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type ListExperimentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A page token to request the next page of results. The token is acquried
@@ -305,7 +393,7 @@ type ListExperimentsRequest struct {
 
 func (x *ListExperimentsRequest) Reset() {
 	*x = ListExperimentsRequest{}
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[3]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +405,7 @@ func (x *ListExperimentsRequest) String() string {
 func (*ListExperimentsRequest) ProtoMessage() {}
 
 func (x *ListExperimentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[3]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +418,7 @@ func (x *ListExperimentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExperimentsRequest.ProtoReflect.Descriptor instead.
 func (*ListExperimentsRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{3}
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListExperimentsRequest) GetPageToken() string {
@@ -382,7 +470,7 @@ type ListExperimentsResponse struct {
 
 func (x *ListExperimentsResponse) Reset() {
 	*x = ListExperimentsResponse{}
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[4]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +482,7 @@ func (x *ListExperimentsResponse) String() string {
 func (*ListExperimentsResponse) ProtoMessage() {}
 
 func (x *ListExperimentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[4]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +495,7 @@ func (x *ListExperimentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListExperimentsResponse.ProtoReflect.Descriptor instead.
 func (*ListExperimentsResponse) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{4}
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListExperimentsResponse) GetExperiments() []*Experiment {
@@ -441,7 +529,7 @@ type DeleteExperimentRequest struct {
 
 func (x *DeleteExperimentRequest) Reset() {
 	*x = DeleteExperimentRequest{}
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[5]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +541,7 @@ func (x *DeleteExperimentRequest) String() string {
 func (*DeleteExperimentRequest) ProtoMessage() {}
 
 func (x *DeleteExperimentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[5]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +554,7 @@ func (x *DeleteExperimentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExperimentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteExperimentRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{5}
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteExperimentRequest) GetExperimentId() string {
@@ -486,7 +574,7 @@ type ArchiveExperimentRequest struct {
 
 func (x *ArchiveExperimentRequest) Reset() {
 	*x = ArchiveExperimentRequest{}
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[6]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -498,7 +586,7 @@ func (x *ArchiveExperimentRequest) String() string {
 func (*ArchiveExperimentRequest) ProtoMessage() {}
 
 func (x *ArchiveExperimentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[6]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +599,7 @@ func (x *ArchiveExperimentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveExperimentRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveExperimentRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{6}
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ArchiveExperimentRequest) GetExperimentId() string {
@@ -531,7 +619,7 @@ type UnarchiveExperimentRequest struct {
 
 func (x *UnarchiveExperimentRequest) Reset() {
 	*x = UnarchiveExperimentRequest{}
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -543,7 +631,7 @@ func (x *UnarchiveExperimentRequest) String() string {
 func (*UnarchiveExperimentRequest) ProtoMessage() {}
 
 func (x *UnarchiveExperimentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +644,7 @@ func (x *UnarchiveExperimentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnarchiveExperimentRequest.ProtoReflect.Descriptor instead.
 func (*UnarchiveExperimentRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{7}
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UnarchiveExperimentRequest) GetExperimentId() string {
@@ -566,11 +654,177 @@ func (x *UnarchiveExperimentRequest) GetExperimentId() string {
 	return ""
 }
 
+type BulkExperimentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the experiments. Either experiment IDs or a filter must be set.
+	ExperimentIds []string `protobuf:"bytes,1,rep,name=experiment_ids,json=experimentIds,proto3" json:"experiment_ids,omitempty"`
+	// A url-encoded, JSON-serialized Filter protocol buffer (see
+	// [filter.proto](https://github.com/kubeflow/pipelines/blob/master/backend/api/v2beta1/filter.proto))
+	// that selects the experiments. Cannot be combined with experiment IDs.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional input. The namespace of the experiments selected by the filter.
+	Namespace     string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkExperimentsRequest) Reset() {
+	*x = BulkExperimentsRequest{}
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkExperimentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkExperimentsRequest) ProtoMessage() {}
+
+func (x *BulkExperimentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkExperimentsRequest.ProtoReflect.Descriptor instead.
+func (*BulkExperimentsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BulkExperimentsRequest) GetExperimentIds() []string {
+	if x != nil {
+		return x.ExperimentIds
+	}
+	return nil
+}
+
+func (x *BulkExperimentsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BulkExperimentsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type BulkExperimentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results, in the order of the requested experiment IDs, or of the
+	// experiments selected by the filter.
+	Results       []*BulkExperimentsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkExperimentsResponse) Reset() {
+	*x = BulkExperimentsResponse{}
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkExperimentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkExperimentsResponse) ProtoMessage() {}
+
+func (x *BulkExperimentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkExperimentsResponse.ProtoReflect.Descriptor instead.
+func (*BulkExperimentsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkExperimentsResponse) GetResults() []*BulkExperimentsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The result of the operation on an experiment.
+type BulkExperimentsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the experiment.
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	// The error of the operation on the experiment. Not set if it succeeded.
+	Error         *status.Status `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkExperimentsResponse_Result) Reset() {
+	*x = BulkExperimentsResponse_Result{}
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkExperimentsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkExperimentsResponse_Result) ProtoMessage() {}
+
+func (x *BulkExperimentsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_experiment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkExperimentsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BulkExperimentsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_experiment_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *BulkExperimentsResponse_Result) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *BulkExperimentsResponse_Result) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 var File_backend_api_v2beta1_experiment_proto protoreflect.FileDescriptor
 
 const file_backend_api_v2beta1_experiment_proto_rawDesc = "" +
 	"\n" +
-	"$backend/api/v2beta1/experiment.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xcc\x03\n" +
+	"$backend/api/v2beta1/experiment.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1dbackend/api/v2beta1/run.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf1\x05\n" +
 	"\n" +
 	"Experiment\x12#\n" +
 	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\x12!\n" +
@@ -580,7 +834,14 @@ const file_backend_api_v2beta1_experiment_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1c\n" +
 	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12d\n" +
 	"\rstorage_state\x18\x06 \x01(\x0e2?.kubeflow.pipelines.backend.api.v2beta1.Experiment.StorageStateR\fstorageState\x12I\n" +
-	"\x13last_run_created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10lastRunCreatedAt\"J\n" +
+	"\x13last_run_created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10lastRunCreatedAt\x12V\n" +
+	"\x06labels\x18\b \x03(\v2>.kubeflow.pipelines.backend.api.v2beta1.Experiment.LabelsEntryR\x06labels\x12f\n" +
+	"\rnotifications\x18\t \x03(\v2@.kubeflow.pipelines.backend.api.v2beta1.NotificationSubscriptionR\rnotifications\x12(\n" +
+	"\x05error\x18\n" +
+	" \x01(\v2\x12.google.rpc.StatusR\x05error\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
 	"\fStorageState\x12\x1d\n" +
 	"\x19STORAGE_STATE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tAVAILABLE\x10\x01\x12\f\n" +
@@ -590,7 +851,13 @@ const file_backend_api_v2beta1_experiment_proto_rawDesc = "" +
 	"experiment\x18\x01 \x01(\v22.kubeflow.pipelines.backend.api.v2beta1.ExperimentR\n" +
 	"experiment\";\n" +
 	"\x14GetExperimentRequest\x12#\n" +
-	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\"\xa3\x01\n" +
+	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\"\xaa\x01\n" +
+	"\x17UpdateExperimentRequest\x12R\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v22.kubeflow.pipelines.backend.api.v2beta1.ExperimentR\n" +
+	"experiment\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xa3\x01\n" +
 	"\x16ListExperimentsRequest\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x01 \x01(\tR\tpageToken\x12\x1b\n" +
@@ -608,15 +875,29 @@ const file_backend_api_v2beta1_experiment_proto_rawDesc = "" +
 	"\x18ArchiveExperimentRequest\x12#\n" +
 	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\"A\n" +
 	"\x1aUnarchiveExperimentRequest\x12#\n" +
-	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId2\xb8\b\n" +
+	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\"u\n" +
+	"\x16BulkExperimentsRequest\x12%\n" +
+	"\x0eexperiment_ids\x18\x01 \x03(\tR\rexperimentIds\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"\xd4\x01\n" +
+	"\x17BulkExperimentsResponse\x12`\n" +
+	"\aresults\x18\x01 \x03(\v2F.kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse.ResultR\aresults\x1aW\n" +
+	"\x06Result\x12#\n" +
+	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.google.rpc.StatusR\x05error2\xf8\x0e\n" +
 	"\x11ExperimentService\x12\xb6\x01\n" +
 	"\x10CreateExperiment\x12?.kubeflow.pipelines.backend.api.v2beta1.CreateExperimentRequest\x1a2.kubeflow.pipelines.backend.api.v2beta1.Experiment\"-\x82\xd3\xe4\x93\x02':\n" +
 	"experiment\"\x19/apis/v2beta1/experiments\x12\xb4\x01\n" +
 	"\rGetExperiment\x12<.kubeflow.pipelines.backend.api.v2beta1.GetExperimentRequest\x1a2.kubeflow.pipelines.backend.api.v2beta1.Experiment\"1\x82\xd3\xe4\x93\x02+\x12)/apis/v2beta1/experiments/{experiment_id}\x12\xb5\x01\n" +
-	"\x0fListExperiments\x12>.kubeflow.pipelines.backend.api.v2beta1.ListExperimentsRequest\x1a?.kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/apis/v2beta1/experiments\x12\xa8\x01\n" +
+	"\x0fListExperiments\x12>.kubeflow.pipelines.backend.api.v2beta1.ListExperimentsRequest\x1a?.kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/apis/v2beta1/experiments\x12\xd1\x01\n" +
+	"\x10UpdateExperiment\x12?.kubeflow.pipelines.backend.api.v2beta1.UpdateExperimentRequest\x1a2.kubeflow.pipelines.backend.api.v2beta1.Experiment\"H\x82\xd3\xe4\x93\x02B:\n" +
+	"experiment24/apis/v2beta1/experiments/{experiment.experiment_id}\x12\xa8\x01\n" +
 	"\x11ArchiveExperiment\x12@.kubeflow.pipelines.backend.api.v2beta1.ArchiveExperimentRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023\"1/apis/v2beta1/experiments/{experiment_id}:archive\x12\xae\x01\n" +
 	"\x13UnarchiveExperiment\x12B.kubeflow.pipelines.backend.api.v2beta1.UnarchiveExperimentRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025\"3/apis/v2beta1/experiments/{experiment_id}:unarchive\x12\x9e\x01\n" +
-	"\x10DeleteExperiment\x12?.kubeflow.pipelines.backend.api.v2beta1.DeleteExperimentRequest\x1a\x16.google.protobuf.Empty\"1\x82\xd3\xe4\x93\x02+*)/apis/v2beta1/experiments/{experiment_id}BD\x92A\x04*\x02\x01\x02Z;github.com/kubeflow/pipelines/backend/api/v2beta1/go_clientb\x06proto3"
+	"\x10DeleteExperiment\x12?.kubeflow.pipelines.backend.api.v2beta1.DeleteExperimentRequest\x1a\x16.google.protobuf.Empty\"1\x82\xd3\xe4\x93\x02+*)/apis/v2beta1/experiments/{experiment_id}\x12\xcb\x01\n" +
+	"\x16BulkArchiveExperiments\x12>.kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsRequest\x1a?.kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/apis/v2beta1/experiments:bulkArchive\x12\xcf\x01\n" +
+	"\x18BulkUnarchiveExperiments\x12>.kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsRequest\x1a?.kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/apis/v2beta1/experiments:bulkUnarchive\x12\xc9\x01\n" +
+	"\x15BulkDeleteExperiments\x12>.kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsRequest\x1a?.kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/apis/v2beta1/experiments:bulkDeleteBD\x92A\x04*\x02\x01\x02Z;github.com/kubeflow/pipelines/backend/api/v2beta1/go_clientb\x06proto3"
 
 var (
 	file_backend_api_v2beta1_experiment_proto_rawDescOnce sync.Once
@@ -631,43 +912,66 @@ func file_backend_api_v2beta1_experiment_proto_rawDescGZIP() []byte {
 }
 
 var file_backend_api_v2beta1_experiment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_backend_api_v2beta1_experiment_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_backend_api_v2beta1_experiment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_backend_api_v2beta1_experiment_proto_goTypes = []any{
-	(Experiment_StorageState)(0),       // 0: kubeflow.pipelines.backend.api.v2beta1.Experiment.StorageState
-	(*Experiment)(nil),                 // 1: kubeflow.pipelines.backend.api.v2beta1.Experiment
-	(*CreateExperimentRequest)(nil),    // 2: kubeflow.pipelines.backend.api.v2beta1.CreateExperimentRequest
-	(*GetExperimentRequest)(nil),       // 3: kubeflow.pipelines.backend.api.v2beta1.GetExperimentRequest
-	(*ListExperimentsRequest)(nil),     // 4: kubeflow.pipelines.backend.api.v2beta1.ListExperimentsRequest
-	(*ListExperimentsResponse)(nil),    // 5: kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse
-	(*DeleteExperimentRequest)(nil),    // 6: kubeflow.pipelines.backend.api.v2beta1.DeleteExperimentRequest
-	(*ArchiveExperimentRequest)(nil),   // 7: kubeflow.pipelines.backend.api.v2beta1.ArchiveExperimentRequest
-	(*UnarchiveExperimentRequest)(nil), // 8: kubeflow.pipelines.backend.api.v2beta1.UnarchiveExperimentRequest
-	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 10: google.protobuf.Empty
+	(Experiment_StorageState)(0),           // 0: kubeflow.pipelines.backend.api.v2beta1.Experiment.StorageState
+	(*Experiment)(nil),                     // 1: kubeflow.pipelines.backend.api.v2beta1.Experiment
+	(*CreateExperimentRequest)(nil),        // 2: kubeflow.pipelines.backend.api.v2beta1.CreateExperimentRequest
+	(*GetExperimentRequest)(nil),           // 3: kubeflow.pipelines.backend.api.v2beta1.GetExperimentRequest
+	(*UpdateExperimentRequest)(nil),        // 4: kubeflow.pipelines.backend.api.v2beta1.UpdateExperimentRequest
+	(*ListExperimentsRequest)(nil),         // 5: kubeflow.pipelines.backend.api.v2beta1.ListExperimentsRequest
+	(*ListExperimentsResponse)(nil),        // 6: kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse
+	(*DeleteExperimentRequest)(nil),        // 7: kubeflow.pipelines.backend.api.v2beta1.DeleteExperimentRequest
+	(*ArchiveExperimentRequest)(nil),       // 8: kubeflow.pipelines.backend.api.v2beta1.ArchiveExperimentRequest
+	(*UnarchiveExperimentRequest)(nil),     // 9: kubeflow.pipelines.backend.api.v2beta1.UnarchiveExperimentRequest
+	(*BulkExperimentsRequest)(nil),         // 10: kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsRequest
+	(*BulkExperimentsResponse)(nil),        // 11: kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse
+	nil,                                    // 12: kubeflow.pipelines.backend.api.v2beta1.Experiment.LabelsEntry
+	(*BulkExperimentsResponse_Result)(nil), // 13: kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse.Result
+	(*timestamppb.Timestamp)(nil),          // 14: google.protobuf.Timestamp
+	(*NotificationSubscription)(nil),       // 15: kubeflow.pipelines.backend.api.v2beta1.NotificationSubscription
+	(*status.Status)(nil),                  // 16: google.rpc.Status
+	(*fieldmaskpb.FieldMask)(nil),          // 17: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                  // 18: google.protobuf.Empty
 }
 var file_backend_api_v2beta1_experiment_proto_depIdxs = []int32{
-	9,  // 0: kubeflow.pipelines.backend.api.v2beta1.Experiment.created_at:type_name -> google.protobuf.Timestamp
+	14, // 0: kubeflow.pipelines.backend.api.v2beta1.Experiment.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 1: kubeflow.pipelines.backend.api.v2beta1.Experiment.storage_state:type_name -> kubeflow.pipelines.backend.api.v2beta1.Experiment.StorageState
-	9,  // 2: kubeflow.pipelines.backend.api.v2beta1.Experiment.last_run_created_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: kubeflow.pipelines.backend.api.v2beta1.CreateExperimentRequest.experiment:type_name -> kubeflow.pipelines.backend.api.v2beta1.Experiment
-	1,  // 4: kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse.experiments:type_name -> kubeflow.pipelines.backend.api.v2beta1.Experiment
-	2,  // 5: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.CreateExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreateExperimentRequest
-	3,  // 6: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.GetExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetExperimentRequest
-	4,  // 7: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ListExperiments:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListExperimentsRequest
-	7,  // 8: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ArchiveExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.ArchiveExperimentRequest
-	8,  // 9: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.UnarchiveExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.UnarchiveExperimentRequest
-	6,  // 10: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.DeleteExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeleteExperimentRequest
-	1,  // 11: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.CreateExperiment:output_type -> kubeflow.pipelines.backend.api.v2beta1.Experiment
-	1,  // 12: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.GetExperiment:output_type -> kubeflow.pipelines.backend.api.v2beta1.Experiment
-	5,  // 13: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ListExperiments:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse
-	10, // 14: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ArchiveExperiment:output_type -> google.protobuf.Empty
-	10, // 15: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.UnarchiveExperiment:output_type -> google.protobuf.Empty
-	10, // 16: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.DeleteExperiment:output_type -> google.protobuf.Empty
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	14, // 2: kubeflow.pipelines.backend.api.v2beta1.Experiment.last_run_created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: kubeflow.pipelines.backend.api.v2beta1.Experiment.labels:type_name -> kubeflow.pipelines.backend.api.v2beta1.Experiment.LabelsEntry
+	15, // 4: kubeflow.pipelines.backend.api.v2beta1.Experiment.notifications:type_name -> kubeflow.pipelines.backend.api.v2beta1.NotificationSubscription
+	16, // 5: kubeflow.pipelines.backend.api.v2beta1.Experiment.error:type_name -> google.rpc.Status
+	1,  // 6: kubeflow.pipelines.backend.api.v2beta1.CreateExperimentRequest.experiment:type_name -> kubeflow.pipelines.backend.api.v2beta1.Experiment
+	1,  // 7: kubeflow.pipelines.backend.api.v2beta1.UpdateExperimentRequest.experiment:type_name -> kubeflow.pipelines.backend.api.v2beta1.Experiment
+	17, // 8: kubeflow.pipelines.backend.api.v2beta1.UpdateExperimentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 9: kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse.experiments:type_name -> kubeflow.pipelines.backend.api.v2beta1.Experiment
+	13, // 10: kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse.results:type_name -> kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse.Result
+	16, // 11: kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse.Result.error:type_name -> google.rpc.Status
+	2,  // 12: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.CreateExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreateExperimentRequest
+	3,  // 13: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.GetExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetExperimentRequest
+	5,  // 14: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ListExperiments:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListExperimentsRequest
+	4,  // 15: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.UpdateExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.UpdateExperimentRequest
+	8,  // 16: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ArchiveExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.ArchiveExperimentRequest
+	9,  // 17: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.UnarchiveExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.UnarchiveExperimentRequest
+	7,  // 18: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.DeleteExperiment:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeleteExperimentRequest
+	10, // 19: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.BulkArchiveExperiments:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsRequest
+	10, // 20: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.BulkUnarchiveExperiments:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsRequest
+	10, // 21: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.BulkDeleteExperiments:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsRequest
+	1,  // 22: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.CreateExperiment:output_type -> kubeflow.pipelines.backend.api.v2beta1.Experiment
+	1,  // 23: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.GetExperiment:output_type -> kubeflow.pipelines.backend.api.v2beta1.Experiment
+	6,  // 24: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ListExperiments:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListExperimentsResponse
+	1,  // 25: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.UpdateExperiment:output_type -> kubeflow.pipelines.backend.api.v2beta1.Experiment
+	18, // 26: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.ArchiveExperiment:output_type -> google.protobuf.Empty
+	18, // 27: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.UnarchiveExperiment:output_type -> google.protobuf.Empty
+	18, // 28: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.DeleteExperiment:output_type -> google.protobuf.Empty
+	11, // 29: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.BulkArchiveExperiments:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse
+	11, // 30: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.BulkUnarchiveExperiments:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse
+	11, // 31: kubeflow.pipelines.backend.api.v2beta1.ExperimentService.BulkDeleteExperiments:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkExperimentsResponse
+	22, // [22:32] is the sub-list for method output_type
+	12, // [12:22] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v2beta1_experiment_proto_init() }
@@ -675,13 +979,14 @@ func file_backend_api_v2beta1_experiment_proto_init() {
 	if File_backend_api_v2beta1_experiment_proto != nil {
 		return
 	}
+	file_backend_api_v2beta1_run_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_api_v2beta1_experiment_proto_rawDesc), len(file_backend_api_v2beta1_experiment_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
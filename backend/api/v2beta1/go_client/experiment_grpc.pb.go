@@ -34,12 +34,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExperimentService_CreateExperiment_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/CreateExperiment"
-	ExperimentService_GetExperiment_FullMethodName       = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/GetExperiment"
-	ExperimentService_ListExperiments_FullMethodName     = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/ListExperiments"
-	ExperimentService_ArchiveExperiment_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/ArchiveExperiment"
-	ExperimentService_UnarchiveExperiment_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/UnarchiveExperiment"
-	ExperimentService_DeleteExperiment_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/DeleteExperiment"
+	ExperimentService_CreateExperiment_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/CreateExperiment"
+	ExperimentService_GetExperiment_FullMethodName            = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/GetExperiment"
+	ExperimentService_ListExperiments_FullMethodName          = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/ListExperiments"
+	ExperimentService_UpdateExperiment_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/UpdateExperiment"
+	ExperimentService_ArchiveExperiment_FullMethodName        = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/ArchiveExperiment"
+	ExperimentService_UnarchiveExperiment_FullMethodName      = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/UnarchiveExperiment"
+	ExperimentService_DeleteExperiment_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/DeleteExperiment"
+	ExperimentService_BulkArchiveExperiments_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/BulkArchiveExperiments"
+	ExperimentService_BulkUnarchiveExperiments_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/BulkUnarchiveExperiments"
+	ExperimentService_BulkDeleteExperiments_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.ExperimentService/BulkDeleteExperiments"
 )
 
 // ExperimentServiceClient is the client API for ExperimentService service.
@@ -52,6 +56,9 @@ type ExperimentServiceClient interface {
 	GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*Experiment, error)
 	// Finds all experiments. Supports pagination, and sorting on certain fields.
 	ListExperiments(ctx context.Context, in *ListExperimentsRequest, opts ...grpc.CallOption) (*ListExperimentsResponse, error)
+	// Updates the description and labels of an experiment. Only the fields
+	// listed in the update mask are changed.
+	UpdateExperiment(ctx context.Context, in *UpdateExperimentRequest, opts ...grpc.CallOption) (*Experiment, error)
 	// Archives an experiment and the experiment's runs and recurring runs.
 	ArchiveExperiment(ctx context.Context, in *ArchiveExperimentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Restores an archived experiment. The experiment's archived runs and recurring
@@ -61,6 +68,18 @@ type ExperimentServiceClient interface {
 	// runs. To avoid unexpected behaviors, delete an experiment's runs and recurring
 	// runs before deleting the experiment.
 	DeleteExperiment(ctx context.Context, in *DeleteExperimentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Archives the experiments with the given IDs, or the experiments matching a
+	// filter, in batches, along with their runs and recurring runs. Returns the
+	// result of each experiment.
+	BulkArchiveExperiments(ctx context.Context, in *BulkExperimentsRequest, opts ...grpc.CallOption) (*BulkExperimentsResponse, error)
+	// Restores the experiments with the given IDs, or the experiments matching a
+	// filter, in batches. Their archived runs and recurring runs stay archived.
+	// Returns the result of each experiment.
+	BulkUnarchiveExperiments(ctx context.Context, in *BulkExperimentsRequest, opts ...grpc.CallOption) (*BulkExperimentsResponse, error)
+	// Deletes the experiments with the given IDs, or the experiments matching a
+	// filter, in batches, without deleting their runs and recurring runs. Returns
+	// the result of each experiment.
+	BulkDeleteExperiments(ctx context.Context, in *BulkExperimentsRequest, opts ...grpc.CallOption) (*BulkExperimentsResponse, error)
 }
 
 type experimentServiceClient struct {
@@ -101,6 +120,16 @@ func (c *experimentServiceClient) ListExperiments(ctx context.Context, in *ListE
 	return out, nil
 }
 
+func (c *experimentServiceClient) UpdateExperiment(ctx context.Context, in *UpdateExperimentRequest, opts ...grpc.CallOption) (*Experiment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Experiment)
+	err := c.cc.Invoke(ctx, ExperimentService_UpdateExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *experimentServiceClient) ArchiveExperiment(ctx context.Context, in *ArchiveExperimentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -131,6 +160,36 @@ func (c *experimentServiceClient) DeleteExperiment(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *experimentServiceClient) BulkArchiveExperiments(ctx context.Context, in *BulkExperimentsRequest, opts ...grpc.CallOption) (*BulkExperimentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkExperimentsResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_BulkArchiveExperiments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentServiceClient) BulkUnarchiveExperiments(ctx context.Context, in *BulkExperimentsRequest, opts ...grpc.CallOption) (*BulkExperimentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkExperimentsResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_BulkUnarchiveExperiments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentServiceClient) BulkDeleteExperiments(ctx context.Context, in *BulkExperimentsRequest, opts ...grpc.CallOption) (*BulkExperimentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkExperimentsResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_BulkDeleteExperiments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExperimentServiceServer is the server API for ExperimentService service.
 // All implementations must embed UnimplementedExperimentServiceServer
 // for forward compatibility.
@@ -141,6 +200,9 @@ type ExperimentServiceServer interface {
 	GetExperiment(context.Context, *GetExperimentRequest) (*Experiment, error)
 	// Finds all experiments. Supports pagination, and sorting on certain fields.
 	ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error)
+	// Updates the description and labels of an experiment. Only the fields
+	// listed in the update mask are changed.
+	UpdateExperiment(context.Context, *UpdateExperimentRequest) (*Experiment, error)
 	// Archives an experiment and the experiment's runs and recurring runs.
 	ArchiveExperiment(context.Context, *ArchiveExperimentRequest) (*emptypb.Empty, error)
 	// Restores an archived experiment. The experiment's archived runs and recurring
@@ -150,6 +212,18 @@ type ExperimentServiceServer interface {
 	// runs. To avoid unexpected behaviors, delete an experiment's runs and recurring
 	// runs before deleting the experiment.
 	DeleteExperiment(context.Context, *DeleteExperimentRequest) (*emptypb.Empty, error)
+	// Archives the experiments with the given IDs, or the experiments matching a
+	// filter, in batches, along with their runs and recurring runs. Returns the
+	// result of each experiment.
+	BulkArchiveExperiments(context.Context, *BulkExperimentsRequest) (*BulkExperimentsResponse, error)
+	// Restores the experiments with the given IDs, or the experiments matching a
+	// filter, in batches. Their archived runs and recurring runs stay archived.
+	// Returns the result of each experiment.
+	BulkUnarchiveExperiments(context.Context, *BulkExperimentsRequest) (*BulkExperimentsResponse, error)
+	// Deletes the experiments with the given IDs, or the experiments matching a
+	// filter, in batches, without deleting their runs and recurring runs. Returns
+	// the result of each experiment.
+	BulkDeleteExperiments(context.Context, *BulkExperimentsRequest) (*BulkExperimentsResponse, error)
 	mustEmbedUnimplementedExperimentServiceServer()
 }
 
@@ -169,6 +243,9 @@ func (UnimplementedExperimentServiceServer) GetExperiment(context.Context, *GetE
 func (UnimplementedExperimentServiceServer) ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListExperiments not implemented")
 }
+func (UnimplementedExperimentServiceServer) UpdateExperiment(context.Context, *UpdateExperimentRequest) (*Experiment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExperiment not implemented")
+}
 func (UnimplementedExperimentServiceServer) ArchiveExperiment(context.Context, *ArchiveExperimentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveExperiment not implemented")
 }
@@ -178,6 +255,15 @@ func (UnimplementedExperimentServiceServer) UnarchiveExperiment(context.Context,
 func (UnimplementedExperimentServiceServer) DeleteExperiment(context.Context, *DeleteExperimentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteExperiment not implemented")
 }
+func (UnimplementedExperimentServiceServer) BulkArchiveExperiments(context.Context, *BulkExperimentsRequest) (*BulkExperimentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkArchiveExperiments not implemented")
+}
+func (UnimplementedExperimentServiceServer) BulkUnarchiveExperiments(context.Context, *BulkExperimentsRequest) (*BulkExperimentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUnarchiveExperiments not implemented")
+}
+func (UnimplementedExperimentServiceServer) BulkDeleteExperiments(context.Context, *BulkExperimentsRequest) (*BulkExperimentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteExperiments not implemented")
+}
 func (UnimplementedExperimentServiceServer) mustEmbedUnimplementedExperimentServiceServer() {}
 func (UnimplementedExperimentServiceServer) testEmbeddedByValue()                           {}
 
@@ -253,6 +339,24 @@ func _ExperimentService_ListExperiments_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExperimentService_UpdateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).UpdateExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_UpdateExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).UpdateExperiment(ctx, req.(*UpdateExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExperimentService_ArchiveExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveExperimentRequest)
 	if err := dec(in); err != nil {
@@ -307,6 +411,60 @@ func _ExperimentService_DeleteExperiment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExperimentService_BulkArchiveExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).BulkArchiveExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_BulkArchiveExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).BulkArchiveExperiments(ctx, req.(*BulkExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentService_BulkUnarchiveExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).BulkUnarchiveExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_BulkUnarchiveExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).BulkUnarchiveExperiments(ctx, req.(*BulkExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentService_BulkDeleteExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).BulkDeleteExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_BulkDeleteExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).BulkDeleteExperiments(ctx, req.(*BulkExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExperimentService_ServiceDesc is the grpc.ServiceDesc for ExperimentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -326,6 +484,10 @@ var ExperimentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListExperiments",
 			Handler:    _ExperimentService_ListExperiments_Handler,
 		},
+		{
+			MethodName: "UpdateExperiment",
+			Handler:    _ExperimentService_UpdateExperiment_Handler,
+		},
 		{
 			MethodName: "ArchiveExperiment",
 			Handler:    _ExperimentService_ArchiveExperiment_Handler,
@@ -338,6 +500,18 @@ var ExperimentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExperiment",
 			Handler:    _ExperimentService_DeleteExperiment_Handler,
 		},
+		{
+			MethodName: "BulkArchiveExperiments",
+			Handler:    _ExperimentService_BulkArchiveExperiments_Handler,
+		},
+		{
+			MethodName: "BulkUnarchiveExperiments",
+			Handler:    _ExperimentService_BulkUnarchiveExperiments_Handler,
+		},
+		{
+			MethodName: "BulkDeleteExperiments",
+			Handler:    _ExperimentService_BulkDeleteExperiments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/v2beta1/experiment.proto",
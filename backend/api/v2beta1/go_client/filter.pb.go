@@ -36,6 +36,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Operator is the boolean operator applied to the operands of the group.
+type FilterGroup_Operator int32
+
+const (
+	// Default operator. This operator is not used.
+	FilterGroup_OPERATOR_UNSPECIFIED FilterGroup_Operator = 0
+	// True if all of the operands are true.
+	FilterGroup_AND FilterGroup_Operator = 1
+	// True if at least one of the operands is true.
+	FilterGroup_OR FilterGroup_Operator = 2
+	// True if the AND of the operands is false.
+	FilterGroup_NOT FilterGroup_Operator = 3
+)
+
+// Enum value maps for FilterGroup_Operator.
+var (
+	FilterGroup_Operator_name = map[int32]string{
+		0: "OPERATOR_UNSPECIFIED",
+		1: "AND",
+		2: "OR",
+		3: "NOT",
+	}
+	FilterGroup_Operator_value = map[string]int32{
+		"OPERATOR_UNSPECIFIED": 0,
+		"AND":                  1,
+		"OR":                   2,
+		"NOT":                  3,
+	}
+)
+
+func (x FilterGroup_Operator) Enum() *FilterGroup_Operator {
+	p := new(FilterGroup_Operator)
+	*p = x
+	return p
+}
+
+func (x FilterGroup_Operator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterGroup_Operator) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_filter_proto_enumTypes[0].Descriptor()
+}
+
+func (FilterGroup_Operator) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_filter_proto_enumTypes[0]
+}
+
+func (x FilterGroup_Operator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterGroup_Operator.Descriptor instead.
+func (FilterGroup_Operator) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // Operation is the operation to apply.
 type Predicate_Operation int32
 
@@ -43,7 +100,7 @@ const (
 	// Default operation. This operation is not used.
 	Predicate_OPERATION_UNSPECIFIED Predicate_Operation = 0
 	// Operation on scalar values. Only applies to one of |int_value|,
-	// |long_value|, |string_value| or |timestamp_value|.
+	// |long_value|, |string_value|, |timestamp_value| or |double_value|.
 	Predicate_EQUALS Predicate_Operation = 1
 	// Negated EQUALS.
 	Predicate_NOT_EQUALS Predicate_Operation = 2
@@ -100,11 +157,11 @@ func (x Predicate_Operation) String() string {
 }
 
 func (Predicate_Operation) Descriptor() protoreflect.EnumDescriptor {
-	return file_backend_api_v2beta1_filter_proto_enumTypes[0].Descriptor()
+	return file_backend_api_v2beta1_filter_proto_enumTypes[1].Descriptor()
 }
 
 func (Predicate_Operation) Type() protoreflect.EnumType {
-	return &file_backend_api_v2beta1_filter_proto_enumTypes[0]
+	return &file_backend_api_v2beta1_filter_proto_enumTypes[1]
 }
 
 func (x Predicate_Operation) Number() protoreflect.EnumNumber {
@@ -113,7 +170,7 @@ func (x Predicate_Operation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Predicate_Operation.Descriptor instead.
 func (Predicate_Operation) EnumDescriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{1, 0}
+	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{2, 0}
 }
 
 // Filter is used to filter resources returned from a ListXXX request.
@@ -158,10 +215,40 @@ func (Predicate_Operation) EnumDescriptor() ([]byte, []int) {
 //	    }
 //	  }
 //	}
+//
+// 4) Filter runs that failed or were canceled, and whose name does not
+// contain 'tmp'
+//
+//	filter {
+//	  groups {
+//	    operator: OR
+//	    predicates {
+//	      key: "state"
+//	      operation: EQUALS
+//	      string_value: "FAILED"
+//	    }
+//	    predicates {
+//	      key: "state"
+//	      operation: EQUALS
+//	      string_value: "CANCELED"
+//	    }
+//	  }
+//	  groups {
+//	    operator: NOT
+//	    predicates {
+//	      key: "name"
+//	      operation: IS_SUBSTRING
+//	      string_value: "tmp"
+//	    }
+//	  }
+//	}
 type Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// All predicates are AND-ed when this filter is applied.
-	Predicates    []*Predicate `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	Predicates []*Predicate `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// Groups of predicates combined with a boolean operator. Each group is
+	// AND-ed with the predicates above and with the other groups.
+	Groups        []*FilterGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -203,6 +290,77 @@ func (x *Filter) GetPredicates() []*Predicate {
 	return nil
 }
 
+func (x *Filter) GetGroups() []*FilterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// FilterGroup combines predicates and nested groups with a boolean operator.
+// A group must contain at least one predicate or nested group.
+type FilterGroup struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Operator FilterGroup_Operator   `protobuf:"varint,1,opt,name=operator,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.FilterGroup_Operator" json:"operator,omitempty"`
+	// Predicates that are operands of this group.
+	Predicates []*Predicate `protobuf:"bytes,2,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// Nested groups that are operands of this group.
+	Groups        []*FilterGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterGroup) Reset() {
+	*x = FilterGroup{}
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterGroup) ProtoMessage() {}
+
+func (x *FilterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterGroup.ProtoReflect.Descriptor instead.
+func (*FilterGroup) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FilterGroup) GetOperator() FilterGroup_Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return FilterGroup_OPERATOR_UNSPECIFIED
+}
+
+func (x *FilterGroup) GetPredicates() []*Predicate {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+func (x *FilterGroup) GetGroups() []*FilterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // Predicate captures individual conditions that must be true for a resource
 // being filtered.
 type Predicate struct {
@@ -221,6 +379,7 @@ type Predicate struct {
 	//	*Predicate_IntValues_
 	//	*Predicate_LongValues_
 	//	*Predicate_StringValues_
+	//	*Predicate_DoubleValue
 	Value         isPredicate_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -228,7 +387,7 @@ type Predicate struct {
 
 func (x *Predicate) Reset() {
 	*x = Predicate{}
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[1]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +399,7 @@ func (x *Predicate) String() string {
 func (*Predicate) ProtoMessage() {}
 
 func (x *Predicate) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[1]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +412,7 @@ func (x *Predicate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Predicate.ProtoReflect.Descriptor instead.
 func (*Predicate) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{1}
+	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Predicate) GetOperation() Predicate_Operation {
@@ -340,6 +499,15 @@ func (x *Predicate) GetStringValues() *Predicate_StringValues {
 	return nil
 }
 
+func (x *Predicate) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*Predicate_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
 type isPredicate_Value interface {
 	isPredicate_Value()
 }
@@ -380,6 +548,11 @@ type Predicate_StringValues_ struct {
 	StringValues *Predicate_StringValues `protobuf:"bytes,9,opt,name=string_values,json=stringValues,proto3,oneof"`
 }
 
+type Predicate_DoubleValue struct {
+	// Double, e.g. to compare run metrics.
+	DoubleValue float64 `protobuf:"fixed64,10,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
 func (*Predicate_IntValue) isPredicate_Value() {}
 
 func (*Predicate_LongValue) isPredicate_Value() {}
@@ -394,6 +567,8 @@ func (*Predicate_LongValues_) isPredicate_Value() {}
 
 func (*Predicate_StringValues_) isPredicate_Value() {}
 
+func (*Predicate_DoubleValue) isPredicate_Value() {}
+
 // List of integers.
 type Predicate_IntValues struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -404,7 +579,7 @@ type Predicate_IntValues struct {
 
 func (x *Predicate_IntValues) Reset() {
 	*x = Predicate_IntValues{}
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[2]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +591,7 @@ func (x *Predicate_IntValues) String() string {
 func (*Predicate_IntValues) ProtoMessage() {}
 
 func (x *Predicate_IntValues) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[2]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +604,7 @@ func (x *Predicate_IntValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Predicate_IntValues.ProtoReflect.Descriptor instead.
 func (*Predicate_IntValues) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{1, 0}
+	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *Predicate_IntValues) GetValues() []int32 {
@@ -449,7 +624,7 @@ type Predicate_StringValues struct {
 
 func (x *Predicate_StringValues) Reset() {
 	*x = Predicate_StringValues{}
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[3]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +636,7 @@ func (x *Predicate_StringValues) String() string {
 func (*Predicate_StringValues) ProtoMessage() {}
 
 func (x *Predicate_StringValues) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[3]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +649,7 @@ func (x *Predicate_StringValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Predicate_StringValues.ProtoReflect.Descriptor instead.
 func (*Predicate_StringValues) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{1, 1}
+	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *Predicate_StringValues) GetValues() []string {
@@ -494,7 +669,7 @@ type Predicate_LongValues struct {
 
 func (x *Predicate_LongValues) Reset() {
 	*x = Predicate_LongValues{}
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[4]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +681,7 @@ func (x *Predicate_LongValues) String() string {
 func (*Predicate_LongValues) ProtoMessage() {}
 
 func (x *Predicate_LongValues) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[4]
+	mi := &file_backend_api_v2beta1_filter_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +694,7 @@ func (x *Predicate_LongValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Predicate_LongValues.ProtoReflect.Descriptor instead.
 func (*Predicate_LongValues) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{1, 2}
+	return file_backend_api_v2beta1_filter_proto_rawDescGZIP(), []int{2, 2}
 }
 
 func (x *Predicate_LongValues) GetValues() []int64 {
@@ -533,11 +708,23 @@ var File_backend_api_v2beta1_filter_proto protoreflect.FileDescriptor
 
 const file_backend_api_v2beta1_filter_proto_rawDesc = "" +
 	"\n" +
-	" backend/api/v2beta1/filter.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1fgoogle/protobuf/timestamp.proto\"[\n" +
+	" backend/api/v2beta1/filter.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x01\n" +
 	"\x06Filter\x12Q\n" +
 	"\n" +
 	"predicates\x18\x01 \x03(\v21.kubeflow.pipelines.backend.api.v2beta1.PredicateR\n" +
-	"predicates\"\xf5\x06\n" +
+	"predicates\x12K\n" +
+	"\x06groups\x18\x02 \x03(\v23.kubeflow.pipelines.backend.api.v2beta1.FilterGroupR\x06groups\"\xc7\x02\n" +
+	"\vFilterGroup\x12X\n" +
+	"\boperator\x18\x01 \x01(\x0e2<.kubeflow.pipelines.backend.api.v2beta1.FilterGroup.OperatorR\boperator\x12Q\n" +
+	"\n" +
+	"predicates\x18\x02 \x03(\v21.kubeflow.pipelines.backend.api.v2beta1.PredicateR\n" +
+	"predicates\x12K\n" +
+	"\x06groups\x18\x03 \x03(\v23.kubeflow.pipelines.backend.api.v2beta1.FilterGroupR\x06groups\">\n" +
+	"\bOperator\x12\x18\n" +
+	"\x14OPERATOR_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03AND\x10\x01\x12\x06\n" +
+	"\x02OR\x10\x02\x12\a\n" +
+	"\x03NOT\x10\x03\"\x9a\a\n" +
 	"\tPredicate\x12Y\n" +
 	"\toperation\x18\x01 \x01(\x0e2;.kubeflow.pipelines.backend.api.v2beta1.Predicate.OperationR\toperation\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
@@ -550,7 +737,9 @@ const file_backend_api_v2beta1_filter_proto_rawDesc = "" +
 	"int_values\x18\a \x01(\v2;.kubeflow.pipelines.backend.api.v2beta1.Predicate.IntValuesH\x00R\tintValues\x12_\n" +
 	"\vlong_values\x18\b \x01(\v2<.kubeflow.pipelines.backend.api.v2beta1.Predicate.LongValuesH\x00R\n" +
 	"longValues\x12e\n" +
-	"\rstring_values\x18\t \x01(\v2>.kubeflow.pipelines.backend.api.v2beta1.Predicate.StringValuesH\x00R\fstringValues\x1a#\n" +
+	"\rstring_values\x18\t \x01(\v2>.kubeflow.pipelines.backend.api.v2beta1.Predicate.StringValuesH\x00R\fstringValues\x12#\n" +
+	"\fdouble_value\x18\n" +
+	" \x01(\x01H\x00R\vdoubleValue\x1a#\n" +
 	"\tIntValues\x12\x16\n" +
 	"\x06values\x18\x01 \x03(\x05R\x06values\x1a&\n" +
 	"\fStringValues\x12\x16\n" +
@@ -586,31 +775,37 @@ func file_backend_api_v2beta1_filter_proto_rawDescGZIP() []byte {
 	return file_backend_api_v2beta1_filter_proto_rawDescData
 }
 
-var file_backend_api_v2beta1_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_backend_api_v2beta1_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_backend_api_v2beta1_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_backend_api_v2beta1_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_backend_api_v2beta1_filter_proto_goTypes = []any{
-	(Predicate_Operation)(0),       // 0: kubeflow.pipelines.backend.api.v2beta1.Predicate.Operation
-	(*Filter)(nil),                 // 1: kubeflow.pipelines.backend.api.v2beta1.Filter
-	(*Predicate)(nil),              // 2: kubeflow.pipelines.backend.api.v2beta1.Predicate
-	(*Predicate_IntValues)(nil),    // 3: kubeflow.pipelines.backend.api.v2beta1.Predicate.IntValues
-	(*Predicate_StringValues)(nil), // 4: kubeflow.pipelines.backend.api.v2beta1.Predicate.StringValues
-	(*Predicate_LongValues)(nil),   // 5: kubeflow.pipelines.backend.api.v2beta1.Predicate.LongValues
-	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+	(FilterGroup_Operator)(0),      // 0: kubeflow.pipelines.backend.api.v2beta1.FilterGroup.Operator
+	(Predicate_Operation)(0),       // 1: kubeflow.pipelines.backend.api.v2beta1.Predicate.Operation
+	(*Filter)(nil),                 // 2: kubeflow.pipelines.backend.api.v2beta1.Filter
+	(*FilterGroup)(nil),            // 3: kubeflow.pipelines.backend.api.v2beta1.FilterGroup
+	(*Predicate)(nil),              // 4: kubeflow.pipelines.backend.api.v2beta1.Predicate
+	(*Predicate_IntValues)(nil),    // 5: kubeflow.pipelines.backend.api.v2beta1.Predicate.IntValues
+	(*Predicate_StringValues)(nil), // 6: kubeflow.pipelines.backend.api.v2beta1.Predicate.StringValues
+	(*Predicate_LongValues)(nil),   // 7: kubeflow.pipelines.backend.api.v2beta1.Predicate.LongValues
+	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
 }
 var file_backend_api_v2beta1_filter_proto_depIdxs = []int32{
-	2, // 0: kubeflow.pipelines.backend.api.v2beta1.Filter.predicates:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate
-	0, // 1: kubeflow.pipelines.backend.api.v2beta1.Predicate.operation:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.Operation
-	6, // 2: kubeflow.pipelines.backend.api.v2beta1.Predicate.timestamp_value:type_name -> google.protobuf.Timestamp
-	3, // 3: kubeflow.pipelines.backend.api.v2beta1.Predicate.int_values:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.IntValues
-	5, // 4: kubeflow.pipelines.backend.api.v2beta1.Predicate.long_values:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.LongValues
-	4, // 5: kubeflow.pipelines.backend.api.v2beta1.Predicate.string_values:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.StringValues
-	1, // 6: kubeflow.pipelines.backend.api.v2beta1.DummyFilterService.GetFilter:input_type -> kubeflow.pipelines.backend.api.v2beta1.Filter
-	1, // 7: kubeflow.pipelines.backend.api.v2beta1.DummyFilterService.GetFilter:output_type -> kubeflow.pipelines.backend.api.v2beta1.Filter
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	4,  // 0: kubeflow.pipelines.backend.api.v2beta1.Filter.predicates:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate
+	3,  // 1: kubeflow.pipelines.backend.api.v2beta1.Filter.groups:type_name -> kubeflow.pipelines.backend.api.v2beta1.FilterGroup
+	0,  // 2: kubeflow.pipelines.backend.api.v2beta1.FilterGroup.operator:type_name -> kubeflow.pipelines.backend.api.v2beta1.FilterGroup.Operator
+	4,  // 3: kubeflow.pipelines.backend.api.v2beta1.FilterGroup.predicates:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate
+	3,  // 4: kubeflow.pipelines.backend.api.v2beta1.FilterGroup.groups:type_name -> kubeflow.pipelines.backend.api.v2beta1.FilterGroup
+	1,  // 5: kubeflow.pipelines.backend.api.v2beta1.Predicate.operation:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.Operation
+	8,  // 6: kubeflow.pipelines.backend.api.v2beta1.Predicate.timestamp_value:type_name -> google.protobuf.Timestamp
+	5,  // 7: kubeflow.pipelines.backend.api.v2beta1.Predicate.int_values:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.IntValues
+	7,  // 8: kubeflow.pipelines.backend.api.v2beta1.Predicate.long_values:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.LongValues
+	6,  // 9: kubeflow.pipelines.backend.api.v2beta1.Predicate.string_values:type_name -> kubeflow.pipelines.backend.api.v2beta1.Predicate.StringValues
+	2,  // 10: kubeflow.pipelines.backend.api.v2beta1.DummyFilterService.GetFilter:input_type -> kubeflow.pipelines.backend.api.v2beta1.Filter
+	2,  // 11: kubeflow.pipelines.backend.api.v2beta1.DummyFilterService.GetFilter:output_type -> kubeflow.pipelines.backend.api.v2beta1.Filter
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v2beta1_filter_proto_init() }
@@ -618,7 +813,7 @@ func file_backend_api_v2beta1_filter_proto_init() {
 	if File_backend_api_v2beta1_filter_proto != nil {
 		return
 	}
-	file_backend_api_v2beta1_filter_proto_msgTypes[1].OneofWrappers = []any{
+	file_backend_api_v2beta1_filter_proto_msgTypes[2].OneofWrappers = []any{
 		(*Predicate_IntValue)(nil),
 		(*Predicate_LongValue)(nil),
 		(*Predicate_StringValue)(nil),
@@ -626,14 +821,15 @@ func file_backend_api_v2beta1_filter_proto_init() {
 		(*Predicate_IntValues_)(nil),
 		(*Predicate_LongValues_)(nil),
 		(*Predicate_StringValues_)(nil),
+		(*Predicate_DoubleValue)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_api_v2beta1_filter_proto_rawDesc), len(file_backend_api_v2beta1_filter_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
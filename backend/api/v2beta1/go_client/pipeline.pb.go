@@ -27,6 +27,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -41,6 +42,274 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Describes what has changed.
+type PipelineVersionChange_Type int32
+
+const (
+	// Default value. This value is not used.
+	PipelineVersionChange_TYPE_UNSPECIFIED PipelineVersionChange_Type = 0
+	// An input parameter of the pipeline was added. The key is the
+	// parameter name.
+	PipelineVersionChange_PARAMETER_ADDED PipelineVersionChange_Type = 1
+	// An input parameter of the pipeline was removed. The key is the
+	// parameter name.
+	PipelineVersionChange_PARAMETER_REMOVED PipelineVersionChange_Type = 2
+	// The type, default value or another property of an input parameter of
+	// the pipeline changed. The key is the parameter name.
+	PipelineVersionChange_PARAMETER_CHANGED PipelineVersionChange_Type = 3
+	// A task was added.
+	PipelineVersionChange_TASK_ADDED PipelineVersionChange_Type = 4
+	// A task was removed.
+	PipelineVersionChange_TASK_REMOVED PipelineVersionChange_Type = 5
+	// A field of a task, its component or its executor changed. The key is
+	// the field name prefixed with where it is defined, e.g.
+	// "task.cachingOptions", "component.inputDefinitions" or
+	// "container.command" for v2 pipeline specs, and "template.retryStrategy"
+	// or "container.args" for v1 Argo templates.
+	PipelineVersionChange_TASK_CHANGED PipelineVersionChange_Type = 6
+	// The container image of a task changed.
+	PipelineVersionChange_IMAGE_CHANGED PipelineVersionChange_Type = 7
+	// The Kubernetes executor config of a task changed, e.g. its node
+	// selector or volume mounts. The key is the field name.
+	PipelineVersionChange_PLATFORM_CHANGED PipelineVersionChange_Type = 8
+)
+
+// Enum value maps for PipelineVersionChange_Type.
+var (
+	PipelineVersionChange_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "PARAMETER_ADDED",
+		2: "PARAMETER_REMOVED",
+		3: "PARAMETER_CHANGED",
+		4: "TASK_ADDED",
+		5: "TASK_REMOVED",
+		6: "TASK_CHANGED",
+		7: "IMAGE_CHANGED",
+		8: "PLATFORM_CHANGED",
+	}
+	PipelineVersionChange_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":  0,
+		"PARAMETER_ADDED":   1,
+		"PARAMETER_REMOVED": 2,
+		"PARAMETER_CHANGED": 3,
+		"TASK_ADDED":        4,
+		"TASK_REMOVED":      5,
+		"TASK_CHANGED":      6,
+		"IMAGE_CHANGED":     7,
+		"PLATFORM_CHANGED":  8,
+	}
+)
+
+func (x PipelineVersionChange_Type) Enum() *PipelineVersionChange_Type {
+	p := new(PipelineVersionChange_Type)
+	*p = x
+	return p
+}
+
+func (x PipelineVersionChange_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PipelineVersionChange_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_pipeline_proto_enumTypes[0].Descriptor()
+}
+
+func (PipelineVersionChange_Type) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_pipeline_proto_enumTypes[0]
+}
+
+func (x PipelineVersionChange_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PipelineVersionChange_Type.Descriptor instead.
+func (PipelineVersionChange_Type) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{17, 0}
+}
+
+// Describes how to import a pipeline or an experiment whose name is
+// already taken in the namespace.
+type ImportBundleRequest_ConflictPolicy int32
+
+const (
+	// Default value. The import fails.
+	ImportBundleRequest_CONFLICT_POLICY_UNSPECIFIED ImportBundleRequest_ConflictPolicy = 0
+	// The pipeline, or the experiment with its recurring runs, is not
+	// imported.
+	ImportBundleRequest_SKIP ImportBundleRequest_ConflictPolicy = 1
+	// The pipeline or the experiment is imported under a new name with a
+	// numeric suffix, e.g. "name-1".
+	ImportBundleRequest_RENAME ImportBundleRequest_ConflictPolicy = 2
+	// The pipeline versions are added to the existing pipeline, and the
+	// recurring runs to the existing experiment. Pipeline versions whose
+	// names are taken are renamed with a numeric suffix. Recurring runs whose
+	// names are taken are not imported again.
+	ImportBundleRequest_NEW_VERSION ImportBundleRequest_ConflictPolicy = 3
+)
+
+// Enum value maps for ImportBundleRequest_ConflictPolicy.
+var (
+	ImportBundleRequest_ConflictPolicy_name = map[int32]string{
+		0: "CONFLICT_POLICY_UNSPECIFIED",
+		1: "SKIP",
+		2: "RENAME",
+		3: "NEW_VERSION",
+	}
+	ImportBundleRequest_ConflictPolicy_value = map[string]int32{
+		"CONFLICT_POLICY_UNSPECIFIED": 0,
+		"SKIP":                        1,
+		"RENAME":                      2,
+		"NEW_VERSION":                 3,
+	}
+)
+
+func (x ImportBundleRequest_ConflictPolicy) Enum() *ImportBundleRequest_ConflictPolicy {
+	p := new(ImportBundleRequest_ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ImportBundleRequest_ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportBundleRequest_ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_pipeline_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportBundleRequest_ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_pipeline_proto_enumTypes[1]
+}
+
+func (x ImportBundleRequest_ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportBundleRequest_ConflictPolicy.Descriptor instead.
+func (ImportBundleRequest_ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{21, 0}
+}
+
+// Type of the resource.
+type ImportedResource_Type int32
+
+const (
+	// Default value. This value is not used.
+	ImportedResource_TYPE_UNSPECIFIED ImportedResource_Type = 0
+	ImportedResource_PIPELINE         ImportedResource_Type = 1
+	ImportedResource_PIPELINE_VERSION ImportedResource_Type = 2
+	ImportedResource_EXPERIMENT       ImportedResource_Type = 3
+	ImportedResource_RECURRING_RUN    ImportedResource_Type = 4
+)
+
+// Enum value maps for ImportedResource_Type.
+var (
+	ImportedResource_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "PIPELINE",
+		2: "PIPELINE_VERSION",
+		3: "EXPERIMENT",
+		4: "RECURRING_RUN",
+	}
+	ImportedResource_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"PIPELINE":         1,
+		"PIPELINE_VERSION": 2,
+		"EXPERIMENT":       3,
+		"RECURRING_RUN":    4,
+	}
+)
+
+func (x ImportedResource_Type) Enum() *ImportedResource_Type {
+	p := new(ImportedResource_Type)
+	*p = x
+	return p
+}
+
+func (x ImportedResource_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportedResource_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_pipeline_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportedResource_Type) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_pipeline_proto_enumTypes[2]
+}
+
+func (x ImportedResource_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportedResource_Type.Descriptor instead.
+func (ImportedResource_Type) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{22, 0}
+}
+
+// Describes what the import does with a resource.
+type ImportedResource_Action int32
+
+const (
+	// Default value. This value is not used.
+	ImportedResource_ACTION_UNSPECIFIED ImportedResource_Action = 0
+	// The resource is created.
+	ImportedResource_CREATED ImportedResource_Action = 1
+	// The resource is created under a new name.
+	ImportedResource_RENAMED ImportedResource_Action = 2
+	// The resource is not imported because its name is taken.
+	ImportedResource_SKIPPED ImportedResource_Action = 3
+	// The pipeline or the experiment already exists, and the resources of
+	// the bundle are added to it. For recurring runs, a recurring run with
+	// the same name already exists in the experiment.
+	ImportedResource_REUSED ImportedResource_Action = 4
+)
+
+// Enum value maps for ImportedResource_Action.
+var (
+	ImportedResource_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "CREATED",
+		2: "RENAMED",
+		3: "SKIPPED",
+		4: "REUSED",
+	}
+	ImportedResource_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"CREATED":            1,
+		"RENAMED":            2,
+		"SKIPPED":            3,
+		"REUSED":             4,
+	}
+)
+
+func (x ImportedResource_Action) Enum() *ImportedResource_Action {
+	p := new(ImportedResource_Action)
+	*p = x
+	return p
+}
+
+func (x ImportedResource_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportedResource_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_pipeline_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportedResource_Action) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_pipeline_proto_enumTypes[3]
+}
+
+func (x ImportedResource_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportedResource_Action.Descriptor instead.
+func (ImportedResource_Action) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{22, 1}
+}
+
 type Pipeline struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Output. Unique pipeline ID. Generated by API server.
@@ -60,7 +329,10 @@ type Pipeline struct {
 	// In case any error happens retrieving a pipeline field, only pipeline ID,
 	// and the error message is returned. Client has the flexibility of choosing
 	// how to handle the error. This is especially useful during listing call.
-	Error         *status.Status `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Error *status.Status `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Optional input field. User-defined labels of the pipeline. Keys and
+	// values must be valid Kubernetes label keys and values.
+	Labels        map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -144,6 +416,13 @@ func (x *Pipeline) GetError() *status.Status {
 	return nil
 }
 
+func (x *Pipeline) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type PipelineVersion struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required input field. Unique ID of the parent pipeline.
@@ -566,6 +845,61 @@ func (x *ListPipelinesResponse) GetNextPageToken() string {
 	return ""
 }
 
+type UpdatePipelineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pipeline to be updated. The pipeline_id field is required.
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// The fields of the pipeline to update. Supported paths are "description"
+	// and "labels".
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePipelineRequest) Reset() {
+	*x = UpdatePipelineRequest{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePipelineRequest) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *UpdatePipelineRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type GetPipelineByNameRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional input. Namespace of the pipeline.
@@ -580,7 +914,7 @@ type GetPipelineByNameRequest struct {
 
 func (x *GetPipelineByNameRequest) Reset() {
 	*x = GetPipelineByNameRequest{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -592,7 +926,7 @@ func (x *GetPipelineByNameRequest) String() string {
 func (*GetPipelineByNameRequest) ProtoMessage() {}
 
 func (x *GetPipelineByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +939,7 @@ func (x *GetPipelineByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPipelineByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetPipelineByNameRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{7}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPipelineByNameRequest) GetNamespace() string {
@@ -635,7 +969,7 @@ type DeletePipelineRequest struct {
 
 func (x *DeletePipelineRequest) Reset() {
 	*x = DeletePipelineRequest{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[8]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +981,7 @@ func (x *DeletePipelineRequest) String() string {
 func (*DeletePipelineRequest) ProtoMessage() {}
 
 func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[8]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +994,7 @@ func (x *DeletePipelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePipelineRequest.ProtoReflect.Descriptor instead.
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{8}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeletePipelineRequest) GetPipelineId() string {
@@ -690,7 +1024,7 @@ type CreatePipelineAndVersionRequest struct {
 
 func (x *CreatePipelineAndVersionRequest) Reset() {
 	*x = CreatePipelineAndVersionRequest{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[9]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -702,7 +1036,7 @@ func (x *CreatePipelineAndVersionRequest) String() string {
 func (*CreatePipelineAndVersionRequest) ProtoMessage() {}
 
 func (x *CreatePipelineAndVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[9]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,7 +1049,7 @@ func (x *CreatePipelineAndVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePipelineAndVersionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePipelineAndVersionRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{9}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreatePipelineAndVersionRequest) GetPipeline() *Pipeline {
@@ -744,7 +1078,7 @@ type CreatePipelineVersionRequest struct {
 
 func (x *CreatePipelineVersionRequest) Reset() {
 	*x = CreatePipelineVersionRequest{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[10]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -756,7 +1090,7 @@ func (x *CreatePipelineVersionRequest) String() string {
 func (*CreatePipelineVersionRequest) ProtoMessage() {}
 
 func (x *CreatePipelineVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[10]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +1103,7 @@ func (x *CreatePipelineVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePipelineVersionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePipelineVersionRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{10}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreatePipelineVersionRequest) GetPipelineId() string {
@@ -798,7 +1132,7 @@ type GetPipelineVersionRequest struct {
 
 func (x *GetPipelineVersionRequest) Reset() {
 	*x = GetPipelineVersionRequest{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[11]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +1144,7 @@ func (x *GetPipelineVersionRequest) String() string {
 func (*GetPipelineVersionRequest) ProtoMessage() {}
 
 func (x *GetPipelineVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[11]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1157,7 @@ func (x *GetPipelineVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPipelineVersionRequest.ProtoReflect.Descriptor instead.
 func (*GetPipelineVersionRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{11}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPipelineVersionRequest) GetPipelineId() string {
@@ -862,7 +1196,7 @@ type ListPipelineVersionsRequest struct {
 
 func (x *ListPipelineVersionsRequest) Reset() {
 	*x = ListPipelineVersionsRequest{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[12]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -874,7 +1208,7 @@ func (x *ListPipelineVersionsRequest) String() string {
 func (*ListPipelineVersionsRequest) ProtoMessage() {}
 
 func (x *ListPipelineVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[12]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1221,7 @@ func (x *ListPipelineVersionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPipelineVersionsRequest.ProtoReflect.Descriptor instead.
 func (*ListPipelineVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{12}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListPipelineVersionsRequest) GetPipelineId() string {
@@ -939,7 +1273,7 @@ type ListPipelineVersionsResponse struct {
 
 func (x *ListPipelineVersionsResponse) Reset() {
 	*x = ListPipelineVersionsResponse{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[13]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -951,7 +1285,7 @@ func (x *ListPipelineVersionsResponse) String() string {
 func (*ListPipelineVersionsResponse) ProtoMessage() {}
 
 func (x *ListPipelineVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[13]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1298,7 @@ func (x *ListPipelineVersionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPipelineVersionsResponse.ProtoReflect.Descriptor instead.
 func (*ListPipelineVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{13}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListPipelineVersionsResponse) GetPipelineVersions() []*PipelineVersion {
@@ -1000,7 +1334,7 @@ type DeletePipelineVersionRequest struct {
 
 func (x *DeletePipelineVersionRequest) Reset() {
 	*x = DeletePipelineVersionRequest{}
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[14]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1012,7 +1346,7 @@ func (x *DeletePipelineVersionRequest) String() string {
 func (*DeletePipelineVersionRequest) ProtoMessage() {}
 
 func (x *DeletePipelineVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[14]
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +1359,7 @@ func (x *DeletePipelineVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePipelineVersionRequest.ProtoReflect.Descriptor instead.
 func (*DeletePipelineVersionRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{14}
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeletePipelineVersionRequest) GetPipelineId() string {
@@ -1042,11 +1376,541 @@ func (x *DeletePipelineVersionRequest) GetPipelineVersionId() string {
 	return ""
 }
 
+type DiffPipelineVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required input. ID of the parent pipeline.
+	PipelineId string `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	// Required input. ID of the pipeline version to compare against, usually
+	// the older one.
+	BasePipelineVersionId string `protobuf:"bytes,2,opt,name=base_pipeline_version_id,json=basePipelineVersionId,proto3" json:"base_pipeline_version_id,omitempty"`
+	// Required input. ID of the pipeline version compared with the base version.
+	TargetPipelineVersionId string `protobuf:"bytes,3,opt,name=target_pipeline_version_id,json=targetPipelineVersionId,proto3" json:"target_pipeline_version_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DiffPipelineVersionsRequest) Reset() {
+	*x = DiffPipelineVersionsRequest{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPipelineVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPipelineVersionsRequest) ProtoMessage() {}
+
+func (x *DiffPipelineVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPipelineVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffPipelineVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiffPipelineVersionsRequest) GetPipelineId() string {
+	if x != nil {
+		return x.PipelineId
+	}
+	return ""
+}
+
+func (x *DiffPipelineVersionsRequest) GetBasePipelineVersionId() string {
+	if x != nil {
+		return x.BasePipelineVersionId
+	}
+	return ""
+}
+
+func (x *DiffPipelineVersionsRequest) GetTargetPipelineVersionId() string {
+	if x != nil {
+		return x.TargetPipelineVersionId
+	}
+	return ""
+}
+
+// A difference between the pipeline specs of two pipeline versions.
+type PipelineVersionChange struct {
+	state protoimpl.MessageState     `protogen:"open.v1"`
+	Type  PipelineVersionChange_Type `protobuf:"varint,1,opt,name=type,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange_Type" json:"type,omitempty"`
+	// Name of the task the change belongs to. Tasks of nested DAGs are named by
+	// their path from the root DAG, e.g. "outer/inner". For v1 Argo templates,
+	// this is the name of the Argo template. Empty for input parameters.
+	TaskName string `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	// Name of the changed parameter or field. Empty for added and removed
+	// tasks, and for image changes.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Value in the base pipeline version. Null if it is not set in the base
+	// version, and for added and removed tasks.
+	BaseValue *structpb.Value `protobuf:"bytes,4,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`
+	// Value in the target pipeline version. Null if it is not set in the target
+	// version, and for added and removed tasks.
+	TargetValue   *structpb.Value `protobuf:"bytes,5,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineVersionChange) Reset() {
+	*x = PipelineVersionChange{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineVersionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineVersionChange) ProtoMessage() {}
+
+func (x *PipelineVersionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineVersionChange.ProtoReflect.Descriptor instead.
+func (*PipelineVersionChange) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PipelineVersionChange) GetType() PipelineVersionChange_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PipelineVersionChange_TYPE_UNSPECIFIED
+}
+
+func (x *PipelineVersionChange) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *PipelineVersionChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PipelineVersionChange) GetBaseValue() *structpb.Value {
+	if x != nil {
+		return x.BaseValue
+	}
+	return nil
+}
+
+func (x *PipelineVersionChange) GetTargetValue() *structpb.Value {
+	if x != nil {
+		return x.TargetValue
+	}
+	return nil
+}
+
+type DiffPipelineVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The changes from the base to the target pipeline version, ordered by
+	// type, task name and key.
+	Changes       []*PipelineVersionChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffPipelineVersionsResponse) Reset() {
+	*x = DiffPipelineVersionsResponse{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPipelineVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPipelineVersionsResponse) ProtoMessage() {}
+
+func (x *DiffPipelineVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPipelineVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffPipelineVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DiffPipelineVersionsResponse) GetChanges() []*PipelineVersionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ExportBundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required input. The resource to be exported.
+	//
+	// Types that are valid to be assigned to Source:
+	//
+	//	*ExportBundleRequest_PipelineId
+	//	*ExportBundleRequest_ExperimentId
+	Source        isExportBundleRequest_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBundleRequest) Reset() {
+	*x = ExportBundleRequest{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBundleRequest) ProtoMessage() {}
+
+func (x *ExportBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBundleRequest.ProtoReflect.Descriptor instead.
+func (*ExportBundleRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportBundleRequest) GetSource() isExportBundleRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ExportBundleRequest) GetPipelineId() string {
+	if x != nil {
+		if x, ok := x.Source.(*ExportBundleRequest_PipelineId); ok {
+			return x.PipelineId
+		}
+	}
+	return ""
+}
+
+func (x *ExportBundleRequest) GetExperimentId() string {
+	if x != nil {
+		if x, ok := x.Source.(*ExportBundleRequest_ExperimentId); ok {
+			return x.ExperimentId
+		}
+	}
+	return ""
+}
+
+type isExportBundleRequest_Source interface {
+	isExportBundleRequest_Source()
+}
+
+type ExportBundleRequest_PipelineId struct {
+	// ID of a pipeline to be exported with all its versions.
+	PipelineId string `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3,oneof"`
+}
+
+type ExportBundleRequest_ExperimentId struct {
+	// ID of an experiment to be exported with its recurring runs. The
+	// recurring runs are exported with the pipeline spec they run.
+	ExperimentId string `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3,oneof"`
+}
+
+func (*ExportBundleRequest_PipelineId) isExportBundleRequest_Source() {}
+
+func (*ExportBundleRequest_ExperimentId) isExportBundleRequest_Source() {}
+
+type ExportBundleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The bundle, a tar.gz archive of the pipeline specs and the metadata of
+	// the exported resources.
+	Bundle        []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBundleResponse) Reset() {
+	*x = ExportBundleResponse{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBundleResponse) ProtoMessage() {}
+
+func (x *ExportBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBundleResponse.ProtoReflect.Descriptor instead.
+func (*ExportBundleResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportBundleResponse) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type ImportBundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required input. A bundle created by ExportBundle.
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// Namespace the resources are imported into.
+	Namespace      string                             `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ConflictPolicy ImportBundleRequest_ConflictPolicy `protobuf:"varint,3,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest_ConflictPolicy" json:"conflict_policy,omitempty"`
+	// If true, the bundle is validated and the outcome of the import is
+	// returned without creating any resource.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBundleRequest) Reset() {
+	*x = ImportBundleRequest{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBundleRequest) ProtoMessage() {}
+
+func (x *ImportBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBundleRequest.ProtoReflect.Descriptor instead.
+func (*ImportBundleRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportBundleRequest) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportBundleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ImportBundleRequest) GetConflictPolicy() ImportBundleRequest_ConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ImportBundleRequest_CONFLICT_POLICY_UNSPECIFIED
+}
+
+func (x *ImportBundleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// The outcome of importing a resource of a bundle.
+type ImportedResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ImportedResource_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.ImportedResource_Type" json:"type,omitempty"`
+	// Name of the resource in the bundle. This is the display name for
+	// recurring runs.
+	BundleName string `protobuf:"bytes,2,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
+	// Name of the resource in the namespace it is imported into. Differs from
+	// the bundle name if the resource is renamed.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the created or the reused resource. Empty if the resource is
+	// skipped, or not created because the import is a dry run.
+	Id            string                  `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Action        ImportedResource_Action `protobuf:"varint,5,opt,name=action,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.ImportedResource_Action" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedResource) Reset() {
+	*x = ImportedResource{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedResource) ProtoMessage() {}
+
+func (x *ImportedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedResource.ProtoReflect.Descriptor instead.
+func (*ImportedResource) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportedResource) GetType() ImportedResource_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ImportedResource_TYPE_UNSPECIFIED
+}
+
+func (x *ImportedResource) GetBundleName() string {
+	if x != nil {
+		return x.BundleName
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportedResource) GetAction() ImportedResource_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ImportedResource_ACTION_UNSPECIFIED
+}
+
+type ImportBundleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The outcome for each resource of the bundle. Pipeline versions follow
+	// their pipeline, and recurring runs follow their experiment.
+	Resources     []*ImportedResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBundleResponse) Reset() {
+	*x = ImportBundleResponse{}
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBundleResponse) ProtoMessage() {}
+
+func (x *ImportBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_pipeline_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBundleResponse.ProtoReflect.Descriptor instead.
+func (*ImportBundleResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_pipeline_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportBundleResponse) GetResources() []*ImportedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
 var File_backend_api_v2beta1_pipeline_proto protoreflect.FileDescriptor
 
 const file_backend_api_v2beta1_pipeline_proto_rawDesc = "" +
 	"\n" +
-	"\"backend/api/v2beta1/pipeline.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x87\x02\n" +
+	"\"backend/api/v2beta1/pipeline.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x98\x03\n" +
 	"\bPipeline\x12\x1f\n" +
 	"\vpipeline_id\x18\x01 \x01(\tR\n" +
 	"pipelineId\x12!\n" +
@@ -1056,7 +1920,11 @@ const file_backend_api_v2beta1_pipeline_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1c\n" +
 	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12(\n" +
-	"\x05error\x18\x06 \x01(\v2\x12.google.rpc.StatusR\x05error\"\xd4\x03\n" +
+	"\x05error\x18\x06 \x01(\v2\x12.google.rpc.StatusR\x05error\x12T\n" +
+	"\x06labels\x18\b \x03(\v2<.kubeflow.pipelines.backend.api.v2beta1.Pipeline.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd4\x03\n" +
 	"\x0fPipelineVersion\x12\x1f\n" +
 	"\vpipeline_id\x18\x01 \x01(\tR\n" +
 	"pipelineId\x12.\n" +
@@ -1090,7 +1958,11 @@ const file_backend_api_v2beta1_pipeline_proto_rawDesc = "" +
 	"\tpipelines\x18\x01 \x03(\v20.kubeflow.pipelines.backend.api.v2beta1.PipelineR\tpipelines\x12\x1d\n" +
 	"\n" +
 	"total_size\x18\x02 \x01(\x05R\ttotalSize\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"L\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa2\x01\n" +
+	"\x15UpdatePipelineRequest\x12L\n" +
+	"\bpipeline\x18\x01 \x01(\v20.kubeflow.pipelines.backend.api.v2beta1.PipelineR\bpipeline\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"L\n" +
 	"\x18GetPipelineByNameRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"R\n" +
@@ -1125,18 +1997,88 @@ const file_backend_api_v2beta1_pipeline_proto_rawDesc = "" +
 	"\x1cDeletePipelineVersionRequest\x12\x1f\n" +
 	"\vpipeline_id\x18\x01 \x01(\tR\n" +
 	"pipelineId\x12.\n" +
-	"\x13pipeline_version_id\x18\x02 \x01(\tR\x11pipelineVersionId2\x97\x0f\n" +
+	"\x13pipeline_version_id\x18\x02 \x01(\tR\x11pipelineVersionId\"\xb4\x01\n" +
+	"\x1bDiffPipelineVersionsRequest\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x127\n" +
+	"\x18base_pipeline_version_id\x18\x02 \x01(\tR\x15basePipelineVersionId\x12;\n" +
+	"\x1atarget_pipeline_version_id\x18\x03 \x01(\tR\x17targetPipelineVersionId\"\xcf\x03\n" +
+	"\x15PipelineVersionChange\x12V\n" +
+	"\x04type\x18\x01 \x01(\x0e2B.kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange.TypeR\x04type\x12\x1b\n" +
+	"\ttask_name\x18\x02 \x01(\tR\btaskName\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x125\n" +
+	"\n" +
+	"base_value\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\tbaseValue\x129\n" +
+	"\ftarget_value\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\vtargetValue\"\xbc\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPARAMETER_ADDED\x10\x01\x12\x15\n" +
+	"\x11PARAMETER_REMOVED\x10\x02\x12\x15\n" +
+	"\x11PARAMETER_CHANGED\x10\x03\x12\x0e\n" +
+	"\n" +
+	"TASK_ADDED\x10\x04\x12\x10\n" +
+	"\fTASK_REMOVED\x10\x05\x12\x10\n" +
+	"\fTASK_CHANGED\x10\x06\x12\x11\n" +
+	"\rIMAGE_CHANGED\x10\a\x12\x14\n" +
+	"\x10PLATFORM_CHANGED\x10\b\"w\n" +
+	"\x1cDiffPipelineVersionsResponse\x12W\n" +
+	"\achanges\x18\x01 \x03(\v2=.kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChangeR\achanges\"i\n" +
+	"\x13ExportBundleRequest\x12!\n" +
+	"\vpipeline_id\x18\x01 \x01(\tH\x00R\n" +
+	"pipelineId\x12%\n" +
+	"\rexperiment_id\x18\x02 \x01(\tH\x00R\fexperimentIdB\b\n" +
+	"\x06source\".\n" +
+	"\x14ExportBundleResponse\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\"\xb3\x02\n" +
+	"\x13ImportBundleRequest\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12s\n" +
+	"\x0fconflict_policy\x18\x03 \x01(\x0e2J.kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest.ConflictPolicyR\x0econflictPolicy\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"X\n" +
+	"\x0eConflictPolicy\x12\x1f\n" +
+	"\x1bCONFLICT_POLICY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04SKIP\x10\x01\x12\n" +
+	"\n" +
+	"\x06RENAME\x10\x02\x12\x0f\n" +
+	"\vNEW_VERSION\x10\x03\"\xbd\x03\n" +
+	"\x10ImportedResource\x12Q\n" +
+	"\x04type\x18\x01 \x01(\x0e2=.kubeflow.pipelines.backend.api.v2beta1.ImportedResource.TypeR\x04type\x12\x1f\n" +
+	"\vbundle_name\x18\x02 \x01(\tR\n" +
+	"bundleName\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12W\n" +
+	"\x06action\x18\x05 \x01(\x0e2?.kubeflow.pipelines.backend.api.v2beta1.ImportedResource.ActionR\x06action\"c\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bPIPELINE\x10\x01\x12\x14\n" +
+	"\x10PIPELINE_VERSION\x10\x02\x12\x0e\n" +
+	"\n" +
+	"EXPERIMENT\x10\x03\x12\x11\n" +
+	"\rRECURRING_RUN\x10\x04\"S\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aRENAMED\x10\x02\x12\v\n" +
+	"\aSKIPPED\x10\x03\x12\n" +
+	"\n" +
+	"\x06REUSED\x10\x04\"n\n" +
+	"\x14ImportBundleResponse\x12V\n" +
+	"\tresources\x18\x01 \x03(\v28.kubeflow.pipelines.backend.api.v2beta1.ImportedResourceR\tresources2\xc6\x15\n" +
 	"\x0fPipelineService\x12\xac\x01\n" +
 	"\x0eCreatePipeline\x12=.kubeflow.pipelines.backend.api.v2beta1.CreatePipelineRequest\x1a0.kubeflow.pipelines.backend.api.v2beta1.Pipeline\")\x82\xd3\xe4\x93\x02#:\bpipeline\"\x17/apis/v2beta1/pipelines\x12\xaa\x01\n" +
 	"\vGetPipeline\x12:.kubeflow.pipelines.backend.api.v2beta1.GetPipelineRequest\x1a0.kubeflow.pipelines.backend.api.v2beta1.Pipeline\"-\x82\xd3\xe4\x93\x02'\x12%/apis/v2beta1/pipelines/{pipeline_id}\x12\xb5\x01\n" +
 	"\x11GetPipelineByName\x12@.kubeflow.pipelines.backend.api.v2beta1.GetPipelineByNameRequest\x1a0.kubeflow.pipelines.backend.api.v2beta1.Pipeline\",\x82\xd3\xe4\x93\x02&\x12$/apis/v2beta1/pipelines/names/{name}\x12\xad\x01\n" +
-	"\rListPipelines\x12<.kubeflow.pipelines.backend.api.v2beta1.ListPipelinesRequest\x1a=.kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/apis/v2beta1/pipelines\x12\x96\x01\n" +
+	"\rListPipelines\x12<.kubeflow.pipelines.backend.api.v2beta1.ListPipelinesRequest\x1a=.kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/apis/v2beta1/pipelines\x12\xc3\x01\n" +
+	"\x0eUpdatePipeline\x12=.kubeflow.pipelines.backend.api.v2beta1.UpdatePipelineRequest\x1a0.kubeflow.pipelines.backend.api.v2beta1.Pipeline\"@\x82\xd3\xe4\x93\x02::\bpipeline2./apis/v2beta1/pipelines/{pipeline.pipeline_id}\x12\x96\x01\n" +
 	"\x0eDeletePipeline\x12=.kubeflow.pipelines.backend.api.v2beta1.DeletePipelineRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'*%/apis/v2beta1/pipelines/{pipeline_id}\x12\xc0\x01\n" +
 	"\x18CreatePipelineAndVersion\x12G.kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest\x1a0.kubeflow.pipelines.backend.api.v2beta1.Pipeline\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/apis/v2beta1/pipelines/create\x12\xe0\x01\n" +
 	"\x15CreatePipelineVersion\x12D.kubeflow.pipelines.backend.api.v2beta1.CreatePipelineVersionRequest\x1a7.kubeflow.pipelines.backend.api.v2beta1.PipelineVersion\"H\x82\xd3\xe4\x93\x02B:\x10pipeline_version\"./apis/v2beta1/pipelines/{pipeline_id}/versions\x12\xde\x01\n" +
 	"\x12GetPipelineVersion\x12A.kubeflow.pipelines.backend.api.v2beta1.GetPipelineVersionRequest\x1a7.kubeflow.pipelines.backend.api.v2beta1.PipelineVersion\"L\x82\xd3\xe4\x93\x02F\x12D/apis/v2beta1/pipelines/{pipeline_id}/versions/{pipeline_version_id}\x12\xd9\x01\n" +
 	"\x14ListPipelineVersions\x12C.kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsRequest\x1aD.kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsResponse\"6\x82\xd3\xe4\x93\x020\x12./apis/v2beta1/pipelines/{pipeline_id}/versions\x12\xc3\x01\n" +
-	"\x15DeletePipelineVersion\x12D.kubeflow.pipelines.backend.api.v2beta1.DeletePipelineVersionRequest\x1a\x16.google.protobuf.Empty\"L\x82\xd3\xe4\x93\x02F*D/apis/v2beta1/pipelines/{pipeline_id}/versions/{pipeline_version_id}B\x98\x01\x92AX*\x02\x01\x02R#\n" +
+	"\x15DeletePipelineVersion\x12D.kubeflow.pipelines.backend.api.v2beta1.DeletePipelineVersionRequest\x1a\x16.google.protobuf.Empty\"L\x82\xd3\xe4\x93\x02F*D/apis/v2beta1/pipelines/{pipeline_id}/versions/{pipeline_version_id}\x12\xfb\x01\n" +
+	"\x14DiffPipelineVersions\x12C.kubeflow.pipelines.backend.api.v2beta1.DiffPipelineVersionsRequest\x1aD.kubeflow.pipelines.backend.api.v2beta1.DiffPipelineVersionsResponse\"X\x82\xd3\xe4\x93\x02R\x12P/apis/v2beta1/pipelines/{pipeline_id}/versions/{target_pipeline_version_id}/diff\x12\xb1\x01\n" +
+	"\fExportBundle\x12;.kubeflow.pipelines.backend.api.v2beta1.ExportBundleRequest\x1a<.kubeflow.pipelines.backend.api.v2beta1.ExportBundleResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/apis/v2beta1/pipelines:export\x12\xb4\x01\n" +
+	"\fImportBundle\x12;.kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest\x1a<.kubeflow.pipelines.backend.api.v2beta1.ImportBundleResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/apis/v2beta1/pipelines:importB\x98\x01\x92AX*\x02\x01\x02R#\n" +
 	"\adefault\x12\x18\x12\x16\n" +
 	"\x14\x1a\x12.google.rpc.StatusZ\x1f\n" +
 	"\x1d\n" +
@@ -1157,66 +2099,102 @@ func file_backend_api_v2beta1_pipeline_proto_rawDescGZIP() []byte {
 	return file_backend_api_v2beta1_pipeline_proto_rawDescData
 }
 
-var file_backend_api_v2beta1_pipeline_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_backend_api_v2beta1_pipeline_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_backend_api_v2beta1_pipeline_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_backend_api_v2beta1_pipeline_proto_goTypes = []any{
-	(*Pipeline)(nil),                        // 0: kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	(*PipelineVersion)(nil),                 // 1: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
-	(*Url)(nil),                             // 2: kubeflow.pipelines.backend.api.v2beta1.Url
-	(*CreatePipelineRequest)(nil),           // 3: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineRequest
-	(*GetPipelineRequest)(nil),              // 4: kubeflow.pipelines.backend.api.v2beta1.GetPipelineRequest
-	(*ListPipelinesRequest)(nil),            // 5: kubeflow.pipelines.backend.api.v2beta1.ListPipelinesRequest
-	(*ListPipelinesResponse)(nil),           // 6: kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse
-	(*GetPipelineByNameRequest)(nil),        // 7: kubeflow.pipelines.backend.api.v2beta1.GetPipelineByNameRequest
-	(*DeletePipelineRequest)(nil),           // 8: kubeflow.pipelines.backend.api.v2beta1.DeletePipelineRequest
-	(*CreatePipelineAndVersionRequest)(nil), // 9: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest
-	(*CreatePipelineVersionRequest)(nil),    // 10: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineVersionRequest
-	(*GetPipelineVersionRequest)(nil),       // 11: kubeflow.pipelines.backend.api.v2beta1.GetPipelineVersionRequest
-	(*ListPipelineVersionsRequest)(nil),     // 12: kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsRequest
-	(*ListPipelineVersionsResponse)(nil),    // 13: kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsResponse
-	(*DeletePipelineVersionRequest)(nil),    // 14: kubeflow.pipelines.backend.api.v2beta1.DeletePipelineVersionRequest
-	(*timestamppb.Timestamp)(nil),           // 15: google.protobuf.Timestamp
-	(*status.Status)(nil),                   // 16: google.rpc.Status
-	(*structpb.Struct)(nil),                 // 17: google.protobuf.Struct
-	(*emptypb.Empty)(nil),                   // 18: google.protobuf.Empty
+	(PipelineVersionChange_Type)(0),         // 0: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange.Type
+	(ImportBundleRequest_ConflictPolicy)(0), // 1: kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest.ConflictPolicy
+	(ImportedResource_Type)(0),              // 2: kubeflow.pipelines.backend.api.v2beta1.ImportedResource.Type
+	(ImportedResource_Action)(0),            // 3: kubeflow.pipelines.backend.api.v2beta1.ImportedResource.Action
+	(*Pipeline)(nil),                        // 4: kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	(*PipelineVersion)(nil),                 // 5: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
+	(*Url)(nil),                             // 6: kubeflow.pipelines.backend.api.v2beta1.Url
+	(*CreatePipelineRequest)(nil),           // 7: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineRequest
+	(*GetPipelineRequest)(nil),              // 8: kubeflow.pipelines.backend.api.v2beta1.GetPipelineRequest
+	(*ListPipelinesRequest)(nil),            // 9: kubeflow.pipelines.backend.api.v2beta1.ListPipelinesRequest
+	(*ListPipelinesResponse)(nil),           // 10: kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse
+	(*UpdatePipelineRequest)(nil),           // 11: kubeflow.pipelines.backend.api.v2beta1.UpdatePipelineRequest
+	(*GetPipelineByNameRequest)(nil),        // 12: kubeflow.pipelines.backend.api.v2beta1.GetPipelineByNameRequest
+	(*DeletePipelineRequest)(nil),           // 13: kubeflow.pipelines.backend.api.v2beta1.DeletePipelineRequest
+	(*CreatePipelineAndVersionRequest)(nil), // 14: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest
+	(*CreatePipelineVersionRequest)(nil),    // 15: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineVersionRequest
+	(*GetPipelineVersionRequest)(nil),       // 16: kubeflow.pipelines.backend.api.v2beta1.GetPipelineVersionRequest
+	(*ListPipelineVersionsRequest)(nil),     // 17: kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsRequest
+	(*ListPipelineVersionsResponse)(nil),    // 18: kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsResponse
+	(*DeletePipelineVersionRequest)(nil),    // 19: kubeflow.pipelines.backend.api.v2beta1.DeletePipelineVersionRequest
+	(*DiffPipelineVersionsRequest)(nil),     // 20: kubeflow.pipelines.backend.api.v2beta1.DiffPipelineVersionsRequest
+	(*PipelineVersionChange)(nil),           // 21: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange
+	(*DiffPipelineVersionsResponse)(nil),    // 22: kubeflow.pipelines.backend.api.v2beta1.DiffPipelineVersionsResponse
+	(*ExportBundleRequest)(nil),             // 23: kubeflow.pipelines.backend.api.v2beta1.ExportBundleRequest
+	(*ExportBundleResponse)(nil),            // 24: kubeflow.pipelines.backend.api.v2beta1.ExportBundleResponse
+	(*ImportBundleRequest)(nil),             // 25: kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest
+	(*ImportedResource)(nil),                // 26: kubeflow.pipelines.backend.api.v2beta1.ImportedResource
+	(*ImportBundleResponse)(nil),            // 27: kubeflow.pipelines.backend.api.v2beta1.ImportBundleResponse
+	nil,                                     // 28: kubeflow.pipelines.backend.api.v2beta1.Pipeline.LabelsEntry
+	(*timestamppb.Timestamp)(nil),           // 29: google.protobuf.Timestamp
+	(*status.Status)(nil),                   // 30: google.rpc.Status
+	(*structpb.Struct)(nil),                 // 31: google.protobuf.Struct
+	(*fieldmaskpb.FieldMask)(nil),           // 32: google.protobuf.FieldMask
+	(*structpb.Value)(nil),                  // 33: google.protobuf.Value
+	(*emptypb.Empty)(nil),                   // 34: google.protobuf.Empty
 }
 var file_backend_api_v2beta1_pipeline_proto_depIdxs = []int32{
-	15, // 0: kubeflow.pipelines.backend.api.v2beta1.Pipeline.created_at:type_name -> google.protobuf.Timestamp
-	16, // 1: kubeflow.pipelines.backend.api.v2beta1.Pipeline.error:type_name -> google.rpc.Status
-	15, // 2: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 3: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.package_url:type_name -> kubeflow.pipelines.backend.api.v2beta1.Url
-	17, // 4: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.pipeline_spec:type_name -> google.protobuf.Struct
-	16, // 5: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.error:type_name -> google.rpc.Status
-	0,  // 6: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineRequest.pipeline:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	0,  // 7: kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse.pipelines:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	0,  // 8: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest.pipeline:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	1,  // 9: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest.pipeline_version:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
-	1,  // 10: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineVersionRequest.pipeline_version:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
-	1,  // 11: kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsResponse.pipeline_versions:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
-	3,  // 12: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipeline:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreatePipelineRequest
-	4,  // 13: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipeline:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetPipelineRequest
-	7,  // 14: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineByName:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetPipelineByNameRequest
-	5,  // 15: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelines:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelinesRequest
-	8,  // 16: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipeline:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeletePipelineRequest
-	9,  // 17: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineAndVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest
-	10, // 18: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreatePipelineVersionRequest
-	11, // 19: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetPipelineVersionRequest
-	12, // 20: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelineVersions:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsRequest
-	14, // 21: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipelineVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeletePipelineVersionRequest
-	0,  // 22: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipeline:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	0,  // 23: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipeline:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	0,  // 24: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineByName:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	6,  // 25: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelines:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse
-	18, // 26: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipeline:output_type -> google.protobuf.Empty
-	0,  // 27: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineAndVersion:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
-	1,  // 28: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineVersion:output_type -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
-	1,  // 29: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineVersion:output_type -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
-	13, // 30: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelineVersions:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsResponse
-	18, // 31: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipelineVersion:output_type -> google.protobuf.Empty
-	22, // [22:32] is the sub-list for method output_type
-	12, // [12:22] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	29, // 0: kubeflow.pipelines.backend.api.v2beta1.Pipeline.created_at:type_name -> google.protobuf.Timestamp
+	30, // 1: kubeflow.pipelines.backend.api.v2beta1.Pipeline.error:type_name -> google.rpc.Status
+	28, // 2: kubeflow.pipelines.backend.api.v2beta1.Pipeline.labels:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline.LabelsEntry
+	29, // 3: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.package_url:type_name -> kubeflow.pipelines.backend.api.v2beta1.Url
+	31, // 5: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.pipeline_spec:type_name -> google.protobuf.Struct
+	30, // 6: kubeflow.pipelines.backend.api.v2beta1.PipelineVersion.error:type_name -> google.rpc.Status
+	4,  // 7: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineRequest.pipeline:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	4,  // 8: kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse.pipelines:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	4,  // 9: kubeflow.pipelines.backend.api.v2beta1.UpdatePipelineRequest.pipeline:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	32, // 10: kubeflow.pipelines.backend.api.v2beta1.UpdatePipelineRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 11: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest.pipeline:type_name -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	5,  // 12: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest.pipeline_version:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
+	5,  // 13: kubeflow.pipelines.backend.api.v2beta1.CreatePipelineVersionRequest.pipeline_version:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
+	5,  // 14: kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsResponse.pipeline_versions:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
+	0,  // 15: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange.type:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange.Type
+	33, // 16: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange.base_value:type_name -> google.protobuf.Value
+	33, // 17: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange.target_value:type_name -> google.protobuf.Value
+	21, // 18: kubeflow.pipelines.backend.api.v2beta1.DiffPipelineVersionsResponse.changes:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersionChange
+	1,  // 19: kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest.conflict_policy:type_name -> kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest.ConflictPolicy
+	2,  // 20: kubeflow.pipelines.backend.api.v2beta1.ImportedResource.type:type_name -> kubeflow.pipelines.backend.api.v2beta1.ImportedResource.Type
+	3,  // 21: kubeflow.pipelines.backend.api.v2beta1.ImportedResource.action:type_name -> kubeflow.pipelines.backend.api.v2beta1.ImportedResource.Action
+	26, // 22: kubeflow.pipelines.backend.api.v2beta1.ImportBundleResponse.resources:type_name -> kubeflow.pipelines.backend.api.v2beta1.ImportedResource
+	7,  // 23: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipeline:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreatePipelineRequest
+	8,  // 24: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipeline:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetPipelineRequest
+	12, // 25: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineByName:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetPipelineByNameRequest
+	9,  // 26: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelines:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelinesRequest
+	11, // 27: kubeflow.pipelines.backend.api.v2beta1.PipelineService.UpdatePipeline:input_type -> kubeflow.pipelines.backend.api.v2beta1.UpdatePipelineRequest
+	13, // 28: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipeline:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeletePipelineRequest
+	14, // 29: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineAndVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreatePipelineAndVersionRequest
+	15, // 30: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreatePipelineVersionRequest
+	16, // 31: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetPipelineVersionRequest
+	17, // 32: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelineVersions:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsRequest
+	19, // 33: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipelineVersion:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeletePipelineVersionRequest
+	20, // 34: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DiffPipelineVersions:input_type -> kubeflow.pipelines.backend.api.v2beta1.DiffPipelineVersionsRequest
+	23, // 35: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ExportBundle:input_type -> kubeflow.pipelines.backend.api.v2beta1.ExportBundleRequest
+	25, // 36: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ImportBundle:input_type -> kubeflow.pipelines.backend.api.v2beta1.ImportBundleRequest
+	4,  // 37: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipeline:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	4,  // 38: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipeline:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	4,  // 39: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineByName:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	10, // 40: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelines:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelinesResponse
+	4,  // 41: kubeflow.pipelines.backend.api.v2beta1.PipelineService.UpdatePipeline:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	34, // 42: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipeline:output_type -> google.protobuf.Empty
+	4,  // 43: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineAndVersion:output_type -> kubeflow.pipelines.backend.api.v2beta1.Pipeline
+	5,  // 44: kubeflow.pipelines.backend.api.v2beta1.PipelineService.CreatePipelineVersion:output_type -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
+	5,  // 45: kubeflow.pipelines.backend.api.v2beta1.PipelineService.GetPipelineVersion:output_type -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersion
+	18, // 46: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ListPipelineVersions:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListPipelineVersionsResponse
+	34, // 47: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DeletePipelineVersion:output_type -> google.protobuf.Empty
+	22, // 48: kubeflow.pipelines.backend.api.v2beta1.PipelineService.DiffPipelineVersions:output_type -> kubeflow.pipelines.backend.api.v2beta1.DiffPipelineVersionsResponse
+	24, // 49: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ExportBundle:output_type -> kubeflow.pipelines.backend.api.v2beta1.ExportBundleResponse
+	27, // 50: kubeflow.pipelines.backend.api.v2beta1.PipelineService.ImportBundle:output_type -> kubeflow.pipelines.backend.api.v2beta1.ImportBundleResponse
+	37, // [37:51] is the sub-list for method output_type
+	23, // [23:37] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v2beta1_pipeline_proto_init() }
@@ -1224,18 +2202,23 @@ func file_backend_api_v2beta1_pipeline_proto_init() {
 	if File_backend_api_v2beta1_pipeline_proto != nil {
 		return
 	}
+	file_backend_api_v2beta1_pipeline_proto_msgTypes[19].OneofWrappers = []any{
+		(*ExportBundleRequest_PipelineId)(nil),
+		(*ExportBundleRequest_ExperimentId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_api_v2beta1_pipeline_proto_rawDesc), len(file_backend_api_v2beta1_pipeline_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_backend_api_v2beta1_pipeline_proto_goTypes,
 		DependencyIndexes: file_backend_api_v2beta1_pipeline_proto_depIdxs,
+		EnumInfos:         file_backend_api_v2beta1_pipeline_proto_enumTypes,
 		MessageInfos:      file_backend_api_v2beta1_pipeline_proto_msgTypes,
 	}.Build()
 	File_backend_api_v2beta1_pipeline_proto = out.File
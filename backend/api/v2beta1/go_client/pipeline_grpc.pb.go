@@ -38,12 +38,16 @@ const (
 	PipelineService_GetPipeline_FullMethodName              = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/GetPipeline"
 	PipelineService_GetPipelineByName_FullMethodName        = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/GetPipelineByName"
 	PipelineService_ListPipelines_FullMethodName            = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/ListPipelines"
+	PipelineService_UpdatePipeline_FullMethodName           = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/UpdatePipeline"
 	PipelineService_DeletePipeline_FullMethodName           = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/DeletePipeline"
 	PipelineService_CreatePipelineAndVersion_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/CreatePipelineAndVersion"
 	PipelineService_CreatePipelineVersion_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/CreatePipelineVersion"
 	PipelineService_GetPipelineVersion_FullMethodName       = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/GetPipelineVersion"
 	PipelineService_ListPipelineVersions_FullMethodName     = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/ListPipelineVersions"
 	PipelineService_DeletePipelineVersion_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/DeletePipelineVersion"
+	PipelineService_DiffPipelineVersions_FullMethodName     = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/DiffPipelineVersions"
+	PipelineService_ExportBundle_FullMethodName             = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/ExportBundle"
+	PipelineService_ImportBundle_FullMethodName             = "/kubeflow.pipelines.backend.api.v2beta1.PipelineService/ImportBundle"
 )
 
 // PipelineServiceClient is the client API for PipelineService service.
@@ -58,6 +62,9 @@ type PipelineServiceClient interface {
 	GetPipelineByName(ctx context.Context, in *GetPipelineByNameRequest, opts ...grpc.CallOption) (*Pipeline, error)
 	// Finds all pipelines within a namespace.
 	ListPipelines(ctx context.Context, in *ListPipelinesRequest, opts ...grpc.CallOption) (*ListPipelinesResponse, error)
+	// Updates the description and labels of a pipeline. Only the fields listed
+	// in the update mask are changed.
+	UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
 	// Deletes a pipeline by ID. If cascade is false (default), it returns an error if the
 	// pipeline has any versions. If cascade is true, it will also delete all pipeline versions.
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -71,6 +78,18 @@ type PipelineServiceClient interface {
 	ListPipelineVersions(ctx context.Context, in *ListPipelineVersionsRequest, opts ...grpc.CallOption) (*ListPipelineVersionsResponse, error)
 	// Deletes a specific pipeline version by pipeline version ID and pipeline ID.
 	DeletePipelineVersion(ctx context.Context, in *DeletePipelineVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Compares the pipeline specs of two versions of a pipeline. Returns the
+	// added, removed and changed tasks, component images, input parameters and
+	// platform configs.
+	DiffPipelineVersions(ctx context.Context, in *DiffPipelineVersionsRequest, opts ...grpc.CallOption) (*DiffPipelineVersionsResponse, error)
+	// Exports a pipeline with all its versions, or an experiment with its
+	// recurring runs, as a bundle that can be imported into another Kubeflow
+	// Pipelines deployment.
+	ExportBundle(ctx context.Context, in *ExportBundleRequest, opts ...grpc.CallOption) (*ExportBundleResponse, error)
+	// Imports the pipelines, or the experiment and its recurring runs, of a
+	// bundle created by ExportBundle. Recurring runs are imported disabled.
+	// Nothing is imported if the import of any resource fails.
+	ImportBundle(ctx context.Context, in *ImportBundleRequest, opts ...grpc.CallOption) (*ImportBundleResponse, error)
 }
 
 type pipelineServiceClient struct {
@@ -121,6 +140,16 @@ func (c *pipelineServiceClient) ListPipelines(ctx context.Context, in *ListPipel
 	return out, nil
 }
 
+func (c *pipelineServiceClient) UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, PipelineService_UpdatePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipelineServiceClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -181,6 +210,36 @@ func (c *pipelineServiceClient) DeletePipelineVersion(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *pipelineServiceClient) DiffPipelineVersions(ctx context.Context, in *DiffPipelineVersionsRequest, opts ...grpc.CallOption) (*DiffPipelineVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffPipelineVersionsResponse)
+	err := c.cc.Invoke(ctx, PipelineService_DiffPipelineVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) ExportBundle(ctx context.Context, in *ExportBundleRequest, opts ...grpc.CallOption) (*ExportBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportBundleResponse)
+	err := c.cc.Invoke(ctx, PipelineService_ExportBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) ImportBundle(ctx context.Context, in *ImportBundleRequest, opts ...grpc.CallOption) (*ImportBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBundleResponse)
+	err := c.cc.Invoke(ctx, PipelineService_ImportBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PipelineServiceServer is the server API for PipelineService service.
 // All implementations must embed UnimplementedPipelineServiceServer
 // for forward compatibility.
@@ -193,6 +252,9 @@ type PipelineServiceServer interface {
 	GetPipelineByName(context.Context, *GetPipelineByNameRequest) (*Pipeline, error)
 	// Finds all pipelines within a namespace.
 	ListPipelines(context.Context, *ListPipelinesRequest) (*ListPipelinesResponse, error)
+	// Updates the description and labels of a pipeline. Only the fields listed
+	// in the update mask are changed.
+	UpdatePipeline(context.Context, *UpdatePipelineRequest) (*Pipeline, error)
 	// Deletes a pipeline by ID. If cascade is false (default), it returns an error if the
 	// pipeline has any versions. If cascade is true, it will also delete all pipeline versions.
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*emptypb.Empty, error)
@@ -206,6 +268,18 @@ type PipelineServiceServer interface {
 	ListPipelineVersions(context.Context, *ListPipelineVersionsRequest) (*ListPipelineVersionsResponse, error)
 	// Deletes a specific pipeline version by pipeline version ID and pipeline ID.
 	DeletePipelineVersion(context.Context, *DeletePipelineVersionRequest) (*emptypb.Empty, error)
+	// Compares the pipeline specs of two versions of a pipeline. Returns the
+	// added, removed and changed tasks, component images, input parameters and
+	// platform configs.
+	DiffPipelineVersions(context.Context, *DiffPipelineVersionsRequest) (*DiffPipelineVersionsResponse, error)
+	// Exports a pipeline with all its versions, or an experiment with its
+	// recurring runs, as a bundle that can be imported into another Kubeflow
+	// Pipelines deployment.
+	ExportBundle(context.Context, *ExportBundleRequest) (*ExportBundleResponse, error)
+	// Imports the pipelines, or the experiment and its recurring runs, of a
+	// bundle created by ExportBundle. Recurring runs are imported disabled.
+	// Nothing is imported if the import of any resource fails.
+	ImportBundle(context.Context, *ImportBundleRequest) (*ImportBundleResponse, error)
 	mustEmbedUnimplementedPipelineServiceServer()
 }
 
@@ -228,6 +302,9 @@ func (UnimplementedPipelineServiceServer) GetPipelineByName(context.Context, *Ge
 func (UnimplementedPipelineServiceServer) ListPipelines(context.Context, *ListPipelinesRequest) (*ListPipelinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPipelines not implemented")
 }
+func (UnimplementedPipelineServiceServer) UpdatePipeline(context.Context, *UpdatePipelineRequest) (*Pipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePipeline not implemented")
+}
 func (UnimplementedPipelineServiceServer) DeletePipeline(context.Context, *DeletePipelineRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePipeline not implemented")
 }
@@ -246,6 +323,15 @@ func (UnimplementedPipelineServiceServer) ListPipelineVersions(context.Context,
 func (UnimplementedPipelineServiceServer) DeletePipelineVersion(context.Context, *DeletePipelineVersionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePipelineVersion not implemented")
 }
+func (UnimplementedPipelineServiceServer) DiffPipelineVersions(context.Context, *DiffPipelineVersionsRequest) (*DiffPipelineVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPipelineVersions not implemented")
+}
+func (UnimplementedPipelineServiceServer) ExportBundle(context.Context, *ExportBundleRequest) (*ExportBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBundle not implemented")
+}
+func (UnimplementedPipelineServiceServer) ImportBundle(context.Context, *ImportBundleRequest) (*ImportBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBundle not implemented")
+}
 func (UnimplementedPipelineServiceServer) mustEmbedUnimplementedPipelineServiceServer() {}
 func (UnimplementedPipelineServiceServer) testEmbeddedByValue()                         {}
 
@@ -339,6 +425,24 @@ func _PipelineService_ListPipelines_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_UpdatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).UpdatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_UpdatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).UpdatePipeline(ctx, req.(*UpdatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipelineService_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeletePipelineRequest)
 	if err := dec(in); err != nil {
@@ -447,6 +551,60 @@ func _PipelineService_DeletePipelineVersion_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_DiffPipelineVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffPipelineVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).DiffPipelineVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_DiffPipelineVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).DiffPipelineVersions(ctx, req.(*DiffPipelineVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_ExportBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).ExportBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_ExportBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).ExportBundle(ctx, req.(*ExportBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_ImportBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).ImportBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PipelineService_ImportBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).ImportBundle(ctx, req.(*ImportBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PipelineService_ServiceDesc is the grpc.ServiceDesc for PipelineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -470,6 +628,10 @@ var PipelineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPipelines",
 			Handler:    _PipelineService_ListPipelines_Handler,
 		},
+		{
+			MethodName: "UpdatePipeline",
+			Handler:    _PipelineService_UpdatePipeline_Handler,
+		},
 		{
 			MethodName: "DeletePipeline",
 			Handler:    _PipelineService_DeletePipeline_Handler,
@@ -494,6 +656,18 @@ var PipelineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePipelineVersion",
 			Handler:    _PipelineService_DeletePipelineVersion_Handler,
 		},
+		{
+			MethodName: "DiffPipelineVersions",
+			Handler:    _PipelineService_DiffPipelineVersions_Handler,
+		},
+		{
+			MethodName: "ExportBundle",
+			Handler:    _PipelineService_ExportBundle_Handler,
+		},
+		{
+			MethodName: "ImportBundle",
+			Handler:    _PipelineService_ImportBundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/v2beta1/pipeline.proto",
@@ -27,6 +27,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -189,7 +190,14 @@ type RecurringRun struct {
 	// Output only. Namespace this recurring run belongs to. Derived from the parent experiment.
 	Namespace string `protobuf:"bytes,16,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	// ID of the parent experiment this recurring run belongs to.
-	ExperimentId  string `protobuf:"bytes,17,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	ExperimentId string `protobuf:"bytes,17,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	// Optional input field. User-defined labels of the recurring run. They are
+	// also added to the ScheduledWorkflow and to the runs it creates. Keys and
+	// values must be valid Kubernetes label keys and values.
+	Labels map[string]string `protobuf:"bytes,19,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional input field. Webhooks notified about state transitions of the
+	// runs created by this recurring run, e.g. to alert on failures.
+	Notifications []*NotificationSubscription `protobuf:"bytes,20,rep,name=notifications,proto3" json:"notifications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -364,6 +372,20 @@ func (x *RecurringRun) GetExperimentId() string {
 	return ""
 }
 
+func (x *RecurringRun) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RecurringRun) GetNotifications() []*NotificationSubscription {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
 type isRecurringRun_PipelineSource interface {
 	isRecurringRun_PipelineSource()
 }
@@ -640,6 +662,63 @@ func (x *ListRecurringRunsResponse) GetNextPageToken() string {
 	return ""
 }
 
+type UpdateRecurringRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recurring run to update. Its recurring_run_id identifies the recurring
+	// run and the fields listed in update_mask hold the new values.
+	RecurringRun *RecurringRun `protobuf:"bytes,1,opt,name=recurring_run,json=recurringRun,proto3" json:"recurring_run,omitempty"`
+	// The fields to update. Supported fields are display_name, description,
+	// pipeline_version_reference, pipeline_spec, runtime_config, service_account,
+	// max_concurrency, trigger, no_catchup, labels and notifications.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringRunRequest) Reset() {
+	*x = UpdateRecurringRunRequest{}
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringRunRequest) ProtoMessage() {}
+
+func (x *UpdateRecurringRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringRunRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringRunRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRecurringRunRequest) GetRecurringRun() *RecurringRun {
+	if x != nil {
+		return x.RecurringRun
+	}
+	return nil
+}
+
+func (x *UpdateRecurringRunRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type EnableRecurringRunRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The ID of the recurring runs to be enabled.
@@ -650,7 +729,7 @@ type EnableRecurringRunRequest struct {
 
 func (x *EnableRecurringRunRequest) Reset() {
 	*x = EnableRecurringRunRequest{}
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[5]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +741,7 @@ func (x *EnableRecurringRunRequest) String() string {
 func (*EnableRecurringRunRequest) ProtoMessage() {}
 
 func (x *EnableRecurringRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[5]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +754,7 @@ func (x *EnableRecurringRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableRecurringRunRequest.ProtoReflect.Descriptor instead.
 func (*EnableRecurringRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{5}
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnableRecurringRunRequest) GetRecurringRunId() string {
@@ -695,7 +774,7 @@ type DisableRecurringRunRequest struct {
 
 func (x *DisableRecurringRunRequest) Reset() {
 	*x = DisableRecurringRunRequest{}
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[6]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -707,7 +786,7 @@ func (x *DisableRecurringRunRequest) String() string {
 func (*DisableRecurringRunRequest) ProtoMessage() {}
 
 func (x *DisableRecurringRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[6]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +799,7 @@ func (x *DisableRecurringRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableRecurringRunRequest.ProtoReflect.Descriptor instead.
 func (*DisableRecurringRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{6}
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DisableRecurringRunRequest) GetRecurringRunId() string {
@@ -730,6 +809,71 @@ func (x *DisableRecurringRunRequest) GetRecurringRunId() string {
 	return ""
 }
 
+type BackfillRecurringRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the recurring run to be backfilled.
+	RecurringRunId string `protobuf:"bytes,1,opt,name=recurring_run_id,json=recurringRunId,proto3" json:"recurring_run_id,omitempty"`
+	// The start time of the backfill window. A trigger time equal to the start
+	// time is backfilled.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end time of the backfill window. Must not be in the future. A trigger
+	// time equal to the end time is backfilled.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillRecurringRunRequest) Reset() {
+	*x = BackfillRecurringRunRequest{}
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillRecurringRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillRecurringRunRequest) ProtoMessage() {}
+
+func (x *BackfillRecurringRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillRecurringRunRequest.ProtoReflect.Descriptor instead.
+func (*BackfillRecurringRunRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BackfillRecurringRunRequest) GetRecurringRunId() string {
+	if x != nil {
+		return x.RecurringRunId
+	}
+	return ""
+}
+
+func (x *BackfillRecurringRunRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BackfillRecurringRunRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
 type DeleteRecurringRunRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The ID of the recurring run to be deleted.
@@ -740,7 +884,7 @@ type DeleteRecurringRunRequest struct {
 
 func (x *DeleteRecurringRunRequest) Reset() {
 	*x = DeleteRecurringRunRequest{}
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -752,7 +896,7 @@ func (x *DeleteRecurringRunRequest) String() string {
 func (*DeleteRecurringRunRequest) ProtoMessage() {}
 
 func (x *DeleteRecurringRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,7 +909,7 @@ func (x *DeleteRecurringRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRecurringRunRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRecurringRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{7}
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteRecurringRunRequest) GetRecurringRunId() string {
@@ -784,14 +928,18 @@ type CronSchedule struct {
 	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	// The cron string. For details how to compose a cron, visit
 	// ttps://en.wikipedia.org/wiki/Cron
-	Cron          string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	// The IANA time zone in which the cron string is evaluated, e.g.
+	// Europe/Berlin. Defaults to the time zone of the scheduled workflow
+	// controller.
+	TimeZone      string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CronSchedule) Reset() {
 	*x = CronSchedule{}
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[8]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -803,7 +951,7 @@ func (x *CronSchedule) String() string {
 func (*CronSchedule) ProtoMessage() {}
 
 func (x *CronSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[8]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +964,7 @@ func (x *CronSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CronSchedule.ProtoReflect.Descriptor instead.
 func (*CronSchedule) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{8}
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CronSchedule) GetStartTime() *timestamppb.Timestamp {
@@ -840,6 +988,13 @@ func (x *CronSchedule) GetCron() string {
 	return ""
 }
 
+func (x *CronSchedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 // PeriodicSchedule allow scheduling the recurring run periodically with certain interval.
 type PeriodicSchedule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -855,7 +1010,7 @@ type PeriodicSchedule struct {
 
 func (x *PeriodicSchedule) Reset() {
 	*x = PeriodicSchedule{}
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[9]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -867,7 +1022,7 @@ func (x *PeriodicSchedule) String() string {
 func (*PeriodicSchedule) ProtoMessage() {}
 
 func (x *PeriodicSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[9]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1035,7 @@ func (x *PeriodicSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeriodicSchedule.ProtoReflect.Descriptor instead.
 func (*PeriodicSchedule) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{9}
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PeriodicSchedule) GetStartTime() *timestamppb.Timestamp {
@@ -904,6 +1059,167 @@ func (x *PeriodicSchedule) GetIntervalSecond() int64 {
 	return 0
 }
 
+// ObjectStoreTrigger creates a run for each object that is written under a
+// bucket prefix after the recurring run is created. Objects are processed in
+// key order, starting after the last processed key, so new objects must be
+// written under increasing keys.
+type ObjectStoreTrigger struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The bucket prefix to watch, e.g. s3://my-bucket/incoming.
+	BucketUrl string `protobuf:"bytes,1,opt,name=bucket_url,json=bucketUrl,proto3" json:"bucket_url,omitempty"`
+	// The provider of the object store, e.g. s3, minio or gs. When empty, the
+	// credentials of the environment are used.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The parameters of the provider, such as the secret holding the credentials.
+	ProviderParams map[string]string `protobuf:"bytes,3,rep,name=provider_params,json=providerParams,proto3" json:"provider_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The interval between two listings of the bucket prefix. Defaults to 60.
+	PollIntervalSecond int64 `protobuf:"varint,4,opt,name=poll_interval_second,json=pollIntervalSecond,proto3" json:"poll_interval_second,omitempty"`
+	// Maps run parameter names to fields of the object: key, uri, size or
+	// modifiedTime.
+	PayloadParameters map[string]string `protobuf:"bytes,5,rep,name=payload_parameters,json=payloadParameters,proto3" json:"payload_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ObjectStoreTrigger) Reset() {
+	*x = ObjectStoreTrigger{}
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectStoreTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStoreTrigger) ProtoMessage() {}
+
+func (x *ObjectStoreTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStoreTrigger.ProtoReflect.Descriptor instead.
+func (*ObjectStoreTrigger) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ObjectStoreTrigger) GetBucketUrl() string {
+	if x != nil {
+		return x.BucketUrl
+	}
+	return ""
+}
+
+func (x *ObjectStoreTrigger) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ObjectStoreTrigger) GetProviderParams() map[string]string {
+	if x != nil {
+		return x.ProviderParams
+	}
+	return nil
+}
+
+func (x *ObjectStoreTrigger) GetPollIntervalSecond() int64 {
+	if x != nil {
+		return x.PollIntervalSecond
+	}
+	return 0
+}
+
+func (x *ObjectStoreTrigger) GetPayloadParameters() map[string]string {
+	if x != nil {
+		return x.PayloadParameters
+	}
+	return nil
+}
+
+// WebhookTrigger creates a run for each signed request posted to
+// /apis/v2beta1/recurringruns/{recurring_run_id}:trigger. The request carries a
+// JSON payload, the header X-Kfp-Signature-Timestamp: <unix seconds> and the
+// header X-Kfp-Signature-256: sha256=<hex HMAC-SHA256 of
+// "<timestamp>.<payload>">. Requests signed more than 5 minutes away from the
+// time of the server are rejected, and each request is accepted once. Like the
+// other triggers, runs are not created beyond the max_concurrency of the
+// recurring run. The limit is best-effort: requests delivered at the same time
+// may each create a run.
+type WebhookTrigger struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the Kubernetes secret, in the namespace of the recurring run,
+	// holding the HMAC key.
+	SecretName string `protobuf:"bytes,1,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	// The key of the HMAC key in the secret. Defaults to hmac-key.
+	SecretKey string `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// Maps run parameter names to fields of the payload. Nested fields are
+	// separated by dots.
+	PayloadParameters map[string]string `protobuf:"bytes,3,rep,name=payload_parameters,json=payloadParameters,proto3" json:"payload_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WebhookTrigger) Reset() {
+	*x = WebhookTrigger{}
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookTrigger) ProtoMessage() {}
+
+func (x *WebhookTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookTrigger.ProtoReflect.Descriptor instead.
+func (*WebhookTrigger) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WebhookTrigger) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *WebhookTrigger) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *WebhookTrigger) GetPayloadParameters() map[string]string {
+	if x != nil {
+		return x.PayloadParameters
+	}
+	return nil
+}
+
 // Trigger defines what starts a pipeline run.
 type Trigger struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -911,6 +1227,8 @@ type Trigger struct {
 	//
 	//	*Trigger_CronSchedule
 	//	*Trigger_PeriodicSchedule
+	//	*Trigger_ObjectStoreTrigger
+	//	*Trigger_WebhookTrigger
 	Trigger       isTrigger_Trigger `protobuf_oneof:"trigger"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -918,7 +1236,7 @@ type Trigger struct {
 
 func (x *Trigger) Reset() {
 	*x = Trigger{}
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[10]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -930,7 +1248,7 @@ func (x *Trigger) String() string {
 func (*Trigger) ProtoMessage() {}
 
 func (x *Trigger) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[10]
+	mi := &file_backend_api_v2beta1_recurring_run_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1261,7 @@ func (x *Trigger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{10}
+	return file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Trigger) GetTrigger() isTrigger_Trigger {
@@ -971,6 +1289,24 @@ func (x *Trigger) GetPeriodicSchedule() *PeriodicSchedule {
 	return nil
 }
 
+func (x *Trigger) GetObjectStoreTrigger() *ObjectStoreTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*Trigger_ObjectStoreTrigger); ok {
+			return x.ObjectStoreTrigger
+		}
+	}
+	return nil
+}
+
+func (x *Trigger) GetWebhookTrigger() *WebhookTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*Trigger_WebhookTrigger); ok {
+			return x.WebhookTrigger
+		}
+	}
+	return nil
+}
+
 type isTrigger_Trigger interface {
 	isTrigger_Trigger()
 }
@@ -983,15 +1319,27 @@ type Trigger_PeriodicSchedule struct {
 	PeriodicSchedule *PeriodicSchedule `protobuf:"bytes,2,opt,name=periodic_schedule,json=periodicSchedule,proto3,oneof"`
 }
 
+type Trigger_ObjectStoreTrigger struct {
+	ObjectStoreTrigger *ObjectStoreTrigger `protobuf:"bytes,3,opt,name=object_store_trigger,json=objectStoreTrigger,proto3,oneof"`
+}
+
+type Trigger_WebhookTrigger struct {
+	WebhookTrigger *WebhookTrigger `protobuf:"bytes,4,opt,name=webhook_trigger,json=webhookTrigger,proto3,oneof"`
+}
+
 func (*Trigger_CronSchedule) isTrigger_Trigger() {}
 
 func (*Trigger_PeriodicSchedule) isTrigger_Trigger() {}
 
+func (*Trigger_ObjectStoreTrigger) isTrigger_Trigger() {}
+
+func (*Trigger_WebhookTrigger) isTrigger_Trigger() {}
+
 var File_backend_api_v2beta1_recurring_run_proto protoreflect.FileDescriptor
 
 const file_backend_api_v2beta1_recurring_run_proto_rawDesc = "" +
 	"\n" +
-	"'backend/api/v2beta1/recurring_run.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a(backend/api/v2beta1/runtime_config.proto\x1a\x1dbackend/api/v2beta1/run.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9e\t\n" +
+	"'backend/api/v2beta1/recurring_run.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a(backend/api/v2beta1/runtime_config.proto\x1a\x1dbackend/api/v2beta1/run.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9b\v\n" +
 	"\fRecurringRun\x12(\n" +
 	"\x10recurring_run_id\x18\x01 \x01(\tR\x0erecurringRunId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -1014,7 +1362,12 @@ const file_backend_api_v2beta1_recurring_run_proto_rawDesc = "" +
 	"\n" +
 	"no_catchup\x18\x0f \x01(\bR\tnoCatchup\x12\x1c\n" +
 	"\tnamespace\x18\x10 \x01(\tR\tnamespace\x12#\n" +
-	"\rexperiment_id\x18\x11 \x01(\tR\fexperimentId\"5\n" +
+	"\rexperiment_id\x18\x11 \x01(\tR\fexperimentId\x12X\n" +
+	"\x06labels\x18\x13 \x03(\v2@.kubeflow.pipelines.backend.api.v2beta1.RecurringRun.LabelsEntryR\x06labels\x12f\n" +
+	"\rnotifications\x18\x14 \x03(\v2@.kubeflow.pipelines.backend.api.v2beta1.NotificationSubscriptionR\rnotifications\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"5\n" +
 	"\x04Mode\x12\x14\n" +
 	"\x10MODE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -1041,33 +1394,69 @@ const file_backend_api_v2beta1_recurring_run_proto_rawDesc = "" +
 	"\rrecurringRuns\x18\x01 \x03(\v24.kubeflow.pipelines.backend.api.v2beta1.RecurringRunR\rrecurringRuns\x12\x1d\n" +
 	"\n" +
 	"total_size\x18\x02 \x01(\x05R\ttotalSize\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"E\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xb3\x01\n" +
+	"\x19UpdateRecurringRunRequest\x12Y\n" +
+	"\rrecurring_run\x18\x01 \x01(\v24.kubeflow.pipelines.backend.api.v2beta1.RecurringRunR\frecurringRun\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"E\n" +
 	"\x19EnableRecurringRunRequest\x12(\n" +
 	"\x10recurring_run_id\x18\x01 \x01(\tR\x0erecurringRunId\"F\n" +
 	"\x1aDisableRecurringRunRequest\x12(\n" +
-	"\x10recurring_run_id\x18\x01 \x01(\tR\x0erecurringRunId\"E\n" +
+	"\x10recurring_run_id\x18\x01 \x01(\tR\x0erecurringRunId\"\xb9\x01\n" +
+	"\x1bBackfillRecurringRunRequest\x12(\n" +
+	"\x10recurring_run_id\x18\x01 \x01(\tR\x0erecurringRunId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"E\n" +
 	"\x19DeleteRecurringRunRequest\x12(\n" +
-	"\x10recurring_run_id\x18\x01 \x01(\tR\x0erecurringRunId\"\x94\x01\n" +
+	"\x10recurring_run_id\x18\x01 \x01(\tR\x0erecurringRunId\"\xb1\x01\n" +
 	"\fCronSchedule\x129\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x12\n" +
-	"\x04cron\x18\x03 \x01(\tR\x04cron\"\xad\x01\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"\xad\x01\n" +
 	"\x10PeriodicSchedule\x129\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12'\n" +
-	"\x0finterval_second\x18\x03 \x01(\x03R\x0eintervalSecond\"\xda\x01\n" +
+	"\x0finterval_second\x18\x03 \x01(\x03R\x0eintervalSecond\"\x86\x04\n" +
+	"\x12ObjectStoreTrigger\x12\x1d\n" +
+	"\n" +
+	"bucket_url\x18\x01 \x01(\tR\tbucketUrl\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12w\n" +
+	"\x0fprovider_params\x18\x03 \x03(\v2N.kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.ProviderParamsEntryR\x0eproviderParams\x120\n" +
+	"\x14poll_interval_second\x18\x04 \x01(\x03R\x12pollIntervalSecond\x12\x80\x01\n" +
+	"\x12payload_parameters\x18\x05 \x03(\v2Q.kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.PayloadParametersEntryR\x11payloadParameters\x1aA\n" +
+	"\x13ProviderParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aD\n" +
+	"\x16PayloadParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x02\n" +
+	"\x0eWebhookTrigger\x12\x1f\n" +
+	"\vsecret_name\x18\x01 \x01(\tR\n" +
+	"secretName\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\x12|\n" +
+	"\x12payload_parameters\x18\x03 \x03(\v2M.kubeflow.pipelines.backend.api.v2beta1.WebhookTrigger.PayloadParametersEntryR\x11payloadParameters\x1aD\n" +
+	"\x16PayloadParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xad\x03\n" +
 	"\aTrigger\x12[\n" +
 	"\rcron_schedule\x18\x01 \x01(\v24.kubeflow.pipelines.backend.api.v2beta1.CronScheduleH\x00R\fcronSchedule\x12g\n" +
-	"\x11periodic_schedule\x18\x02 \x01(\v28.kubeflow.pipelines.backend.api.v2beta1.PeriodicScheduleH\x00R\x10periodicScheduleB\t\n" +
-	"\atrigger2\xea\b\n" +
+	"\x11periodic_schedule\x18\x02 \x01(\v28.kubeflow.pipelines.backend.api.v2beta1.PeriodicScheduleH\x00R\x10periodicSchedule\x12n\n" +
+	"\x14object_store_trigger\x18\x03 \x01(\v2:.kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTriggerH\x00R\x12objectStoreTrigger\x12a\n" +
+	"\x0fwebhook_trigger\x18\x04 \x01(\v26.kubeflow.pipelines.backend.api.v2beta1.WebhookTriggerH\x00R\x0ewebhookTriggerB\t\n" +
+	"\atrigger2\x89\f\n" +
 	"\x13RecurringRunService\x12\xc1\x01\n" +
 	"\x12CreateRecurringRun\x12A.kubeflow.pipelines.backend.api.v2beta1.CreateRecurringRunRequest\x1a4.kubeflow.pipelines.backend.api.v2beta1.RecurringRun\"2\x82\xd3\xe4\x93\x02,:\rrecurring_run\"\x1b/apis/v2beta1/recurringruns\x12\xbf\x01\n" +
 	"\x0fGetRecurringRun\x12>.kubeflow.pipelines.backend.api.v2beta1.GetRecurringRunRequest\x1a4.kubeflow.pipelines.backend.api.v2beta1.RecurringRun\"6\x82\xd3\xe4\x93\x020\x12./apis/v2beta1/recurringruns/{recurring_run_id}\x12\xbd\x01\n" +
-	"\x11ListRecurringRuns\x12@.kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsRequest\x1aA.kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/apis/v2beta1/recurringruns\x12\xae\x01\n" +
+	"\x11ListRecurringRuns\x12@.kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsRequest\x1aA.kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/apis/v2beta1/recurringruns\x12\xe2\x01\n" +
+	"\x12UpdateRecurringRun\x12A.kubeflow.pipelines.backend.api.v2beta1.UpdateRecurringRunRequest\x1a4.kubeflow.pipelines.backend.api.v2beta1.RecurringRun\"S\x82\xd3\xe4\x93\x02M:\rrecurring_run2</apis/v2beta1/recurringruns/{recurring_run.recurring_run_id}\x12\xae\x01\n" +
 	"\x12EnableRecurringRun\x12A.kubeflow.pipelines.backend.api.v2beta1.EnableRecurringRunRequest\x1a\x16.google.protobuf.Empty\"=\x82\xd3\xe4\x93\x027\"5/apis/v2beta1/recurringruns/{recurring_run_id}:enable\x12\xb1\x01\n" +
-	"\x13DisableRecurringRun\x12B.kubeflow.pipelines.backend.api.v2beta1.DisableRecurringRunRequest\x1a\x16.google.protobuf.Empty\">\x82\xd3\xe4\x93\x028\"6/apis/v2beta1/recurringruns/{recurring_run_id}:disable\x12\xa7\x01\n" +
+	"\x13DisableRecurringRun\x12B.kubeflow.pipelines.backend.api.v2beta1.DisableRecurringRunRequest\x1a\x16.google.protobuf.Empty\">\x82\xd3\xe4\x93\x028\"6/apis/v2beta1/recurringruns/{recurring_run_id}:disable\x12\xb7\x01\n" +
+	"\x14BackfillRecurringRun\x12C.kubeflow.pipelines.backend.api.v2beta1.BackfillRecurringRunRequest\x1a\x16.google.protobuf.Empty\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/apis/v2beta1/recurringruns/{recurring_run_id}:backfill\x12\xa7\x01\n" +
 	"\x12DeleteRecurringRun\x12A.kubeflow.pipelines.backend.api.v2beta1.DeleteRecurringRunRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020*./apis/v2beta1/recurringruns/{recurring_run_id}BD\x92A\x04*\x02\x01\x02Z;github.com/kubeflow/pipelines/backend/api/v2beta1/go_clientb\x06proto3"
 
 var (
@@ -1083,63 +1472,88 @@ func file_backend_api_v2beta1_recurring_run_proto_rawDescGZIP() []byte {
 }
 
 var file_backend_api_v2beta1_recurring_run_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_backend_api_v2beta1_recurring_run_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_backend_api_v2beta1_recurring_run_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_backend_api_v2beta1_recurring_run_proto_goTypes = []any{
-	(RecurringRun_Mode)(0),             // 0: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.Mode
-	(RecurringRun_Status)(0),           // 1: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.Status
-	(*RecurringRun)(nil),               // 2: kubeflow.pipelines.backend.api.v2beta1.RecurringRun
-	(*CreateRecurringRunRequest)(nil),  // 3: kubeflow.pipelines.backend.api.v2beta1.CreateRecurringRunRequest
-	(*GetRecurringRunRequest)(nil),     // 4: kubeflow.pipelines.backend.api.v2beta1.GetRecurringRunRequest
-	(*ListRecurringRunsRequest)(nil),   // 5: kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsRequest
-	(*ListRecurringRunsResponse)(nil),  // 6: kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse
-	(*EnableRecurringRunRequest)(nil),  // 7: kubeflow.pipelines.backend.api.v2beta1.EnableRecurringRunRequest
-	(*DisableRecurringRunRequest)(nil), // 8: kubeflow.pipelines.backend.api.v2beta1.DisableRecurringRunRequest
-	(*DeleteRecurringRunRequest)(nil),  // 9: kubeflow.pipelines.backend.api.v2beta1.DeleteRecurringRunRequest
-	(*CronSchedule)(nil),               // 10: kubeflow.pipelines.backend.api.v2beta1.CronSchedule
-	(*PeriodicSchedule)(nil),           // 11: kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule
-	(*Trigger)(nil),                    // 12: kubeflow.pipelines.backend.api.v2beta1.Trigger
-	(*structpb.Struct)(nil),            // 13: google.protobuf.Struct
-	(*PipelineVersionReference)(nil),   // 14: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
-	(*RuntimeConfig)(nil),              // 15: kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
-	(*timestamppb.Timestamp)(nil),      // 16: google.protobuf.Timestamp
-	(*status.Status)(nil),              // 17: google.rpc.Status
-	(*emptypb.Empty)(nil),              // 18: google.protobuf.Empty
+	(RecurringRun_Mode)(0),              // 0: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.Mode
+	(RecurringRun_Status)(0),            // 1: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.Status
+	(*RecurringRun)(nil),                // 2: kubeflow.pipelines.backend.api.v2beta1.RecurringRun
+	(*CreateRecurringRunRequest)(nil),   // 3: kubeflow.pipelines.backend.api.v2beta1.CreateRecurringRunRequest
+	(*GetRecurringRunRequest)(nil),      // 4: kubeflow.pipelines.backend.api.v2beta1.GetRecurringRunRequest
+	(*ListRecurringRunsRequest)(nil),    // 5: kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsRequest
+	(*ListRecurringRunsResponse)(nil),   // 6: kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse
+	(*UpdateRecurringRunRequest)(nil),   // 7: kubeflow.pipelines.backend.api.v2beta1.UpdateRecurringRunRequest
+	(*EnableRecurringRunRequest)(nil),   // 8: kubeflow.pipelines.backend.api.v2beta1.EnableRecurringRunRequest
+	(*DisableRecurringRunRequest)(nil),  // 9: kubeflow.pipelines.backend.api.v2beta1.DisableRecurringRunRequest
+	(*BackfillRecurringRunRequest)(nil), // 10: kubeflow.pipelines.backend.api.v2beta1.BackfillRecurringRunRequest
+	(*DeleteRecurringRunRequest)(nil),   // 11: kubeflow.pipelines.backend.api.v2beta1.DeleteRecurringRunRequest
+	(*CronSchedule)(nil),                // 12: kubeflow.pipelines.backend.api.v2beta1.CronSchedule
+	(*PeriodicSchedule)(nil),            // 13: kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule
+	(*ObjectStoreTrigger)(nil),          // 14: kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger
+	(*WebhookTrigger)(nil),              // 15: kubeflow.pipelines.backend.api.v2beta1.WebhookTrigger
+	(*Trigger)(nil),                     // 16: kubeflow.pipelines.backend.api.v2beta1.Trigger
+	nil,                                 // 17: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.LabelsEntry
+	nil,                                 // 18: kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.ProviderParamsEntry
+	nil,                                 // 19: kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.PayloadParametersEntry
+	nil,                                 // 20: kubeflow.pipelines.backend.api.v2beta1.WebhookTrigger.PayloadParametersEntry
+	(*structpb.Struct)(nil),             // 21: google.protobuf.Struct
+	(*PipelineVersionReference)(nil),    // 22: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
+	(*RuntimeConfig)(nil),               // 23: kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
+	(*timestamppb.Timestamp)(nil),       // 24: google.protobuf.Timestamp
+	(*status.Status)(nil),               // 25: google.rpc.Status
+	(*NotificationSubscription)(nil),    // 26: kubeflow.pipelines.backend.api.v2beta1.NotificationSubscription
+	(*fieldmaskpb.FieldMask)(nil),       // 27: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),               // 28: google.protobuf.Empty
 }
 var file_backend_api_v2beta1_recurring_run_proto_depIdxs = []int32{
-	13, // 0: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.pipeline_spec:type_name -> google.protobuf.Struct
-	14, // 1: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.pipeline_version_reference:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
-	15, // 2: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.runtime_config:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
-	12, // 3: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.trigger:type_name -> kubeflow.pipelines.backend.api.v2beta1.Trigger
+	21, // 0: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.pipeline_spec:type_name -> google.protobuf.Struct
+	22, // 1: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.pipeline_version_reference:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
+	23, // 2: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.runtime_config:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
+	16, // 3: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.trigger:type_name -> kubeflow.pipelines.backend.api.v2beta1.Trigger
 	0,  // 4: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.mode:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun.Mode
-	16, // 5: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.created_at:type_name -> google.protobuf.Timestamp
-	16, // 6: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 5: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.created_at:type_name -> google.protobuf.Timestamp
+	24, // 6: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 7: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.status:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun.Status
-	17, // 8: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.error:type_name -> google.rpc.Status
-	2,  // 9: kubeflow.pipelines.backend.api.v2beta1.CreateRecurringRunRequest.recurring_run:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
-	2,  // 10: kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse.recurringRuns:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
-	16, // 11: kubeflow.pipelines.backend.api.v2beta1.CronSchedule.start_time:type_name -> google.protobuf.Timestamp
-	16, // 12: kubeflow.pipelines.backend.api.v2beta1.CronSchedule.end_time:type_name -> google.protobuf.Timestamp
-	16, // 13: kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule.start_time:type_name -> google.protobuf.Timestamp
-	16, // 14: kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule.end_time:type_name -> google.protobuf.Timestamp
-	10, // 15: kubeflow.pipelines.backend.api.v2beta1.Trigger.cron_schedule:type_name -> kubeflow.pipelines.backend.api.v2beta1.CronSchedule
-	11, // 16: kubeflow.pipelines.backend.api.v2beta1.Trigger.periodic_schedule:type_name -> kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule
-	3,  // 17: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.CreateRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreateRecurringRunRequest
-	4,  // 18: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.GetRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetRecurringRunRequest
-	5,  // 19: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.ListRecurringRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsRequest
-	7,  // 20: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.EnableRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.EnableRecurringRunRequest
-	8,  // 21: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DisableRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.DisableRecurringRunRequest
-	9,  // 22: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DeleteRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeleteRecurringRunRequest
-	2,  // 23: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.CreateRecurringRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
-	2,  // 24: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.GetRecurringRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
-	6,  // 25: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.ListRecurringRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse
-	18, // 26: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.EnableRecurringRun:output_type -> google.protobuf.Empty
-	18, // 27: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DisableRecurringRun:output_type -> google.protobuf.Empty
-	18, // 28: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DeleteRecurringRun:output_type -> google.protobuf.Empty
-	23, // [23:29] is the sub-list for method output_type
-	17, // [17:23] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	25, // 8: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.error:type_name -> google.rpc.Status
+	17, // 9: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.labels:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun.LabelsEntry
+	26, // 10: kubeflow.pipelines.backend.api.v2beta1.RecurringRun.notifications:type_name -> kubeflow.pipelines.backend.api.v2beta1.NotificationSubscription
+	2,  // 11: kubeflow.pipelines.backend.api.v2beta1.CreateRecurringRunRequest.recurring_run:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
+	2,  // 12: kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse.recurringRuns:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
+	2,  // 13: kubeflow.pipelines.backend.api.v2beta1.UpdateRecurringRunRequest.recurring_run:type_name -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
+	27, // 14: kubeflow.pipelines.backend.api.v2beta1.UpdateRecurringRunRequest.update_mask:type_name -> google.protobuf.FieldMask
+	24, // 15: kubeflow.pipelines.backend.api.v2beta1.BackfillRecurringRunRequest.start_time:type_name -> google.protobuf.Timestamp
+	24, // 16: kubeflow.pipelines.backend.api.v2beta1.BackfillRecurringRunRequest.end_time:type_name -> google.protobuf.Timestamp
+	24, // 17: kubeflow.pipelines.backend.api.v2beta1.CronSchedule.start_time:type_name -> google.protobuf.Timestamp
+	24, // 18: kubeflow.pipelines.backend.api.v2beta1.CronSchedule.end_time:type_name -> google.protobuf.Timestamp
+	24, // 19: kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule.start_time:type_name -> google.protobuf.Timestamp
+	24, // 20: kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule.end_time:type_name -> google.protobuf.Timestamp
+	18, // 21: kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.provider_params:type_name -> kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.ProviderParamsEntry
+	19, // 22: kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.payload_parameters:type_name -> kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger.PayloadParametersEntry
+	20, // 23: kubeflow.pipelines.backend.api.v2beta1.WebhookTrigger.payload_parameters:type_name -> kubeflow.pipelines.backend.api.v2beta1.WebhookTrigger.PayloadParametersEntry
+	12, // 24: kubeflow.pipelines.backend.api.v2beta1.Trigger.cron_schedule:type_name -> kubeflow.pipelines.backend.api.v2beta1.CronSchedule
+	13, // 25: kubeflow.pipelines.backend.api.v2beta1.Trigger.periodic_schedule:type_name -> kubeflow.pipelines.backend.api.v2beta1.PeriodicSchedule
+	14, // 26: kubeflow.pipelines.backend.api.v2beta1.Trigger.object_store_trigger:type_name -> kubeflow.pipelines.backend.api.v2beta1.ObjectStoreTrigger
+	15, // 27: kubeflow.pipelines.backend.api.v2beta1.Trigger.webhook_trigger:type_name -> kubeflow.pipelines.backend.api.v2beta1.WebhookTrigger
+	3,  // 28: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.CreateRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreateRecurringRunRequest
+	4,  // 29: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.GetRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetRecurringRunRequest
+	5,  // 30: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.ListRecurringRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsRequest
+	7,  // 31: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.UpdateRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.UpdateRecurringRunRequest
+	8,  // 32: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.EnableRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.EnableRecurringRunRequest
+	9,  // 33: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DisableRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.DisableRecurringRunRequest
+	10, // 34: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.BackfillRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.BackfillRecurringRunRequest
+	11, // 35: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DeleteRecurringRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeleteRecurringRunRequest
+	2,  // 36: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.CreateRecurringRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
+	2,  // 37: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.GetRecurringRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
+	6,  // 38: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.ListRecurringRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListRecurringRunsResponse
+	2,  // 39: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.UpdateRecurringRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.RecurringRun
+	28, // 40: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.EnableRecurringRun:output_type -> google.protobuf.Empty
+	28, // 41: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DisableRecurringRun:output_type -> google.protobuf.Empty
+	28, // 42: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.BackfillRecurringRun:output_type -> google.protobuf.Empty
+	28, // 43: kubeflow.pipelines.backend.api.v2beta1.RecurringRunService.DeleteRecurringRun:output_type -> google.protobuf.Empty
+	36, // [36:44] is the sub-list for method output_type
+	28, // [28:36] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v2beta1_recurring_run_proto_init() }
@@ -1154,9 +1568,11 @@ func file_backend_api_v2beta1_recurring_run_proto_init() {
 		(*RecurringRun_PipelineSpec)(nil),
 		(*RecurringRun_PipelineVersionReference)(nil),
 	}
-	file_backend_api_v2beta1_recurring_run_proto_msgTypes[10].OneofWrappers = []any{
+	file_backend_api_v2beta1_recurring_run_proto_msgTypes[14].OneofWrappers = []any{
 		(*Trigger_CronSchedule)(nil),
 		(*Trigger_PeriodicSchedule)(nil),
+		(*Trigger_ObjectStoreTrigger)(nil),
+		(*Trigger_WebhookTrigger)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1164,7 +1580,7 @@ func file_backend_api_v2beta1_recurring_run_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_api_v2beta1_recurring_run_proto_rawDesc), len(file_backend_api_v2beta1_recurring_run_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,12 +34,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RecurringRunService_CreateRecurringRun_FullMethodName  = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/CreateRecurringRun"
-	RecurringRunService_GetRecurringRun_FullMethodName     = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/GetRecurringRun"
-	RecurringRunService_ListRecurringRuns_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/ListRecurringRuns"
-	RecurringRunService_EnableRecurringRun_FullMethodName  = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/EnableRecurringRun"
-	RecurringRunService_DisableRecurringRun_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/DisableRecurringRun"
-	RecurringRunService_DeleteRecurringRun_FullMethodName  = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/DeleteRecurringRun"
+	RecurringRunService_CreateRecurringRun_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/CreateRecurringRun"
+	RecurringRunService_GetRecurringRun_FullMethodName      = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/GetRecurringRun"
+	RecurringRunService_ListRecurringRuns_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/ListRecurringRuns"
+	RecurringRunService_UpdateRecurringRun_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/UpdateRecurringRun"
+	RecurringRunService_EnableRecurringRun_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/EnableRecurringRun"
+	RecurringRunService_DisableRecurringRun_FullMethodName  = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/DisableRecurringRun"
+	RecurringRunService_BackfillRecurringRun_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/BackfillRecurringRun"
+	RecurringRunService_DeleteRecurringRun_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.RecurringRunService/DeleteRecurringRun"
 )
 
 // RecurringRunServiceClient is the client API for RecurringRunService service.
@@ -53,11 +55,18 @@ type RecurringRunServiceClient interface {
 	// Finds all recurring runs given experiment and namespace.
 	// If experiment ID is not specified, find all recurring runs across all experiments.
 	ListRecurringRuns(ctx context.Context, in *ListRecurringRunsRequest, opts ...grpc.CallOption) (*ListRecurringRunsResponse, error)
+	// Updates the fields of a recurring run listed in the update mask. The changes
+	// apply to the runs scheduled after the update.
+	UpdateRecurringRun(ctx context.Context, in *UpdateRecurringRunRequest, opts ...grpc.CallOption) (*RecurringRun, error)
 	// Restarts a recurring run that was previously stopped. All runs associated with the
 	// recurring run will continue.
 	EnableRecurringRun(ctx context.Context, in *EnableRecurringRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Stops a recurring run and all its associated runs. The recurring run is not deleted.
 	DisableRecurringRun(ctx context.Context, in *DisableRecurringRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates runs for the trigger times of a recurring run within a past time
+	// window. Runs are created over time, subject to the max_concurrency of the
+	// recurring run. Requesting a new window replaces the previous one.
+	BackfillRecurringRun(ctx context.Context, in *BackfillRecurringRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deletes a recurring run.
 	DeleteRecurringRun(ctx context.Context, in *DeleteRecurringRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -100,6 +109,16 @@ func (c *recurringRunServiceClient) ListRecurringRuns(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *recurringRunServiceClient) UpdateRecurringRun(ctx context.Context, in *UpdateRecurringRunRequest, opts ...grpc.CallOption) (*RecurringRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringRun)
+	err := c.cc.Invoke(ctx, RecurringRunService_UpdateRecurringRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *recurringRunServiceClient) EnableRecurringRun(ctx context.Context, in *EnableRecurringRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -120,6 +139,16 @@ func (c *recurringRunServiceClient) DisableRecurringRun(ctx context.Context, in
 	return out, nil
 }
 
+func (c *recurringRunServiceClient) BackfillRecurringRun(ctx context.Context, in *BackfillRecurringRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecurringRunService_BackfillRecurringRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *recurringRunServiceClient) DeleteRecurringRun(ctx context.Context, in *DeleteRecurringRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -141,11 +170,18 @@ type RecurringRunServiceServer interface {
 	// Finds all recurring runs given experiment and namespace.
 	// If experiment ID is not specified, find all recurring runs across all experiments.
 	ListRecurringRuns(context.Context, *ListRecurringRunsRequest) (*ListRecurringRunsResponse, error)
+	// Updates the fields of a recurring run listed in the update mask. The changes
+	// apply to the runs scheduled after the update.
+	UpdateRecurringRun(context.Context, *UpdateRecurringRunRequest) (*RecurringRun, error)
 	// Restarts a recurring run that was previously stopped. All runs associated with the
 	// recurring run will continue.
 	EnableRecurringRun(context.Context, *EnableRecurringRunRequest) (*emptypb.Empty, error)
 	// Stops a recurring run and all its associated runs. The recurring run is not deleted.
 	DisableRecurringRun(context.Context, *DisableRecurringRunRequest) (*emptypb.Empty, error)
+	// Creates runs for the trigger times of a recurring run within a past time
+	// window. Runs are created over time, subject to the max_concurrency of the
+	// recurring run. Requesting a new window replaces the previous one.
+	BackfillRecurringRun(context.Context, *BackfillRecurringRunRequest) (*emptypb.Empty, error)
 	// Deletes a recurring run.
 	DeleteRecurringRun(context.Context, *DeleteRecurringRunRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedRecurringRunServiceServer()
@@ -167,12 +203,18 @@ func (UnimplementedRecurringRunServiceServer) GetRecurringRun(context.Context, *
 func (UnimplementedRecurringRunServiceServer) ListRecurringRuns(context.Context, *ListRecurringRunsRequest) (*ListRecurringRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringRuns not implemented")
 }
+func (UnimplementedRecurringRunServiceServer) UpdateRecurringRun(context.Context, *UpdateRecurringRunRequest) (*RecurringRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringRun not implemented")
+}
 func (UnimplementedRecurringRunServiceServer) EnableRecurringRun(context.Context, *EnableRecurringRunRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableRecurringRun not implemented")
 }
 func (UnimplementedRecurringRunServiceServer) DisableRecurringRun(context.Context, *DisableRecurringRunRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableRecurringRun not implemented")
 }
+func (UnimplementedRecurringRunServiceServer) BackfillRecurringRun(context.Context, *BackfillRecurringRunRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillRecurringRun not implemented")
+}
 func (UnimplementedRecurringRunServiceServer) DeleteRecurringRun(context.Context, *DeleteRecurringRunRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringRun not implemented")
 }
@@ -251,6 +293,24 @@ func _RecurringRunService_ListRecurringRuns_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RecurringRunService_UpdateRecurringRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringRunServiceServer).UpdateRecurringRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringRunService_UpdateRecurringRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringRunServiceServer).UpdateRecurringRun(ctx, req.(*UpdateRecurringRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RecurringRunService_EnableRecurringRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnableRecurringRunRequest)
 	if err := dec(in); err != nil {
@@ -287,6 +347,24 @@ func _RecurringRunService_DisableRecurringRun_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RecurringRunService_BackfillRecurringRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillRecurringRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringRunServiceServer).BackfillRecurringRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringRunService_BackfillRecurringRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringRunServiceServer).BackfillRecurringRun(ctx, req.(*BackfillRecurringRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RecurringRunService_DeleteRecurringRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRecurringRunRequest)
 	if err := dec(in); err != nil {
@@ -324,6 +402,10 @@ var RecurringRunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRecurringRuns",
 			Handler:    _RecurringRunService_ListRecurringRuns_Handler,
 		},
+		{
+			MethodName: "UpdateRecurringRun",
+			Handler:    _RecurringRunService_UpdateRecurringRun_Handler,
+		},
 		{
 			MethodName: "EnableRecurringRun",
 			Handler:    _RecurringRunService_EnableRecurringRun_Handler,
@@ -332,6 +414,10 @@ var RecurringRunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisableRecurringRun",
 			Handler:    _RecurringRunService_DisableRecurringRun_Handler,
 		},
+		{
+			MethodName: "BackfillRecurringRun",
+			Handler:    _RecurringRunService_BackfillRecurringRun_Handler,
+		},
 		{
 			MethodName: "DeleteRecurringRun",
 			Handler:    _RecurringRunService_DeleteRecurringRun_Handler,
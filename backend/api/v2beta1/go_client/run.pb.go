@@ -27,6 +27,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -172,6 +173,192 @@ func (Run_StorageState) EnumDescriptor() ([]byte, []int) {
 	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// Containers of a task whose logs can be read.
+type ReadRunLogRequest_Container int32
+
+const (
+	// Defaults to USER.
+	ReadRunLogRequest_CONTAINER_UNSPECIFIED ReadRunLogRequest_Container = 0
+	// The driver, which resolves the task inputs and decides whether the
+	// task is cached.
+	ReadRunLogRequest_DRIVER ReadRunLogRequest_Container = 1
+	// The init container that installs the launcher into the executor pod.
+	ReadRunLogRequest_LAUNCHER ReadRunLogRequest_Container = 2
+	// The executor, which runs the user code through the launcher.
+	ReadRunLogRequest_USER ReadRunLogRequest_Container = 3
+)
+
+// Enum value maps for ReadRunLogRequest_Container.
+var (
+	ReadRunLogRequest_Container_name = map[int32]string{
+		0: "CONTAINER_UNSPECIFIED",
+		1: "DRIVER",
+		2: "LAUNCHER",
+		3: "USER",
+	}
+	ReadRunLogRequest_Container_value = map[string]int32{
+		"CONTAINER_UNSPECIFIED": 0,
+		"DRIVER":                1,
+		"LAUNCHER":              2,
+		"USER":                  3,
+	}
+)
+
+func (x ReadRunLogRequest_Container) Enum() *ReadRunLogRequest_Container {
+	p := new(ReadRunLogRequest_Container)
+	*p = x
+	return p
+}
+
+func (x ReadRunLogRequest_Container) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReadRunLogRequest_Container) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_run_proto_enumTypes[2].Descriptor()
+}
+
+func (ReadRunLogRequest_Container) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_run_proto_enumTypes[2]
+}
+
+func (x ReadRunLogRequest_Container) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReadRunLogRequest_Container.Descriptor instead.
+func (ReadRunLogRequest_Container) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{23, 0}
+}
+
+// Describes what a row compares.
+type RunComparisonRow_Kind int32
+
+const (
+	// Default value. This value is not used.
+	RunComparisonRow_KIND_UNSPECIFIED RunComparisonRow_Kind = 0
+	// A runtime config parameter. The key is the parameter name.
+	RunComparisonRow_PARAMETER RunComparisonRow_Kind = 1
+	// The state of a task. The value is the name of the runtime state.
+	RunComparisonRow_TASK_STATE RunComparisonRow_Kind = 2
+	// The duration of a task in seconds, if it has started and finished.
+	RunComparisonRow_TASK_DURATION RunComparisonRow_Kind = 3
+	// A scalar metric reported by a task. The key is the metric name, and
+	// the task name is the node ID of the task.
+	RunComparisonRow_METRIC RunComparisonRow_Kind = 4
+	// The URI of an output artifact of a task. The key is the output name.
+	RunComparisonRow_ARTIFACT RunComparisonRow_Kind = 5
+)
+
+// Enum value maps for RunComparisonRow_Kind.
+var (
+	RunComparisonRow_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "PARAMETER",
+		2: "TASK_STATE",
+		3: "TASK_DURATION",
+		4: "METRIC",
+		5: "ARTIFACT",
+	}
+	RunComparisonRow_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"PARAMETER":        1,
+		"TASK_STATE":       2,
+		"TASK_DURATION":    3,
+		"METRIC":           4,
+		"ARTIFACT":         5,
+	}
+)
+
+func (x RunComparisonRow_Kind) Enum() *RunComparisonRow_Kind {
+	p := new(RunComparisonRow_Kind)
+	*p = x
+	return p
+}
+
+func (x RunComparisonRow_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunComparisonRow_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_run_proto_enumTypes[3].Descriptor()
+}
+
+func (RunComparisonRow_Kind) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_run_proto_enumTypes[3]
+}
+
+func (x RunComparisonRow_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunComparisonRow_Kind.Descriptor instead.
+func (RunComparisonRow_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{28, 0}
+}
+
+// The kind of change.
+type WatchRunsResponse_EventType int32
+
+const (
+	// Default value. This value is not used.
+	WatchRunsResponse_EVENT_TYPE_UNSPECIFIED WatchRunsResponse_EventType = 0
+	// The run or task was created.
+	WatchRunsResponse_CREATED WatchRunsResponse_EventType = 1
+	// The run or task was updated, e.g. its state changed.
+	WatchRunsResponse_UPDATED WatchRunsResponse_EventType = 2
+	// The run was deleted, or left the states the watch is filtered by.
+	WatchRunsResponse_DELETED WatchRunsResponse_EventType = 3
+	// No change. Sent when the watch starts and periodically while there
+	// are no changes, with the resource version to resume from.
+	WatchRunsResponse_BOOKMARK WatchRunsResponse_EventType = 4
+)
+
+// Enum value maps for WatchRunsResponse_EventType.
+var (
+	WatchRunsResponse_EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+		4: "BOOKMARK",
+	}
+	WatchRunsResponse_EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED": 0,
+		"CREATED":                1,
+		"UPDATED":                2,
+		"DELETED":                3,
+		"BOOKMARK":               4,
+	}
+)
+
+func (x WatchRunsResponse_EventType) Enum() *WatchRunsResponse_EventType {
+	p := new(WatchRunsResponse_EventType)
+	*p = x
+	return p
+}
+
+func (x WatchRunsResponse_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchRunsResponse_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_v2beta1_run_proto_enumTypes[4].Descriptor()
+}
+
+func (WatchRunsResponse_EventType) Type() protoreflect.EnumType {
+	return &file_backend_api_v2beta1_run_proto_enumTypes[4]
+}
+
+func (x WatchRunsResponse_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchRunsResponse_EventType.Descriptor instead.
+func (WatchRunsResponse_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{33, 0}
+}
+
 type Run struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Input. ID of the parent experiment.
@@ -219,7 +406,13 @@ type Run struct {
 	RecurringRunId string `protobuf:"bytes,16,opt,name=recurring_run_id,json=recurringRunId,proto3" json:"recurring_run_id,omitempty"`
 	// Output. A sequence of run statuses. This field keeps a record
 	// of state transitions.
-	StateHistory  []*RuntimeStatus `protobuf:"bytes,17,rep,name=state_history,json=stateHistory,proto3" json:"state_history,omitempty"`
+	StateHistory []*RuntimeStatus `protobuf:"bytes,17,rep,name=state_history,json=stateHistory,proto3" json:"state_history,omitempty"`
+	// Output. Scalar metrics reported by the tasks of the run.
+	Metrics []*RunMetric `protobuf:"bytes,19,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// Optional input. User-defined labels of the run. They are also added to
+	// the Kubernetes resources of the run. Keys and values must be valid
+	// Kubernetes label keys and values.
+	Labels        map[string]string `protobuf:"bytes,20,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -394,6 +587,20 @@ func (x *Run) GetStateHistory() []*RuntimeStatus {
 	return nil
 }
 
+func (x *Run) GetMetrics() []*RunMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Run) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type isRun_PipelineSource interface {
 	isRun_PipelineSource()
 }
@@ -421,6 +628,71 @@ func (*Run_PipelineSpec) isRun_PipelineSource() {}
 
 func (*Run_PipelineVersionReference) isRun_PipelineSource() {}
 
+// A scalar metric reported by a task of a run, e.g. from a Metrics artifact.
+type RunMetric struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the metric. Runs can be sorted by a metric with the sort key
+	// "metric:<name>", and filtered with the same key.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the node (pod) of the task that reported the metric.
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Value of the metric.
+	NumberValue   float64 `protobuf:"fixed64,3,opt,name=number_value,json=numberValue,proto3" json:"number_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunMetric) Reset() {
+	*x = RunMetric{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunMetric) ProtoMessage() {}
+
+func (x *RunMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunMetric.ProtoReflect.Descriptor instead.
+func (*RunMetric) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RunMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunMetric) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RunMetric) GetNumberValue() float64 {
+	if x != nil {
+		return x.NumberValue
+	}
+	return 0
+}
+
 // Reference to an existing pipeline version.
 type PipelineVersionReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -434,7 +706,7 @@ type PipelineVersionReference struct {
 
 func (x *PipelineVersionReference) Reset() {
 	*x = PipelineVersionReference{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[1]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +718,7 @@ func (x *PipelineVersionReference) String() string {
 func (*PipelineVersionReference) ProtoMessage() {}
 
 func (x *PipelineVersionReference) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[1]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +731,7 @@ func (x *PipelineVersionReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineVersionReference.ProtoReflect.Descriptor instead.
 func (*PipelineVersionReference) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{1}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PipelineVersionReference) GetPipelineId() string {
@@ -495,7 +767,7 @@ type RuntimeStatus struct {
 
 func (x *RuntimeStatus) Reset() {
 	*x = RuntimeStatus{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[2]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +779,7 @@ func (x *RuntimeStatus) String() string {
 func (*RuntimeStatus) ProtoMessage() {}
 
 func (x *RuntimeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[2]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +792,7 @@ func (x *RuntimeStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuntimeStatus.ProtoReflect.Descriptor instead.
 func (*RuntimeStatus) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{2}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RuntimeStatus) GetUpdateTime() *timestamppb.Timestamp {
@@ -544,6 +816,98 @@ func (x *RuntimeStatus) GetError() *status.Status {
 	return nil
 }
 
+// NotificationSubscription posts a CloudEvent to a webhook when a run of an
+// experiment or a recurring run transitions to one of the subscribed states.
+// The event has the type org.kubeflow.pipelines.run.<state>, e.g.
+// org.kubeflow.pipelines.run.failed, and carries the run ID, the pipeline
+// version, the state, the duration and a link to the run. Deliveries that do
+// not get a 2xx response are retried with exponential backoff.
+type NotificationSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required input field. The http or https URL the events are posted to.
+	WebhookUrl string `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Optional input field. The states that are notified, among SUCCEEDED,
+	// FAILED, CANCELED and PENDING. Defaults to SUCCEEDED, FAILED and CANCELED.
+	States []RuntimeState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.RuntimeState" json:"states,omitempty"`
+	// Optional input field. A run is notified as PENDING once it has been
+	// pending for this long. Defaults to 600.
+	PendingTimeoutSeconds int64 `protobuf:"varint,3,opt,name=pending_timeout_seconds,json=pendingTimeoutSeconds,proto3" json:"pending_timeout_seconds,omitempty"`
+	// Optional input field. The name of the Kubernetes secret, in the namespace
+	// of the run, holding the HMAC key the events are signed with. The header
+	// X-Kfp-Signature-256: sha256=<hex HMAC-SHA256 of the body> is added when set.
+	SecretName string `protobuf:"bytes,4,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	// Optional input field. The key of the HMAC key in the secret. Defaults to
+	// hmac-key.
+	SecretKey     string `protobuf:"bytes,5,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSubscription) Reset() {
+	*x = NotificationSubscription{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSubscription) ProtoMessage() {}
+
+func (x *NotificationSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSubscription.ProtoReflect.Descriptor instead.
+func (*NotificationSubscription) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NotificationSubscription) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetStates() []RuntimeState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *NotificationSubscription) GetPendingTimeoutSeconds() int64 {
+	if x != nil {
+		return x.PendingTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *NotificationSubscription) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *NotificationSubscription) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
 // Runtime details of a run.
 type RunDetails struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -559,7 +923,7 @@ type RunDetails struct {
 
 func (x *RunDetails) Reset() {
 	*x = RunDetails{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[3]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -571,7 +935,7 @@ func (x *RunDetails) String() string {
 func (*RunDetails) ProtoMessage() {}
 
 func (x *RunDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[3]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +948,7 @@ func (x *RunDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunDetails.ProtoReflect.Descriptor instead.
 func (*RunDetails) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{3}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RunDetails) GetPipelineContextId() int64 {
@@ -654,7 +1018,7 @@ type PipelineTaskDetail struct {
 
 func (x *PipelineTaskDetail) Reset() {
 	*x = PipelineTaskDetail{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[4]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +1030,7 @@ func (x *PipelineTaskDetail) String() string {
 func (*PipelineTaskDetail) ProtoMessage() {}
 
 func (x *PipelineTaskDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[4]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +1043,7 @@ func (x *PipelineTaskDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineTaskDetail.ProtoReflect.Descriptor instead.
 func (*PipelineTaskDetail) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{4}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PipelineTaskDetail) GetRunId() string {
@@ -819,7 +1183,7 @@ type PipelineTaskExecutorDetail struct {
 
 func (x *PipelineTaskExecutorDetail) Reset() {
 	*x = PipelineTaskExecutorDetail{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[5]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -831,7 +1195,7 @@ func (x *PipelineTaskExecutorDetail) String() string {
 func (*PipelineTaskExecutorDetail) ProtoMessage() {}
 
 func (x *PipelineTaskExecutorDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[5]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1208,7 @@ func (x *PipelineTaskExecutorDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineTaskExecutorDetail.ProtoReflect.Descriptor instead.
 func (*PipelineTaskExecutorDetail) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{5}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PipelineTaskExecutorDetail) GetMainJob() string {
@@ -886,7 +1250,7 @@ type ArtifactList struct {
 
 func (x *ArtifactList) Reset() {
 	*x = ArtifactList{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[6]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -898,7 +1262,7 @@ func (x *ArtifactList) String() string {
 func (*ArtifactList) ProtoMessage() {}
 
 func (x *ArtifactList) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[6]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,7 +1275,7 @@ func (x *ArtifactList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtifactList.ProtoReflect.Descriptor instead.
 func (*ArtifactList) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{6}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ArtifactList) GetArtifactIds() []int64 {
@@ -935,7 +1299,7 @@ type CreateRunRequest struct {
 
 func (x *CreateRunRequest) Reset() {
 	*x = CreateRunRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1311,7 @@ func (x *CreateRunRequest) String() string {
 func (*CreateRunRequest) ProtoMessage() {}
 
 func (x *CreateRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[7]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1324,7 @@ func (x *CreateRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRunRequest.ProtoReflect.Descriptor instead.
 func (*CreateRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{7}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{9}
 }
 
 // Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
@@ -992,7 +1356,7 @@ type GetRunRequest struct {
 
 func (x *GetRunRequest) Reset() {
 	*x = GetRunRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[8]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1368,7 @@ func (x *GetRunRequest) String() string {
 func (*GetRunRequest) ProtoMessage() {}
 
 func (x *GetRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[8]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1381,7 @@ func (x *GetRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRunRequest.ProtoReflect.Descriptor instead.
 func (*GetRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{8}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{10}
 }
 
 // Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
@@ -1061,7 +1425,7 @@ type ListRunsRequest struct {
 
 func (x *ListRunsRequest) Reset() {
 	*x = ListRunsRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[9]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1073,7 +1437,7 @@ func (x *ListRunsRequest) String() string {
 func (*ListRunsRequest) ProtoMessage() {}
 
 func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[9]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1450,7 @@ func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
 func (*ListRunsRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{9}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListRunsRequest) GetNamespace() string {
@@ -1131,33 +1495,32 @@ func (x *ListRunsRequest) GetFilter() string {
 	return ""
 }
 
-type TerminateRunRequest struct {
+type UpdateRunRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the parent experiment.
-	//
-	// Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
-	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
-	// The ID of the run to be terminated.
-	RunId         string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// The run to be updated. The run_id field is required.
+	Run *Run `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	// The fields of the run to update. Supported paths are "description" and
+	// "labels".
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TerminateRunRequest) Reset() {
-	*x = TerminateRunRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[10]
+func (x *UpdateRunRequest) Reset() {
+	*x = UpdateRunRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TerminateRunRequest) String() string {
+func (x *UpdateRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TerminateRunRequest) ProtoMessage() {}
+func (*UpdateRunRequest) ProtoMessage() {}
 
-func (x *TerminateRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[10]
+func (x *UpdateRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,12 +1531,68 @@ func (x *TerminateRunRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TerminateRunRequest.ProtoReflect.Descriptor instead.
-func (*TerminateRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateRunRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRunRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{12}
 }
 
-// Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
+func (x *UpdateRunRequest) GetRun() *Run {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *UpdateRunRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type TerminateRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the parent experiment.
+	//
+	// Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	// The ID of the run to be terminated.
+	RunId         string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateRunRequest) Reset() {
+	*x = TerminateRunRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateRunRequest) ProtoMessage() {}
+
+func (x *TerminateRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateRunRequest.ProtoReflect.Descriptor instead.
+func (*TerminateRunRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{13}
+}
+
+// Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
 func (x *TerminateRunRequest) GetExperimentId() string {
 	if x != nil {
 		return x.ExperimentId
@@ -1196,13 +1615,16 @@ type ListRunsResponse struct {
 	TotalSize int32 `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	// The token to list the next page of runs.
 	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The resource version as of the listing. Watching runs from it returns
+	// the changes after the listing.
+	ResourceVersion string `protobuf:"bytes,4,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListRunsResponse) Reset() {
 	*x = ListRunsResponse{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[11]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1214,7 +1636,7 @@ func (x *ListRunsResponse) String() string {
 func (*ListRunsResponse) ProtoMessage() {}
 
 func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[11]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,7 +1649,7 @@ func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
 func (*ListRunsResponse) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{11}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListRunsResponse) GetRuns() []*Run {
@@ -1251,6 +1673,13 @@ func (x *ListRunsResponse) GetNextPageToken() string {
 	return ""
 }
 
+func (x *ListRunsResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
 type ArchiveRunRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The ID of the parent experiment.
@@ -1265,7 +1694,7 @@ type ArchiveRunRequest struct {
 
 func (x *ArchiveRunRequest) Reset() {
 	*x = ArchiveRunRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[12]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1277,7 +1706,7 @@ func (x *ArchiveRunRequest) String() string {
 func (*ArchiveRunRequest) ProtoMessage() {}
 
 func (x *ArchiveRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[12]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +1719,7 @@ func (x *ArchiveRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRunRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{12}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{15}
 }
 
 // Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
@@ -1322,7 +1751,7 @@ type UnarchiveRunRequest struct {
 
 func (x *UnarchiveRunRequest) Reset() {
 	*x = UnarchiveRunRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[13]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1763,7 @@ func (x *UnarchiveRunRequest) String() string {
 func (*UnarchiveRunRequest) ProtoMessage() {}
 
 func (x *UnarchiveRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[13]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1776,7 @@ func (x *UnarchiveRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnarchiveRunRequest.ProtoReflect.Descriptor instead.
 func (*UnarchiveRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{13}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{16}
 }
 
 // Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
@@ -1379,7 +1808,7 @@ type DeleteRunRequest struct {
 
 func (x *DeleteRunRequest) Reset() {
 	*x = DeleteRunRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[14]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1391,7 +1820,7 @@ func (x *DeleteRunRequest) String() string {
 func (*DeleteRunRequest) ProtoMessage() {}
 
 func (x *DeleteRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[14]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,7 +1833,7 @@ func (x *DeleteRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRunRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{14}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{17}
 }
 
 // Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
@@ -1440,7 +1869,7 @@ type ReadArtifactRequest struct {
 
 func (x *ReadArtifactRequest) Reset() {
 	*x = ReadArtifactRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[15]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1452,7 +1881,7 @@ func (x *ReadArtifactRequest) String() string {
 func (*ReadArtifactRequest) ProtoMessage() {}
 
 func (x *ReadArtifactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[15]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1465,7 +1894,7 @@ func (x *ReadArtifactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadArtifactRequest.ProtoReflect.Descriptor instead.
 func (*ReadArtifactRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{15}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{18}
 }
 
 // Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
@@ -1507,7 +1936,7 @@ type ReadArtifactResponse struct {
 
 func (x *ReadArtifactResponse) Reset() {
 	*x = ReadArtifactResponse{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[16]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1519,7 +1948,7 @@ func (x *ReadArtifactResponse) String() string {
 func (*ReadArtifactResponse) ProtoMessage() {}
 
 func (x *ReadArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[16]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1532,7 +1961,7 @@ func (x *ReadArtifactResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadArtifactResponse.ProtoReflect.Descriptor instead.
 func (*ReadArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{16}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReadArtifactResponse) GetData() []byte {
@@ -1556,7 +1985,7 @@ type RetryRunRequest struct {
 
 func (x *RetryRunRequest) Reset() {
 	*x = RetryRunRequest{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[17]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1568,7 +1997,7 @@ func (x *RetryRunRequest) String() string {
 func (*RetryRunRequest) ProtoMessage() {}
 
 func (x *RetryRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[17]
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,7 +2010,7 @@ func (x *RetryRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryRunRequest.ProtoReflect.Descriptor instead.
 func (*RetryRunRequest) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{17}
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{20}
 }
 
 // Deprecated: Marked as deprecated in backend/api/v2beta1/run.proto.
@@ -1599,34 +2028,29 @@ func (x *RetryRunRequest) GetRunId() string {
 	return ""
 }
 
-// A dependent task that requires this one to succeed.
-// Represented by either task_id or pod_name.
-type PipelineTaskDetail_ChildTask struct {
+type PauseRunRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to ChildTask:
-	//
-	//	*PipelineTaskDetail_ChildTask_TaskId
-	//	*PipelineTaskDetail_ChildTask_PodName
-	ChildTask     isPipelineTaskDetail_ChildTask_ChildTask `protobuf_oneof:"child_task"`
+	// The ID of the run to be paused.
+	RunId         string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PipelineTaskDetail_ChildTask) Reset() {
-	*x = PipelineTaskDetail_ChildTask{}
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[20]
+func (x *PauseRunRequest) Reset() {
+	*x = PauseRunRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PipelineTaskDetail_ChildTask) String() string {
+func (x *PauseRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineTaskDetail_ChildTask) ProtoMessage() {}
+func (*PauseRunRequest) ProtoMessage() {}
 
-func (x *PipelineTaskDetail_ChildTask) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_api_v2beta1_run_proto_msgTypes[20]
+func (x *PauseRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,109 +2061,1010 @@ func (x *PipelineTaskDetail_ChildTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineTaskDetail_ChildTask.ProtoReflect.Descriptor instead.
-func (*PipelineTaskDetail_ChildTask) Descriptor() ([]byte, []int) {
-	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{4, 2}
+// Deprecated: Use PauseRunRequest.ProtoReflect.Descriptor instead.
+func (*PauseRunRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PipelineTaskDetail_ChildTask) GetChildTask() isPipelineTaskDetail_ChildTask_ChildTask {
+func (x *PauseRunRequest) GetRunId() string {
 	if x != nil {
-		return x.ChildTask
+		return x.RunId
 	}
-	return nil
+	return ""
 }
 
-func (x *PipelineTaskDetail_ChildTask) GetTaskId() string {
+type ResumeRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the run to be resumed.
+	RunId         string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRunRequest) Reset() {
+	*x = ResumeRunRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRunRequest) ProtoMessage() {}
+
+func (x *ResumeRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[22]
 	if x != nil {
-		if x, ok := x.ChildTask.(*PipelineTaskDetail_ChildTask_TaskId); ok {
-			return x.TaskId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRunRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRunRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResumeRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *PipelineTaskDetail_ChildTask) GetPodName() string {
+type ReadRunLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the run.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// System-generated ID of the task. Either task_id or task_name must be set.
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Name of the task. Either task_id or task_name must be set.
+	// The name must identify a single task of the run, e.g. tasks inside a
+	// ParallelFor loop have to be addressed by their task_id.
+	TaskName string `protobuf:"bytes,3,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	// The container to read the log from.
+	Container ReadRunLogRequest_Container `protobuf:"varint,4,opt,name=container,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest_Container" json:"container,omitempty"`
+	// Whether to keep streaming the log until the container terminates.
+	// Ignored when the log is read from the log archive.
+	Follow bool `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+	// If positive, only the given number of lines from the end of the log
+	// is returned.
+	TailLines int64 `protobuf:"varint,6,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// If set, only log lines written at or after this time are returned.
+	SinceTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadRunLogRequest) Reset() {
+	*x = ReadRunLogRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadRunLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRunLogRequest) ProtoMessage() {}
+
+func (x *ReadRunLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[23]
 	if x != nil {
-		if x, ok := x.ChildTask.(*PipelineTaskDetail_ChildTask_PodName); ok {
-			return x.PodName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRunLogRequest.ProtoReflect.Descriptor instead.
+func (*ReadRunLogRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReadRunLogRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
 	}
 	return ""
 }
 
-type isPipelineTaskDetail_ChildTask_ChildTask interface {
-	isPipelineTaskDetail_ChildTask_ChildTask()
+func (x *ReadRunLogRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
 }
 
-type PipelineTaskDetail_ChildTask_TaskId struct {
-	// System-generated ID of a task.
-	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3,oneof"`
+func (x *ReadRunLogRequest) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
 }
 
-type PipelineTaskDetail_ChildTask_PodName struct {
-	// Name of the corresponding pod assigned by the orchestration engine.
-	// Also known as node_id.
-	PodName string `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3,oneof"`
+func (x *ReadRunLogRequest) GetContainer() ReadRunLogRequest_Container {
+	if x != nil {
+		return x.Container
+	}
+	return ReadRunLogRequest_CONTAINER_UNSPECIFIED
 }
 
-func (*PipelineTaskDetail_ChildTask_TaskId) isPipelineTaskDetail_ChildTask_ChildTask() {}
+func (x *ReadRunLogRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
 
-func (*PipelineTaskDetail_ChildTask_PodName) isPipelineTaskDetail_ChildTask_ChildTask() {}
+func (x *ReadRunLogRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
 
-var File_backend_api_v2beta1_run_proto protoreflect.FileDescriptor
+func (x *ReadRunLogRequest) GetSinceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SinceTime
+	}
+	return nil
+}
 
-const file_backend_api_v2beta1_run_proto_rawDesc = "" +
-	"\n" +
-	"\x1dbackend/api/v2beta1/run.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a(backend/api/v2beta1/runtime_config.proto\"\xcc\t\n" +
-	"\x03Run\x12#\n" +
-	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\x12\x15\n" +
-	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12!\n" +
-	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12]\n" +
-	"\rstorage_state\x18\x04 \x01(\x0e28.kubeflow.pipelines.backend.api.v2beta1.Run.StorageStateR\fstorageState\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x124\n" +
-	"\x13pipeline_version_id\x18\x06 \x01(\tB\x02\x18\x01H\x00R\x11pipelineVersionId\x12>\n" +
-	"\rpipeline_spec\x18\a \x01(\v2\x17.google.protobuf.StructH\x00R\fpipelineSpec\x12\x80\x01\n" +
-	"\x1apipeline_version_reference\x18\x12 \x01(\v2@.kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReferenceH\x00R\x18pipelineVersionReference\x12\\\n" +
-	"\x0eruntime_config\x18\b \x01(\v25.kubeflow.pipelines.backend.api.v2beta1.RuntimeConfigR\rruntimeConfig\x12'\n" +
-	"\x0fservice_account\x18\t \x01(\tR\x0eserviceAccount\x129\n" +
-	"\n" +
-	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fscheduled_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12;\n" +
-	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"finishedAt\x12J\n" +
-	"\x05state\x18\r \x01(\x0e24.kubeflow.pipelines.backend.api.v2beta1.RuntimeStateR\x05state\x12(\n" +
-	"\x05error\x18\x0e \x01(\v2\x12.google.rpc.StatusR\x05error\x12S\n" +
-	"\vrun_details\x18\x0f \x01(\v22.kubeflow.pipelines.backend.api.v2beta1.RunDetailsR\n" +
-	"runDetails\x12(\n" +
-	"\x10recurring_run_id\x18\x10 \x01(\tR\x0erecurringRunId\x12Z\n" +
-	"\rstate_history\x18\x11 \x03(\v25.kubeflow.pipelines.backend.api.v2beta1.RuntimeStatusR\fstateHistory\"J\n" +
-	"\fStorageState\x12\x1d\n" +
-	"\x19STORAGE_STATE_UNSPECIFIED\x10\x00\x12\r\n" +
-	"\tAVAILABLE\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02B\x11\n" +
-	"\x0fpipeline_source\"k\n" +
-	"\x18PipelineVersionReference\x12\x1f\n" +
-	"\vpipeline_id\x18\x01 \x01(\tR\n" +
-	"pipelineId\x12.\n" +
-	"\x13pipeline_version_id\x18\x02 \x01(\tR\x11pipelineVersionId\"\xc2\x01\n" +
-	"\rRuntimeStatus\x12;\n" +
-	"\vupdate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\x12J\n" +
-	"\x05state\x18\x02 \x01(\x0e24.kubeflow.pipelines.backend.api.v2beta1.RuntimeStateR\x05state\x12(\n" +
-	"\x05error\x18\x03 \x01(\v2\x12.google.rpc.StatusR\x05error\"\xd2\x01\n" +
-	"\n" +
-	"RunDetails\x12.\n" +
-	"\x13pipeline_context_id\x18\x01 \x01(\x03R\x11pipelineContextId\x125\n" +
-	"\x17pipeline_run_context_id\x18\x02 \x01(\x03R\x14pipelineRunContextId\x12]\n" +
-	"\ftask_details\x18\x03 \x03(\v2:.kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetailR\vtaskDetails\"\x99\n" +
-	"\n" +
-	"\x12PipelineTaskDetail\x12\x15\n" +
-	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12!\n" +
-	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12;\n" +
-	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\x129\n" +
+type ReadRunLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One or more complete lines of the log, including the line terminators.
+	Content       string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadRunLogResponse) Reset() {
+	*x = ReadRunLogResponse{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadRunLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRunLogResponse) ProtoMessage() {}
+
+func (x *ReadRunLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRunLogResponse.ProtoReflect.Descriptor instead.
+func (*ReadRunLogResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReadRunLogResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ListRunMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the run.
+	RunId         string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunMetricsRequest) Reset() {
+	*x = ListRunMetricsRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunMetricsRequest) ProtoMessage() {}
+
+func (x *ListRunMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunMetricsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRunMetricsRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type ListRunMetricsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The metrics of the run, ordered by name and node ID.
+	Metrics       []*RunMetric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunMetricsResponse) Reset() {
+	*x = ListRunMetricsResponse{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunMetricsResponse) ProtoMessage() {}
+
+func (x *ListRunMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunMetricsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListRunMetricsResponse) GetMetrics() []*RunMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type CompareRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the runs to compare. At least two and at most ten distinct
+	// runs can be compared.
+	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds,proto3" json:"run_ids,omitempty"`
+	// Whether to only return the rows whose values differ between the runs.
+	OnlyDifferences bool `protobuf:"varint,2,opt,name=only_differences,json=onlyDifferences,proto3" json:"only_differences,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompareRunsRequest) Reset() {
+	*x = CompareRunsRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRunsRequest) ProtoMessage() {}
+
+func (x *CompareRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRunsRequest.ProtoReflect.Descriptor instead.
+func (*CompareRunsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CompareRunsRequest) GetRunIds() []string {
+	if x != nil {
+		return x.RunIds
+	}
+	return nil
+}
+
+func (x *CompareRunsRequest) GetOnlyDifferences() bool {
+	if x != nil {
+		return x.OnlyDifferences
+	}
+	return false
+}
+
+// A row of a run comparison.
+type RunComparisonRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  RunComparisonRow_Kind  `protobuf:"varint,1,opt,name=kind,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow_Kind" json:"kind,omitempty"`
+	// Name of the task the row belongs to. Empty for parameters.
+	TaskName string `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	// Name of the parameter, metric or artifact. Empty for task states and
+	// durations.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// The values of the row, in the order of the compared runs. A null value
+	// means the run has no such parameter, task, metric or artifact.
+	Values []*structpb.Value `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	// Whether the values differ between the runs.
+	Differs       bool `protobuf:"varint,5,opt,name=differs,proto3" json:"differs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunComparisonRow) Reset() {
+	*x = RunComparisonRow{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunComparisonRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunComparisonRow) ProtoMessage() {}
+
+func (x *RunComparisonRow) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunComparisonRow.ProtoReflect.Descriptor instead.
+func (*RunComparisonRow) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RunComparisonRow) GetKind() RunComparisonRow_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RunComparisonRow_KIND_UNSPECIFIED
+}
+
+func (x *RunComparisonRow) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *RunComparisonRow) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RunComparisonRow) GetValues() []*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *RunComparisonRow) GetDiffers() bool {
+	if x != nil {
+		return x.Differs
+	}
+	return false
+}
+
+type CompareRunsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the compared runs, in the order of the row values.
+	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds,proto3" json:"run_ids,omitempty"`
+	// The rows of the comparison, ordered by kind, task name and key.
+	Rows          []*RunComparisonRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareRunsResponse) Reset() {
+	*x = CompareRunsResponse{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRunsResponse) ProtoMessage() {}
+
+func (x *CompareRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRunsResponse.ProtoReflect.Descriptor instead.
+func (*CompareRunsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CompareRunsResponse) GetRunIds() []string {
+	if x != nil {
+		return x.RunIds
+	}
+	return nil
+}
+
+func (x *CompareRunsResponse) GetRows() []*RunComparisonRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type BulkRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the runs. Either run IDs, or a filter or an experiment ID,
+	// must be set.
+	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds,proto3" json:"run_ids,omitempty"`
+	// A url-encoded, JSON-serialized Filter protocol buffer (see
+	// [filter.proto](https://github.com/kubeflow/pipelines/blob/master/backend/api/v2beta1/filter.proto))
+	// that selects the runs. Cannot be combined with run IDs.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional input. The namespace of the runs selected by the filter.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The ID of the parent experiment of the runs selected by the filter. If
+	// set without a filter, all runs of the experiment are selected.
+	ExperimentId  string `protobuf:"bytes,4,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRunsRequest) Reset() {
+	*x = BulkRunsRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRunsRequest) ProtoMessage() {}
+
+func (x *BulkRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRunsRequest.ProtoReflect.Descriptor instead.
+func (*BulkRunsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkRunsRequest) GetRunIds() []string {
+	if x != nil {
+		return x.RunIds
+	}
+	return nil
+}
+
+func (x *BulkRunsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BulkRunsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BulkRunsRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+type BulkRunsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results, in the order of the requested run IDs, or of the runs
+	// selected by the filter.
+	Results       []*BulkRunsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRunsResponse) Reset() {
+	*x = BulkRunsResponse{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRunsResponse) ProtoMessage() {}
+
+func (x *BulkRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRunsResponse.ProtoReflect.Descriptor instead.
+func (*BulkRunsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BulkRunsResponse) GetResults() []*BulkRunsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WatchRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional input. Only the changes of runs in this namespace are returned.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Only the changes of runs in this experiment are returned, if set.
+	ExperimentId string `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	// Only the changes of runs in one of these states are returned, if set.
+	// Changes of tasks are filtered by the state of their run.
+	States []RuntimeState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.RuntimeState" json:"states,omitempty"`
+	// The resource version of the last change seen by the client, or of the
+	// listing of the runs. Only the changes after it are returned. If not set,
+	// only the changes after the watch starts are returned. A watch fails with
+	// OUT_OF_RANGE if the changes after the resource version are no longer
+	// available, in which case the client has to list the runs again.
+	ResourceVersion string `protobuf:"bytes,4,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchRunsRequest) Reset() {
+	*x = WatchRunsRequest{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRunsRequest) ProtoMessage() {}
+
+func (x *WatchRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRunsRequest.ProtoReflect.Descriptor instead.
+func (*WatchRunsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchRunsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchRunsRequest) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *WatchRunsRequest) GetStates() []RuntimeState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *WatchRunsRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type WatchRunsResponse struct {
+	state protoimpl.MessageState      `protogen:"open.v1"`
+	Type  WatchRunsResponse_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse_EventType" json:"type,omitempty"`
+	// The resource version of the change. Pass it to a later watch to resume
+	// after this change.
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	// The run or task that changed. Not set for bookmarks.
+	//
+	// Types that are valid to be assigned to Object:
+	//
+	//	*WatchRunsResponse_Run
+	//	*WatchRunsResponse_TaskDetail
+	Object        isWatchRunsResponse_Object `protobuf_oneof:"object"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRunsResponse) Reset() {
+	*x = WatchRunsResponse{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRunsResponse) ProtoMessage() {}
+
+func (x *WatchRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRunsResponse.ProtoReflect.Descriptor instead.
+func (*WatchRunsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchRunsResponse) GetType() WatchRunsResponse_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchRunsResponse_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchRunsResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *WatchRunsResponse) GetObject() isWatchRunsResponse_Object {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *WatchRunsResponse) GetRun() *Run {
+	if x != nil {
+		if x, ok := x.Object.(*WatchRunsResponse_Run); ok {
+			return x.Run
+		}
+	}
+	return nil
+}
+
+func (x *WatchRunsResponse) GetTaskDetail() *PipelineTaskDetail {
+	if x != nil {
+		if x, ok := x.Object.(*WatchRunsResponse_TaskDetail); ok {
+			return x.TaskDetail
+		}
+	}
+	return nil
+}
+
+type isWatchRunsResponse_Object interface {
+	isWatchRunsResponse_Object()
+}
+
+type WatchRunsResponse_Run struct {
+	// The run, as of the change.
+	Run *Run `protobuf:"bytes,3,opt,name=run,proto3,oneof"`
+}
+
+type WatchRunsResponse_TaskDetail struct {
+	// The task, as of the change. The run_id of the task identifies its run.
+	TaskDetail *PipelineTaskDetail `protobuf:"bytes,4,opt,name=task_detail,json=taskDetail,proto3,oneof"`
+}
+
+func (*WatchRunsResponse_Run) isWatchRunsResponse_Object() {}
+
+func (*WatchRunsResponse_TaskDetail) isWatchRunsResponse_Object() {}
+
+// A dependent task that requires this one to succeed.
+// Represented by either task_id or pod_name.
+type PipelineTaskDetail_ChildTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to ChildTask:
+	//
+	//	*PipelineTaskDetail_ChildTask_TaskId
+	//	*PipelineTaskDetail_ChildTask_PodName
+	ChildTask     isPipelineTaskDetail_ChildTask_ChildTask `protobuf_oneof:"child_task"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineTaskDetail_ChildTask) Reset() {
+	*x = PipelineTaskDetail_ChildTask{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineTaskDetail_ChildTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineTaskDetail_ChildTask) ProtoMessage() {}
+
+func (x *PipelineTaskDetail_ChildTask) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineTaskDetail_ChildTask.ProtoReflect.Descriptor instead.
+func (*PipelineTaskDetail_ChildTask) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *PipelineTaskDetail_ChildTask) GetChildTask() isPipelineTaskDetail_ChildTask_ChildTask {
+	if x != nil {
+		return x.ChildTask
+	}
+	return nil
+}
+
+func (x *PipelineTaskDetail_ChildTask) GetTaskId() string {
+	if x != nil {
+		if x, ok := x.ChildTask.(*PipelineTaskDetail_ChildTask_TaskId); ok {
+			return x.TaskId
+		}
+	}
+	return ""
+}
+
+func (x *PipelineTaskDetail_ChildTask) GetPodName() string {
+	if x != nil {
+		if x, ok := x.ChildTask.(*PipelineTaskDetail_ChildTask_PodName); ok {
+			return x.PodName
+		}
+	}
+	return ""
+}
+
+type isPipelineTaskDetail_ChildTask_ChildTask interface {
+	isPipelineTaskDetail_ChildTask_ChildTask()
+}
+
+type PipelineTaskDetail_ChildTask_TaskId struct {
+	// System-generated ID of a task.
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3,oneof"`
+}
+
+type PipelineTaskDetail_ChildTask_PodName struct {
+	// Name of the corresponding pod assigned by the orchestration engine.
+	// Also known as node_id.
+	PodName string `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3,oneof"`
+}
+
+func (*PipelineTaskDetail_ChildTask_TaskId) isPipelineTaskDetail_ChildTask_ChildTask() {}
+
+func (*PipelineTaskDetail_ChildTask_PodName) isPipelineTaskDetail_ChildTask_ChildTask() {}
+
+// The result of the operation on a run.
+type BulkRunsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the run.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// The error of the operation on the run. Not set if it succeeded.
+	Error         *status.Status `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRunsResponse_Result) Reset() {
+	*x = BulkRunsResponse_Result{}
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRunsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRunsResponse_Result) ProtoMessage() {}
+
+func (x *BulkRunsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_v2beta1_run_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRunsResponse_Result.ProtoReflect.Descriptor instead.
+func (*BulkRunsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_backend_api_v2beta1_run_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *BulkRunsResponse_Result) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *BulkRunsResponse_Result) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_backend_api_v2beta1_run_proto protoreflect.FileDescriptor
+
+const file_backend_api_v2beta1_run_proto_rawDesc = "" +
+	"\n" +
+	"\x1dbackend/api/v2beta1/run.proto\x12&kubeflow.pipelines.backend.api.v2beta1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a(backend/api/v2beta1/runtime_config.proto\"\xa5\v\n" +
+	"\x03Run\x12#\n" +
+	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12]\n" +
+	"\rstorage_state\x18\x04 \x01(\x0e28.kubeflow.pipelines.backend.api.v2beta1.Run.StorageStateR\fstorageState\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x124\n" +
+	"\x13pipeline_version_id\x18\x06 \x01(\tB\x02\x18\x01H\x00R\x11pipelineVersionId\x12>\n" +
+	"\rpipeline_spec\x18\a \x01(\v2\x17.google.protobuf.StructH\x00R\fpipelineSpec\x12\x80\x01\n" +
+	"\x1apipeline_version_reference\x18\x12 \x01(\v2@.kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReferenceH\x00R\x18pipelineVersionReference\x12\\\n" +
+	"\x0eruntime_config\x18\b \x01(\v25.kubeflow.pipelines.backend.api.v2beta1.RuntimeConfigR\rruntimeConfig\x12'\n" +
+	"\x0fservice_account\x18\t \x01(\tR\x0eserviceAccount\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fscheduled_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12J\n" +
+	"\x05state\x18\r \x01(\x0e24.kubeflow.pipelines.backend.api.v2beta1.RuntimeStateR\x05state\x12(\n" +
+	"\x05error\x18\x0e \x01(\v2\x12.google.rpc.StatusR\x05error\x12S\n" +
+	"\vrun_details\x18\x0f \x01(\v22.kubeflow.pipelines.backend.api.v2beta1.RunDetailsR\n" +
+	"runDetails\x12(\n" +
+	"\x10recurring_run_id\x18\x10 \x01(\tR\x0erecurringRunId\x12Z\n" +
+	"\rstate_history\x18\x11 \x03(\v25.kubeflow.pipelines.backend.api.v2beta1.RuntimeStatusR\fstateHistory\x12K\n" +
+	"\ametrics\x18\x13 \x03(\v21.kubeflow.pipelines.backend.api.v2beta1.RunMetricR\ametrics\x12O\n" +
+	"\x06labels\x18\x14 \x03(\v27.kubeflow.pipelines.backend.api.v2beta1.Run.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\fStorageState\x12\x1d\n" +
+	"\x19STORAGE_STATE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tAVAILABLE\x10\x01\x12\f\n" +
+	"\bARCHIVED\x10\x02B\x11\n" +
+	"\x0fpipeline_source\"[\n" +
+	"\tRunMetric\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12!\n" +
+	"\fnumber_value\x18\x03 \x01(\x01R\vnumberValue\"k\n" +
+	"\x18PipelineVersionReference\x12\x1f\n" +
+	"\vpipeline_id\x18\x01 \x01(\tR\n" +
+	"pipelineId\x12.\n" +
+	"\x13pipeline_version_id\x18\x02 \x01(\tR\x11pipelineVersionId\"\xc2\x01\n" +
+	"\rRuntimeStatus\x12;\n" +
+	"\vupdate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12J\n" +
+	"\x05state\x18\x02 \x01(\x0e24.kubeflow.pipelines.backend.api.v2beta1.RuntimeStateR\x05state\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.google.rpc.StatusR\x05error\"\x81\x02\n" +
+	"\x18NotificationSubscription\x12\x1f\n" +
+	"\vwebhook_url\x18\x01 \x01(\tR\n" +
+	"webhookUrl\x12L\n" +
+	"\x06states\x18\x02 \x03(\x0e24.kubeflow.pipelines.backend.api.v2beta1.RuntimeStateR\x06states\x126\n" +
+	"\x17pending_timeout_seconds\x18\x03 \x01(\x03R\x15pendingTimeoutSeconds\x12\x1f\n" +
+	"\vsecret_name\x18\x04 \x01(\tR\n" +
+	"secretName\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x05 \x01(\tR\tsecretKey\"\xd2\x01\n" +
+	"\n" +
+	"RunDetails\x12.\n" +
+	"\x13pipeline_context_id\x18\x01 \x01(\x03R\x11pipelineContextId\x125\n" +
+	"\x17pipeline_run_context_id\x18\x02 \x01(\x03R\x14pipelineRunContextId\x12]\n" +
+	"\ftask_details\x18\x03 \x03(\v2:.kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetailR\vtaskDetails\"\x99\n" +
+	"\n" +
+	"\x12PipelineTaskDetail\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x129\n" +
 	"\n" +
 	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12k\n" +
@@ -1786,15 +3111,20 @@ const file_backend_api_v2beta1_run_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x17\n" +
 	"\asort_by\x18\x05 \x01(\tR\x06sortBy\x12\x16\n" +
-	"\x06filter\x18\x06 \x01(\tR\x06filter\"U\n" +
+	"\x06filter\x18\x06 \x01(\tR\x06filter\"\x8e\x01\n" +
+	"\x10UpdateRunRequest\x12=\n" +
+	"\x03run\x18\x01 \x01(\v2+.kubeflow.pipelines.backend.api.v2beta1.RunR\x03run\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"U\n" +
 	"\x13TerminateRunRequest\x12'\n" +
 	"\rexperiment_id\x18\x01 \x01(\tB\x02\x18\x01R\fexperimentId\x12\x15\n" +
-	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\x9a\x01\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\xc5\x01\n" +
 	"\x10ListRunsResponse\x12?\n" +
 	"\x04runs\x18\x01 \x03(\v2+.kubeflow.pipelines.backend.api.v2beta1.RunR\x04runs\x12\x1d\n" +
 	"\n" +
 	"total_size\x18\x02 \x01(\x05R\ttotalSize\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"S\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x12)\n" +
+	"\x10resource_version\x18\x04 \x01(\tR\x0fresourceVersion\"S\n" +
 	"\x11ArchiveRunRequest\x12'\n" +
 	"\rexperiment_id\x18\x01 \x01(\tB\x02\x18\x01R\fexperimentId\x12\x15\n" +
 	"\x06run_id\x18\x02 \x01(\tR\x05runId\"U\n" +
@@ -1813,7 +3143,82 @@ const file_backend_api_v2beta1_run_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x01(\fR\x04data\"Q\n" +
 	"\x0fRetryRunRequest\x12'\n" +
 	"\rexperiment_id\x18\x01 \x01(\tB\x02\x18\x01R\fexperimentId\x12\x15\n" +
-	"\x06run_id\x18\x02 \x01(\tR\x05runId*\x98\x01\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"(\n" +
+	"\x0fPauseRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\")\n" +
+	"\x10ResumeRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"\x81\x03\n" +
+	"\x11ReadRunLogRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\ttask_name\x18\x03 \x01(\tR\btaskName\x12a\n" +
+	"\tcontainer\x18\x04 \x01(\x0e2C.kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest.ContainerR\tcontainer\x12\x16\n" +
+	"\x06follow\x18\x05 \x01(\bR\x06follow\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\x06 \x01(\x03R\ttailLines\x129\n" +
+	"\n" +
+	"since_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tsinceTime\"J\n" +
+	"\tContainer\x12\x19\n" +
+	"\x15CONTAINER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06DRIVER\x10\x01\x12\f\n" +
+	"\bLAUNCHER\x10\x02\x12\b\n" +
+	"\x04USER\x10\x03\".\n" +
+	"\x12ReadRunLogResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\".\n" +
+	"\x15ListRunMetricsRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"e\n" +
+	"\x16ListRunMetricsResponse\x12K\n" +
+	"\ametrics\x18\x01 \x03(\v21.kubeflow.pipelines.backend.api.v2beta1.RunMetricR\ametrics\"X\n" +
+	"\x12CompareRunsRequest\x12\x17\n" +
+	"\arun_ids\x18\x01 \x03(\tR\x06runIds\x12)\n" +
+	"\x10only_differences\x18\x02 \x01(\bR\x0fonlyDifferences\"\xc8\x02\n" +
+	"\x10RunComparisonRow\x12Q\n" +
+	"\x04kind\x18\x01 \x01(\x0e2=.kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow.KindR\x04kind\x12\x1b\n" +
+	"\ttask_name\x18\x02 \x01(\tR\btaskName\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12.\n" +
+	"\x06values\x18\x04 \x03(\v2\x16.google.protobuf.ValueR\x06values\x12\x18\n" +
+	"\adiffers\x18\x05 \x01(\bR\adiffers\"h\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tPARAMETER\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TASK_STATE\x10\x02\x12\x11\n" +
+	"\rTASK_DURATION\x10\x03\x12\n" +
+	"\n" +
+	"\x06METRIC\x10\x04\x12\f\n" +
+	"\bARTIFACT\x10\x05\"|\n" +
+	"\x13CompareRunsResponse\x12\x17\n" +
+	"\arun_ids\x18\x01 \x03(\tR\x06runIds\x12L\n" +
+	"\x04rows\x18\x02 \x03(\v28.kubeflow.pipelines.backend.api.v2beta1.RunComparisonRowR\x04rows\"\x85\x01\n" +
+	"\x0fBulkRunsRequest\x12\x17\n" +
+	"\arun_ids\x18\x01 \x03(\tR\x06runIds\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12#\n" +
+	"\rexperiment_id\x18\x04 \x01(\tR\fexperimentId\"\xb8\x01\n" +
+	"\x10BulkRunsResponse\x12Y\n" +
+	"\aresults\x18\x01 \x03(\v2?.kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse.ResultR\aresults\x1aI\n" +
+	"\x06Result\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.google.rpc.StatusR\x05error\"\xce\x01\n" +
+	"\x10WatchRunsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12#\n" +
+	"\rexperiment_id\x18\x02 \x01(\tR\fexperimentId\x12L\n" +
+	"\x06states\x18\x03 \x03(\x0e24.kubeflow.pipelines.backend.api.v2beta1.RuntimeStateR\x06states\x12)\n" +
+	"\x10resource_version\x18\x04 \x01(\tR\x0fresourceVersion\"\x9f\x03\n" +
+	"\x11WatchRunsResponse\x12W\n" +
+	"\x04type\x18\x01 \x01(\x0e2C.kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse.EventTypeR\x04type\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\x12?\n" +
+	"\x03run\x18\x03 \x01(\v2+.kubeflow.pipelines.backend.api.v2beta1.RunH\x00R\x03run\x12]\n" +
+	"\vtask_detail\x18\x04 \x01(\v2:.kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetailH\x00R\n" +
+	"taskDetail\"\\\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aUPDATED\x10\x02\x12\v\n" +
+	"\aDELETED\x10\x03\x12\f\n" +
+	"\bBOOKMARK\x10\x04B\b\n" +
+	"\x06object*\x98\x01\n" +
 	"\fRuntimeState\x12\x1d\n" +
 	"\x19RUNTIME_STATE_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\v\n" +
@@ -1825,20 +3230,32 @@ const file_backend_api_v2beta1_run_proto_rawDesc = "" +
 	"\tCANCELING\x10\x06\x12\f\n" +
 	"\bCANCELED\x10\a\x12\n" +
 	"\n" +
-	"\x06PAUSED\x10\b2\xf9\n" +
-	"\n" +
+	"\x06PAUSED\x10\b2\xf2\x1a\n" +
 	"\n" +
 	"RunService\x12\x93\x01\n" +
 	"\tCreateRun\x128.kubeflow.pipelines.backend.api.v2beta1.CreateRunRequest\x1a+.kubeflow.pipelines.backend.api.v2beta1.Run\"\x1f\x82\xd3\xe4\x93\x02\x19:\x03run\"\x12/apis/v2beta1/runs\x12\x91\x01\n" +
 	"\x06GetRun\x125.kubeflow.pipelines.backend.api.v2beta1.GetRunRequest\x1a+.kubeflow.pipelines.backend.api.v2beta1.Run\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/apis/v2beta1/runs/{run_id}\x12\x99\x01\n" +
-	"\bListRuns\x127.kubeflow.pipelines.backend.api.v2beta1.ListRunsRequest\x1a8.kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/apis/v2beta1/runs\x12\x8c\x01\n" +
+	"\bListRuns\x127.kubeflow.pipelines.backend.api.v2beta1.ListRunsRequest\x1a8.kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/apis/v2beta1/runs\x12\xa0\x01\n" +
+	"\tUpdateRun\x128.kubeflow.pipelines.backend.api.v2beta1.UpdateRunRequest\x1a+.kubeflow.pipelines.backend.api.v2beta1.Run\",\x82\xd3\xe4\x93\x02&:\x03run2\x1f/apis/v2beta1/runs/{run.run_id}\x12\x8c\x01\n" +
 	"\n" +
 	"ArchiveRun\x129.kubeflow.pipelines.backend.api.v2beta1.ArchiveRunRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%\"#/apis/v2beta1/runs/{run_id}:archive\x12\x92\x01\n" +
 	"\fUnarchiveRun\x12;.kubeflow.pipelines.backend.api.v2beta1.UnarchiveRunRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'\"%/apis/v2beta1/runs/{run_id}:unarchive\x12\x82\x01\n" +
 	"\tDeleteRun\x128.kubeflow.pipelines.backend.api.v2beta1.DeleteRunRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/apis/v2beta1/runs/{run_id}\x12\xdd\x01\n" +
 	"\fReadArtifact\x12;.kubeflow.pipelines.backend.api.v2beta1.ReadArtifactRequest\x1a<.kubeflow.pipelines.backend.api.v2beta1.ReadArtifactResponse\"R\x82\xd3\xe4\x93\x02L\x12J/apis/v2beta1/runs/{run_id}/nodes/{node_id}/artifacts/{artifact_name}:read\x12\x92\x01\n" +
 	"\fTerminateRun\x12;.kubeflow.pipelines.backend.api.v2beta1.TerminateRunRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'\"%/apis/v2beta1/runs/{run_id}:terminate\x12\x86\x01\n" +
-	"\bRetryRun\x127.kubeflow.pipelines.backend.api.v2beta1.RetryRunRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#\"!/apis/v2beta1/runs/{run_id}:retryB\x98\x01\x92AX*\x02\x01\x02R#\n" +
+	"\bRetryRun\x127.kubeflow.pipelines.backend.api.v2beta1.RetryRunRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#\"!/apis/v2beta1/runs/{run_id}:retry\x12\x86\x01\n" +
+	"\bPauseRun\x127.kubeflow.pipelines.backend.api.v2beta1.PauseRunRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#\"!/apis/v2beta1/runs/{run_id}:pause\x12\x89\x01\n" +
+	"\tResumeRun\x128.kubeflow.pipelines.backend.api.v2beta1.ResumeRunRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$\"\"/apis/v2beta1/runs/{run_id}:resume\x12\xaf\x01\n" +
+	"\n" +
+	"ReadRunLog\x129.kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest\x1a:.kubeflow.pipelines.backend.api.v2beta1.ReadRunLogResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /apis/v2beta1/runs/{run_id}/logs0\x01\x12\xbc\x01\n" +
+	"\x0eListRunMetrics\x12=.kubeflow.pipelines.backend.api.v2beta1.ListRunMetricsRequest\x1a>.kubeflow.pipelines.backend.api.v2beta1.ListRunMetricsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/apis/v2beta1/runs/{run_id}/metrics\x12\xaf\x01\n" +
+	"\x0fBulkArchiveRuns\x127.kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest\x1a8.kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/apis/v2beta1/runs:bulkArchive\x12\xb3\x01\n" +
+	"\x11BulkUnarchiveRuns\x127.kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest\x1a8.kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /apis/v2beta1/runs:bulkUnarchive\x12\xad\x01\n" +
+	"\x0eBulkDeleteRuns\x127.kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest\x1a8.kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/apis/v2beta1/runs:bulkDelete\x12\xb3\x01\n" +
+	"\x11BulkTerminateRuns\x127.kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest\x1a8.kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /apis/v2beta1/runs:bulkTerminate\x12\xab\x01\n" +
+	"\rBulkRetryRuns\x127.kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest\x1a8.kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/apis/v2beta1/runs:bulkRetry\x12\xaa\x01\n" +
+	"\vCompareRuns\x12:.kubeflow.pipelines.backend.api.v2beta1.CompareRunsRequest\x1a;.kubeflow.pipelines.backend.api.v2beta1.CompareRunsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/apis/v2beta1/runs:compare\x12\xa4\x01\n" +
+	"\tWatchRuns\x128.kubeflow.pipelines.backend.api.v2beta1.WatchRunsRequest\x1a9.kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/apis/v2beta1/runs:watch0\x01B\x98\x01\x92AX*\x02\x01\x02R#\n" +
 	"\adefault\x12\x18\x12\x16\n" +
 	"\x14\x1a\x12.google.rpc.StatusZ\x1f\n" +
 	"\x1d\n" +
@@ -1859,91 +3276,155 @@ func file_backend_api_v2beta1_run_proto_rawDescGZIP() []byte {
 	return file_backend_api_v2beta1_run_proto_rawDescData
 }
 
-var file_backend_api_v2beta1_run_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_backend_api_v2beta1_run_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_backend_api_v2beta1_run_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_backend_api_v2beta1_run_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_backend_api_v2beta1_run_proto_goTypes = []any{
 	(RuntimeState)(0),                    // 0: kubeflow.pipelines.backend.api.v2beta1.RuntimeState
 	(Run_StorageState)(0),                // 1: kubeflow.pipelines.backend.api.v2beta1.Run.StorageState
-	(*Run)(nil),                          // 2: kubeflow.pipelines.backend.api.v2beta1.Run
-	(*PipelineVersionReference)(nil),     // 3: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
-	(*RuntimeStatus)(nil),                // 4: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus
-	(*RunDetails)(nil),                   // 5: kubeflow.pipelines.backend.api.v2beta1.RunDetails
-	(*PipelineTaskDetail)(nil),           // 6: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail
-	(*PipelineTaskExecutorDetail)(nil),   // 7: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskExecutorDetail
-	(*ArtifactList)(nil),                 // 8: kubeflow.pipelines.backend.api.v2beta1.ArtifactList
-	(*CreateRunRequest)(nil),             // 9: kubeflow.pipelines.backend.api.v2beta1.CreateRunRequest
-	(*GetRunRequest)(nil),                // 10: kubeflow.pipelines.backend.api.v2beta1.GetRunRequest
-	(*ListRunsRequest)(nil),              // 11: kubeflow.pipelines.backend.api.v2beta1.ListRunsRequest
-	(*TerminateRunRequest)(nil),          // 12: kubeflow.pipelines.backend.api.v2beta1.TerminateRunRequest
-	(*ListRunsResponse)(nil),             // 13: kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse
-	(*ArchiveRunRequest)(nil),            // 14: kubeflow.pipelines.backend.api.v2beta1.ArchiveRunRequest
-	(*UnarchiveRunRequest)(nil),          // 15: kubeflow.pipelines.backend.api.v2beta1.UnarchiveRunRequest
-	(*DeleteRunRequest)(nil),             // 16: kubeflow.pipelines.backend.api.v2beta1.DeleteRunRequest
-	(*ReadArtifactRequest)(nil),          // 17: kubeflow.pipelines.backend.api.v2beta1.ReadArtifactRequest
-	(*ReadArtifactResponse)(nil),         // 18: kubeflow.pipelines.backend.api.v2beta1.ReadArtifactResponse
-	(*RetryRunRequest)(nil),              // 19: kubeflow.pipelines.backend.api.v2beta1.RetryRunRequest
-	nil,                                  // 20: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.InputsEntry
-	nil,                                  // 21: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.OutputsEntry
-	(*PipelineTaskDetail_ChildTask)(nil), // 22: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.ChildTask
-	(*structpb.Struct)(nil),              // 23: google.protobuf.Struct
-	(*RuntimeConfig)(nil),                // 24: kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
-	(*timestamppb.Timestamp)(nil),        // 25: google.protobuf.Timestamp
-	(*status.Status)(nil),                // 26: google.rpc.Status
-	(*emptypb.Empty)(nil),                // 27: google.protobuf.Empty
+	(ReadRunLogRequest_Container)(0),     // 2: kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest.Container
+	(RunComparisonRow_Kind)(0),           // 3: kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow.Kind
+	(WatchRunsResponse_EventType)(0),     // 4: kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse.EventType
+	(*Run)(nil),                          // 5: kubeflow.pipelines.backend.api.v2beta1.Run
+	(*RunMetric)(nil),                    // 6: kubeflow.pipelines.backend.api.v2beta1.RunMetric
+	(*PipelineVersionReference)(nil),     // 7: kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
+	(*RuntimeStatus)(nil),                // 8: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus
+	(*NotificationSubscription)(nil),     // 9: kubeflow.pipelines.backend.api.v2beta1.NotificationSubscription
+	(*RunDetails)(nil),                   // 10: kubeflow.pipelines.backend.api.v2beta1.RunDetails
+	(*PipelineTaskDetail)(nil),           // 11: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail
+	(*PipelineTaskExecutorDetail)(nil),   // 12: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskExecutorDetail
+	(*ArtifactList)(nil),                 // 13: kubeflow.pipelines.backend.api.v2beta1.ArtifactList
+	(*CreateRunRequest)(nil),             // 14: kubeflow.pipelines.backend.api.v2beta1.CreateRunRequest
+	(*GetRunRequest)(nil),                // 15: kubeflow.pipelines.backend.api.v2beta1.GetRunRequest
+	(*ListRunsRequest)(nil),              // 16: kubeflow.pipelines.backend.api.v2beta1.ListRunsRequest
+	(*UpdateRunRequest)(nil),             // 17: kubeflow.pipelines.backend.api.v2beta1.UpdateRunRequest
+	(*TerminateRunRequest)(nil),          // 18: kubeflow.pipelines.backend.api.v2beta1.TerminateRunRequest
+	(*ListRunsResponse)(nil),             // 19: kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse
+	(*ArchiveRunRequest)(nil),            // 20: kubeflow.pipelines.backend.api.v2beta1.ArchiveRunRequest
+	(*UnarchiveRunRequest)(nil),          // 21: kubeflow.pipelines.backend.api.v2beta1.UnarchiveRunRequest
+	(*DeleteRunRequest)(nil),             // 22: kubeflow.pipelines.backend.api.v2beta1.DeleteRunRequest
+	(*ReadArtifactRequest)(nil),          // 23: kubeflow.pipelines.backend.api.v2beta1.ReadArtifactRequest
+	(*ReadArtifactResponse)(nil),         // 24: kubeflow.pipelines.backend.api.v2beta1.ReadArtifactResponse
+	(*RetryRunRequest)(nil),              // 25: kubeflow.pipelines.backend.api.v2beta1.RetryRunRequest
+	(*PauseRunRequest)(nil),              // 26: kubeflow.pipelines.backend.api.v2beta1.PauseRunRequest
+	(*ResumeRunRequest)(nil),             // 27: kubeflow.pipelines.backend.api.v2beta1.ResumeRunRequest
+	(*ReadRunLogRequest)(nil),            // 28: kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest
+	(*ReadRunLogResponse)(nil),           // 29: kubeflow.pipelines.backend.api.v2beta1.ReadRunLogResponse
+	(*ListRunMetricsRequest)(nil),        // 30: kubeflow.pipelines.backend.api.v2beta1.ListRunMetricsRequest
+	(*ListRunMetricsResponse)(nil),       // 31: kubeflow.pipelines.backend.api.v2beta1.ListRunMetricsResponse
+	(*CompareRunsRequest)(nil),           // 32: kubeflow.pipelines.backend.api.v2beta1.CompareRunsRequest
+	(*RunComparisonRow)(nil),             // 33: kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow
+	(*CompareRunsResponse)(nil),          // 34: kubeflow.pipelines.backend.api.v2beta1.CompareRunsResponse
+	(*BulkRunsRequest)(nil),              // 35: kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest
+	(*BulkRunsResponse)(nil),             // 36: kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse
+	(*WatchRunsRequest)(nil),             // 37: kubeflow.pipelines.backend.api.v2beta1.WatchRunsRequest
+	(*WatchRunsResponse)(nil),            // 38: kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse
+	nil,                                  // 39: kubeflow.pipelines.backend.api.v2beta1.Run.LabelsEntry
+	nil,                                  // 40: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.InputsEntry
+	nil,                                  // 41: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.OutputsEntry
+	(*PipelineTaskDetail_ChildTask)(nil), // 42: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.ChildTask
+	(*BulkRunsResponse_Result)(nil),      // 43: kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse.Result
+	(*structpb.Struct)(nil),              // 44: google.protobuf.Struct
+	(*RuntimeConfig)(nil),                // 45: kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
+	(*timestamppb.Timestamp)(nil),        // 46: google.protobuf.Timestamp
+	(*status.Status)(nil),                // 47: google.rpc.Status
+	(*fieldmaskpb.FieldMask)(nil),        // 48: google.protobuf.FieldMask
+	(*structpb.Value)(nil),               // 49: google.protobuf.Value
+	(*emptypb.Empty)(nil),                // 50: google.protobuf.Empty
 }
 var file_backend_api_v2beta1_run_proto_depIdxs = []int32{
 	1,  // 0: kubeflow.pipelines.backend.api.v2beta1.Run.storage_state:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run.StorageState
-	23, // 1: kubeflow.pipelines.backend.api.v2beta1.Run.pipeline_spec:type_name -> google.protobuf.Struct
-	3,  // 2: kubeflow.pipelines.backend.api.v2beta1.Run.pipeline_version_reference:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
-	24, // 3: kubeflow.pipelines.backend.api.v2beta1.Run.runtime_config:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
-	25, // 4: kubeflow.pipelines.backend.api.v2beta1.Run.created_at:type_name -> google.protobuf.Timestamp
-	25, // 5: kubeflow.pipelines.backend.api.v2beta1.Run.scheduled_at:type_name -> google.protobuf.Timestamp
-	25, // 6: kubeflow.pipelines.backend.api.v2beta1.Run.finished_at:type_name -> google.protobuf.Timestamp
+	44, // 1: kubeflow.pipelines.backend.api.v2beta1.Run.pipeline_spec:type_name -> google.protobuf.Struct
+	7,  // 2: kubeflow.pipelines.backend.api.v2beta1.Run.pipeline_version_reference:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineVersionReference
+	45, // 3: kubeflow.pipelines.backend.api.v2beta1.Run.runtime_config:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeConfig
+	46, // 4: kubeflow.pipelines.backend.api.v2beta1.Run.created_at:type_name -> google.protobuf.Timestamp
+	46, // 5: kubeflow.pipelines.backend.api.v2beta1.Run.scheduled_at:type_name -> google.protobuf.Timestamp
+	46, // 6: kubeflow.pipelines.backend.api.v2beta1.Run.finished_at:type_name -> google.protobuf.Timestamp
 	0,  // 7: kubeflow.pipelines.backend.api.v2beta1.Run.state:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeState
-	26, // 8: kubeflow.pipelines.backend.api.v2beta1.Run.error:type_name -> google.rpc.Status
-	5,  // 9: kubeflow.pipelines.backend.api.v2beta1.Run.run_details:type_name -> kubeflow.pipelines.backend.api.v2beta1.RunDetails
-	4,  // 10: kubeflow.pipelines.backend.api.v2beta1.Run.state_history:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus
-	25, // 11: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 12: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus.state:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeState
-	26, // 13: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus.error:type_name -> google.rpc.Status
-	6,  // 14: kubeflow.pipelines.backend.api.v2beta1.RunDetails.task_details:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail
-	25, // 15: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.create_time:type_name -> google.protobuf.Timestamp
-	25, // 16: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.start_time:type_name -> google.protobuf.Timestamp
-	25, // 17: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.end_time:type_name -> google.protobuf.Timestamp
-	7,  // 18: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.executor_detail:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskExecutorDetail
-	0,  // 19: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.state:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeState
-	26, // 20: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.error:type_name -> google.rpc.Status
-	20, // 21: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.inputs:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.InputsEntry
-	21, // 22: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.outputs:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.OutputsEntry
-	4,  // 23: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.state_history:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus
-	22, // 24: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.child_tasks:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.ChildTask
-	2,  // 25: kubeflow.pipelines.backend.api.v2beta1.CreateRunRequest.run:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run
-	2,  // 26: kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse.runs:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run
-	8,  // 27: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.InputsEntry.value:type_name -> kubeflow.pipelines.backend.api.v2beta1.ArtifactList
-	8,  // 28: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.OutputsEntry.value:type_name -> kubeflow.pipelines.backend.api.v2beta1.ArtifactList
-	9,  // 29: kubeflow.pipelines.backend.api.v2beta1.RunService.CreateRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreateRunRequest
-	10, // 30: kubeflow.pipelines.backend.api.v2beta1.RunService.GetRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetRunRequest
-	11, // 31: kubeflow.pipelines.backend.api.v2beta1.RunService.ListRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListRunsRequest
-	14, // 32: kubeflow.pipelines.backend.api.v2beta1.RunService.ArchiveRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.ArchiveRunRequest
-	15, // 33: kubeflow.pipelines.backend.api.v2beta1.RunService.UnarchiveRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.UnarchiveRunRequest
-	16, // 34: kubeflow.pipelines.backend.api.v2beta1.RunService.DeleteRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeleteRunRequest
-	17, // 35: kubeflow.pipelines.backend.api.v2beta1.RunService.ReadArtifact:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReadArtifactRequest
-	12, // 36: kubeflow.pipelines.backend.api.v2beta1.RunService.TerminateRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.TerminateRunRequest
-	19, // 37: kubeflow.pipelines.backend.api.v2beta1.RunService.RetryRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.RetryRunRequest
-	2,  // 38: kubeflow.pipelines.backend.api.v2beta1.RunService.CreateRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.Run
-	2,  // 39: kubeflow.pipelines.backend.api.v2beta1.RunService.GetRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.Run
-	13, // 40: kubeflow.pipelines.backend.api.v2beta1.RunService.ListRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse
-	27, // 41: kubeflow.pipelines.backend.api.v2beta1.RunService.ArchiveRun:output_type -> google.protobuf.Empty
-	27, // 42: kubeflow.pipelines.backend.api.v2beta1.RunService.UnarchiveRun:output_type -> google.protobuf.Empty
-	27, // 43: kubeflow.pipelines.backend.api.v2beta1.RunService.DeleteRun:output_type -> google.protobuf.Empty
-	18, // 44: kubeflow.pipelines.backend.api.v2beta1.RunService.ReadArtifact:output_type -> kubeflow.pipelines.backend.api.v2beta1.ReadArtifactResponse
-	27, // 45: kubeflow.pipelines.backend.api.v2beta1.RunService.TerminateRun:output_type -> google.protobuf.Empty
-	27, // 46: kubeflow.pipelines.backend.api.v2beta1.RunService.RetryRun:output_type -> google.protobuf.Empty
-	38, // [38:47] is the sub-list for method output_type
-	29, // [29:38] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	47, // 8: kubeflow.pipelines.backend.api.v2beta1.Run.error:type_name -> google.rpc.Status
+	10, // 9: kubeflow.pipelines.backend.api.v2beta1.Run.run_details:type_name -> kubeflow.pipelines.backend.api.v2beta1.RunDetails
+	8,  // 10: kubeflow.pipelines.backend.api.v2beta1.Run.state_history:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus
+	6,  // 11: kubeflow.pipelines.backend.api.v2beta1.Run.metrics:type_name -> kubeflow.pipelines.backend.api.v2beta1.RunMetric
+	39, // 12: kubeflow.pipelines.backend.api.v2beta1.Run.labels:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run.LabelsEntry
+	46, // 13: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 14: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus.state:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeState
+	47, // 15: kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus.error:type_name -> google.rpc.Status
+	0,  // 16: kubeflow.pipelines.backend.api.v2beta1.NotificationSubscription.states:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeState
+	11, // 17: kubeflow.pipelines.backend.api.v2beta1.RunDetails.task_details:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail
+	46, // 18: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.create_time:type_name -> google.protobuf.Timestamp
+	46, // 19: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.start_time:type_name -> google.protobuf.Timestamp
+	46, // 20: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.end_time:type_name -> google.protobuf.Timestamp
+	12, // 21: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.executor_detail:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskExecutorDetail
+	0,  // 22: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.state:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeState
+	47, // 23: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.error:type_name -> google.rpc.Status
+	40, // 24: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.inputs:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.InputsEntry
+	41, // 25: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.outputs:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.OutputsEntry
+	8,  // 26: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.state_history:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeStatus
+	42, // 27: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.child_tasks:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.ChildTask
+	5,  // 28: kubeflow.pipelines.backend.api.v2beta1.CreateRunRequest.run:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run
+	5,  // 29: kubeflow.pipelines.backend.api.v2beta1.UpdateRunRequest.run:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run
+	48, // 30: kubeflow.pipelines.backend.api.v2beta1.UpdateRunRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 31: kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse.runs:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run
+	2,  // 32: kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest.container:type_name -> kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest.Container
+	46, // 33: kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest.since_time:type_name -> google.protobuf.Timestamp
+	6,  // 34: kubeflow.pipelines.backend.api.v2beta1.ListRunMetricsResponse.metrics:type_name -> kubeflow.pipelines.backend.api.v2beta1.RunMetric
+	3,  // 35: kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow.kind:type_name -> kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow.Kind
+	49, // 36: kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow.values:type_name -> google.protobuf.Value
+	33, // 37: kubeflow.pipelines.backend.api.v2beta1.CompareRunsResponse.rows:type_name -> kubeflow.pipelines.backend.api.v2beta1.RunComparisonRow
+	43, // 38: kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse.results:type_name -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse.Result
+	0,  // 39: kubeflow.pipelines.backend.api.v2beta1.WatchRunsRequest.states:type_name -> kubeflow.pipelines.backend.api.v2beta1.RuntimeState
+	4,  // 40: kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse.type:type_name -> kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse.EventType
+	5,  // 41: kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse.run:type_name -> kubeflow.pipelines.backend.api.v2beta1.Run
+	11, // 42: kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse.task_detail:type_name -> kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail
+	13, // 43: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.InputsEntry.value:type_name -> kubeflow.pipelines.backend.api.v2beta1.ArtifactList
+	13, // 44: kubeflow.pipelines.backend.api.v2beta1.PipelineTaskDetail.OutputsEntry.value:type_name -> kubeflow.pipelines.backend.api.v2beta1.ArtifactList
+	47, // 45: kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse.Result.error:type_name -> google.rpc.Status
+	14, // 46: kubeflow.pipelines.backend.api.v2beta1.RunService.CreateRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.CreateRunRequest
+	15, // 47: kubeflow.pipelines.backend.api.v2beta1.RunService.GetRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.GetRunRequest
+	16, // 48: kubeflow.pipelines.backend.api.v2beta1.RunService.ListRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListRunsRequest
+	17, // 49: kubeflow.pipelines.backend.api.v2beta1.RunService.UpdateRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.UpdateRunRequest
+	20, // 50: kubeflow.pipelines.backend.api.v2beta1.RunService.ArchiveRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.ArchiveRunRequest
+	21, // 51: kubeflow.pipelines.backend.api.v2beta1.RunService.UnarchiveRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.UnarchiveRunRequest
+	22, // 52: kubeflow.pipelines.backend.api.v2beta1.RunService.DeleteRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.DeleteRunRequest
+	23, // 53: kubeflow.pipelines.backend.api.v2beta1.RunService.ReadArtifact:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReadArtifactRequest
+	18, // 54: kubeflow.pipelines.backend.api.v2beta1.RunService.TerminateRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.TerminateRunRequest
+	25, // 55: kubeflow.pipelines.backend.api.v2beta1.RunService.RetryRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.RetryRunRequest
+	26, // 56: kubeflow.pipelines.backend.api.v2beta1.RunService.PauseRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.PauseRunRequest
+	27, // 57: kubeflow.pipelines.backend.api.v2beta1.RunService.ResumeRun:input_type -> kubeflow.pipelines.backend.api.v2beta1.ResumeRunRequest
+	28, // 58: kubeflow.pipelines.backend.api.v2beta1.RunService.ReadRunLog:input_type -> kubeflow.pipelines.backend.api.v2beta1.ReadRunLogRequest
+	30, // 59: kubeflow.pipelines.backend.api.v2beta1.RunService.ListRunMetrics:input_type -> kubeflow.pipelines.backend.api.v2beta1.ListRunMetricsRequest
+	35, // 60: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkArchiveRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest
+	35, // 61: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkUnarchiveRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest
+	35, // 62: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkDeleteRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest
+	35, // 63: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkTerminateRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest
+	35, // 64: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkRetryRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsRequest
+	32, // 65: kubeflow.pipelines.backend.api.v2beta1.RunService.CompareRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.CompareRunsRequest
+	37, // 66: kubeflow.pipelines.backend.api.v2beta1.RunService.WatchRuns:input_type -> kubeflow.pipelines.backend.api.v2beta1.WatchRunsRequest
+	5,  // 67: kubeflow.pipelines.backend.api.v2beta1.RunService.CreateRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.Run
+	5,  // 68: kubeflow.pipelines.backend.api.v2beta1.RunService.GetRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.Run
+	19, // 69: kubeflow.pipelines.backend.api.v2beta1.RunService.ListRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListRunsResponse
+	5,  // 70: kubeflow.pipelines.backend.api.v2beta1.RunService.UpdateRun:output_type -> kubeflow.pipelines.backend.api.v2beta1.Run
+	50, // 71: kubeflow.pipelines.backend.api.v2beta1.RunService.ArchiveRun:output_type -> google.protobuf.Empty
+	50, // 72: kubeflow.pipelines.backend.api.v2beta1.RunService.UnarchiveRun:output_type -> google.protobuf.Empty
+	50, // 73: kubeflow.pipelines.backend.api.v2beta1.RunService.DeleteRun:output_type -> google.protobuf.Empty
+	24, // 74: kubeflow.pipelines.backend.api.v2beta1.RunService.ReadArtifact:output_type -> kubeflow.pipelines.backend.api.v2beta1.ReadArtifactResponse
+	50, // 75: kubeflow.pipelines.backend.api.v2beta1.RunService.TerminateRun:output_type -> google.protobuf.Empty
+	50, // 76: kubeflow.pipelines.backend.api.v2beta1.RunService.RetryRun:output_type -> google.protobuf.Empty
+	50, // 77: kubeflow.pipelines.backend.api.v2beta1.RunService.PauseRun:output_type -> google.protobuf.Empty
+	50, // 78: kubeflow.pipelines.backend.api.v2beta1.RunService.ResumeRun:output_type -> google.protobuf.Empty
+	29, // 79: kubeflow.pipelines.backend.api.v2beta1.RunService.ReadRunLog:output_type -> kubeflow.pipelines.backend.api.v2beta1.ReadRunLogResponse
+	31, // 80: kubeflow.pipelines.backend.api.v2beta1.RunService.ListRunMetrics:output_type -> kubeflow.pipelines.backend.api.v2beta1.ListRunMetricsResponse
+	36, // 81: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkArchiveRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse
+	36, // 82: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkUnarchiveRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse
+	36, // 83: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkDeleteRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse
+	36, // 84: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkTerminateRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse
+	36, // 85: kubeflow.pipelines.backend.api.v2beta1.RunService.BulkRetryRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.BulkRunsResponse
+	34, // 86: kubeflow.pipelines.backend.api.v2beta1.RunService.CompareRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.CompareRunsResponse
+	38, // 87: kubeflow.pipelines.backend.api.v2beta1.RunService.WatchRuns:output_type -> kubeflow.pipelines.backend.api.v2beta1.WatchRunsResponse
+	67, // [67:88] is the sub-list for method output_type
+	46, // [46:67] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_v2beta1_run_proto_init() }
@@ -1957,7 +3438,11 @@ func file_backend_api_v2beta1_run_proto_init() {
 		(*Run_PipelineSpec)(nil),
 		(*Run_PipelineVersionReference)(nil),
 	}
-	file_backend_api_v2beta1_run_proto_msgTypes[20].OneofWrappers = []any{
+	file_backend_api_v2beta1_run_proto_msgTypes[33].OneofWrappers = []any{
+		(*WatchRunsResponse_Run)(nil),
+		(*WatchRunsResponse_TaskDetail)(nil),
+	}
+	file_backend_api_v2beta1_run_proto_msgTypes[37].OneofWrappers = []any{
 		(*PipelineTaskDetail_ChildTask_TaskId)(nil),
 		(*PipelineTaskDetail_ChildTask_PodName)(nil),
 	}
@@ -1966,8 +3451,8 @@ func file_backend_api_v2beta1_run_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backend_api_v2beta1_run_proto_rawDesc), len(file_backend_api_v2beta1_run_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   21,
+			NumEnums:      5,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
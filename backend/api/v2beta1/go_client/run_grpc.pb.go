@@ -34,15 +34,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RunService_CreateRun_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun"
-	RunService_GetRun_FullMethodName       = "/kubeflow.pipelines.backend.api.v2beta1.RunService/GetRun"
-	RunService_ListRuns_FullMethodName     = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ListRuns"
-	RunService_ArchiveRun_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ArchiveRun"
-	RunService_UnarchiveRun_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.RunService/UnarchiveRun"
-	RunService_DeleteRun_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.RunService/DeleteRun"
-	RunService_ReadArtifact_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ReadArtifact"
-	RunService_TerminateRun_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.RunService/TerminateRun"
-	RunService_RetryRun_FullMethodName     = "/kubeflow.pipelines.backend.api.v2beta1.RunService/RetryRun"
+	RunService_CreateRun_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.RunService/CreateRun"
+	RunService_GetRun_FullMethodName            = "/kubeflow.pipelines.backend.api.v2beta1.RunService/GetRun"
+	RunService_ListRuns_FullMethodName          = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ListRuns"
+	RunService_UpdateRun_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.RunService/UpdateRun"
+	RunService_ArchiveRun_FullMethodName        = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ArchiveRun"
+	RunService_UnarchiveRun_FullMethodName      = "/kubeflow.pipelines.backend.api.v2beta1.RunService/UnarchiveRun"
+	RunService_DeleteRun_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.RunService/DeleteRun"
+	RunService_ReadArtifact_FullMethodName      = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ReadArtifact"
+	RunService_TerminateRun_FullMethodName      = "/kubeflow.pipelines.backend.api.v2beta1.RunService/TerminateRun"
+	RunService_RetryRun_FullMethodName          = "/kubeflow.pipelines.backend.api.v2beta1.RunService/RetryRun"
+	RunService_PauseRun_FullMethodName          = "/kubeflow.pipelines.backend.api.v2beta1.RunService/PauseRun"
+	RunService_ResumeRun_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ResumeRun"
+	RunService_ReadRunLog_FullMethodName        = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ReadRunLog"
+	RunService_ListRunMetrics_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.RunService/ListRunMetrics"
+	RunService_BulkArchiveRuns_FullMethodName   = "/kubeflow.pipelines.backend.api.v2beta1.RunService/BulkArchiveRuns"
+	RunService_BulkUnarchiveRuns_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.RunService/BulkUnarchiveRuns"
+	RunService_BulkDeleteRuns_FullMethodName    = "/kubeflow.pipelines.backend.api.v2beta1.RunService/BulkDeleteRuns"
+	RunService_BulkTerminateRuns_FullMethodName = "/kubeflow.pipelines.backend.api.v2beta1.RunService/BulkTerminateRuns"
+	RunService_BulkRetryRuns_FullMethodName     = "/kubeflow.pipelines.backend.api.v2beta1.RunService/BulkRetryRuns"
+	RunService_CompareRuns_FullMethodName       = "/kubeflow.pipelines.backend.api.v2beta1.RunService/CompareRuns"
+	RunService_WatchRuns_FullMethodName         = "/kubeflow.pipelines.backend.api.v2beta1.RunService/WatchRuns"
 )
 
 // RunServiceClient is the client API for RunService service.
@@ -57,6 +69,9 @@ type RunServiceClient interface {
 	// Finds all runs in an experiment given by experiment ID.
 	// If experiment id is not specified, finds all runs across all experiments.
 	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
+	// Updates the description and labels of a run. Only the fields listed in the
+	// update mask are changed.
+	UpdateRun(ctx context.Context, in *UpdateRunRequest, opts ...grpc.CallOption) (*Run, error)
 	// Archives a run in an experiment given by run ID and experiment ID.
 	ArchiveRun(ctx context.Context, in *ArchiveRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Restores an archived run in an experiment given by run ID and experiment ID.
@@ -69,6 +84,44 @@ type RunServiceClient interface {
 	TerminateRun(ctx context.Context, in *TerminateRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Re-initiates a failed or terminated run.
 	RetryRun(ctx context.Context, in *RetryRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pauses an active run. Running tasks are allowed to finish, but no new
+	// tasks are started until the run is resumed.
+	PauseRun(ctx context.Context, in *PauseRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Resumes a paused run.
+	ResumeRun(ctx context.Context, in *ResumeRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams the log of a run task. The task is addressed by its task ID or
+	// task name as reported in the run details. Once the task pod is gone, the
+	// log is read from the log archive, if one is configured.
+	ReadRunLog(ctx context.Context, in *ReadRunLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadRunLogResponse], error)
+	// Finds the metrics reported by the tasks of a run.
+	ListRunMetrics(ctx context.Context, in *ListRunMetricsRequest, opts ...grpc.CallOption) (*ListRunMetricsResponse, error)
+	// Archives the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkArchiveRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error)
+	// Un-archives the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkUnarchiveRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error)
+	// Deletes the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkDeleteRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error)
+	// Terminates the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkTerminateRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error)
+	// Retries the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkRetryRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error)
+	// Compares runs side by side. Returns a table with one row per runtime
+	// parameter, task state, task duration, metric and output artifact of the
+	// runs, and one column per run.
+	CompareRuns(ctx context.Context, in *CompareRunsRequest, opts ...grpc.CallOption) (*CompareRunsResponse, error)
+	// Streams the changes of runs and of their tasks as they are reported.
+	// Starts with the changes after the given resource version, if any, so that
+	// a client can list the runs once and then watch them, or reconnect
+	// without missing changes. Over HTTP, the changes are sent as server-sent
+	// events if the request accepts text/event-stream.
+	// The changes are recorded in the database, so a watch sees the changes
+	// made through all API server replicas, and can be resumed on any of them.
+	WatchRuns(ctx context.Context, in *WatchRunsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchRunsResponse], error)
 }
 
 type runServiceClient struct {
@@ -109,6 +162,16 @@ func (c *runServiceClient) ListRuns(ctx context.Context, in *ListRunsRequest, op
 	return out, nil
 }
 
+func (c *runServiceClient) UpdateRun(ctx context.Context, in *UpdateRunRequest, opts ...grpc.CallOption) (*Run, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Run)
+	err := c.cc.Invoke(ctx, RunService_UpdateRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runServiceClient) ArchiveRun(ctx context.Context, in *ArchiveRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -169,6 +232,134 @@ func (c *runServiceClient) RetryRun(ctx context.Context, in *RetryRunRequest, op
 	return out, nil
 }
 
+func (c *runServiceClient) PauseRun(ctx context.Context, in *PauseRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RunService_PauseRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) ResumeRun(ctx context.Context, in *ResumeRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RunService_ResumeRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) ReadRunLog(ctx context.Context, in *ReadRunLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadRunLogResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RunService_ServiceDesc.Streams[0], RunService_ReadRunLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReadRunLogRequest, ReadRunLogResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RunService_ReadRunLogClient = grpc.ServerStreamingClient[ReadRunLogResponse]
+
+func (c *runServiceClient) ListRunMetrics(ctx context.Context, in *ListRunMetricsRequest, opts ...grpc.CallOption) (*ListRunMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunMetricsResponse)
+	err := c.cc.Invoke(ctx, RunService_ListRunMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) BulkArchiveRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkRunsResponse)
+	err := c.cc.Invoke(ctx, RunService_BulkArchiveRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) BulkUnarchiveRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkRunsResponse)
+	err := c.cc.Invoke(ctx, RunService_BulkUnarchiveRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) BulkDeleteRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkRunsResponse)
+	err := c.cc.Invoke(ctx, RunService_BulkDeleteRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) BulkTerminateRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkRunsResponse)
+	err := c.cc.Invoke(ctx, RunService_BulkTerminateRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) BulkRetryRuns(ctx context.Context, in *BulkRunsRequest, opts ...grpc.CallOption) (*BulkRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkRunsResponse)
+	err := c.cc.Invoke(ctx, RunService_BulkRetryRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) CompareRuns(ctx context.Context, in *CompareRunsRequest, opts ...grpc.CallOption) (*CompareRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareRunsResponse)
+	err := c.cc.Invoke(ctx, RunService_CompareRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) WatchRuns(ctx context.Context, in *WatchRunsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchRunsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RunService_ServiceDesc.Streams[1], RunService_WatchRuns_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRunsRequest, WatchRunsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RunService_WatchRunsClient = grpc.ServerStreamingClient[WatchRunsResponse]
+
 // RunServiceServer is the server API for RunService service.
 // All implementations must embed UnimplementedRunServiceServer
 // for forward compatibility.
@@ -181,6 +372,9 @@ type RunServiceServer interface {
 	// Finds all runs in an experiment given by experiment ID.
 	// If experiment id is not specified, finds all runs across all experiments.
 	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	// Updates the description and labels of a run. Only the fields listed in the
+	// update mask are changed.
+	UpdateRun(context.Context, *UpdateRunRequest) (*Run, error)
 	// Archives a run in an experiment given by run ID and experiment ID.
 	ArchiveRun(context.Context, *ArchiveRunRequest) (*emptypb.Empty, error)
 	// Restores an archived run in an experiment given by run ID and experiment ID.
@@ -193,6 +387,44 @@ type RunServiceServer interface {
 	TerminateRun(context.Context, *TerminateRunRequest) (*emptypb.Empty, error)
 	// Re-initiates a failed or terminated run.
 	RetryRun(context.Context, *RetryRunRequest) (*emptypb.Empty, error)
+	// Pauses an active run. Running tasks are allowed to finish, but no new
+	// tasks are started until the run is resumed.
+	PauseRun(context.Context, *PauseRunRequest) (*emptypb.Empty, error)
+	// Resumes a paused run.
+	ResumeRun(context.Context, *ResumeRunRequest) (*emptypb.Empty, error)
+	// Streams the log of a run task. The task is addressed by its task ID or
+	// task name as reported in the run details. Once the task pod is gone, the
+	// log is read from the log archive, if one is configured.
+	ReadRunLog(*ReadRunLogRequest, grpc.ServerStreamingServer[ReadRunLogResponse]) error
+	// Finds the metrics reported by the tasks of a run.
+	ListRunMetrics(context.Context, *ListRunMetricsRequest) (*ListRunMetricsResponse, error)
+	// Archives the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkArchiveRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error)
+	// Un-archives the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkUnarchiveRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error)
+	// Deletes the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkDeleteRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error)
+	// Terminates the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkTerminateRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error)
+	// Retries the runs with the given IDs, or the runs matching a filter, in
+	// batches. Returns the result of each run.
+	BulkRetryRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error)
+	// Compares runs side by side. Returns a table with one row per runtime
+	// parameter, task state, task duration, metric and output artifact of the
+	// runs, and one column per run.
+	CompareRuns(context.Context, *CompareRunsRequest) (*CompareRunsResponse, error)
+	// Streams the changes of runs and of their tasks as they are reported.
+	// Starts with the changes after the given resource version, if any, so that
+	// a client can list the runs once and then watch them, or reconnect
+	// without missing changes. Over HTTP, the changes are sent as server-sent
+	// events if the request accepts text/event-stream.
+	// The changes are recorded in the database, so a watch sees the changes
+	// made through all API server replicas, and can be resumed on any of them.
+	WatchRuns(*WatchRunsRequest, grpc.ServerStreamingServer[WatchRunsResponse]) error
 	mustEmbedUnimplementedRunServiceServer()
 }
 
@@ -212,6 +444,9 @@ func (UnimplementedRunServiceServer) GetRun(context.Context, *GetRunRequest) (*R
 func (UnimplementedRunServiceServer) ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRuns not implemented")
 }
+func (UnimplementedRunServiceServer) UpdateRun(context.Context, *UpdateRunRequest) (*Run, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRun not implemented")
+}
 func (UnimplementedRunServiceServer) ArchiveRun(context.Context, *ArchiveRunRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveRun not implemented")
 }
@@ -230,6 +465,39 @@ func (UnimplementedRunServiceServer) TerminateRun(context.Context, *TerminateRun
 func (UnimplementedRunServiceServer) RetryRun(context.Context, *RetryRunRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryRun not implemented")
 }
+func (UnimplementedRunServiceServer) PauseRun(context.Context, *PauseRunRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRun not implemented")
+}
+func (UnimplementedRunServiceServer) ResumeRun(context.Context, *ResumeRunRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRun not implemented")
+}
+func (UnimplementedRunServiceServer) ReadRunLog(*ReadRunLogRequest, grpc.ServerStreamingServer[ReadRunLogResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReadRunLog not implemented")
+}
+func (UnimplementedRunServiceServer) ListRunMetrics(context.Context, *ListRunMetricsRequest) (*ListRunMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunMetrics not implemented")
+}
+func (UnimplementedRunServiceServer) BulkArchiveRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkArchiveRuns not implemented")
+}
+func (UnimplementedRunServiceServer) BulkUnarchiveRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUnarchiveRuns not implemented")
+}
+func (UnimplementedRunServiceServer) BulkDeleteRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteRuns not implemented")
+}
+func (UnimplementedRunServiceServer) BulkTerminateRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkTerminateRuns not implemented")
+}
+func (UnimplementedRunServiceServer) BulkRetryRuns(context.Context, *BulkRunsRequest) (*BulkRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkRetryRuns not implemented")
+}
+func (UnimplementedRunServiceServer) CompareRuns(context.Context, *CompareRunsRequest) (*CompareRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareRuns not implemented")
+}
+func (UnimplementedRunServiceServer) WatchRuns(*WatchRunsRequest, grpc.ServerStreamingServer[WatchRunsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRuns not implemented")
+}
 func (UnimplementedRunServiceServer) mustEmbedUnimplementedRunServiceServer() {}
 func (UnimplementedRunServiceServer) testEmbeddedByValue()                    {}
 
@@ -305,6 +573,24 @@ func _RunService_ListRuns_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RunService_UpdateRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).UpdateRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_UpdateRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).UpdateRun(ctx, req.(*UpdateRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RunService_ArchiveRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveRunRequest)
 	if err := dec(in); err != nil {
@@ -413,6 +699,190 @@ func _RunService_RetryRun_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RunService_PauseRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).PauseRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_PauseRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).PauseRun(ctx, req.(*PauseRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_ResumeRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).ResumeRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_ResumeRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).ResumeRun(ctx, req.(*ResumeRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_ReadRunLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadRunLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RunServiceServer).ReadRunLog(m, &grpc.GenericServerStream[ReadRunLogRequest, ReadRunLogResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RunService_ReadRunLogServer = grpc.ServerStreamingServer[ReadRunLogResponse]
+
+func _RunService_ListRunMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).ListRunMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_ListRunMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).ListRunMetrics(ctx, req.(*ListRunMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_BulkArchiveRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BulkArchiveRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_BulkArchiveRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BulkArchiveRuns(ctx, req.(*BulkRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_BulkUnarchiveRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BulkUnarchiveRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_BulkUnarchiveRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BulkUnarchiveRuns(ctx, req.(*BulkRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_BulkDeleteRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BulkDeleteRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_BulkDeleteRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BulkDeleteRuns(ctx, req.(*BulkRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_BulkTerminateRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BulkTerminateRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_BulkTerminateRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BulkTerminateRuns(ctx, req.(*BulkRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_BulkRetryRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BulkRetryRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_BulkRetryRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BulkRetryRuns(ctx, req.(*BulkRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_CompareRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).CompareRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RunService_CompareRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).CompareRuns(ctx, req.(*CompareRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_WatchRuns_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRunsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RunServiceServer).WatchRuns(m, &grpc.GenericServerStream[WatchRunsRequest, WatchRunsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RunService_WatchRunsServer = grpc.ServerStreamingServer[WatchRunsResponse]
+
 // RunService_ServiceDesc is the grpc.ServiceDesc for RunService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -432,6 +902,10 @@ var RunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRuns",
 			Handler:    _RunService_ListRuns_Handler,
 		},
+		{
+			MethodName: "UpdateRun",
+			Handler:    _RunService_UpdateRun_Handler,
+		},
 		{
 			MethodName: "ArchiveRun",
 			Handler:    _RunService_ArchiveRun_Handler,
@@ -456,7 +930,54 @@ var RunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetryRun",
 			Handler:    _RunService_RetryRun_Handler,
 		},
+		{
+			MethodName: "PauseRun",
+			Handler:    _RunService_PauseRun_Handler,
+		},
+		{
+			MethodName: "ResumeRun",
+			Handler:    _RunService_ResumeRun_Handler,
+		},
+		{
+			MethodName: "ListRunMetrics",
+			Handler:    _RunService_ListRunMetrics_Handler,
+		},
+		{
+			MethodName: "BulkArchiveRuns",
+			Handler:    _RunService_BulkArchiveRuns_Handler,
+		},
+		{
+			MethodName: "BulkUnarchiveRuns",
+			Handler:    _RunService_BulkUnarchiveRuns_Handler,
+		},
+		{
+			MethodName: "BulkDeleteRuns",
+			Handler:    _RunService_BulkDeleteRuns_Handler,
+		},
+		{
+			MethodName: "BulkTerminateRuns",
+			Handler:    _RunService_BulkTerminateRuns_Handler,
+		},
+		{
+			MethodName: "BulkRetryRuns",
+			Handler:    _RunService_BulkRetryRuns_Handler,
+		},
+		{
+			MethodName: "CompareRuns",
+			Handler:    _RunService_CompareRuns_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadRunLog",
+			Handler:       _RunService_ReadRunLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchRuns",
+			Handler:       _RunService_WatchRuns_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/v2beta1/run.proto",
 }
@@ -56,6 +56,12 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	ExperimentServiceArchiveExperiment(params *ExperimentServiceArchiveExperimentParams, opts ...ClientOption) (*ExperimentServiceArchiveExperimentOK, error)
 
+	ExperimentServiceBulkArchiveExperiments(params *ExperimentServiceBulkArchiveExperimentsParams, opts ...ClientOption) (*ExperimentServiceBulkArchiveExperimentsOK, error)
+
+	ExperimentServiceBulkDeleteExperiments(params *ExperimentServiceBulkDeleteExperimentsParams, opts ...ClientOption) (*ExperimentServiceBulkDeleteExperimentsOK, error)
+
+	ExperimentServiceBulkUnarchiveExperiments(params *ExperimentServiceBulkUnarchiveExperimentsParams, opts ...ClientOption) (*ExperimentServiceBulkUnarchiveExperimentsOK, error)
+
 	ExperimentServiceCreateExperiment(params *ExperimentServiceCreateExperimentParams, opts ...ClientOption) (*ExperimentServiceCreateExperimentOK, error)
 
 	ExperimentServiceDeleteExperiment(params *ExperimentServiceDeleteExperimentParams, opts ...ClientOption) (*ExperimentServiceDeleteExperimentOK, error)
@@ -66,6 +72,8 @@ type ClientService interface {
 
 	ExperimentServiceUnarchiveExperiment(params *ExperimentServiceUnarchiveExperimentParams, opts ...ClientOption) (*ExperimentServiceUnarchiveExperimentOK, error)
 
+	ExperimentServiceUpdateExperiment(params *ExperimentServiceUpdateExperimentParams, opts ...ClientOption) (*ExperimentServiceUpdateExperimentOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -106,6 +114,117 @@ func (a *Client) ExperimentServiceArchiveExperiment(params *ExperimentServiceArc
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ExperimentServiceBulkArchiveExperiments archives the experiments with the given i ds or the experiments matching a filter in batches along with their runs and recurring runs returns the result of each experiment
+*/
+func (a *Client) ExperimentServiceBulkArchiveExperiments(params *ExperimentServiceBulkArchiveExperimentsParams, opts ...ClientOption) (*ExperimentServiceBulkArchiveExperimentsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExperimentServiceBulkArchiveExperimentsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExperimentService_BulkArchiveExperiments",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/experiments:bulkArchive",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExperimentServiceBulkArchiveExperimentsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExperimentServiceBulkArchiveExperimentsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ExperimentServiceBulkArchiveExperimentsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ExperimentServiceBulkDeleteExperiments deletes the experiments with the given i ds or the experiments matching a filter in batches without deleting their runs and recurring runs returns the result of each experiment
+*/
+func (a *Client) ExperimentServiceBulkDeleteExperiments(params *ExperimentServiceBulkDeleteExperimentsParams, opts ...ClientOption) (*ExperimentServiceBulkDeleteExperimentsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExperimentServiceBulkDeleteExperimentsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExperimentService_BulkDeleteExperiments",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/experiments:bulkDelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExperimentServiceBulkDeleteExperimentsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExperimentServiceBulkDeleteExperimentsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ExperimentServiceBulkDeleteExperimentsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ExperimentServiceBulkUnarchiveExperiments restores the experiments with the given i ds or the experiments matching a filter in batches their archived runs and recurring runs stay archived returns the result of each experiment
+*/
+func (a *Client) ExperimentServiceBulkUnarchiveExperiments(params *ExperimentServiceBulkUnarchiveExperimentsParams, opts ...ClientOption) (*ExperimentServiceBulkUnarchiveExperimentsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExperimentServiceBulkUnarchiveExperimentsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExperimentService_BulkUnarchiveExperiments",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/experiments:bulkUnarchive",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExperimentServiceBulkUnarchiveExperimentsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExperimentServiceBulkUnarchiveExperimentsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ExperimentServiceBulkUnarchiveExperimentsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ExperimentServiceCreateExperiment creates a new experiment
 */
@@ -291,6 +410,43 @@ func (a *Client) ExperimentServiceUnarchiveExperiment(params *ExperimentServiceU
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ExperimentServiceUpdateExperiment updates the description and labels of an experiment only the fields listed in the update mask are changed
+*/
+func (a *Client) ExperimentServiceUpdateExperiment(params *ExperimentServiceUpdateExperimentParams, opts ...ClientOption) (*ExperimentServiceUpdateExperimentOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExperimentServiceUpdateExperimentParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExperimentService_UpdateExperiment",
+		Method:             "PATCH",
+		PathPattern:        "/apis/v2beta1/experiments/{experiment.experiment_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExperimentServiceUpdateExperimentReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExperimentServiceUpdateExperimentOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ExperimentServiceUpdateExperimentDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
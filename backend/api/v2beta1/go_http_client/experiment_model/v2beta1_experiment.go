@@ -7,6 +7,7 @@ package experiment_model
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -29,9 +30,18 @@ type V2beta1Experiment struct {
 	// Required input field. Unique experiment name provided by user.
 	DisplayName string `json:"display_name,omitempty"`
 
+	// In case any error happens retrieving an experiment field, only experiment ID
+	// and the error message is returned. Client has the flexibility of choosing
+	// how to handle the error. This is especially useful during listing call.
+	Error *GooglerpcStatus `json:"error,omitempty"`
+
 	// Output. Unique experiment ID. Generated by API server.
 	ExperimentID string `json:"experiment_id,omitempty"`
 
+	// Optional input field. User-defined labels of the experiment. Keys and
+	// values must be valid Kubernetes label keys and values.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Output. The creation time of the last run in this experiment.
 	// Format: date-time
 	LastRunCreatedAt strfmt.DateTime `json:"last_run_created_at,omitempty"`
@@ -39,6 +49,10 @@ type V2beta1Experiment struct {
 	// Optional input field. Specify the namespace this experiment belongs to.
 	Namespace string `json:"namespace,omitempty"`
 
+	// Optional input field. Webhooks notified about state transitions of the
+	// runs in this experiment.
+	Notifications []*V2beta1NotificationSubscription `json:"notifications"`
+
 	// Output. Specifies whether this experiment is in archived or available state.
 	StorageState *V2beta1ExperimentStorageState `json:"storage_state,omitempty"`
 }
@@ -51,10 +65,18 @@ func (m *V2beta1Experiment) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateError(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateLastRunCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateNotifications(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStorageState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -77,6 +99,25 @@ func (m *V2beta1Experiment) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2beta1Experiment) validateError(formats strfmt.Registry) error {
+	if swag.IsZero(m.Error) { // not required
+		return nil
+	}
+
+	if m.Error != nil {
+		if err := m.Error.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("error")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("error")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2beta1Experiment) validateLastRunCreatedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.LastRunCreatedAt) { // not required
 		return nil
@@ -89,6 +130,32 @@ func (m *V2beta1Experiment) validateLastRunCreatedAt(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *V2beta1Experiment) validateNotifications(formats strfmt.Registry) error {
+	if swag.IsZero(m.Notifications) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Notifications); i++ {
+		if swag.IsZero(m.Notifications[i]) { // not required
+			continue
+		}
+
+		if m.Notifications[i] != nil {
+			if err := m.Notifications[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("notifications" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("notifications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2beta1Experiment) validateStorageState(formats strfmt.Registry) error {
 	if swag.IsZero(m.StorageState) { // not required
 		return nil
@@ -112,6 +179,14 @@ func (m *V2beta1Experiment) validateStorageState(formats strfmt.Registry) error
 func (m *V2beta1Experiment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNotifications(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateStorageState(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -122,6 +197,52 @@ func (m *V2beta1Experiment) ContextValidate(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *V2beta1Experiment) contextValidateError(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Error != nil {
+
+		if swag.IsZero(m.Error) { // not required
+			return nil
+		}
+
+		if err := m.Error.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("error")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("error")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V2beta1Experiment) contextValidateNotifications(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Notifications); i++ {
+
+		if m.Notifications[i] != nil {
+
+			if swag.IsZero(m.Notifications[i]) { // not required
+				return nil
+			}
+
+			if err := m.Notifications[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("notifications" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("notifications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2beta1Experiment) contextValidateStorageState(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.StorageState != nil {
@@ -64,16 +64,24 @@ type ClientService interface {
 
 	PipelineServiceDeletePipelineVersion(params *PipelineServiceDeletePipelineVersionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceDeletePipelineVersionOK, error)
 
+	PipelineServiceDiffPipelineVersions(params *PipelineServiceDiffPipelineVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceDiffPipelineVersionsOK, error)
+
+	PipelineServiceExportBundle(params *PipelineServiceExportBundleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceExportBundleOK, error)
+
 	PipelineServiceGetPipeline(params *PipelineServiceGetPipelineParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceGetPipelineOK, error)
 
 	PipelineServiceGetPipelineByName(params *PipelineServiceGetPipelineByNameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceGetPipelineByNameOK, error)
 
 	PipelineServiceGetPipelineVersion(params *PipelineServiceGetPipelineVersionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceGetPipelineVersionOK, error)
 
+	PipelineServiceImportBundle(params *PipelineServiceImportBundleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceImportBundleOK, error)
+
 	PipelineServiceListPipelineVersions(params *PipelineServiceListPipelineVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceListPipelineVersionsOK, error)
 
 	PipelineServiceListPipelines(params *PipelineServiceListPipelinesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceListPipelinesOK, error)
 
+	PipelineServiceUpdatePipeline(params *PipelineServiceUpdatePipelineParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceUpdatePipelineOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -267,6 +275,82 @@ func (a *Client) PipelineServiceDeletePipelineVersion(params *PipelineServiceDel
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PipelineServiceDiffPipelineVersions compares the pipeline specs of two versions of a pipeline returns the added removed and changed tasks component images input parameters and platform configs
+*/
+func (a *Client) PipelineServiceDiffPipelineVersions(params *PipelineServiceDiffPipelineVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceDiffPipelineVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPipelineServiceDiffPipelineVersionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PipelineService_DiffPipelineVersions",
+		Method:             "GET",
+		PathPattern:        "/apis/v2beta1/pipelines/{pipeline_id}/versions/{target_pipeline_version_id}/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PipelineServiceDiffPipelineVersionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PipelineServiceDiffPipelineVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PipelineServiceDiffPipelineVersionsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+PipelineServiceExportBundle exports a pipeline with all its versions or an experiment with its recurring runs as a bundle that can be imported into another kubeflow pipelines deployment
+*/
+func (a *Client) PipelineServiceExportBundle(params *PipelineServiceExportBundleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceExportBundleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPipelineServiceExportBundleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PipelineService_ExportBundle",
+		Method:             "GET",
+		PathPattern:        "/apis/v2beta1/pipelines:export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PipelineServiceExportBundleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PipelineServiceExportBundleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PipelineServiceExportBundleDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PipelineServiceGetPipeline finds a specific pipeline by ID
 */
@@ -381,6 +465,44 @@ func (a *Client) PipelineServiceGetPipelineVersion(params *PipelineServiceGetPip
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PipelineServiceImportBundle imports the pipelines or the experiment and its recurring runs of a bundle created by export bundle recurring runs are imported disabled nothing is imported if the import of any resource fails
+*/
+func (a *Client) PipelineServiceImportBundle(params *PipelineServiceImportBundleParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceImportBundleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPipelineServiceImportBundleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PipelineService_ImportBundle",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/pipelines:import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PipelineServiceImportBundleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PipelineServiceImportBundleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PipelineServiceImportBundleDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PipelineServiceListPipelineVersions lists all pipeline versions of a given pipeline ID
 */
@@ -457,6 +579,44 @@ func (a *Client) PipelineServiceListPipelines(params *PipelineServiceListPipelin
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PipelineServiceUpdatePipeline updates the description and labels of a pipeline only the fields listed in the update mask are changed
+*/
+func (a *Client) PipelineServiceUpdatePipeline(params *PipelineServiceUpdatePipelineParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PipelineServiceUpdatePipelineOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPipelineServiceUpdatePipelineParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PipelineService_UpdatePipeline",
+		Method:             "PATCH",
+		PathPattern:        "/apis/v2beta1/pipelines/{pipeline.pipeline_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PipelineServiceUpdatePipelineReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PipelineServiceUpdatePipelineOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PipelineServiceUpdatePipelineDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -34,6 +34,10 @@ type V2beta1Pipeline struct {
 	// how to handle the error. This is especially useful during listing call.
 	Error *GooglerpcStatus `json:"error,omitempty"`
 
+	// Optional input field. User-defined labels of the pipeline. Keys and
+	// values must be valid Kubernetes label keys and values.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Required if display_name is not provided. Pipeline name provided by user.
 	Name string `json:"name,omitempty"`
 
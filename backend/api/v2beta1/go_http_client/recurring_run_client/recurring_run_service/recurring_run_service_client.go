@@ -54,6 +54,8 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	RecurringRunServiceBackfillRecurringRun(params *RecurringRunServiceBackfillRecurringRunParams, opts ...ClientOption) (*RecurringRunServiceBackfillRecurringRunOK, error)
+
 	RecurringRunServiceCreateRecurringRun(params *RecurringRunServiceCreateRecurringRunParams, opts ...ClientOption) (*RecurringRunServiceCreateRecurringRunOK, error)
 
 	RecurringRunServiceDeleteRecurringRun(params *RecurringRunServiceDeleteRecurringRunParams, opts ...ClientOption) (*RecurringRunServiceDeleteRecurringRunOK, error)
@@ -66,9 +68,48 @@ type ClientService interface {
 
 	RecurringRunServiceListRecurringRuns(params *RecurringRunServiceListRecurringRunsParams, opts ...ClientOption) (*RecurringRunServiceListRecurringRunsOK, error)
 
+	RecurringRunServiceUpdateRecurringRun(params *RecurringRunServiceUpdateRecurringRunParams, opts ...ClientOption) (*RecurringRunServiceUpdateRecurringRunOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+RecurringRunServiceBackfillRecurringRun creates runs for the trigger times of a recurring run within a past time window runs are created over time subject to the max concurrency of the recurring run requesting a new window replaces the previous one
+*/
+func (a *Client) RecurringRunServiceBackfillRecurringRun(params *RecurringRunServiceBackfillRecurringRunParams, opts ...ClientOption) (*RecurringRunServiceBackfillRecurringRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRecurringRunServiceBackfillRecurringRunParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RecurringRunService_BackfillRecurringRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/recurringruns/{recurring_run_id}:backfill",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RecurringRunServiceBackfillRecurringRunReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RecurringRunServiceBackfillRecurringRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RecurringRunServiceBackfillRecurringRunDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 RecurringRunServiceCreateRecurringRun creates a new recurring run in an experiment given the experiment ID
 */
@@ -291,6 +332,43 @@ func (a *Client) RecurringRunServiceListRecurringRuns(params *RecurringRunServic
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RecurringRunServiceUpdateRecurringRun updates the fields of a recurring run listed in the update mask the changes apply to the runs scheduled after the update
+*/
+func (a *Client) RecurringRunServiceUpdateRecurringRun(params *RecurringRunServiceUpdateRecurringRunParams, opts ...ClientOption) (*RecurringRunServiceUpdateRecurringRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRecurringRunServiceUpdateRecurringRunParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RecurringRunService_UpdateRecurringRun",
+		Method:             "PATCH",
+		PathPattern:        "/apis/v2beta1/recurringruns/{recurring_run.recurring_run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RecurringRunServiceUpdateRecurringRunReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RecurringRunServiceUpdateRecurringRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RecurringRunServiceUpdateRecurringRunDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -30,6 +30,11 @@ type V2beta1CronSchedule struct {
 	// The start time of the cron job.
 	// Format: date-time
 	StartTime strfmt.DateTime `json:"start_time,omitempty"`
+
+	// The IANA time zone in which the cron string is evaluated, e.g.
+	// Europe/Berlin. Defaults to the time zone of the scheduled workflow
+	// controller.
+	TimeZone string `json:"time_zone,omitempty"`
 }
 
 // Validate validates this v2beta1 cron schedule
@@ -7,6 +7,7 @@ package recurring_run_model
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -37,6 +38,11 @@ type V2beta1RecurringRun struct {
 	// ID of the parent experiment this recurring run belongs to.
 	ExperimentID string `json:"experiment_id,omitempty"`
 
+	// Optional input field. User-defined labels of the recurring run. They are
+	// also added to the ScheduledWorkflow and to the runs it creates. Keys and
+	// values must be valid Kubernetes label keys and values.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Required input field.
 	// Specifies how many runs can be executed concurrently. Range [1-10].
 	MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`
@@ -54,6 +60,10 @@ type V2beta1RecurringRun struct {
 	// If false, the recurring run will catch up on each past interval.
 	NoCatchup bool `json:"no_catchup,omitempty"`
 
+	// Optional input field. Webhooks notified about state transitions of the
+	// runs created by this recurring run, e.g. to alert on failures.
+	Notifications []*V2beta1NotificationSubscription `json:"notifications"`
+
 	// The pipeline spec.
 	PipelineSpec interface{} `json:"pipeline_spec,omitempty"`
 
@@ -100,6 +110,10 @@ func (m *V2beta1RecurringRun) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNotifications(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePipelineVersionReference(formats); err != nil {
 		res = append(res, err)
 	}
@@ -176,6 +190,32 @@ func (m *V2beta1RecurringRun) validateMode(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2beta1RecurringRun) validateNotifications(formats strfmt.Registry) error {
+	if swag.IsZero(m.Notifications) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Notifications); i++ {
+		if swag.IsZero(m.Notifications[i]) { // not required
+			continue
+		}
+
+		if m.Notifications[i] != nil {
+			if err := m.Notifications[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("notifications" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("notifications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2beta1RecurringRun) validatePipelineVersionReference(formats strfmt.Registry) error {
 	if swag.IsZero(m.PipelineVersionReference) { // not required
 		return nil
@@ -280,6 +320,10 @@ func (m *V2beta1RecurringRun) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateNotifications(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePipelineVersionReference(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -353,6 +397,31 @@ func (m *V2beta1RecurringRun) contextValidateNamespace(ctx context.Context, form
 	return nil
 }
 
+func (m *V2beta1RecurringRun) contextValidateNotifications(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Notifications); i++ {
+
+		if m.Notifications[i] != nil {
+
+			if swag.IsZero(m.Notifications[i]) { // not required
+				return nil
+			}
+
+			if err := m.Notifications[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("notifications" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("notifications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2beta1RecurringRun) contextValidatePipelineVersionReference(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.PipelineVersionReference != nil {
@@ -21,8 +21,14 @@ type V2beta1Trigger struct {
 	// cron schedule
 	CronSchedule *V2beta1CronSchedule `json:"cron_schedule,omitempty"`
 
+	// object store trigger
+	ObjectStoreTrigger *V2beta1ObjectStoreTrigger `json:"object_store_trigger,omitempty"`
+
 	// periodic schedule
 	PeriodicSchedule *V2beta1PeriodicSchedule `json:"periodic_schedule,omitempty"`
+
+	// webhook trigger
+	WebhookTrigger *V2beta1WebhookTrigger `json:"webhook_trigger,omitempty"`
 }
 
 // Validate validates this v2beta1 trigger
@@ -33,10 +39,18 @@ func (m *V2beta1Trigger) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectStoreTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePeriodicSchedule(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateWebhookTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -62,6 +76,25 @@ func (m *V2beta1Trigger) validateCronSchedule(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2beta1Trigger) validateObjectStoreTrigger(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectStoreTrigger) { // not required
+		return nil
+	}
+
+	if m.ObjectStoreTrigger != nil {
+		if err := m.ObjectStoreTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("object_store_trigger")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("object_store_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2beta1Trigger) validatePeriodicSchedule(formats strfmt.Registry) error {
 	if swag.IsZero(m.PeriodicSchedule) { // not required
 		return nil
@@ -81,6 +114,25 @@ func (m *V2beta1Trigger) validatePeriodicSchedule(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *V2beta1Trigger) validateWebhookTrigger(formats strfmt.Registry) error {
+	if swag.IsZero(m.WebhookTrigger) { // not required
+		return nil
+	}
+
+	if m.WebhookTrigger != nil {
+		if err := m.WebhookTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("webhook_trigger")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("webhook_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this v2beta1 trigger based on the context it is used
 func (m *V2beta1Trigger) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -89,10 +141,18 @@ func (m *V2beta1Trigger) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateObjectStoreTrigger(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePeriodicSchedule(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateWebhookTrigger(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -120,6 +180,27 @@ func (m *V2beta1Trigger) contextValidateCronSchedule(ctx context.Context, format
 	return nil
 }
 
+func (m *V2beta1Trigger) contextValidateObjectStoreTrigger(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectStoreTrigger != nil {
+
+		if swag.IsZero(m.ObjectStoreTrigger) { // not required
+			return nil
+		}
+
+		if err := m.ObjectStoreTrigger.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("object_store_trigger")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("object_store_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2beta1Trigger) contextValidatePeriodicSchedule(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.PeriodicSchedule != nil {
@@ -141,6 +222,27 @@ func (m *V2beta1Trigger) contextValidatePeriodicSchedule(ctx context.Context, fo
 	return nil
 }
 
+func (m *V2beta1Trigger) contextValidateWebhookTrigger(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.WebhookTrigger != nil {
+
+		if swag.IsZero(m.WebhookTrigger) { // not required
+			return nil
+		}
+
+		if err := m.WebhookTrigger.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("webhook_trigger")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("webhook_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *V2beta1Trigger) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -56,22 +56,46 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	RunServiceArchiveRun(params *RunServiceArchiveRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceArchiveRunOK, error)
 
+	RunServiceBulkArchiveRuns(params *RunServiceBulkArchiveRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkArchiveRunsOK, error)
+
+	RunServiceBulkDeleteRuns(params *RunServiceBulkDeleteRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkDeleteRunsOK, error)
+
+	RunServiceBulkRetryRuns(params *RunServiceBulkRetryRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkRetryRunsOK, error)
+
+	RunServiceBulkTerminateRuns(params *RunServiceBulkTerminateRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkTerminateRunsOK, error)
+
+	RunServiceBulkUnarchiveRuns(params *RunServiceBulkUnarchiveRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkUnarchiveRunsOK, error)
+
+	RunServiceCompareRuns(params *RunServiceCompareRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceCompareRunsOK, error)
+
 	RunServiceCreateRun(params *RunServiceCreateRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceCreateRunOK, error)
 
 	RunServiceDeleteRun(params *RunServiceDeleteRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceDeleteRunOK, error)
 
 	RunServiceGetRun(params *RunServiceGetRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceGetRunOK, error)
 
+	RunServiceListRunMetrics(params *RunServiceListRunMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceListRunMetricsOK, error)
+
 	RunServiceListRuns(params *RunServiceListRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceListRunsOK, error)
 
+	RunServicePauseRun(params *RunServicePauseRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServicePauseRunOK, error)
+
 	RunServiceReadArtifact(params *RunServiceReadArtifactParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceReadArtifactOK, error)
 
+	RunServiceReadRunLog(params *RunServiceReadRunLogParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceReadRunLogOK, error)
+
+	RunServiceResumeRun(params *RunServiceResumeRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceResumeRunOK, error)
+
 	RunServiceRetryRun(params *RunServiceRetryRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceRetryRunOK, error)
 
 	RunServiceTerminateRun(params *RunServiceTerminateRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceTerminateRunOK, error)
 
 	RunServiceUnarchiveRun(params *RunServiceUnarchiveRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceUnarchiveRunOK, error)
 
+	RunServiceUpdateRun(params *RunServiceUpdateRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceUpdateRunOK, error)
+
+	RunServiceWatchRuns(params *RunServiceWatchRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceWatchRunsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -113,6 +137,234 @@ func (a *Client) RunServiceArchiveRun(params *RunServiceArchiveRunParams, authIn
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RunServiceBulkArchiveRuns archives the runs with the given i ds or the runs matching a filter in batches returns the result of each run
+*/
+func (a *Client) RunServiceBulkArchiveRuns(params *RunServiceBulkArchiveRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkArchiveRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceBulkArchiveRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_BulkArchiveRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/runs:bulkArchive",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceBulkArchiveRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceBulkArchiveRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceBulkArchiveRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RunServiceBulkDeleteRuns deletes the runs with the given i ds or the runs matching a filter in batches returns the result of each run
+*/
+func (a *Client) RunServiceBulkDeleteRuns(params *RunServiceBulkDeleteRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkDeleteRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceBulkDeleteRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_BulkDeleteRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/runs:bulkDelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceBulkDeleteRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceBulkDeleteRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceBulkDeleteRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RunServiceBulkRetryRuns retries the runs with the given i ds or the runs matching a filter in batches returns the result of each run
+*/
+func (a *Client) RunServiceBulkRetryRuns(params *RunServiceBulkRetryRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkRetryRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceBulkRetryRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_BulkRetryRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/runs:bulkRetry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceBulkRetryRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceBulkRetryRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceBulkRetryRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RunServiceBulkTerminateRuns terminates the runs with the given i ds or the runs matching a filter in batches returns the result of each run
+*/
+func (a *Client) RunServiceBulkTerminateRuns(params *RunServiceBulkTerminateRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkTerminateRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceBulkTerminateRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_BulkTerminateRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/runs:bulkTerminate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceBulkTerminateRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceBulkTerminateRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceBulkTerminateRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RunServiceBulkUnarchiveRuns uns archives the runs with the given i ds or the runs matching a filter in batches returns the result of each run
+*/
+func (a *Client) RunServiceBulkUnarchiveRuns(params *RunServiceBulkUnarchiveRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceBulkUnarchiveRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceBulkUnarchiveRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_BulkUnarchiveRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/runs:bulkUnarchive",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceBulkUnarchiveRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceBulkUnarchiveRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceBulkUnarchiveRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RunServiceCompareRuns compares runs side by side returns a table with one row per runtime parameter task state task duration metric and output artifact of the runs and one column per run
+*/
+func (a *Client) RunServiceCompareRuns(params *RunServiceCompareRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceCompareRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceCompareRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_CompareRuns",
+		Method:             "GET",
+		PathPattern:        "/apis/v2beta1/runs:compare",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceCompareRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceCompareRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceCompareRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 RunServiceCreateRun creates a new run in an experiment specified by experiment ID if experiment ID is not specified the run is created in the default experiment
 */
@@ -227,6 +479,44 @@ func (a *Client) RunServiceGetRun(params *RunServiceGetRunParams, authInfo runti
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RunServiceListRunMetrics finds the metrics reported by the tasks of a run
+*/
+func (a *Client) RunServiceListRunMetrics(params *RunServiceListRunMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceListRunMetricsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceListRunMetricsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_ListRunMetrics",
+		Method:             "GET",
+		PathPattern:        "/apis/v2beta1/runs/{run_id}/metrics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceListRunMetricsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceListRunMetricsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceListRunMetricsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 RunServiceListRuns finds all runs in an experiment given by experiment ID if experiment id is not specified finds all runs across all experiments
 */
@@ -265,6 +555,44 @@ func (a *Client) RunServiceListRuns(params *RunServiceListRunsParams, authInfo r
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RunServicePauseRun pauses an active run running tasks are allowed to finish but no new tasks are started until the run is resumed
+*/
+func (a *Client) RunServicePauseRun(params *RunServicePauseRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServicePauseRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServicePauseRunParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_PauseRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/runs/{run_id}:pause",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServicePauseRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServicePauseRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServicePauseRunDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 RunServiceReadArtifact finds artifact data in a run
 */
@@ -303,6 +631,82 @@ func (a *Client) RunServiceReadArtifact(params *RunServiceReadArtifactParams, au
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RunServiceReadRunLog streams the log of a run task the task is addressed by its task ID or task name as reported in the run details once the task pod is gone the log is read from the log archive if one is configured
+*/
+func (a *Client) RunServiceReadRunLog(params *RunServiceReadRunLogParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceReadRunLogOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceReadRunLogParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_ReadRunLog",
+		Method:             "GET",
+		PathPattern:        "/apis/v2beta1/runs/{run_id}/logs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceReadRunLogReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceReadRunLogOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceReadRunLogDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RunServiceResumeRun resumes a paused run
+*/
+func (a *Client) RunServiceResumeRun(params *RunServiceResumeRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceResumeRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceResumeRunParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_ResumeRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v2beta1/runs/{run_id}:resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceResumeRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceResumeRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceResumeRunDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 RunServiceRetryRun res initiates a failed or terminated run
 */
@@ -417,6 +821,82 @@ func (a *Client) RunServiceUnarchiveRun(params *RunServiceUnarchiveRunParams, au
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RunServiceUpdateRun updates the description and labels of a run only the fields listed in the update mask are changed
+*/
+func (a *Client) RunServiceUpdateRun(params *RunServiceUpdateRunParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceUpdateRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceUpdateRunParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_UpdateRun",
+		Method:             "PATCH",
+		PathPattern:        "/apis/v2beta1/runs/{run.run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceUpdateRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceUpdateRunOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceUpdateRunDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RunServiceWatchRuns streams the changes of runs and of their tasks as they are reported starts with the changes after the given resource version if any so that a client can list the runs once and then watch them or reconnect without missing changes over HTTP the changes are sent as server sent events if the request accepts text event stream the changes are recorded in the database so a watch sees the changes made through all API server replicas and can be resumed on any of them
+*/
+func (a *Client) RunServiceWatchRuns(params *RunServiceWatchRunsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RunServiceWatchRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunServiceWatchRunsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RunService_WatchRuns",
+		Method:             "GET",
+		PathPattern:        "/apis/v2beta1/runs:watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RunServiceWatchRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunServiceWatchRunsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunServiceWatchRunsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -22,6 +22,10 @@ type V2beta1ListRunsResponse struct {
 	// The token to list the next page of runs.
 	NextPageToken string `json:"next_page_token,omitempty"`
 
+	// The resource version as of the listing. Watching runs from it returns
+	// the changes after the listing.
+	ResourceVersion string `json:"resource_version,omitempty"`
+
 	// List of retrieved runs.
 	Runs []*V2beta1Run `json:"runs"`
 
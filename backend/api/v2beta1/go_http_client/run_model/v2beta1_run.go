@@ -44,6 +44,14 @@ type V2beta1Run struct {
 	// Format: date-time
 	FinishedAt strfmt.DateTime `json:"finished_at,omitempty"`
 
+	// Optional input. User-defined labels of the run. They are also added to
+	// the Kubernetes resources of the run. Keys and values must be valid
+	// Kubernetes label keys and values.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Output. Scalar metrics reported by the tasks of the run.
+	Metrics []*V2beta1RunMetric `json:"metrics"`
+
 	// Pipeline spec.
 	PipelineSpec interface{} `json:"pipeline_spec,omitempty"`
 
@@ -101,6 +109,10 @@ func (m *V2beta1Run) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMetrics(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePipelineVersionReference(formats); err != nil {
 		res = append(res, err)
 	}
@@ -178,6 +190,32 @@ func (m *V2beta1Run) validateFinishedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2beta1Run) validateMetrics(formats strfmt.Registry) error {
+	if swag.IsZero(m.Metrics) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Metrics); i++ {
+		if swag.IsZero(m.Metrics[i]) { // not required
+			continue
+		}
+
+		if m.Metrics[i] != nil {
+			if err := m.Metrics[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("metrics" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("metrics" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2beta1Run) validatePipelineVersionReference(formats strfmt.Registry) error {
 	if swag.IsZero(m.PipelineVersionReference) { // not required
 		return nil
@@ -319,6 +357,10 @@ func (m *V2beta1Run) ContextValidate(ctx context.Context, formats strfmt.Registr
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMetrics(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePipelineVersionReference(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -370,6 +412,31 @@ func (m *V2beta1Run) contextValidateError(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *V2beta1Run) contextValidateMetrics(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Metrics); i++ {
+
+		if m.Metrics[i] != nil {
+
+			if swag.IsZero(m.Metrics[i]) { // not required
+				return nil
+			}
+
+			if err := m.Metrics[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("metrics" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("metrics" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2beta1Run) contextValidatePipelineVersionReference(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.PipelineVersionReference != nil {
@@ -40,6 +40,12 @@ const (
 type ExtractLogOptions struct {
 	LogFormat  LogFormat
 	Timestamps bool
+	// If set, lines with an earlier timestamp are skipped. Lines without a
+	// timestamp are always kept.
+	SinceTime *time.Time
+	// If positive, only the given number of lines from the end of the log are
+	// copied.
+	TailLines int64
 }
 
 type LogArchiveInterface interface {
@@ -264,6 +270,12 @@ func (a *LogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts E
 		return err
 	}
 
+	out := dst
+	var tail *tailWriter
+	if opts.TailLines > 0 {
+		tail = &tailWriter{limit: opts.TailLines}
+		out = tail
+	}
 	scanner := bufio.NewScanner(reader)
 	for scanner.Scan() {
 		// line := strings.Trim(scanner.LogFormatText(), "\n\r\t ")
@@ -274,25 +286,90 @@ func (a *LogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts E
 		var err error
 		var entry RunLogEntry
 		if json.Unmarshal(bytes, &entry) == nil {
+			if !opts.includes(entry.Timestamp) {
+				continue
+			}
 			if opts.LogFormat == LogFormatJSON {
-				err = writeBytesLn(dst, bytes)
+				err = writeBytesLn(out, bytes)
 			} else if opts.Timestamps && !entry.Timestamp.IsZero() {
-				_, err = fmt.Fprintf(dst, "%s %s\n", entry.Timestamp.Format(time.RFC3339), entry.Log)
+				_, err = fmt.Fprintf(out, "%s %s\n", entry.Timestamp.Format(time.RFC3339), entry.Log)
 			} else {
-				_, err = fmt.Fprintln(dst, entry.Log)
+				_, err = fmt.Fprintln(out, entry.Log)
 			}
 		} else if result := crioLogPrefixExp.FindSubmatch(bytes); len(result) == 4 {
-			err = writeLogLn(dst, result[3], result[1], opts)
+			if !opts.includesRaw(result[1]) {
+				continue
+			}
+			err = writeLogLn(out, result[3], result[1], opts)
 		} else if result := k8sLogPrefixExp.FindSubmatch(bytes); len(result) == 3 {
-			err = writeLogLn(dst, result[2], result[1], opts)
+			if !opts.includesRaw(result[1]) {
+				continue
+			}
+			err = writeLogLn(out, result[2], result[1], opts)
 		} else {
-			err = writeLogLn(dst, bytes, nil, opts)
+			err = writeLogLn(out, bytes, nil, opts)
 		}
 		if err != nil {
 			return util.NewInternalServerError(err, "error in parsing the log lines")
 		}
 	}
 
+	if tail != nil {
+		if err := tail.flushTo(dst); err != nil {
+			return util.NewInternalServerError(err, "error in writing the log lines")
+		}
+	}
+	return nil
+}
+
+// includes reports whether a line with the given timestamp passes the
+// SinceTime filter.
+func (opts ExtractLogOptions) includes(timestamp time.Time) bool {
+	return opts.SinceTime == nil || timestamp.IsZero() || !timestamp.Before(*opts.SinceTime)
+}
+
+func (opts ExtractLogOptions) includesRaw(timestamp []byte) bool {
+	if opts.SinceTime == nil {
+		return true
+	}
+	ts, err := time.Parse(time.RFC3339, string(timestamp))
+	if err != nil {
+		return true
+	}
+	return opts.includes(ts)
+}
+
+// tailWriter keeps the last limit lines written to it.
+type tailWriter struct {
+	limit   int64
+	lines   [][]byte
+	partial []byte
+}
+
+func (w *tailWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		line := make([]byte, i+1)
+		copy(line, w.partial[:i+1])
+		w.partial = w.partial[i+1:]
+		w.lines = append(w.lines, line)
+		if int64(len(w.lines)) > w.limit {
+			w.lines = w.lines[1:]
+		}
+	}
+	return len(p), nil
+}
+
+func (w *tailWriter) flushTo(dst io.Writer) error {
+	for _, line := range w.lines {
+		if _, err := dst.Write(line); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
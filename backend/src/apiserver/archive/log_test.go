@@ -276,3 +276,44 @@ func TestCopyLogFromArchive_FromCriOTextToTextWithTimestamp(t *testing.T) {
 	line = scanner.Text()
 	assert.Equal(t, "2020-08-31T15:00:02.260657206Z [ERROR] Unable to connect", line)
 }
+
+func TestCopyLogFromArchive_SinceTime(t *testing.T) {
+	logArchive := initLogArchive()
+	since := logTs0.Add(time.Second)
+	opts := ExtractLogOptions{LogFormat: LogFormatText, SinceTime: &since}
+	dst := bytes.Buffer{}
+	src := compressInput(t, logCriOText)
+
+	err := logArchive.CopyLogFromArchive(src, &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "[ERROR] Unable to connect\n", dst.String())
+}
+
+func TestCopyLogFromArchive_SinceTimeKeepsLinesWithoutTimestamp(t *testing.T) {
+	logArchive := initLogArchive()
+	since := logTs0.Add(time.Second)
+	opts := ExtractLogOptions{LogFormat: LogFormatText, SinceTime: &since}
+	dst := bytes.Buffer{}
+	src := compressInput(t, logJsonLines)
+
+	err := logArchive.CopyLogFromArchive(src, &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "[ERROR] Unable to connect\n", dst.String())
+}
+
+func TestCopyLogFromArchive_TailLines(t *testing.T) {
+	logArchive := initLogArchive()
+	opts := ExtractLogOptions{LogFormat: LogFormatText, Timestamps: true, TailLines: 1}
+	dst := bytes.Buffer{}
+	src := compressInput(t, logCriOText)
+
+	err := logArchive.CopyLogFromArchive(src, &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-08-31T15:00:02.260657206Z [ERROR] Unable to connect\n", dst.String())
+
+	opts.TailLines = 5
+	dst.Reset()
+	err = logArchive.CopyLogFromArchive(src, &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-08-31T15:00:00.000000000Z [INFO] OK\n2020-08-31T15:00:02.260657206Z [ERROR] Unable to connect\n", dst.String())
+}
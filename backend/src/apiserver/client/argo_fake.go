@@ -64,6 +64,14 @@ func (c *FakeExecClient) IsTerminated(name string) (bool, error) {
 	return *activeDeadlineSeconds == 0, nil
 }
 
+func (c *FakeExecClient) IsPaused(name string) (bool, error) {
+	workflow, ok := c.workflowClientFake.workflows[name]
+	if !ok {
+		return false, errors.New("No workflow found with name: " + name)
+	}
+	return workflow.Spec.Suspend != nil && *workflow.Spec.Suspend, nil
+}
+
 type FakeExecClientWithBadWorkflow struct {
 	workflowClientFake *FakeBadWorkflowClient
 }
@@ -11,6 +11,8 @@ import (
 
 type KubernetesCoreInterface interface {
 	PodClient(namespace string) v1.PodInterface
+	SecretClient(namespace string) v1.SecretInterface
+	ConfigMapClient(namespace string) v1.ConfigMapInterface
 }
 
 type KubernetesCore struct {
@@ -21,6 +23,14 @@ func (c *KubernetesCore) PodClient(namespace string) v1.PodInterface {
 	return c.coreV1Client.Pods(namespace)
 }
 
+func (c *KubernetesCore) SecretClient(namespace string) v1.SecretInterface {
+	return c.coreV1Client.Secrets(namespace)
+}
+
+func (c *KubernetesCore) ConfigMapClient(namespace string) v1.ConfigMapInterface {
+	return c.coreV1Client.ConfigMaps(namespace)
+}
+
 func createKubernetesCore(clientParams util.ClientParameters) (KubernetesCoreInterface, error) {
 	clientSet, err := getKubernetesClientset(clientParams)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	k8schema "k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
@@ -99,7 +100,15 @@ func (c *FakeWorkflowClient) Delete(ctx context.Context, name string, options v1
 func (c *FakeWorkflowClient) DeleteCollection(ctx context.Context, options v1.DeleteOptions,
 	listOptions v1.ListOptions,
 ) error {
-	glog.Error("This fake method is not yet implemented")
+	selector, err := labels.Parse(listOptions.LabelSelector)
+	if err != nil {
+		return k8errors.NewBadRequest(err.Error())
+	}
+	for name, workflow := range c.workflows {
+		if selector.Matches(labels.Set(workflow.Labels)) {
+			delete(c.workflows, name)
+		}
+	}
 	return nil
 }
 
@@ -118,6 +127,19 @@ func (c *FakeWorkflowClient) Patch(ctx context.Context, name string, pt types.Pa
 
 	if _, ok := dat["spec"]; ok {
 		spec := dat["spec"].(map[string]interface{})
+
+		// Simulate suspending or resuming a workflow
+		if suspend, ok := spec["suspend"]; ok && pt == types.MergePatchType {
+			workflow := c.workflows[name]
+			if suspend == nil {
+				workflow.Spec.Suspend = nil
+			} else {
+				newSuspend := suspend.(bool)
+				workflow.Spec.Suspend = &newSuspend
+			}
+			return util.NewWorkflow(workflow), nil
+		}
+
 		activeDeadlineSeconds := spec["activeDeadlineSeconds"].(float64)
 
 		// Simulate terminating a workflow
@@ -36,6 +36,8 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	k8sapi "github.com/kubeflow/pipelines/backend/src/crd/kubernetes/v2beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/native"
 	"github.com/minio/minio-go/v7"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -87,6 +89,10 @@ func init() {
 		// Panic is okay here because it means there's a code issue and so the package shouldn't initialize.
 		panic(fmt.Sprintf("Failed to initialize the Kubernetes API scheme: %v", err))
 	}
+	err = util.AddNativeRunToScheme(scheme)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to initialize the Kubernetes API scheme: %v", err))
+	}
 }
 
 // Container for all service clients.
@@ -110,6 +116,7 @@ type ClientManager struct {
 	time                      util.TimeInterface
 	uuid                      util.UUIDGeneratorInterface
 	authenticators            []auth.Authenticator
+	metadataClient            metadata.ClientInterface
 	controllerClient          ctrlclient.Client
 	controllerClientNoCache   ctrlclient.Client
 }
@@ -202,6 +209,10 @@ func (c *ClientManager) Authenticators() []auth.Authenticator {
 	return c.authenticators
 }
 
+func (c *ClientManager) MetadataClient() metadata.ClientInterface {
+	return c.metadataClient
+}
+
 func (c *ClientManager) init(options *Options) error {
 	// time
 	c.time = util.NewRealTime()
@@ -294,18 +305,20 @@ func (c *ClientManager) init(options *Options) error {
 		Burst: common.GetIntConfigWithDefault(clientBurst, 10),
 	}
 
-	c.execClient = util.NewExecutionClientOrFatal(util.CurrentExecutionType(), common.GetDurationConfig(initConnectionTimeout), clientParams)
+	c.k8sCoreClient = client.CreateKubernetesCoreOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
+
+	c.execClient = initExecutionClient(c.k8sCoreClient, common.GetDurationConfig(initConnectionTimeout), clientParams)
 
 	c.swfClient = client.NewScheduledWorkflowClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 
-	c.k8sCoreClient = client.CreateKubernetesCoreOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
-
 	runStore := storage.NewRunStore(db, c.time)
 	c.runStore = runStore
 
 	// Log archive
 	c.logArchive = initLogArchive()
 
+	c.metadataClient = initMetadataClient()
+
 	if common.IsMultiUserMode() {
 		c.subjectAccessReviewClient = client.CreateSubjectAccessReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.tokenReviewClient = client.CreateTokenReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
@@ -401,6 +414,10 @@ func InitDBClient(initConnectionTimeout time.Duration) *storage.DB {
 		&model.RunMetric{},
 		&model.Task{},
 		&model.ResourceReference{},
+		&model.Label{},
+		&model.WebhookDelivery{},
+		&model.RunNotification{},
+		&model.RunChange{},
 	)
 
 	if ignoreAlreadyExistError(driverName, response.Error) != nil {
@@ -675,6 +692,42 @@ func initLogArchive() (logArchive archive.LogArchiveInterface) {
 	return
 }
 
+// initMetadataClient creates the client of the ML Metadata service, which is
+// used to look up the output artifacts of runs.
+func initMetadataClient() metadata.ClientInterface {
+	config := metadata.DefaultConfig()
+	metadataClient, err := metadata.NewClient(config.Address, config.Port)
+	if err != nil {
+		glog.Fatalf("Failed to create the ML Metadata client: %v", err)
+	}
+	return metadataClient
+}
+
+// initExecutionClient creates the client of the configured execution type.
+// The native execution backend stores its runs as NativeRun custom resources
+// and runs their tasks in pods.
+func initExecutionClient(k8sCoreClient client.KubernetesCoreInterface, initConnectionTimeout time.Duration, clientParams util.ClientParameters) util.ExecutionClient {
+	if util.CurrentExecutionType() != util.NativeExecution {
+		return util.NewExecutionClientOrFatal(util.CurrentExecutionType(), initConnectionTimeout, clientParams)
+	}
+	restConfig, err := util.GetKubernetesConfig()
+	if err != nil {
+		glog.Fatalf("Failed to get the Kubernetes config of the native execution backend: %v", err)
+	}
+	restConfig.QPS = float32(clientParams.QPS)
+	restConfig.Burst = clientParams.Burst
+	k8sClient, err := ctrlclient.New(restConfig, ctrlclient.Options{Scheme: scheme})
+	if err != nil {
+		glog.Fatalf("Failed to create the Kubernetes client of the native execution backend: %v", err)
+	}
+	config := metadata.DefaultConfig()
+	driver, err := native.NewDriver(config.Address, config.Port)
+	if err != nil {
+		glog.Fatalf("Failed to create the driver of the native execution backend: %v", err)
+	}
+	return native.NewClient(k8sClient, k8sCoreClient.PodClient, driver)
+}
+
 // NewClientManager creates and Init a new instance of ClientManager.
 func NewClientManager(options *Options) (*ClientManager, error) {
 	clientManager := &ClientManager{}
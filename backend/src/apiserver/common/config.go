@@ -32,6 +32,18 @@ const (
 	KubeflowUserIDPrefix                    string = "KUBEFLOW_USERID_PREFIX"
 	UpdatePipelineVersionByDefault          string = "AUTO_UPDATE_PIPELINE_DEFAULT_VERSION"
 	TokenReviewAudience                     string = "TOKEN_REVIEW_AUDIENCE"
+	DefaultPipelineRoot                     string = "DefaultPipelineRoot"
+	FilePipelineRoots                       string = "FilePipelineRoots"
+	RunRetentionPolicies                    string = "RunRetentionPolicies"
+	RunRetentionInterval                    string = "RunRetentionInterval"
+	RunNotificationUIBaseURL                string = "RunNotificationUIBaseURL"
+	RunNotificationRetryTimeout             string = "RunNotificationRetryTimeout"
+	RunNotificationPendingInterval          string = "RunNotificationPendingInterval"
+	RunNotificationAllowedHosts             string = "RunNotificationAllowedHosts"
+	RunNotificationDeniedNetworks           string = "RunNotificationDeniedNetworks"
+	RunNotificationShutdownTimeout          string = "RunNotificationShutdownTimeout"
+	RunWatchHistorySize                     string = "RunWatchHistorySize"
+	BackfillMaxRuns                         string = "BackfillMaxRuns"
 )
 
 func IsPipelineVersionUpdatedByDefault() bool {
@@ -92,6 +104,13 @@ func GetDurationConfig(configName string) time.Duration {
 	return viper.GetDuration(configName)
 }
 
+func GetDurationConfigWithDefault(configName string, value time.Duration) time.Duration {
+	if !viper.IsSet(configName) {
+		return value
+	}
+	return viper.GetDuration(configName)
+}
+
 func IsMultiUserMode() bool {
 	return GetBoolConfigWithDefault(MultiUserMode, false)
 }
@@ -127,3 +146,7 @@ func GetKubeflowUserIDPrefix() string {
 func GetTokenReviewAudience() string {
 	return GetStringConfigWithDefault(TokenReviewAudience, DefaultTokenReviewAudience)
 }
+
+func GetDefaultPipelineRoot() string {
+	return GetStringConfigWithDefault(DefaultPipelineRoot, DefaultPipelineRootValue)
+}
@@ -27,6 +27,7 @@ const (
 	RbacResourceTypeVisualizations     = "visualizations"
 	RbacResourceTypeScheduledWorkflows = "scheduledworkflows"
 	RbacResourceTypeWorkflows          = "workflows"
+	RbacResourceTypeCaches             = "caches"
 
 	RbacResourceVerbArchive       = "archive"
 	RbacResourceVerbUpdate        = "update"
@@ -36,12 +37,17 @@ const (
 	RbacResourceVerbEnable        = "enable"
 	RbacResourceVerbGet           = "get"
 	RbacResourceVerbList          = "list"
+	RbacResourceVerbWatch         = "watch"
 	RbacResourceVerbRetry         = "retry"
 	RbacResourceVerbTerminate     = "terminate"
+	RbacResourceVerbPause         = "pause"
+	RbacResourceVerbResume        = "resume"
 	RbacResourceVerbUnarchive     = "unarchive"
 	RbacResourceVerbReportMetrics = "reportMetrics"
 	RbacResourceVerbReadArtifact  = "readArtifact"
+	RbacResourceVerbReadLog       = "readLog"
 	RbacResourceVerbReport        = "report"
+	RbacResourceVerbInvalidate    = "invalidate"
 )
 
 const (
@@ -49,10 +55,16 @@ const (
 	GoogleIAPUserIdentityPrefix    string = "accounts.google.com:"
 	AuthorizationBearerTokenHeader string = "Authorization"
 	AuthorizationBearerTokenPrefix string = "Bearer "
+	// The header with which browsers resume server-sent event streams.
+	LastEventIDHeader string = "Last-Event-ID"
 )
 
 const DefaultTokenReviewAudience string = "pipelines.kubeflow.org"
 
+// The pipeline root of v2 runs when neither the run nor the launcher config
+// sets one.
+const DefaultPipelineRootValue string = "minio://mlpipeline/v2/artifacts"
+
 const (
 	DefaultPipelineRunnerServiceAccount = "pipeline-runner"
 	HasDefaultBucketEnvVar              = "HAS_DEFAULT_BUCKET"
@@ -19,6 +19,8 @@ package filter
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/Masterminds/squirrel"
@@ -26,9 +28,16 @@ import (
 	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/kubeflow/pipelines/backend/src/crd/kubernetes/v2beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 )
 
-const filterMessage = "Filter %v is not implemented for Kubernetes pipeline store. Only substring is supported."
+// Boolean operators supported by filter groups.
+const (
+	groupOperatorAnd = "AND"
+	groupOperatorOr  = "OR"
+	groupOperatorNot = "NOT"
+)
 
 // Internal representation of a predicate.
 type Predicate struct {
@@ -50,6 +59,22 @@ type Filter struct {
 	in map[string][]interface{}
 
 	substring map[string][]interface{}
+
+	// groups are AND-ed with the predicates above and with each other.
+	groups []*filterGroup
+
+	// apiKeys are the keys of the API that were replaced by the keys of the
+	// predicates above, e.g. "labels.team" for the SQL expression of the label.
+	apiKeys map[string]string
+}
+
+// filterGroup is the internal representation of a FilterGroup. Each operand is
+// a Filter that holds either a single predicate or a single nested group, so
+// that the group operator can be applied to each of them individually. Fields
+// are exported so that groups can be marshaled into page tokens.
+type filterGroup struct {
+	Operator string
+	Operands []*Filter
 }
 
 // filterForMarshaling is a helper struct for marshaling Filter into JSON. This
@@ -65,6 +90,10 @@ type filterForMarshaling struct {
 	IN map[string][]interface{}
 
 	SUBSTRING map[string][]interface{}
+
+	GROUPS []*filterGroup `json:",omitempty"`
+
+	APIKEYS map[string]string `json:",omitempty"`
 }
 
 // MarshalJSON implements JSON Marshaler for Filter.
@@ -78,6 +107,8 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		LTE:       f.lte,
 		IN:        f.in,
 		SUBSTRING: f.substring,
+		GROUPS:    f.groups,
+		APIKEYS:   f.apiKeys,
 	})
 }
 
@@ -97,6 +128,8 @@ func (f *Filter) UnmarshalJSON(b []byte) error {
 	f.lte = ffm.LTE
 	f.in = ffm.IN
 	f.substring = ffm.SUBSTRING
+	f.groups = ffm.GROUPS
+	f.apiKeys = ffm.APIKEYS
 
 	return nil
 }
@@ -107,7 +140,11 @@ func New(filterProto interface{}) (*Filter, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewFromPredicate(predicates)
+	groups, err := toGroups(filterProto)
+	if err != nil {
+		return nil, err
+	}
+	return newFilter(predicates, groups)
 }
 
 // NewWithKeyMap is like New, but takes an additional map and model name for mapping key names
@@ -126,6 +163,10 @@ func NewWithKeyMap(filterProto interface{}, keyMap map[string]string, modelName
 	if err != nil {
 		return nil, err
 	}
+	groups, err := toGroups(filterProto)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, pred := range predicates {
 		k, ok := keyMap[pred.key]
@@ -134,12 +175,23 @@ func NewWithKeyMap(filterProto interface{}, keyMap map[string]string, modelName
 		}
 		pred.key = modelNamePrefix + k
 	}
-	return NewFromPredicate(predicates)
+	for _, g := range groups {
+		if err := g.replaceKeys(keyMap, modelNamePrefix); err != nil {
+			return nil, err
+		}
+	}
+	return newFilter(predicates, groups)
 }
 
 // New creates a new Filter from parsed predicates.
 func NewFromPredicate(predicates []*Predicate) (*Filter, error) {
-	if len(predicates) == 0 {
+	return newFilter(predicates, nil)
+}
+
+// newFilter creates a new Filter from parsed predicates and groups. It returns
+// nil if there is nothing to filter on.
+func newFilter(predicates []*Predicate, groups []*filterGroup) (*Filter, error) {
+	if len(predicates) == 0 && len(groups) == 0 {
 		return nil, nil
 	}
 
@@ -157,6 +209,7 @@ func NewFromPredicate(predicates []*Predicate) (*Filter, error) {
 	if err := f.parsePredicates(predicates); err != nil {
 		return nil, err
 	}
+	f.groups = groups
 	return f, nil
 }
 
@@ -166,35 +219,92 @@ func (f *Filter) ReplaceKeys(keyMap map[string]string, prefix string) error {
 	if prefix != "" {
 		prefix = prefix + "."
 	}
-	if err := replaceMapKeys(f.eq, keyMap, prefix); err != nil {
+	return f.replaceKeys(keyMap, prefix)
+}
+
+// replaceKeys is like ReplaceKeys, but expects a prefix that already ends with
+// a separator. It also replaces the keys of nested groups.
+func (f *Filter) replaceKeys(keyMap map[string]string, prefix string) error {
+	if f.apiKeys == nil {
+		f.apiKeys = make(map[string]string)
+	}
+	if err := f.replaceMapKeys(f.eq, keyMap, prefix); err != nil {
 		return err
 	}
-	if err := replaceMapKeys(f.neq, keyMap, prefix); err != nil {
+	if err := f.replaceMapKeys(f.neq, keyMap, prefix); err != nil {
 		return err
 	}
-	if err := replaceMapKeys(f.gt, keyMap, prefix); err != nil {
+	if err := f.replaceMapKeys(f.gt, keyMap, prefix); err != nil {
 		return err
 	}
-	if err := replaceMapKeys(f.gte, keyMap, prefix); err != nil {
+	if err := f.replaceMapKeys(f.gte, keyMap, prefix); err != nil {
 		return err
 	}
-	if err := replaceMapKeys(f.lt, keyMap, prefix); err != nil {
+	if err := f.replaceMapKeys(f.lt, keyMap, prefix); err != nil {
 		return err
 	}
-	if err := replaceMapKeys(f.lte, keyMap, prefix); err != nil {
+	if err := f.replaceMapKeys(f.lte, keyMap, prefix); err != nil {
 		return err
 	}
-	if err := replaceMapKeys(f.in, keyMap, prefix); err != nil {
+	if err := f.replaceMapKeys(f.in, keyMap, prefix); err != nil {
 		return err
 	}
-	if err := replaceMapKeys(f.substring, keyMap, prefix); err != nil {
+	if err := f.replaceMapKeys(f.substring, keyMap, prefix); err != nil {
 		return err
 	}
+	for _, g := range f.groups {
+		if err := g.replaceKeys(keyMap, prefix); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-// Replaces string keys in a map and adds a prefix.
-func replaceMapKeys(m map[string][]interface{}, keyMap map[string]string, prefix string) error {
+func (g *filterGroup) replaceKeys(keyMap map[string]string, prefix string) error {
+	for _, operand := range g.Operands {
+		if err := operand.replaceKeys(keyMap, prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Keys returns the keys the filter f and its groups filter on, without
+// duplicates.
+func (f *Filter) Keys() []string {
+	seen := make(map[string]bool)
+	keys := make([]string, 0)
+	f.collectKeys(seen, &keys)
+	return keys
+}
+
+func (f *Filter) collectKeys(seen map[string]bool, keys *[]string) {
+	for _, m := range []map[string][]interface{}{f.eq, f.neq, f.gt, f.gte, f.lt, f.lte, f.in, f.substring} {
+		for _, k := range sortedKeys(m) {
+			if !seen[k] {
+				seen[k] = true
+				*keys = append(*keys, k)
+			}
+		}
+	}
+	for _, g := range f.groups {
+		for _, operand := range g.Operands {
+			operand.collectKeys(seen, keys)
+		}
+	}
+}
+
+// apiKey returns the key of the API that key replaced, or key itself if it did
+// not replace one.
+func (f *Filter) apiKey(key string) string {
+	if apiKey, ok := f.apiKeys[key]; ok {
+		return apiKey
+	}
+	return key
+}
+
+// Replaces string keys in a map of the filter f and adds a prefix.
+func (f *Filter) replaceMapKeys(m map[string][]interface{}, keyMap map[string]string, prefix string) error {
 	keys := make([]string, 0)
 	for k := range m {
 		keys = append(keys, k)
@@ -204,163 +314,331 @@ func replaceMapKeys(m map[string][]interface{}, keyMap map[string]string, prefix
 		if !ok {
 			return util.NewInvalidInputError("no support for filtering on unrecognized field %q", k)
 		}
+		f.apiKeys[prefix+newKey] = f.apiKey(k)
 		m[prefix+newKey] = m[k]
 		delete(m, k)
 	}
 	return nil
 }
 
+// FilterK8sPipelines returns true if the Kubernetes pipeline matches the filter f.
 func (f *Filter) FilterK8sPipelines(pipeline v2beta1.Pipeline) (bool, error) {
-	if len(f.eq) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "eq"))
-	}
-
-	if len(f.neq) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "neq"))
-	}
-
-	if len(f.gt) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "gt"))
-	}
+	return f.matches(pipeline.GetField)
+}
 
-	if len(f.gte) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "gte"))
-	}
+// FilterK8sPipelineVersions returns true if the Kubernetes pipeline version
+// matches the filter f.
+func (f *Filter) FilterK8sPipelineVersions(pipelineVersion v2beta1.PipelineVersion) (bool, error) {
+	return f.matches(pipelineVersion.GetField)
+}
 
-	if len(f.lt) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "lt"))
+// matches evaluates the filter f against a resource held in memory, with the
+// same semantics as the SQL conditions built by AddToSelect. getField returns
+// the value of the resource for a filter key of the API, or nil if the
+// resource has no such value, in which case no predicate on the key holds.
+func (f *Filter) matches(getField func(key string) (interface{}, error)) (bool, error) {
+	predicateMaps := []struct {
+		operation string
+		m         map[string][]interface{}
+	}{
+		{"EQUALS", f.eq},
+		{"NOT_EQUALS", f.neq},
+		{"GREATER_THAN", f.gt},
+		{"GREATER_THAN_EQUALS", f.gte},
+		{"LESS_THAN", f.lt},
+		{"LESS_THAN_EQUALS", f.lte},
+		{"IN", f.in},
+		{"IS_SUBSTRING", f.substring},
+	}
+	for _, pm := range predicateMaps {
+		for _, k := range sortedKeys(pm.m) {
+			fieldValue, err := getField(f.apiKey(k))
+			if err != nil {
+				return false, err
+			}
+			for _, v := range pm.m[k] {
+				match, err := matchValue(pm.operation, fieldValue, v)
+				if err != nil || !match {
+					return false, err
+				}
+			}
+		}
 	}
-
-	if len(f.lte) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "lte"))
+	for _, g := range f.groups {
+		match, err := g.matches(getField)
+		if err != nil || !match {
+			return false, err
+		}
 	}
+	return true, nil
+}
 
-	if len(f.in) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "in"))
+func (g *filterGroup) matches(getField func(key string) (interface{}, error)) (bool, error) {
+	for _, operand := range g.Operands {
+		match, err := operand.matches(getField)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case g.Operator == groupOperatorOr && match:
+			return true, nil
+		case g.Operator != groupOperatorOr && !match:
+			// NOT negates the conjunction of its operands.
+			return g.Operator == groupOperatorNot, nil
+		}
 	}
+	return g.Operator == groupOperatorAnd, nil
+}
 
-	for k := range f.substring {
-		for _, v := range f.substring[k] {
-			if strings.Contains(fmt.Sprint(pipeline.GetField(k)), fmt.Sprint(v)) {
+// matchValue returns true if the value of a field satisfies the predicate with
+// the given operation and value.
+func matchValue(operation string, fieldValue interface{}, value interface{}) (bool, error) {
+	if fieldValue == nil {
+		return false, nil
+	}
+	switch operation {
+	case "IS_SUBSTRING":
+		return strings.Contains(fmt.Sprint(fieldValue), fmt.Sprint(value)), nil
+	case "IN":
+		values := reflect.ValueOf(value)
+		if values.Kind() != reflect.Slice {
+			return false, util.NewInvalidInputError("cannot use IN operator with scalar type %T", value)
+		}
+		for i := 0; i < values.Len(); i++ {
+			if CompareValues(fieldValue, values.Index(i).Interface()) == 0 {
 				return true, nil
 			}
 		}
+		return false, nil
+	}
+	c := CompareValues(fieldValue, value)
+	switch operation {
+	case "EQUALS":
+		return c == 0, nil
+	case "NOT_EQUALS":
+		return c != 0, nil
+	case "GREATER_THAN":
+		return c > 0, nil
+	case "GREATER_THAN_EQUALS":
+		return c >= 0, nil
+	case "LESS_THAN":
+		return c < 0, nil
+	case "LESS_THAN_EQUALS":
+		return c <= 0, nil
+	default:
+		return false, util.NewInvalidInputError("invalid predicate operation: %v", operation)
 	}
-
-	return false, nil
 }
 
-func (f *Filter) FilterK8sPipelineVersions(pipelineVersion v2beta1.PipelineVersion) (bool, error) {
-	if len(f.eq) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "eq"))
-	}
-
-	if len(f.neq) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "neq"))
+// CompareValues compares two values numerically if both are numbers, and by
+// their string representations otherwise. Numbers need to be compared
+// regardless of their type, as the values of a filter restored from a page
+// token are all float64.
+func CompareValues(a interface{}, b interface{}) int {
+	numberA, okA := toFloat(a)
+	numberB, okB := toFloat(b)
+	if okA && okB {
+		switch {
+		case numberA < numberB:
+			return -1
+		case numberA > numberB:
+			return 1
+		default:
+			return 0
+		}
 	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
 
-	if len(f.gt) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "gt"))
+func toFloat(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
 	}
+}
 
-	if len(f.gte) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "gte"))
+// K8sLabelSelector returns a label selector for the EQUALS and IN predicates
+// of the filter f on keys of the API that labelName maps to a Kubernetes label,
+// so that the Kubernetes API can preselect the resources to be matched with the
+// filter.
+// The other predicates and the groups are not part of the selector.
+func (f *Filter) K8sLabelSelector(labelName func(key string) (string, bool)) labels.Selector {
+	selector := labels.NewSelector()
+	addRequirement := func(key string, op selection.Operator, values []string) {
+		name, ok := labelName(f.apiKey(key))
+		if !ok {
+			return
+		}
+		// Values that are not valid label values are left to the filter.
+		requirement, err := labels.NewRequirement(name, op, values)
+		if err == nil {
+			selector = selector.Add(*requirement)
+		}
 	}
-
-	if len(f.lt) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "lt"))
+	for _, k := range sortedKeys(f.eq) {
+		for _, v := range f.eq[k] {
+			if value, ok := v.(string); ok {
+				addRequirement(k, selection.Equals, []string{value})
+			}
+		}
 	}
-
-	if len(f.lte) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "lte"))
+	for _, k := range sortedKeys(f.in) {
+		for _, v := range f.in[k] {
+			if values, ok := toStrings(v); ok {
+				addRequirement(k, selection.In, values)
+			}
+		}
 	}
+	return selector
+}
 
-	if len(f.in) > 0 {
-		return false, util.NewInvalidInputError("%s", fmt.Sprintf(filterMessage, "in"))
+// toStrings converts a slice of strings to []string. The values of a filter
+// restored from a page token are []interface{} rather than []string.
+func toStrings(v interface{}) ([]string, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return nil, false
 	}
-
-	for k := range f.substring {
-		for _, v := range f.substring[k] {
-			if strings.Contains(fmt.Sprint(pipelineVersion.GetField(k)), fmt.Sprint(v)) {
-				return true, nil
-			}
+	values := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		value, ok := rv.Index(i).Interface().(string)
+		if !ok {
+			return nil, false
 		}
+		values = append(values, value)
 	}
-
-	return false, nil
+	return values, true
 }
 
 // AddToSelect builds a WHERE clause from the Filter f, adds it to the supplied
 // SelectBuilder object and returns it for use in SQL queries.
 func (f *Filter) AddToSelect(sb squirrel.SelectBuilder) squirrel.SelectBuilder {
-	for k := range f.eq {
+	for _, cond := range f.conditions() {
+		sb = sb.Where(cond)
+	}
+	return sb
+}
+
+// conditions returns the SQL conditions of the Filter f, which must all hold
+// for a row to match. Keys are visited in sorted order so that the generated
+// SQL is deterministic.
+func (f *Filter) conditions() []squirrel.Sqlizer {
+	conds := make([]squirrel.Sqlizer, 0)
+
+	for _, k := range sortedKeys(f.eq) {
 		for _, v := range f.eq[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Eq(m))
+			conds = append(conds, squirrel.Eq{k: v})
 		}
 	}
 
-	for k := range f.neq {
+	for _, k := range sortedKeys(f.neq) {
 		for _, v := range f.neq[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.NotEq(m))
+			conds = append(conds, squirrel.NotEq{k: v})
 		}
 	}
 
-	for k := range f.gt {
+	for _, k := range sortedKeys(f.gt) {
 		for _, v := range f.gt[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Gt(m))
+			conds = append(conds, squirrel.Gt{k: v})
 		}
 	}
 
-	for k := range f.gte {
+	for _, k := range sortedKeys(f.gte) {
 		for _, v := range f.gte[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.GtOrEq(m))
+			conds = append(conds, squirrel.GtOrEq{k: v})
 		}
 	}
 
-	for k := range f.lt {
+	for _, k := range sortedKeys(f.lt) {
 		for _, v := range f.lt[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Lt(m))
+			conds = append(conds, squirrel.Lt{k: v})
 		}
 	}
 
-	for k := range f.lte {
+	for _, k := range sortedKeys(f.lte) {
 		for _, v := range f.lte[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.LtOrEq(m))
+			conds = append(conds, squirrel.LtOrEq{k: v})
 		}
 	}
 
 	// In
-	for k := range f.in {
+	for _, k := range sortedKeys(f.in) {
 		for _, v := range f.in[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Eq(m))
+			conds = append(conds, squirrel.Eq{k: v})
 		}
 	}
 
-	for k := range f.substring {
+	for _, k := range sortedKeys(f.substring) {
 		// Modify each string value v so it looks like %v% so we are doing a substring
 		// match with the LIKE operator.
 		for _, v := range f.substring[k] {
-			like := make(squirrel.Like)
-			like[k] = fmt.Sprintf("%%%s%%", v)
-			sb = sb.Where(like)
+			conds = append(conds, squirrel.Like{k: fmt.Sprintf("%%%s%%", v)})
 		}
 	}
 
-	return sb
+	for _, g := range f.groups {
+		conds = append(conds, g.toSqlizer())
+	}
+
+	return conds
+}
+
+// toSqlizer builds the SQL expression of the group g. Only standard SQL
+// operators are used, so the expression is valid for every supported dialect.
+func (g *filterGroup) toSqlizer() squirrel.Sqlizer {
+	operands := make([]squirrel.Sqlizer, 0, len(g.Operands))
+	for _, operand := range g.Operands {
+		conds := operand.conditions()
+		if len(conds) == 1 {
+			operands = append(operands, conds[0])
+		} else {
+			operands = append(operands, squirrel.And(conds))
+		}
+	}
+	switch g.Operator {
+	case groupOperatorOr:
+		return squirrel.Or(operands)
+	case groupOperatorNot:
+		return notExpr{squirrel.And(operands)}
+	default:
+		return squirrel.And(operands)
+	}
+}
+
+// notExpr negates the wrapped SQL expression.
+type notExpr struct {
+	expr squirrel.Sqlizer
+}
+
+func (n notExpr) ToSql() (string, []interface{}, error) {
+	sql, args, err := n.expr.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("NOT %s", sql), args, nil
+}
+
+func sortedKeys(m map[string][]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func checkPredicate(p *Predicate) error {
 	switch p.operation {
 	case apiv1beta1.Predicate_IN.String(), apiv2beta1.Predicate_IN.String():
 		switch t := p.value.(type) {
-		case int32, int64, string:
+		case int32, int64, float64, string:
 			return util.NewInvalidInputError("cannot use IN operator with scalar type %T", t)
 		}
 	case apiv1beta1.Predicate_EQUALS.String(), apiv1beta1.Predicate_NOT_EQUALS.String(), apiv1beta1.Predicate_GREATER_THAN.String(), apiv1beta1.Predicate_GREATER_THAN_EQUALS.String(), apiv1beta1.Predicate_LESS_THAN.String(), apiv1beta1.Predicate_LESS_THAN_EQUALS.String(), apiv2beta1.Predicate_EQUALS.String(), apiv2beta1.Predicate_NOT_EQUALS.String(), apiv2beta1.Predicate_GREATER_THAN.String(), apiv2beta1.Predicate_GREATER_THAN_EQUALS.String(), apiv2beta1.Predicate_LESS_THAN.String(), apiv2beta1.Predicate_LESS_THAN_EQUALS.String():
@@ -440,6 +718,66 @@ func toPredicates(filterProto interface{}) ([]*Predicate, error) {
 	return predicates, nil
 }
 
+func toGroups(filterProto interface{}) ([]*filterGroup, error) {
+	// Only the v2beta1 API supports filter groups.
+	filterProtoV2, ok := filterProto.(*apiv2beta1.Filter)
+	if !ok {
+		return nil, nil
+	}
+	// Leave groups nil when there are none, so that a filter restored from a
+	// page token still matches the one parsed from the request.
+	var groups []*filterGroup
+	for _, g := range filterProtoV2.GetGroups() {
+		group, err := toGroup(g)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func toGroup(g *apiv2beta1.FilterGroup) (*filterGroup, error) {
+	var operator string
+	switch g.GetOperator() {
+	case apiv2beta1.FilterGroup_AND:
+		operator = groupOperatorAnd
+	case apiv2beta1.FilterGroup_OR:
+		operator = groupOperatorOr
+	case apiv2beta1.FilterGroup_NOT:
+		operator = groupOperatorNot
+	default:
+		return nil, util.NewInvalidInputError("invalid filter group operator: %v", g.GetOperator())
+	}
+	group := &filterGroup{Operator: operator}
+	for _, p := range g.GetPredicates() {
+		pred, err := toPredicate(p)
+		if err != nil {
+			return nil, err
+		}
+		operand, err := newFilter([]*Predicate{pred}, nil)
+		if err != nil {
+			return nil, err
+		}
+		group.Operands = append(group.Operands, operand)
+	}
+	for _, nested := range g.GetGroups() {
+		nestedGroup, err := toGroup(nested)
+		if err != nil {
+			return nil, err
+		}
+		operand, err := newFilter(nil, []*filterGroup{nestedGroup})
+		if err != nil {
+			return nil, err
+		}
+		group.Operands = append(group.Operands, operand)
+	}
+	if len(group.Operands) == 0 {
+		return nil, util.NewInvalidInputError("filter group with operator %v must contain at least one predicate or group", operator)
+	}
+	return group, nil
+}
+
 func toPredicate(p interface{}) (*Predicate, error) {
 	if p == nil {
 		return nil, nil
@@ -524,6 +862,8 @@ func toValue(v interface{}) (interface{}, error) {
 		return v.StringValues.GetValues(), nil
 	case *apiv2beta1.Predicate_LongValues_:
 		return v.LongValues.GetValues(), nil
+	case *apiv2beta1.Predicate_DoubleValue:
+		return v.DoubleValue, nil
 
 	case *apiv1beta1.Predicate_IntValue:
 		return v.IntValue, nil
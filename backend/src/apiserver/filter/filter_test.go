@@ -16,6 +16,8 @@ package filter
 
 import (
 	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	"google.golang.org/protobuf/encoding/prototext"
@@ -696,6 +698,11 @@ func TestFilter_ReplaceKeys(t *testing.T) {
 			&Filter{
 				eq: expectedEQ,
 				in: expectedIN,
+				apiKeys: map[string]string{
+					"pipelines.Namespace":      "namespace",
+					"pipelines.CreatedAtInSec": "created_at",
+					"pipelines.Name":           "name",
+				},
 			},
 		},
 	}
@@ -709,3 +716,260 @@ func TestFilter_ReplaceKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestAddToSelectWithGroups(t *testing.T) {
+	tests := []struct {
+		protoStr string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			`groups { operator: OR
+				predicates { key: "state" operation: EQUALS string_value: "FAILED" }
+				predicates { key: "state" operation: EQUALS string_value: "CANCELED" } }`,
+			"SELECT mycolumn WHERE (state = ? OR state = ?)",
+			[]interface{}{"FAILED", "CANCELED"},
+		},
+		{
+			`groups { operator: NOT
+				predicates { key: "name" operation: IS_SUBSTRING string_value: "tmp" } }`,
+			"SELECT mycolumn WHERE NOT (name LIKE ?)",
+			[]interface{}{"%tmp%"},
+		},
+		{
+			`groups { operator: AND
+				predicates { key: "total" operation: GREATER_THAN long_value: 10 }
+				predicates { key: "total" operation: LESS_THAN long_value: 20 } }`,
+			"SELECT mycolumn WHERE (total > ? AND total < ?)",
+			[]interface{}{int64(10), int64(20)},
+		},
+		{
+			`predicates { key: "namespace" operation: EQUALS string_value: "ns" }
+			 groups { operator: OR
+				predicates { key: "state" operation: IN string_values { values: "FAILED" values: "CANCELED" } }
+				groups { operator: NOT
+					predicates { key: "name" operation: IS_SUBSTRING string_value: "tmp" }
+					predicates { key: "total" operation: LESS_THAN_EQUALS int_value: 5 } } }`,
+			"SELECT mycolumn WHERE namespace = ? AND (state IN (?,?) OR NOT (name LIKE ? AND total <= ?))",
+			[]interface{}{"ns", "FAILED", "CANCELED", "%tmp%", int32(5)},
+		},
+	}
+
+	for _, test := range tests {
+		filterProto := &apiv2beta1.Filter{}
+		if err := prototext.Unmarshal([]byte(test.protoStr), filterProto); err != nil {
+			t.Errorf("Failed to unmarshal Filter text proto\n%q\nError: %v", test.protoStr, err)
+			continue
+		}
+
+		filter, err := New(filterProto)
+		if err != nil {
+			t.Errorf("New(%+v) = %+v, %v\nWant nil error", filterProto, filter, err)
+			continue
+		}
+
+		sb := squirrel.Select("mycolumn")
+		gotSQL, gotArgs, err := filter.AddToSelect(sb).ToSql()
+		if !cmp.Equal(gotSQL, test.wantSQL) || !cmp.Equal(gotArgs, test.wantArgs) || err != nil {
+			t.Errorf("Filter.AddToSelect(%+v).ToSql() =\nGot: %+v, %v, %v\nWant: %+v, %+v, <nil>", filter, gotSQL, gotArgs, err, test.wantSQL, test.wantArgs)
+		}
+	}
+}
+
+func TestInvalidFilterGroups(t *testing.T) {
+	tests := []struct {
+		protoStr string
+	}{
+		{
+			`groups { predicates { key: "state" operation: EQUALS string_value: "FAILED" } }`,
+		},
+		{
+			`groups { operator: OR }`,
+		},
+		{
+			`groups { operator: NOT groups { operator: AND } }`,
+		},
+		{
+			`groups { operator: OR
+				predicates { key: "state" operation: IN string_value: "FAILED" } }`,
+		},
+	}
+
+	for _, test := range tests {
+		filterProto := &apiv2beta1.Filter{}
+		if err := prototext.Unmarshal([]byte(test.protoStr), filterProto); err != nil {
+			t.Errorf("Failed to unmarshal Filter text proto\n%q\nError: %v", test.protoStr, err)
+			continue
+		}
+
+		got, err := New(filterProto)
+		if err == nil {
+			t.Errorf("New(%+v) = %+v, <nil>\nWant non-nil error ", filterProto, got)
+		}
+	}
+}
+
+func TestFilterGroupsJSONRoundTrip(t *testing.T) {
+	filterProto := &apiv2beta1.Filter{}
+	protoStr := `groups { operator: OR
+		predicates { key: "state" operation: EQUALS string_value: "FAILED" }
+		groups { operator: NOT predicates { key: "name" operation: IS_SUBSTRING string_value: "tmp" } } }`
+	if err := prototext.Unmarshal([]byte(protoStr), filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	f, err := New(filterProto)
+	assert.Nil(t, err)
+
+	b, err := json.Marshal(f)
+	assert.Nil(t, err)
+	got := &Filter{}
+	assert.Nil(t, json.Unmarshal(b, got))
+
+	wantSQL, wantArgs, err := f.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	assert.Nil(t, err)
+	gotSQL, gotArgs, err := got.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT mycolumn WHERE (state = ? OR NOT (name LIKE ?))", gotSQL)
+	assert.Equal(t, wantSQL, gotSQL)
+	assert.Equal(t, wantArgs, gotArgs)
+}
+
+func TestFilterWithoutGroupsJSONRoundTrip(t *testing.T) {
+	filterProto := &apiv2beta1.Filter{
+		Predicates: []*apiv2beta1.Predicate{
+			{Key: "name", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "SomeName"}},
+		},
+	}
+	f, err := New(filterProto)
+	assert.Nil(t, err)
+
+	b, err := json.Marshal(f)
+	assert.Nil(t, err)
+	got := &Filter{}
+	assert.Nil(t, json.Unmarshal(b, got))
+	// Page tokens are compared with reflect.DeepEqual, see list.Options.Matches.
+	assert.True(t, reflect.DeepEqual(f, got))
+}
+
+func TestNewWithKeyMapWithGroups(t *testing.T) {
+	filterProto := &apiv2beta1.Filter{
+		Groups: []*apiv2beta1.FilterGroup{
+			{
+				Operator: apiv2beta1.FilterGroup_OR,
+				Predicates: []*apiv2beta1.Predicate{
+					{
+						Key:       "name",
+						Operation: apiv2beta1.Predicate_EQUALS,
+						Value:     &apiv2beta1.Predicate_StringValue{StringValue: "a"},
+					},
+					{
+						Key:       "description",
+						Operation: apiv2beta1.Predicate_EQUALS,
+						Value:     &apiv2beta1.Predicate_StringValue{StringValue: "b"},
+					},
+				},
+			},
+		},
+	}
+	keyMap := map[string]string{
+		"name":        "Name",
+		"description": "Description",
+	}
+
+	got, err := NewWithKeyMap(filterProto, keyMap, "pipelines")
+	assert.Nil(t, err)
+	gotSQL, gotArgs, err := got.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT mycolumn WHERE (pipelines.Name = ? OR pipelines.Description = ?)", gotSQL)
+	assert.Equal(t, []interface{}{"a", "b"}, gotArgs)
+
+	filterProto.Groups[0].Predicates[0].Key = "unknown"
+	_, err = NewWithKeyMap(filterProto, keyMap, "pipelines")
+	assert.NotNil(t, err)
+}
+
+func TestFilter_Keys(t *testing.T) {
+	filterProto := &apiv2beta1.Filter{}
+	protoStr := `predicates { key: "metric:accuracy" operation: GREATER_THAN double_value: 0.9 }
+		predicates { key: "name" operation: EQUALS string_value: "a" }
+		groups { operator: OR
+			predicates { key: "name" operation: IS_SUBSTRING string_value: "b" }
+			predicates { key: "state" operation: EQUALS string_value: "FAILED" } }`
+	if err := prototext.Unmarshal([]byte(protoStr), filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	f, err := New(filterProto)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"name", "metric:accuracy", "state"}, f.Keys())
+
+	gotSQL, gotArgs, err := f.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT mycolumn WHERE name = ? AND metric:accuracy > ? AND (name LIKE ? OR state = ?)", gotSQL)
+	assert.Equal(t, []interface{}{"a", 0.9, "%b%", "FAILED"}, gotArgs)
+}
+
+func TestDoubleValueWithInOperator(t *testing.T) {
+	filterProto := &apiv2beta1.Filter{
+		Predicates: []*apiv2beta1.Predicate{
+			{
+				Key:       "metric:accuracy",
+				Operation: apiv2beta1.Predicate_IN,
+				Value:     &apiv2beta1.Predicate_DoubleValue{DoubleValue: 0.9},
+			},
+		},
+	}
+	_, err := New(filterProto)
+	assert.NotNil(t, err)
+}
+
+func TestK8sLabelSelector(t *testing.T) {
+	filterProto := &apiv2beta1.Filter{
+		Predicates: []*apiv2beta1.Predicate{
+			{Key: "labels.team", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "a"}},
+			{Key: "labels.tier", Operation: apiv2beta1.Predicate_IN, Value: &apiv2beta1.Predicate_StringValues_{StringValues: &apiv2beta1.Predicate_StringValues{Values: []string{"x", "y"}}}},
+			// Not a valid label value, so it is left to the filter.
+			{Key: "labels.owner", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "a b"}},
+			{Key: "name", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "p1"}},
+			{Key: "labels.env", Operation: apiv2beta1.Predicate_NOT_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "dev"}},
+		},
+	}
+	f, err := New(filterProto)
+	assert.Nil(t, err)
+
+	labelName := func(key string) (string, bool) {
+		name, ok := strings.CutPrefix(key, "labels.")
+		return name, ok
+	}
+	assert.Equal(t, "team=a,tier in (x,y)", f.K8sLabelSelector(labelName).String())
+}
+
+func TestK8sLabelSelector_ReplacedKeys(t *testing.T) {
+	filterProto := &apiv2beta1.Filter{
+		Predicates: []*apiv2beta1.Predicate{
+			{Key: "labels.team", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "a"}},
+			{Key: "name", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "p1"}},
+		},
+	}
+	f, err := New(filterProto)
+	assert.Nil(t, err)
+	err = f.ReplaceKeys(map[string]string{
+		"labels.team": "(SELECT labels.Value FROM labels WHERE labels.Name = 'team')",
+		"name":        "pipelines.Name",
+	}, "")
+	assert.Nil(t, err)
+
+	// The keys of the API are kept in page tokens.
+	b, err := json.Marshal(f)
+	assert.Nil(t, err)
+	restored := &Filter{}
+	assert.Nil(t, json.Unmarshal(b, restored))
+
+	for _, f := range []*Filter{f, restored} {
+		assert.Equal(t, "team=a", f.K8sLabelSelector(model.LabelNameFromKey).String())
+		match, err := f.matches(func(key string) (interface{}, error) {
+			return map[string]interface{}{"labels.team": "a", "name": "p1"}[key], nil
+		})
+		assert.Nil(t, err)
+		assert.True(t, match)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strings"
 
 	sq "github.com/Masterminds/squirrel"
@@ -172,7 +173,7 @@ func NewOptions(listable Listable, pageSize int, sortBy string, filter *filter.F
 
 	// Filtering.
 	if filter != nil {
-		if err := filter.ReplaceKeys(listable.APIToModelFieldMap(), listable.GetModelName()); err != nil {
+		if err := filter.ReplaceKeys(filterKeyMap(listable, filter), ""); err != nil {
 			return nil, err
 		}
 		token.Filter = filter
@@ -342,6 +343,43 @@ type Listable interface {
 	GetFieldValue(name string) interface{}
 }
 
+// ComputedFieldListable is implemented by listables that can also be filtered
+// on fields which are not columns of their table, e.g. the metrics of a run or
+// the labels of a resource.
+type ComputedFieldListable interface {
+	// GetComputedField returns the SQL expression of a filter key that is not
+	// in APIToModelFieldMap.
+	GetComputedField(key string) (string, bool)
+}
+
+// filterKeyMap returns the map of the filter keys of the listable to the
+// columns they refer to, extended by the computed fields that filter f uses.
+// Columns are qualified with the model name of the listable, while computed
+// fields are SQL expressions and are used as they are.
+func filterKeyMap(listable Listable, f *filter.Filter) map[string]string {
+	prefix := ""
+	if listable.GetModelName() != "" {
+		prefix = listable.GetModelName() + "."
+	}
+	keyMap := make(map[string]string)
+	for k, v := range listable.APIToModelFieldMap() {
+		keyMap[k] = prefix + v
+	}
+	computed, ok := listable.(ComputedFieldListable)
+	if !ok {
+		return keyMap
+	}
+	for _, k := range f.Keys() {
+		if _, ok := keyMap[k]; ok {
+			continue
+		}
+		if field, ok := computed.GetComputedField(k); ok {
+			keyMap[k] = field
+		}
+	}
+	return keyMap
+}
+
 // NextPageToken returns a string that can be used to fetch the subsequent set
 // of results using the same listing options in o, starting with listable as the
 // first record.
@@ -380,6 +418,49 @@ func (o *Options) nextPageToken(listable Listable) (*token, error) {
 	}, nil
 }
 
+// SortAndPaginate sorts listables held in memory, e.g. resources listed from
+// Kubernetes, by the sorting criteria of o and returns the page of them that o
+// selects, with the total number of listables and the token of the next page.
+// Ties are broken by the primary key, so pages are stable as they are for SQL
+// queries.
+func SortAndPaginate[T Listable](o *Options, listables []T) ([]T, int, string, error) {
+	compare := func(sortByValue interface{}, keyValue interface{}, otherSortByValue interface{}, otherKeyValue interface{}) int {
+		c := filter.CompareValues(sortByValue, otherSortByValue)
+		if c == 0 {
+			c = filter.CompareValues(keyValue, otherKeyValue)
+		}
+		if o.IsDesc {
+			return -c
+		}
+		return c
+	}
+	sort.SliceStable(listables, func(i, j int) bool {
+		a, b := listables[i], listables[j]
+		return compare(a.GetFieldValue(o.SortByFieldName), primaryKeyValue(a), b.GetFieldValue(o.SortByFieldName), primaryKeyValue(b)) < 0
+	})
+
+	start := 0
+	if o.SortByFieldValue != nil && o.KeyFieldValue != nil {
+		start = sort.Search(len(listables), func(i int) bool {
+			return compare(listables[i].GetFieldValue(o.SortByFieldName), primaryKeyValue(listables[i]), o.SortByFieldValue, o.KeyFieldValue) >= 0
+		})
+	}
+	end := len(listables)
+	nextPageToken := ""
+	if o.PageSize < end-start {
+		end = start + o.PageSize
+		var err error
+		if nextPageToken, err = o.NextPageToken(listables[end]); err != nil {
+			return nil, 0, "", err
+		}
+	}
+	return listables[start:end], len(listables), nextPageToken, nil
+}
+
+func primaryKeyValue(listable Listable) interface{} {
+	return reflect.ValueOf(listable).Elem().FieldByName(listable.PrimaryKeyColumnName()).Interface()
+}
+
 const (
 	defaultPageSize = 20
 	maxPageSize     = 200
@@ -487,6 +487,9 @@ func TestNewOptions_ValidFilter(t *testing.T) {
 		cmpopts.EquateEmpty(), protocmp.Transform(),
 		cmp.AllowUnexported(Options{}),
 		cmp.AllowUnexported(filter.Filter{}),
+		// The keys of the API that the filter keys replaced are only used by
+		// stores that filter in memory.
+		cmpopts.IgnoreFields(filter.Filter{}, "apiKeys"),
 	}
 
 	if !cmp.Equal(got, want, opts...) || err != nil {
@@ -555,6 +558,9 @@ func TestNewOptions_ModelFilter(t *testing.T) {
 		cmpopts.EquateEmpty(), protocmp.Transform(),
 		cmp.AllowUnexported(Options{}),
 		cmp.AllowUnexported(filter.Filter{}),
+		// The keys of the API that the filter keys replaced are only used by
+		// stores that filter in memory.
+		cmpopts.IgnoreFields(filter.Filter{}, "apiKeys"),
 	}
 
 	if !cmp.Equal(got, want, opts...) || err != nil {
@@ -1085,3 +1091,38 @@ func TestAddStatusFilterToSelectWithRunModel(t *testing.T) {
 	assert.Contains(t, sql, "WHERE Conditions <> ?") // filtering on status, aka Conditions in db
 	assert.Contains(t, args, "somevalue")
 }
+
+func TestAddMetricFilterToSelectWithRunModel(t *testing.T) {
+	protoFilter := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "metric:accuracy",
+				Op:    api.Predicate_GREATER_THAN_EQUALS,
+				Value: &api.Predicate_LongValue{LongValue: 1},
+			},
+		},
+	}
+	newFilter, _ := filter.New(protoFilter)
+	listableOptions, err := NewOptions(&model.Run{}, 10, "metric:accuracy desc", newFilter)
+	assert.Nil(t, err)
+	assert.Equal(t, "accuracy", listableOptions.SortByFieldName)
+	sql, args, err := listableOptions.AddFilterToSelect(sq.Select("*").From("run_details")).ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT * FROM run_details WHERE (SELECT MAX(run_metrics.NumberValue) FROM run_metrics WHERE run_metrics.RunUUID = run_details.UUID AND run_metrics.Name = 'accuracy') >= ?", sql)
+	assert.Equal(t, []interface{}{int64(1)}, args)
+
+	// Metric names are validated, as they end up in the SQL query.
+	protoFilter.Predicates[0].Key = "metric:a' OR '1'='1"
+	newFilter, _ = filter.New(protoFilter)
+	_, err = NewOptions(&model.Run{}, 10, "", newFilter)
+	assert.NotNil(t, err)
+	_, err = NewOptions(&model.Run{}, 10, "metric:a'", nil)
+	assert.NotNil(t, err)
+	// Hyphens are allowed for filtering, but not for sorting.
+	protoFilter.Predicates[0].Key = "metric:my-metric"
+	newFilter, _ = filter.New(protoFilter)
+	_, err = NewOptions(&model.Run{}, 10, "", newFilter)
+	assert.Nil(t, err)
+	_, err = NewOptions(&model.Run{}, 10, "metric:my-metric", nil)
+	assert.NotNil(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -44,6 +45,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/webhook"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/native"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -169,15 +171,41 @@ func main() {
 		glog.Fatalf("Failed to get Workspace PVC Spec: %v", err)
 	}
 
+	runRetentionPolicies, err := getRunRetentionPolicies()
+	if err != nil {
+		glog.Fatalf("Failed to get the run retention policies: %v", err)
+	}
+
+	runNotificationDeniedNetworks, err := getRunNotificationDeniedNetworks()
+	if err != nil {
+		glog.Fatalf("Failed to get the denied networks of run notifications: %v", err)
+	}
+
 	resourceManager := resource.NewResourceManager(
 		clientManager,
 		&resource.ResourceManagerOptions{
-			CollectMetrics:       *collectMetricsFlag,
-			CacheDisabled:        !common.GetBoolConfigWithDefault("CacheEnabled", true),
-			DefaultWorkspace:     pvcSpec,
-			DefaultWorkspaceSize: defaultWorkspaceSize,
+			CollectMetrics:                *collectMetricsFlag,
+			CacheDisabled:                 !common.GetBoolConfigWithDefault("CacheEnabled", true),
+			DefaultWorkspace:              pvcSpec,
+			DefaultWorkspaceSize:          defaultWorkspaceSize,
+			RunRetentionPolicies:          runRetentionPolicies,
+			RunNotificationUIBaseURL:      common.GetStringConfigWithDefault(common.RunNotificationUIBaseURL, ""),
+			RunNotificationRetryTimeout:   common.GetDurationConfigWithDefault(common.RunNotificationRetryTimeout, 0),
+			RunNotificationAllowedHosts:   viper.GetStringSlice(common.RunNotificationAllowedHosts),
+			RunNotificationDeniedNetworks: runNotificationDeniedNetworks,
+			RunWatchHistorySize:           common.GetIntConfigWithDefault(common.RunWatchHistorySize, 0),
+			BackfillMaxRuns:               common.GetIntConfigWithDefault(common.BackfillMaxRuns, 0),
+			FilePipelineRoots:             viper.GetStringSlice(common.FilePipelineRoots),
 		},
 	)
+	// Native runs are reported and run by the API server itself, as there is
+	// no persistence agent or workflow controller watching them.
+	if nativeClient, ok := clientManager.ExecClient().(*native.Client); ok {
+		nativeClient.SetReporter(server.NewReportServer(resourceManager).ReportExecution)
+		if err := nativeClient.Resume(backgroundCtx); err != nil {
+			glog.Errorf("Failed to resume the native runs: %v", err)
+		}
+	}
 	err = config.LoadSamples(resourceManager, *sampleConfigPath)
 	if err != nil {
 		glog.Fatalf("Failed to load samples. Err: %v", err)
@@ -192,11 +220,20 @@ func main() {
 
 	wg.Add(1)
 	go reconcileSwfCrs(resourceManager, backgroundCtx, &wg)
+	if len(runRetentionPolicies) > 0 {
+		wg.Add(1)
+		go enforceRunRetentionPolicies(resourceManager, backgroundCtx, &wg)
+	}
+	if pendingInterval := common.GetDurationConfigWithDefault(common.RunNotificationPendingInterval, time.Minute); pendingInterval > 0 {
+		wg.Add(1)
+		go notifyPendingRuns(resourceManager, pendingInterval, backgroundCtx, &wg)
+	}
 	go startRpcServer(resourceManager)
 	// This is blocking
-	startHttpProxy(resourceManager, *usePipelinesKubernetesStorage)
+	startHttpProxy(resourceManager, *usePipelinesKubernetesStorage, backgroundCtx)
 	backgroundCancel()
 	wg.Wait()
+	resourceManager.StopNotifications(common.GetDurationConfigWithDefault(common.RunNotificationShutdownTimeout, 30*time.Second))
 }
 
 func reconcileSwfCrs(resourceManager *resource.ResourceManager, ctx context.Context, wg *sync.WaitGroup) {
@@ -207,12 +244,57 @@ func reconcileSwfCrs(resourceManager *resource.ResourceManager, ctx context.Cont
 	}
 }
 
+// Enforces the run retention policies periodically until the context is done.
+func enforceRunRetentionPolicies(resourceManager *resource.ResourceManager, ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(common.GetDurationConfigWithDefault(common.RunRetentionInterval, time.Hour))
+	defer ticker.Stop()
+	for {
+		err := resourceManager.EnforceRunRetentionPolicies(ctx)
+		if err != nil {
+			log.Errorf("Could not enforce the run retention policies: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Notifies the webhooks subscribed to runs stuck in PENDING, and notifies
+// again the webhooks whose delivery did not finish, periodically until the
+// context is done.
+func notifyPendingRuns(resourceManager *resource.ResourceManager, interval time.Duration, ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := resourceManager.NotifyPendingRuns(ctx)
+		if err != nil {
+			log.Errorf("Could not notify about pending runs: %v", err)
+		}
+		err = resourceManager.RedeliverRunNotifications(ctx)
+		if err != nil {
+			log.Errorf("Could not notify again about runs: %v", err)
+		}
+	}
+}
+
 // A custom http request header matcher to pass on the user identity
 // Reference: https://github.com/grpc-ecosystem/grpc-gateway/blob/v1.16.0/docs/_docs/customizingyourgateway.md#mapping-from-http-request-headers-to-grpc-client-metadata
 func grpcCustomMatcher(key string) (string, bool) {
 	if strings.EqualFold(key, common.GetKubeflowUserIDHeader()) {
 		return strings.ToLower(key), true
 	}
+	if strings.EqualFold(key, common.LastEventIDHeader) {
+		return strings.ToLower(key), true
+	}
 	return strings.ToLower(key), false
 }
 
@@ -222,7 +304,7 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	if err != nil {
 		glog.Fatalf("Failed to start RPC server: %v", err)
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
+	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.StreamInterceptor(apiServerStreamInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
 
 	ExperimentServerV1 := server.NewExperimentServerV1(resourceManager, &server.ExperimentServerOptions{CollectMetrics: *collectMetricsFlag})
 	ExperimentServer := server.NewExperimentServer(resourceManager, &server.ExperimentServerOptions{CollectMetrics: *collectMetricsFlag})
@@ -260,6 +342,7 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	apiv2beta1.RegisterRecurringRunServiceServer(s, JobServer)
 	apiv2beta1.RegisterRunServiceServer(s, RunServer)
 	apiv2beta1.RegisterReportServiceServer(s, ReportServer)
+	apiv2beta1.RegisterCacheServiceServer(s, server.NewCacheServer(resourceManager))
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
@@ -269,7 +352,8 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	glog.Info("RPC server started")
 }
 
-func startHttpProxy(resourceManager *resource.ResourceManager, usePipelinesKubernetesStorage bool) {
+// Serves the HTTP proxy until the given context is done.
+func startHttpProxy(resourceManager *resource.ResourceManager, usePipelinesKubernetesStorage bool, shutdownCtx context.Context) {
 	glog.Info("Starting Http Proxy")
 
 	ctx := context.Background()
@@ -303,7 +387,8 @@ func startHttpProxy(resourceManager *resource.ResourceManager, usePipelinesKuber
 	}
 	runtimeMux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(grpcCustomMatcher),
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, customMarshaler))
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, customMarshaler),
+		runtime.WithMarshalerOption(server.EventStreamContentType, server.NewEventStreamMarshaler(customMarshaler)))
 	registerHttpHandlerFromEndpoint(apiv1beta1.RegisterPipelineServiceHandlerFromEndpoint, "PipelineService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv1beta1.RegisterExperimentServiceHandlerFromEndpoint, "ExperimentService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv1beta1.RegisterJobServiceHandlerFromEndpoint, "JobService", ctx, runtimeMux)
@@ -319,6 +404,7 @@ func startHttpProxy(resourceManager *resource.ResourceManager, usePipelinesKuber
 	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterRecurringRunServiceHandlerFromEndpoint, "RecurringRunService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterRunServiceHandlerFromEndpoint, "RunService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterReportServiceHandlerFromEndpoint, "ReportService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterCacheServiceHandlerFromEndpoint, "CacheService", ctx, runtimeMux)
 
 	// Create a top level mux to include both pipeline upload server and gRPC servers.
 	topMux := mux.NewRouter()
@@ -346,13 +432,27 @@ func startHttpProxy(resourceManager *resource.ResourceManager, usePipelinesKuber
 	runLogServer := server.NewRunLogServer(resourceManager)
 	topMux.HandleFunc("/apis/v1alpha1/runs/{run_id}/nodes/{node_id}/log", runLogServer.ReadRunLogV1)
 
+	// Webhook triggers of recurring runs sign the raw request body, which grpc-gateway does not expose.
+	recurringRunWebhookServer := server.NewRecurringRunWebhookServer(resourceManager)
+	topMux.HandleFunc("/apis/v2beta1/recurringruns/{recurring_run_id:[^/:]+}:trigger", recurringRunWebhookServer.TriggerRecurringRun)
+
 	topMux.PathPrefix("/apis/").Handler(runtimeMux)
 
 	// Register a handler for Prometheus to poll.
 	topMux.Handle("/metrics", promhttp.Handler())
 
-	http.ListenAndServe(*httpPortFlag, topMux)
+	httpServer := &http.Server{Addr: *httpPortFlag, Handler: topMux}
+	go func() {
+		<-shutdownCtx.Done()
+		glog.Info("Shutting down Http Proxy...")
+		if err := httpServer.Shutdown(context.Background()); err != nil {
+			glog.Errorf("Error shutting down Http Proxy: %v", err)
+		}
+	}()
 	glog.Info("Http Proxy started")
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		glog.Fatalf("Failed to start Http Proxy: %v", err)
+	}
 }
 
 func startWebhook(client ctrlclient.Client, clientNoCahe ctrlclient.Client, wg *sync.WaitGroup) (*http.Server, error) {
@@ -453,3 +553,36 @@ func getPVCSpec() (*corev1.PersistentVolumeClaimSpec, string, error) {
 	defaultSize := workspaceConfig.GetString(workspaceSize)
 	return &pvcSpec, defaultSize, nil
 }
+
+// getRunRetentionPolicies returns the validated run retention policies of the
+// config, or nil if none are configured.
+func getRunRetentionPolicies() ([]*resource.RunRetentionPolicy, error) {
+	if !viper.IsSet(common.RunRetentionPolicies) {
+		return nil, nil
+	}
+	var policies []*resource.RunRetentionPolicy
+	if err := viper.UnmarshalKey(common.RunRetentionPolicies, &policies); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", common.RunRetentionPolicies, err)
+	}
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", common.RunRetentionPolicies, err)
+		}
+	}
+	return policies, nil
+}
+
+// getRunNotificationDeniedNetworks returns the CIDRs of the networks run
+// notification webhooks may not be reached at, or nil to use the default ones.
+func getRunNotificationDeniedNetworks() ([]string, error) {
+	if !viper.IsSet(common.RunNotificationDeniedNetworks) {
+		return nil, nil
+	}
+	networks := viper.GetStringSlice(common.RunNotificationDeniedNetworks)
+	for _, network := range networks {
+		if _, _, err := net.ParseCIDR(network); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", common.RunNotificationDeniedNetworks, err)
+		}
+	}
+	return networks, nil
+}
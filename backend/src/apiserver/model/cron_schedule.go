@@ -25,6 +25,10 @@ type CronSchedule struct {
 	// Cron string describing when a workflow should be created within the
 	// time interval defined by StartTime and EndTime.
 	Cron *string `gorm:"column:Schedule;"`
+
+	// IANA time zone in which the cron string is evaluated.
+	// If no time zone is specified, the default time zone of the controller is used.
+	CronScheduleTimeZone *string `gorm:"column:CronScheduleTimeZone;"`
 }
 
 func (c CronSchedule) IsEmpty() bool {
@@ -0,0 +1,77 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "encoding/json"
+
+// EventTrigger creates workflows when events occur rather than on a schedule.
+// Exactly one of its triggers is set.
+type EventTrigger struct {
+	ObjectStore *ObjectStoreTrigger `json:"objectStore,omitempty"`
+	Webhook     *WebhookTrigger     `json:"webhook,omitempty"`
+}
+
+// ObjectStoreTrigger creates a workflow for each new object of a bucket prefix.
+type ObjectStoreTrigger struct {
+	BucketURL          string            `json:"bucketUrl"`
+	Provider           string            `json:"provider,omitempty"`
+	ProviderParams     map[string]string `json:"providerParams,omitempty"`
+	PollIntervalSecond int64             `json:"pollIntervalSecond,omitempty"`
+	PayloadParameters  map[string]string `json:"payloadParameters,omitempty"`
+}
+
+// WebhookTrigger creates a workflow for each signed request posted to the
+// trigger endpoint of the job.
+type WebhookTrigger struct {
+	SecretName        string            `json:"secretName"`
+	SecretKey         string            `json:"secretKey,omitempty"`
+	PayloadParameters map[string]string `json:"payloadParameters,omitempty"`
+}
+
+// WebhookDelivery is a request accepted by the webhook trigger of a job. It is
+// kept for as long as the signature of the request is valid, so that the
+// request cannot be replayed.
+type WebhookDelivery struct {
+	JobUUID          string `gorm:"column:JobUUID; not null; primary_key;"`
+	Signature        string `gorm:"column:Signature; not null; primary_key;"`
+	DeliveredAtInSec int64  `gorm:"column:DeliveredAtInSec; not null; index;"`
+}
+
+// GetEventTrigger returns the event trigger, or nil if workflows are not
+// created by events.
+func (t Trigger) GetEventTrigger() (*EventTrigger, error) {
+	if t.Event == "" {
+		return nil, nil
+	}
+	eventTrigger := &EventTrigger{}
+	if err := json.Unmarshal([]byte(t.Event), eventTrigger); err != nil {
+		return nil, err
+	}
+	return eventTrigger, nil
+}
+
+// SetEventTrigger serializes the event trigger. A nil trigger clears it.
+func (t *Trigger) SetEventTrigger(eventTrigger *EventTrigger) error {
+	if eventTrigger == nil {
+		t.Event = ""
+		return nil
+	}
+	event, err := json.Marshal(eventTrigger)
+	if err != nil {
+		return err
+	}
+	t.Event = string(event)
+	return nil
+}
@@ -22,6 +22,11 @@ type Experiment struct {
 	LastRunCreatedAtInSec int64        `gorm:"column:LastRunCreatedAtInSec; not null;"`
 	Namespace             string       `gorm:"column:Namespace; not null; unique_index:idx_name_namespace;"`
 	StorageState          StorageState `gorm:"column:StorageState; not null;"`
+	// Webhooks notified about state transitions of the runs in this
+	// experiment. Stores the serialized []NotificationSubscription.
+	Notifications string `gorm:"column:Notifications; size:65535;"`
+	// Labels are stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 }
 
 // Note: Experiment.StorageState can have values: "STORAGE_STATE_UNSPECIFIED", "AVAILABLE" or "ARCHIVED"
@@ -74,6 +79,12 @@ func (e *Experiment) GetField(name string) (string, bool) {
 	return "", false
 }
 
+// GetComputedField maps the filter key "labels.<name>" to the value of the
+// experiment's label with the given name.
+func (e *Experiment) GetComputedField(key string) (string, bool) {
+	return labelField(key, ExperimentResourceType, "experiments.UUID")
+}
+
 func (e *Experiment) GetFieldValue(name string) interface{} {
 	switch name {
 	case "UUID":
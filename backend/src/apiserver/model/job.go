@@ -104,6 +104,11 @@ type Job struct {
 	Trigger
 	PipelineSpec
 	Conditions string `gorm:"column:Conditions; not null;"`
+	// Webhooks notified about state transitions of the runs created by this
+	// job. Stores the serialized []NotificationSubscription.
+	Notifications string `gorm:"column:Notifications; size:65535;"`
+	// Labels are stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 }
 
 // Converts to v1beta1-compatible internal representation of job.
@@ -187,6 +192,8 @@ type Trigger struct {
 	CronSchedule
 	// Create workflows periodically.
 	PeriodicSchedule
+	// Create workflows when events occur. Stores the serialized EventTrigger.
+	Event string `gorm:"column:EventTrigger; size:65535;"`
 }
 
 func (j Job) GetValueOfPrimaryKey() string {
@@ -234,6 +241,12 @@ func (j *Job) GetField(name string) (string, bool) {
 	return "", false
 }
 
+// GetComputedField maps the filter key "labels.<name>" to the value of the
+// job's label with the given name.
+func (j *Job) GetComputedField(key string) (string, bool) {
+	return labelField(key, JobResourceType, "jobs.UUID")
+}
+
 func (j *Job) GetFieldValue(name string) interface{} {
 	switch name {
 	case "UUID":
@@ -0,0 +1,62 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Label is a user-defined key/value pair attached to a pipeline, an experiment,
+// a run or a recurring run.
+type Label struct {
+	ResourceUUID string       `gorm:"column:ResourceUUID; not null; primary_key;"`
+	ResourceType ResourceType `gorm:"column:ResourceType; not null; primary_key;"`
+	Name         string       `gorm:"column:Name; not null; primary_key;"`
+	Value        string       `gorm:"column:Value; not null;"`
+}
+
+// labelKeyPrefix is the prefix of the filtering keys that refer to a label,
+// e.g. "labels.team".
+const labelKeyPrefix = "labels."
+
+// labelField returns the SQL expression of the value of the label referred to
+// by the filter key "labels.<name>" for resources of the given type. The
+// expression is NULL for resources without the label.
+func labelField(key string, resourceType ResourceType, uuidColumn string) (string, bool) {
+	name, ok := LabelNameFromKey(key)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("(SELECT labels.Value FROM labels WHERE labels.ResourceUUID = %s AND labels.ResourceType = '%s' AND labels.Name = '%s')", uuidColumn, resourceType, name), true
+}
+
+// LabelNameFromKey returns the name of the label referred to by the filter key
+// "labels.<name>". Stores that do not use SQL, e.g. the Kubernetes pipeline
+// store, use it to look up the label instead.
+func LabelNameFromKey(key string) (string, bool) {
+	name, ok := strings.CutPrefix(key, labelKeyPrefix)
+	if !ok {
+		return "", false
+	}
+	// Valid label keys only contain alphanumerics, '-', '_', '.' and '/', so
+	// they are safe to quote in SQL.
+	if len(validation.IsQualifiedName(name)) > 0 {
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "encoding/json"
+
+// NotificationSubscription posts a CloudEvent to a webhook when a run enters
+// one of the subscribed states.
+type NotificationSubscription struct {
+	WebhookURL string         `json:"webhookUrl"`
+	States     []RuntimeState `json:"states,omitempty"`
+	// A run is considered stuck once it is PENDING for longer than this.
+	PendingTimeoutSeconds int64  `json:"pendingTimeoutSeconds,omitempty"`
+	SecretName            string `json:"secretName,omitempty"`
+	SecretKey             string `json:"secretKey,omitempty"`
+}
+
+// DefaultNotificationStates are the states notified when a subscription does
+// not list any.
+var DefaultNotificationStates = []RuntimeState{RuntimeStateSucceeded, RuntimeStateFailed, RuntimeStateCanceled}
+
+// DefaultNotificationPendingTimeoutSeconds is used when a subscription to
+// PENDING runs does not set a timeout.
+const DefaultNotificationPendingTimeoutSeconds = 600
+
+// IsSubscribed returns whether the subscription is notified about runs in the
+// given state.
+func (s *NotificationSubscription) IsSubscribed(state RuntimeState) bool {
+	states := s.States
+	if len(states) == 0 {
+		states = DefaultNotificationStates
+	}
+	for _, subscribed := range states {
+		if subscribed.ToV2() == state.ToV2() {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPendingTimeoutSeconds returns the time a run may stay PENDING before the
+// subscription is notified.
+func (s *NotificationSubscription) GetPendingTimeoutSeconds() int64 {
+	if s.PendingTimeoutSeconds <= 0 {
+		return DefaultNotificationPendingTimeoutSeconds
+	}
+	return s.PendingTimeoutSeconds
+}
+
+// GetNotifications returns the notification subscriptions of the experiment.
+func (e *Experiment) GetNotifications() ([]*NotificationSubscription, error) {
+	return unmarshalNotifications(e.Notifications)
+}
+
+// SetNotifications serializes the notification subscriptions of the
+// experiment. An empty list clears them.
+func (e *Experiment) SetNotifications(notifications []*NotificationSubscription) error {
+	serialized, err := marshalNotifications(notifications)
+	if err != nil {
+		return err
+	}
+	e.Notifications = serialized
+	return nil
+}
+
+// GetNotifications returns the notification subscriptions of the job.
+func (j *Job) GetNotifications() ([]*NotificationSubscription, error) {
+	return unmarshalNotifications(j.Notifications)
+}
+
+// SetNotifications serializes the notification subscriptions of the job. An
+// empty list clears them.
+func (j *Job) SetNotifications(notifications []*NotificationSubscription) error {
+	serialized, err := marshalNotifications(notifications)
+	if err != nil {
+		return err
+	}
+	j.Notifications = serialized
+	return nil
+}
+
+func unmarshalNotifications(serialized string) ([]*NotificationSubscription, error) {
+	if serialized == "" {
+		return nil, nil
+	}
+	var notifications []*NotificationSubscription
+	if err := json.Unmarshal([]byte(serialized), &notifications); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func marshalNotifications(notifications []*NotificationSubscription) (string, error) {
+	if len(notifications) == 0 {
+		return "", nil
+	}
+	serialized, err := json.Marshal(notifications)
+	if err != nil {
+		return "", err
+	}
+	return string(serialized), nil
+}
+
+// RunNotification records that a webhook is notified about a state of a run,
+// so that the webhook is notified once even by several API servers, and
+// notified again if the API server stops before the delivery finishes.
+type RunNotification struct {
+	RunUUID string `gorm:"column:RunUUID; not null; primary_key;"`
+	// The SHA-256 of the webhook URL, as a URL may exceed the size of a key.
+	WebhookHash string       `gorm:"column:WebhookHash; not null; primary_key;"`
+	State       RuntimeState `gorm:"column:State; not null; primary_key;"`
+	// When the last delivery started.
+	NotifiedAtInSec int64 `gorm:"column:NotifiedAtInSec; not null;"`
+	// When the delivery finished, whether the webhook accepted the
+	// notification or it was given up. Zero while the delivery is pending.
+	DeliveredAtInSec int64 `gorm:"column:DeliveredAtInSec; not null; default:0;"`
+}
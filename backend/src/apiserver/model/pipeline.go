@@ -42,6 +42,8 @@ type Pipeline struct {
 	// TODO(gkcalat): this is deprecated. Consider removing and adding data migration logic at the server startup.
 	DefaultVersionId string `gorm:"column:DefaultVersionId;"` // deprecated
 	Namespace        string `gorm:"column:Namespace; unique_index:namespace_name; size:63;"`
+	// Labels are stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 }
 
 func (p Pipeline) GetValueOfPrimaryKey() string {
@@ -90,6 +92,12 @@ func (p *Pipeline) GetField(name string) (string, bool) {
 	return "", false
 }
 
+// GetComputedField maps the filter key "labels.<name>" to the value of the
+// pipeline's label with the given name.
+func (p *Pipeline) GetComputedField(key string) (string, bool) {
+	return labelField(key, PipelineResourceType, "pipelines.UUID")
+}
+
 func (p *Pipeline) GetFieldValue(name string) interface{} {
 	switch name {
 	case "UUID":
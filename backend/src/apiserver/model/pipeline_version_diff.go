@@ -0,0 +1,42 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "google.golang.org/protobuf/types/known/structpb"
+
+type PipelineVersionChangeType string
+
+// The change types are ordered as they are listed in a diff.
+const (
+	PipelineVersionChangeTypeParameterAdded   PipelineVersionChangeType = "PARAMETER_ADDED"
+	PipelineVersionChangeTypeParameterRemoved PipelineVersionChangeType = "PARAMETER_REMOVED"
+	PipelineVersionChangeTypeParameterChanged PipelineVersionChangeType = "PARAMETER_CHANGED"
+	PipelineVersionChangeTypeTaskAdded        PipelineVersionChangeType = "TASK_ADDED"
+	PipelineVersionChangeTypeTaskRemoved      PipelineVersionChangeType = "TASK_REMOVED"
+	PipelineVersionChangeTypeTaskChanged      PipelineVersionChangeType = "TASK_CHANGED"
+	PipelineVersionChangeTypeImageChanged     PipelineVersionChangeType = "IMAGE_CHANGED"
+	PipelineVersionChangeTypePlatformChanged  PipelineVersionChangeType = "PLATFORM_CHANGED"
+)
+
+// PipelineVersionChange is a difference between the templates of two pipeline
+// versions. The base value is nil for additions and the target value is nil
+// for removals.
+type PipelineVersionChange struct {
+	Type        PipelineVersionChangeType
+	TaskName    string
+	Key         string
+	BaseValue   *structpb.Value
+	TargetValue *structpb.Value
+}
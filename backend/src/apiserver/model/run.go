@@ -15,6 +15,8 @@
 package model
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -217,6 +219,8 @@ type Run struct {
 	StorageState   StorageState `gorm:"column:StorageState; not null;"`
 	ServiceAccount string       `gorm:"column:ServiceAccount; not null;"`
 	Metrics        []*RunMetric
+	// Labels are stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 
 	// ResourceReferences are deprecated. Use Namespace, ExperimentId,
 	// RecurringRunId, PipelineSpec.PipelineId, PipelineSpec.PipelineVersionId
@@ -327,6 +331,15 @@ type RunMetric struct {
 	Payload     string  `gorm:"column:Payload; not null; size:65535;"`
 }
 
+// RunMetricNamePattern is the pattern the names of run metrics must match.
+const RunMetricNamePattern = "^[a-zA-Z]([-_a-zA-Z0-9]{0,62}[a-zA-Z0-9])?$"
+
+// runMetricKeyPrefix is the prefix of the sorting and filtering keys that
+// refer to a run metric, e.g. "metric:accuracy".
+const runMetricKeyPrefix = "metric:"
+
+var runMetricNameRegexp = regexp.MustCompile(RunMetricNamePattern)
+
 type RuntimeStatus struct {
 	UpdateTimeInSec int64        `json:"UpdateTimeInSec,omitempty"`
 	State           RuntimeState `json:"State,omitempty"`
@@ -386,12 +399,36 @@ func (r *Run) GetField(name string) (string, bool) {
 	if field, ok := runAPIToModelFieldMap[name]; ok {
 		return field, true
 	}
-	if strings.HasPrefix(name, "metric:") {
-		return name[7:], true
+	if strings.HasPrefix(name, runMetricKeyPrefix) {
+		metricName := strings.TrimPrefix(name, runMetricKeyPrefix)
+		// The metric name is used as a column alias when sorting, so it
+		// cannot contain hyphens.
+		if runMetricNameRegexp.MatchString(metricName) && !strings.Contains(metricName, "-") {
+			return metricName, true
+		}
 	}
 	return "", false
 }
 
+// GetComputedField maps the filter key "metric:<name>" to the value of the run
+// metric with the given name, and the filter key "labels.<name>" to the value
+// of the run's label with the given name. If several tasks of a run reported
+// the metric, the largest value is used.
+func (r *Run) GetComputedField(key string) (string, bool) {
+	if field, ok := labelField(key, RunResourceType, "run_details.UUID"); ok {
+		return field, true
+	}
+	if !strings.HasPrefix(key, runMetricKeyPrefix) {
+		return "", false
+	}
+	metricName := strings.TrimPrefix(key, runMetricKeyPrefix)
+	// The pattern guarantees that the name is safe to quote in SQL.
+	if !runMetricNameRegexp.MatchString(metricName) {
+		return "", false
+	}
+	return fmt.Sprintf("(SELECT MAX(run_metrics.NumberValue) FROM run_metrics WHERE run_metrics.RunUUID = run_details.UUID AND run_metrics.Name = '%s')", metricName), true
+}
+
 func (r *Run) GetFieldValue(name string) interface{} {
 	// "name" could be a field in Run type or a name inside an array typed field
 	// in Run type
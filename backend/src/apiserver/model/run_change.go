@@ -0,0 +1,32 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// RunChange records a change of a run, or of one of its tasks, so that all
+// API server instances can send it to the run watchers.
+type RunChange struct {
+	// Orders the changes. Used as the resource version of the watches.
+	Sequence int64  `gorm:"column:Sequence; primary_key; AUTO_INCREMENT;"`
+	Type     string `gorm:"column:Type; not null;"`
+	RunUUID  string `gorm:"column:RunUUID; not null;"`
+	// Empty for the changes of runs.
+	TaskUUID       string `gorm:"column:TaskUUID; not null;"`
+	Namespace      string `gorm:"column:Namespace; not null;"`
+	ExperimentUUID string `gorm:"column:ExperimentUUID; not null;"`
+	// The state of the run after the change, and before it. Both are the
+	// state of the run for the changes of its tasks.
+	State         RuntimeState `gorm:"column:State; not null;"`
+	PreviousState RuntimeState `gorm:"column:PreviousState; not null;"`
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "google.golang.org/protobuf/types/known/structpb"
+
+type RunComparisonRowKind string
+
+// The row kinds are ordered as they are listed in a comparison.
+const (
+	RunComparisonRowKindParameter    RunComparisonRowKind = "PARAMETER"
+	RunComparisonRowKindTaskState    RunComparisonRowKind = "TASK_STATE"
+	RunComparisonRowKindTaskDuration RunComparisonRowKind = "TASK_DURATION"
+	RunComparisonRowKindMetric       RunComparisonRowKind = "METRIC"
+	RunComparisonRowKindArtifact     RunComparisonRowKind = "ARTIFACT"
+)
+
+// RunComparison is a table of runs, with one column per run.
+type RunComparison struct {
+	RunIds []string
+	Rows   []*RunComparisonRow
+}
+
+// RunComparisonRow holds the values of a parameter, task or metric in the
+// compared runs. Values are aligned with the run IDs of the comparison, and
+// nil if the run has no such parameter, task or metric.
+type RunComparisonRow struct {
+	Kind     RunComparisonRowKind
+	TaskName string
+	Key      string
+	Values   []*structpb.Value
+	Differs  bool
+}
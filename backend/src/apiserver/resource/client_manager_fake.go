@@ -21,6 +21,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 )
 
 type FakeClientManager struct {
@@ -43,6 +44,7 @@ type FakeClientManager struct {
 	time                          util.TimeInterface
 	uuid                          util.UUIDGeneratorInterface
 	AuthenticatorsFake            []auth.Authenticator
+	MetadataClientFake            metadata.ClientInterface
 }
 
 func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterface) (
@@ -83,6 +85,7 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		time:                          time,
 		uuid:                          uuid,
 		AuthenticatorsFake:            auth.GetAuthenticators(client.NewFakeTokenReviewClient()),
+		MetadataClientFake:            metadata.NewFakeClient(),
 	}, nil
 }
 
@@ -185,6 +188,10 @@ func (f *FakeClientManager) Authenticators() []auth.Authenticator {
 	return f.AuthenticatorsFake
 }
 
+func (f *FakeClientManager) MetadataClient() metadata.ClientInterface {
+	return f.MetadataClientFake
+}
+
 func (f *FakeClientManager) Close() error {
 	return f.db.Close()
 }
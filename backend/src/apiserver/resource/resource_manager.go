@@ -16,32 +16,46 @@ package resource
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
 	"net"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
 	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	exec "github.com/kubeflow/pipelines/backend/src/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	swfutil "github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	scheduledworkflowclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/config"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -50,6 +64,13 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
+// The number of times a change of a ScheduledWorkflow is attempted when it
+// conflicts with concurrent changes.
+const maxScheduledWorkflowUpdateAttempts = 5
+
+// The default maximum number of runs a backfill may request.
+const defaultBackfillMaxRuns = 1000
+
 // Metric variables. Please prefix the metric names with resource_manager_.
 var (
 	extraLabels = []string{
@@ -98,6 +119,7 @@ type ClientManagerInterface interface {
 	Time() util.TimeInterface
 	UUID() util.UUIDGeneratorInterface
 	Authenticators() []kfpauth.Authenticator
+	MetadataClient() metadata.ClientInterface
 }
 
 type ResourceManagerOptions struct {
@@ -105,6 +127,76 @@ type ResourceManagerOptions struct {
 	CacheDisabled        bool                              `json:"cache_disabled,omitempty"`
 	DefaultWorkspace     *corev1.PersistentVolumeClaimSpec `json:"default_workspace,omitempty"`
 	DefaultWorkspaceSize string                            `json:"default_workspace_size,omitempty"`
+	RunRetentionPolicies []*RunRetentionPolicy             `json:"run_retention_policies,omitempty"`
+	// The base URL of the UI linked to in run notifications.
+	RunNotificationUIBaseURL string `json:"run_notification_ui_base_url,omitempty"`
+	// The time after which a failing run notification is given up.
+	RunNotificationRetryTimeout time.Duration `json:"run_notification_retry_timeout,omitempty"`
+	// The hosts run notification webhooks may have, or subdomains of a host if
+	// prefixed with "*.". Any host is allowed if empty. The allowed hosts may
+	// be reached in the denied networks.
+	RunNotificationAllowedHosts []string `json:"run_notification_allowed_hosts,omitempty"`
+	// The CIDRs of the networks run notification webhooks may not be reached
+	// at. Defaults to DefaultRunNotificationDeniedNetworks if nil.
+	RunNotificationDeniedNetworks []string `json:"run_notification_denied_networks,omitempty"`
+	// The number of recent run changes kept for watches to resume from.
+	RunWatchHistorySize int `json:"run_watch_history_size,omitempty"`
+	// The file:// pipeline roots whose artifacts ReadArtifact serves, besides
+	// the default pipeline root if it is a file:// root. Each must be mounted
+	// into the API server at the same path as into the pipeline pods.
+	FilePipelineRoots []string `json:"file_pipeline_roots,omitempty"`
+	// The maximum number of runs a backfill may request. It cannot exceed
+	// the number of runs the ScheduledWorkflow controller creates for a
+	// backfill.
+	BackfillMaxRuns int `json:"backfill_max_runs,omitempty"`
+}
+
+// RunRetentionPolicy archives or deletes the finished runs of a namespace or
+// an experiment. Runs that have not finished are left untouched.
+type RunRetentionPolicy struct {
+	// The namespace of the runs. Cannot be combined with an experiment ID. The
+	// policy applies to all runs if neither is set.
+	Namespace string
+	// The ID of the parent experiment of the runs.
+	ExperimentId string
+	// Deletes the runs created longer ago than the duration.
+	MaxAge time.Duration
+	// Deletes the finished runs beyond the given number of most recently
+	// created finished runs.
+	MaxRuns int
+	// Archives the runs created longer ago than the duration.
+	ArchiveAfter time.Duration
+	// Whether to delete the artifacts of the deleted runs from their pipeline
+	// root. Only pipeline roots with a minio:// or s3:// scheme in the bucket of
+	// the object store of the API server are supported. A run with another
+	// pipeline root, e.g. gs:// or file://, fails to be purged and is kept.
+	PurgeArtifacts bool
+}
+
+// Validate checks that the policy has a valid scope and at least one rule.
+func (p *RunRetentionPolicy) Validate() error {
+	if p.Namespace != "" && p.ExperimentId != "" {
+		return util.NewInvalidInputError("A run retention policy cannot have both a namespace and an experiment id")
+	}
+	if p.MaxAge < 0 || p.MaxRuns < 0 || p.ArchiveAfter < 0 {
+		return util.NewInvalidInputError("A run retention policy cannot have a negative max age, max runs or archive after")
+	}
+	if p.MaxAge == 0 && p.MaxRuns == 0 && p.ArchiveAfter == 0 {
+		return util.NewInvalidInputError("A run retention policy must set a max age, max runs or archive after")
+	}
+	return nil
+}
+
+// Describes the runs the policy applies to.
+func (p *RunRetentionPolicy) scope() string {
+	switch {
+	case p.ExperimentId != "":
+		return fmt.Sprintf("experiment %v", p.ExperimentId)
+	case p.Namespace != "":
+		return fmt.Sprintf("namespace %v", p.Namespace)
+	default:
+		return "all runs"
+	}
 }
 
 type ResourceManager struct {
@@ -126,6 +218,9 @@ type ResourceManager struct {
 	time                      util.TimeInterface
 	uuid                      util.UUIDGeneratorInterface
 	authenticators            []kfpauth.Authenticator
+	metadataClient            metadata.ClientInterface
+	notifier                  *runNotifier
+	runWatchHub               *runWatchHub
 	options                   *ResourceManagerOptions
 }
 
@@ -149,6 +244,9 @@ func NewResourceManager(clientManager ClientManagerInterface, options *ResourceM
 		time:                      clientManager.Time(),
 		uuid:                      clientManager.UUID(),
 		authenticators:            clientManager.Authenticators(),
+		metadataClient:            clientManager.MetadataClient(),
+		notifier:                  newRunNotifier(clientManager.KubernetesCoreClient(), clientManager.Time(), options),
+		runWatchHub:               newRunWatchHub(clientManager.RunStore(), clientManager.TaskStore(), clientManager.Time(), options),
 		options:                   options,
 	}
 }
@@ -168,6 +266,9 @@ func (r *ResourceManager) CreateExperiment(experiment *model.Experiment) (*model
 			return nil, util.NewInvalidInputError("Namespace cannot be empty")
 		}
 	}
+	if err := r.checkExperimentNotifications(experiment); err != nil {
+		return nil, util.Wrap(err, "Failed to create experiment")
+	}
 	return r.experimentStore.CreateExperiment(experiment)
 }
 
@@ -176,6 +277,39 @@ func (r *ResourceManager) GetExperiment(experimentId string) (*model.Experiment,
 	return r.experimentStore.GetExperiment(experimentId)
 }
 
+// Returns an experiment specified by name and namespace.
+func (r *ResourceManager) GetExperimentByNameAndNamespace(name string, namespace string) (*model.Experiment, error) {
+	if experiment, err := r.experimentStore.GetExperimentByNameNamespace(name, namespace); err != nil {
+		return nil, util.Wrapf(err, "Failed to get an experiment named %v in namespace %v", name, namespace)
+	} else {
+		return experiment, nil
+	}
+}
+
+// Updates the description and the labels of an experiment.
+func (r *ResourceManager) UpdateExperiment(experiment *model.Experiment) (*model.Experiment, error) {
+	if _, err := r.experimentStore.GetExperiment(experiment.UUID); err != nil {
+		return nil, util.Wrapf(err, "Failed to update experiment %v due to error fetching it", experiment.UUID)
+	}
+	if err := r.checkExperimentNotifications(experiment); err != nil {
+		return nil, util.Wrapf(err, "Failed to update experiment %v", experiment.UUID)
+	}
+	if err := r.experimentStore.UpdateExperiment(experiment); err != nil {
+		return nil, util.Wrapf(err, "Failed to update experiment %v", experiment.UUID)
+	}
+	return r.experimentStore.GetExperiment(experiment.UUID)
+}
+
+// Checks that the webhooks notified about the runs of an experiment may be
+// notified.
+func (r *ResourceManager) checkExperimentNotifications(experiment *model.Experiment) error {
+	subscriptions, err := experiment.GetNotifications()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to parse the notifications of experiment %v", experiment.UUID)
+	}
+	return r.notifier.checkWebhooks(subscriptions)
+}
+
 // Fetches experiments with the given filtering and listing options.
 func (r *ResourceManager) ListExperiments(filterContext *model.FilterContext, opts *list.Options) ([]*model.Experiment, int, string, error) {
 	return r.experimentStore.ListExperiments(filterContext, opts)
@@ -204,6 +338,14 @@ func (r *ResourceManager) ArchiveExperiment(ctx context.Context, experimentId st
 	// (2.1) archive experiments
 	// (2.2) archive runs
 	// (2.3) disable jobs
+	if err := r.disableExperimentJobs(ctx, experimentId); err != nil {
+		return err
+	}
+	return r.experimentStore.ArchiveExperiment(experimentId)
+}
+
+// Disables the ScheduledWorkflows of the jobs of an experiment.
+func (r *ResourceManager) disableExperimentJobs(ctx context.Context, experimentId string) error {
 	opts, err := list.NewOptions(&model.Job{}, 50, "name", nil)
 	if err != nil {
 		return util.NewInternalServerError(err,
@@ -242,7 +384,7 @@ func (r *ResourceManager) ArchiveExperiment(ctx context.Context, experimentId st
 			}
 		}
 	}
-	return r.experimentStore.ArchiveExperiment(experimentId)
+	return nil
 }
 
 // Un-archives the experiment with the given id.
@@ -250,6 +392,90 @@ func (r *ResourceManager) UnarchiveExperiment(experimentId string) error {
 	return r.experimentStore.UnarchiveExperiment(experimentId)
 }
 
+// Archives experiments in batches. The jobs of each experiment are disabled,
+// and each batch is archived in a single transaction. Returns the errors of the
+// experiments, in the order of the experiment IDs.
+func (r *ResourceManager) ArchiveExperiments(ctx context.Context, experimentIds []string) []error {
+	errs := make([]error, len(experimentIds))
+	forEachBatch(experimentIds, errs, func(batch []string, batchErrs []error) {
+		var valid []int
+		for i, experimentId := range batch {
+			if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+				batchErrs[i] = util.Wrapf(err, "Failed to archive experiment %v due to error fetching it", experimentId)
+				continue
+			}
+			if err := r.disableExperimentJobs(ctx, experimentId); err != nil {
+				batchErrs[i] = err
+				continue
+			}
+			valid = append(valid, i)
+		}
+		if err := r.experimentStore.ArchiveExperiments(selectIds(batch, valid)); err != nil {
+			for _, i := range valid {
+				batchErrs[i] = util.Wrapf(err, "Failed to archive experiment %v", batch[i])
+			}
+		}
+	})
+	return errs
+}
+
+// Un-archives experiments in batches. Each batch is un-archived in a single
+// transaction. Returns the errors of the experiments, in the order of the
+// experiment IDs.
+func (r *ResourceManager) UnarchiveExperiments(experimentIds []string) []error {
+	errs := make([]error, len(experimentIds))
+	forEachBatch(experimentIds, errs, func(batch []string, batchErrs []error) {
+		var found []int
+		for i, experimentId := range batch {
+			if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+				batchErrs[i] = util.Wrapf(err, "Failed to unarchive experiment %v due to error fetching it", experimentId)
+				continue
+			}
+			found = append(found, i)
+		}
+		if err := r.experimentStore.UnarchiveExperiments(selectIds(batch, found)); err != nil {
+			for _, i := range found {
+				batchErrs[i] = util.Wrapf(err, "Failed to unarchive experiment %v", batch[i])
+			}
+		}
+	})
+	return errs
+}
+
+// Deletes experiments in batches. Each batch is deleted in a single
+// transaction. Returns the errors of the experiments, in the order of the
+// experiment IDs.
+func (r *ResourceManager) DeleteExperiments(experimentIds []string) []error {
+	errs := make([]error, len(experimentIds))
+	defaultExperimentId, err := r.GetDefaultExperimentId()
+	if err != nil {
+		for i, experimentId := range experimentIds {
+			errs[i] = util.Wrapf(err, "Failed to delete experiment %v due to error fetching the default experiment id", experimentId)
+		}
+		return errs
+	}
+	forEachBatch(experimentIds, errs, func(batch []string, batchErrs []error) {
+		var found []int
+		for i, experimentId := range batch {
+			if defaultExperimentId != "" && experimentId == defaultExperimentId {
+				batchErrs[i] = util.NewBadRequestError(util.NewInvalidInputError("Experiment id cannot be equal to the default id %v", defaultExperimentId), "Failed to delete experiment %v. The default experiment cannot be deleted", experimentId)
+				continue
+			}
+			if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+				batchErrs[i] = util.Wrapf(err, "Failed to delete experiment %v due to error fetching it", experimentId)
+				continue
+			}
+			found = append(found, i)
+		}
+		if err := r.experimentStore.DeleteExperiments(selectIds(batch, found)); err != nil {
+			for _, i := range found {
+				batchErrs[i] = util.Wrapf(err, "Failed to delete experiment %v", batch[i])
+			}
+		}
+	})
+	return errs
+}
+
 // Returns a list of pipelines.
 func (r *ResourceManager) ListPipelines(filterContext *model.FilterContext, opts *list.Options) ([]*model.Pipeline, int, string, error) {
 	pipelines, total_size, nextPageToken, err := r.pipelineStore.ListPipelines(filterContext, opts)
@@ -279,6 +505,17 @@ func (r *ResourceManager) GetPipeline(pipelineId string) (*model.Pipeline, error
 	}
 }
 
+// Updates the description and the labels of a pipeline.
+func (r *ResourceManager) UpdatePipeline(pipeline *model.Pipeline) (*model.Pipeline, error) {
+	if _, err := r.GetPipeline(pipeline.UUID); err != nil {
+		return nil, util.Wrapf(err, "Failed to update pipeline %v due to error fetching it", pipeline.UUID)
+	}
+	if err := r.pipelineStore.UpdatePipeline(pipeline); err != nil {
+		return nil, util.Wrapf(err, "Failed to update pipeline %v", pipeline.UUID)
+	}
+	return r.GetPipeline(pipeline.UUID)
+}
+
 // Returns a pipeline specified by name and namespace.
 func (r *ResourceManager) GetPipelineByNameAndNamespace(name string, namespace string) (*model.Pipeline, error) {
 	if pipeline, err := r.pipelineStore.GetPipelineByNameAndNamespace(name, namespace); err != nil {
@@ -535,6 +772,22 @@ func (r *ResourceManager) CreateRun(ctx context.Context, run *model.Run) (*model
 		run.UUID = uuid.String()
 	}
 	run.RunDetails.CreatedAtInSec = r.time.Now().Unix()
+
+	// Runs created by a recurring run are owned by its scheduledworkflow and
+	// inherit its labels.
+	var swf *scheduledworkflow.ScheduledWorkflow
+	if run.RecurringRunId != "" {
+		job, err := r.jobStore.GetJob(run.RecurringRunId)
+		if err != nil {
+			return nil, util.NewInternalServerError(util.NewInvalidInputError("RecurringRunId doesn't exist: %s", run.RecurringRunId), "Failed to create a run due to invalid recurring run id")
+		}
+		swf, err = r.swfClient.ScheduledWorkflow(job.Namespace).Get(ctx, job.K8SName, v1.GetOptions{})
+		if err != nil {
+			return nil, util.NewInternalServerError(util.NewInvalidInputError("ScheduledWorkflow doesn't exist: %s", job.K8SName), "Failed to create a run due to invalid name")
+		}
+		run.Labels = mergeLabels(job.Labels, run.Labels)
+	}
+
 	runWorkflowOptions := template.RunWorkflowOptions{
 		RunId:            run.UUID,
 		RunAt:            run.CreatedAtInSec,
@@ -560,15 +813,7 @@ func (r *ResourceManager) CreateRun(ctx context.Context, run *model.Run) (*model
 	executionSpec.SetExecutionNamespace(k8sNamespace)
 
 	// assign OwnerReference to scheduledworkflow
-	if run.RecurringRunId != "" {
-		job, err := r.jobStore.GetJob(run.RecurringRunId)
-		if err != nil {
-			return nil, util.NewInternalServerError(util.NewInvalidInputError("RecurringRunId doesn't exist: %s", run.RecurringRunId), "Failed to create a run due to invalid recurring run id")
-		}
-		swf, err := r.swfClient.ScheduledWorkflow(job.Namespace).Get(ctx, job.K8SName, v1.GetOptions{})
-		if err != nil {
-			return nil, util.NewInternalServerError(util.NewInvalidInputError("ScheduledWorkflow doesn't exist: %s", job.K8SName), "Failed to create a run due to invalid name")
-		}
+	if swf != nil {
 		executionSpec.SetOwnerReferences(swf)
 	}
 
@@ -611,6 +856,7 @@ func (r *ResourceManager) CreateRun(ctx context.Context, run *model.Run) (*model
 	if err != nil {
 		return nil, util.Wrap(err, fmt.Sprintf("Failed to set last run timestamp on experiment %s for run %s", newRun.ExperimentId, newRun.UUID))
 	}
+	r.runWatchHub.publish(RunEventCreated, newRun, nil, newRun.State)
 
 	return newRun, nil
 }
@@ -661,6 +907,8 @@ func (r *ResourceManager) ReconcileSwfCrs(ctx context.Context) error {
 				return failedToReconcileSwfCrsError(err)
 			}
 
+			// The backfill is not stored with the recurring run, so keep the one of the ScheduledWorkflow.
+			newScheduledWorkflow.Spec.Backfill = currentScheduledWorkflow.Spec.Backfill
 			if !reflect.DeepEqual(currentScheduledWorkflow.Spec, newScheduledWorkflow.Spec) {
 				currentScheduledWorkflow.Spec = newScheduledWorkflow.Spec
 				err = r.updateSwfCrSpec(ctx, jobs[i].Namespace, currentScheduledWorkflow)
@@ -680,6 +928,374 @@ func (r *ResourceManager) ReconcileSwfCrs(ctx context.Context) error {
 	return nil
 }
 
+// EnforceRunRetentionPolicies archives and deletes the finished runs selected
+// by the run retention policies. A run that fails to be archived or deleted
+// does not stop the others from being processed.
+func (r *ResourceManager) EnforceRunRetentionPolicies(ctx context.Context) error {
+	var errs []error
+	for _, policy := range r.options.RunRetentionPolicies {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		if err := r.enforceRunRetentionPolicy(ctx, policy); err != nil {
+			errs = append(errs, util.Wrapf(err, "Failed to enforce the run retention policy of %v", policy.scope()))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *ResourceManager) enforceRunRetentionPolicy(ctx context.Context, policy *RunRetentionPolicy) error {
+	filterContext := &model.FilterContext{}
+	if policy.ExperimentId != "" {
+		filterContext.ReferenceKey = &model.ReferenceKey{Type: model.ExperimentResourceType, ID: policy.ExperimentId}
+	} else if policy.Namespace != "" {
+		filterContext.ReferenceKey = &model.ReferenceKey{Type: model.NamespaceResourceType, ID: policy.Namespace}
+	}
+	now := r.time.Now()
+	// The runs are listed from the most recent one. The page tokens hold the
+	// created_at and the id of the next run, so the runs deleted with a page do
+	// not shift the following pages.
+	opts, err := list.NewOptions(&model.Run{}, runRetentionPageSize, "created_at desc", nil)
+	if err != nil {
+		return util.Wrap(err, "Failed to create list options")
+	}
+	position := 0
+	var errs []error
+	for {
+		runs, _, nextPageToken, err := r.ListRuns(filterContext, opts)
+		if err != nil {
+			return err
+		}
+		var deleteIds []string
+		for _, run := range runs {
+			select {
+			case <-ctx.Done():
+				return utilerrors.NewAggregate(errs)
+			default:
+			}
+			if !isRunFinished(run) {
+				continue
+			}
+			position++
+			age := now.Sub(time.Unix(run.CreatedAtInSec, 0))
+			switch {
+			case policy.MaxRuns > 0 && position > policy.MaxRuns, policy.MaxAge > 0 && age > policy.MaxAge:
+				if policy.PurgeArtifacts {
+					if err := r.purgeRunArtifacts(ctx, run); err != nil {
+						// The run is kept so that purging is retried next time.
+						errs = append(errs, err)
+						continue
+					}
+				}
+				deleteIds = append(deleteIds, run.UUID)
+			case policy.ArchiveAfter > 0 && age > policy.ArchiveAfter && run.StorageState != model.StorageStateArchived:
+				if err := r.ArchiveRun(run.UUID); err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				glog.Infof("Archived run %v of %v as per its retention policy", run.UUID, policy.scope())
+			}
+		}
+		for i, err := range r.DeleteRuns(ctx, deleteIds) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			glog.Infof("Deleted run %v of %v as per its retention policy", deleteIds[i], policy.scope())
+		}
+		if nextPageToken == "" {
+			return utilerrors.NewAggregate(errs)
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, runRetentionPageSize); err != nil {
+			return util.Wrap(err, "Failed to create list options from the page token")
+		}
+	}
+}
+
+// Notifies the webhooks subscribed by the experiment or the recurring run of a
+// run about the run entering a final state. Failures are logged rather than
+// returned, so that they do not fail the reporting of the run.
+func (r *ResourceManager) notifyRunStateChange(run *model.Run, previousState model.RuntimeState) {
+	state := run.State.ToV2()
+	if state == previousState.ToV2() {
+		return
+	}
+	switch state {
+	case model.RuntimeStateSucceeded, model.RuntimeStateFailed, model.RuntimeStateCanceled:
+	default:
+		return
+	}
+	subscriptions, err := r.getNotificationSubscriptions(run)
+	if err != nil {
+		glog.Errorf("Failed to notify about state %v of run %v: %v", state, run.UUID, err)
+		return
+	}
+	for _, subscription := range subscriptions {
+		if !subscription.IsSubscribed(state) {
+			continue
+		}
+		if err := r.sendRunNotification(subscription, run, state); err != nil {
+			glog.Errorf("Failed to notify %v about state %v of run %v: %v", subscription.WebhookURL, state, run.UUID, err)
+		}
+	}
+}
+
+// Records that a webhook is notified about a state of a run, then notifies it.
+// Recording the notification first makes sure that a webhook is notified
+// once, even by several API servers, and notified again by
+// RedeliverRunNotifications if the delivery does not finish.
+func (r *ResourceManager) sendRunNotification(subscription *model.NotificationSubscription, run *model.Run, state model.RuntimeState) error {
+	notification := &model.RunNotification{
+		RunUUID:         run.UUID,
+		WebhookHash:     webhookHash(subscription.WebhookURL),
+		State:           state,
+		NotifiedAtInSec: r.time.Now().Unix(),
+	}
+	err := r.runStore.CreateRunNotification(notification)
+	if util.IsUserErrorCodeMatch(err, codes.AlreadyExists) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	r.deliverRunNotification(subscription, run, notification)
+	return nil
+}
+
+// Notifies a webhook about a state of a run in the background, and records
+// when the delivery finishes.
+func (r *ResourceManager) deliverRunNotification(subscription *model.NotificationSubscription, run *model.Run, notification *model.RunNotification) {
+	r.notifier.notify(subscription, run, notification.State, func() {
+		if err := r.runStore.MarkRunNotificationDelivered(notification, r.time.Now().Unix()); err != nil {
+			glog.Errorf("Failed to record the notification of %v about state %v of run %v: %v", subscription.WebhookURL, notification.State, run.UUID, err)
+		}
+	})
+}
+
+// Notifies the webhooks again about the states of runs whose delivery did not
+// finish, e.g. because the API server stopped. A delivery is sent again once
+// it could no longer be in flight. The records of the notifications about
+// final states the runs left, e.g. as they were retried, or of deleted runs
+// are removed.
+func (r *ResourceManager) RedeliverRunNotifications(ctx context.Context) error {
+	now := r.time.Now()
+	notifications, err := r.runStore.ListUndeliveredRunNotifications(now.Add(-r.notifier.retryTimeout).Unix())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, notification := range notifications {
+		select {
+		case <-ctx.Done():
+			return utilerrors.NewAggregate(errs)
+		default:
+		}
+		err := r.runStore.ClaimRunNotification(notification, now.Unix())
+		if util.IsUserErrorCodeMatch(err, codes.AlreadyExists) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		subscription, run, err := r.getRunNotificationSubscription(notification)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if subscription == nil {
+			// There is nothing to notify anymore.
+			if err := r.runStore.MarkRunNotificationDelivered(notification, now.Unix()); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		r.deliverRunNotification(subscription, run, notification)
+	}
+	for _, state := range []model.RuntimeState{model.RuntimeStateSucceeded, model.RuntimeStateFailed, model.RuntimeStateCanceled} {
+		if err := r.runStore.DeleteStaleRunNotifications(state); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// Returns the subscription and the run of a notification. The subscription is
+// nil if the run was deleted, or the webhook is no longer subscribed.
+func (r *ResourceManager) getRunNotificationSubscription(notification *model.RunNotification) (*model.NotificationSubscription, *model.Run, error) {
+	run, err := r.runStore.GetRun(notification.RunUUID)
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	subscriptions, err := r.getNotificationSubscriptions(run)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, subscription := range subscriptions {
+		if webhookHash(subscription.WebhookURL) == notification.WebhookHash && subscription.IsSubscribed(notification.State) {
+			return subscription, run, nil
+		}
+	}
+	return nil, run, nil
+}
+
+// Returns the SHA-256 of a webhook URL, which notifications are recorded by.
+func webhookHash(webhookURL string) string {
+	hash := sha256.Sum256([]byte(webhookURL))
+	return hex.EncodeToString(hash[:])
+}
+
+// Notifies the webhooks subscribed to PENDING runs about the runs that have
+// been pending for longer than the timeout of the subscription. A webhook is
+// notified once about each run.
+func (r *ResourceManager) NotifyPendingRuns(ctx context.Context) error {
+	pendingFilter, err := filter.New(&apiv2beta1.Filter{
+		Predicates: []*apiv2beta1.Predicate{{
+			Key:       "state",
+			Operation: apiv2beta1.Predicate_EQUALS,
+			Value:     &apiv2beta1.Predicate_StringValue{StringValue: string(model.RuntimeStatePending)},
+		}},
+	})
+	if err != nil {
+		return util.Wrap(err, "Failed to create a filter of pending runs")
+	}
+	opts, err := list.NewOptions(&model.Run{}, runRetentionPageSize, "created_at", pendingFilter)
+	if err != nil {
+		return util.Wrap(err, "Failed to create list options")
+	}
+	now := r.time.Now()
+	var errs []error
+	for {
+		runs, _, nextPageToken, err := r.ListRuns(&model.FilterContext{}, opts)
+		if err != nil {
+			return err
+		}
+		for _, run := range runs {
+			select {
+			case <-ctx.Done():
+				return utilerrors.NewAggregate(errs)
+			default:
+			}
+			subscriptions, err := r.getNotificationSubscriptions(run)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			pendingFor := now.Sub(time.Unix(run.CreatedAtInSec, 0))
+			for _, subscription := range subscriptions {
+				if !subscription.IsSubscribed(model.RuntimeStatePending) || pendingFor < time.Duration(subscription.GetPendingTimeoutSeconds())*time.Second {
+					continue
+				}
+				if err := r.sendRunNotification(subscription, run, model.RuntimeStatePending); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, runRetentionPageSize); err != nil {
+			return util.Wrap(err, "Failed to create list options from the page token")
+		}
+	}
+	// A run that is pending again later is notified again.
+	if err := r.runStore.DeleteStaleRunNotifications(model.RuntimeStatePending); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// Stops sending run notifications. Waits for the notifications in flight for
+// up to the given timeout, then cancels them.
+func (r *ResourceManager) StopNotifications(timeout time.Duration) {
+	r.notifier.stop(timeout)
+}
+
+// Returns the notification subscriptions of the experiment and the recurring
+// run of a run.
+func (r *ResourceManager) getNotificationSubscriptions(run *model.Run) ([]*model.NotificationSubscription, error) {
+	var subscriptions []*model.NotificationSubscription
+	if run.ExperimentId != "" {
+		experiment, err := r.experimentStore.GetExperiment(run.ExperimentId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to fetch the notifications of experiment %v", run.ExperimentId)
+		}
+		experimentSubscriptions, err := experiment.GetNotifications()
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse the notifications of experiment %v", run.ExperimentId)
+		}
+		subscriptions = append(subscriptions, experimentSubscriptions...)
+	}
+	if run.RecurringRunId != "" {
+		job, err := r.jobStore.GetJob(run.RecurringRunId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to fetch the notifications of recurring run %v", run.RecurringRunId)
+		}
+		jobSubscriptions, err := job.GetNotifications()
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse the notifications of recurring run %v", run.RecurringRunId)
+		}
+		subscriptions = append(subscriptions, jobSubscriptions...)
+	}
+	return subscriptions, nil
+}
+
+// Deletes the artifacts that a v2 run wrote under its pipeline root. Only the
+// artifacts of minio:// and s3:// pipeline roots in the bucket of the object
+// store can be deleted, other pipeline roots return an invalid input error.
+func (r *ResourceManager) purgeRunArtifacts(ctx context.Context, run *model.Run) error {
+	if run.PipelineSpec.PipelineSpecManifest == "" {
+		// The artifacts of v1 runs are managed by Argo.
+		return nil
+	}
+	tmpl, err := template.New([]byte(run.PipelineSpec.PipelineSpecManifest), true, nil)
+	if err != nil {
+		return util.Wrapf(err, "Failed to purge the artifacts of run %v due to an invalid pipeline spec", run.UUID)
+	}
+	if !tmpl.IsV2() {
+		return nil
+	}
+	pipelineRoot, err := r.getRunPipelineRoot(ctx, run)
+	if err != nil {
+		return util.Wrapf(err, "Failed to purge the artifacts of run %v", run.UUID)
+	}
+	runRoot := metadata.GenerateOutputURI(pipelineRoot, []string{tmpl.V2PipelineName(), run.UUID}, false)
+	bucketConfig, err := objectstore.ParseBucketPathToConfig(runRoot)
+	if err != nil {
+		return util.NewInvalidInputError("Failed to purge the artifacts of run %v: %v", run.UUID, err)
+	}
+	if (bucketConfig.Scheme != "minio://" && bucketConfig.Scheme != "s3://") || bucketConfig.BucketName != r.objectStore.GetBucketName() {
+		return util.NewInvalidInputError("Failed to purge the artifacts of run %v as its pipeline root %v is not in bucket %v", run.UUID, pipelineRoot, r.objectStore.GetBucketName())
+	}
+	if err := r.objectStore.DeleteFolder(ctx, bucketConfig.Prefix); err != nil {
+		return util.Wrapf(err, "Failed to purge the artifacts of run %v", run.UUID)
+	}
+	return nil
+}
+
+// Returns the pipeline root of a run the way the launcher resolves it: the
+// pipeline root of the run, else the default pipeline root of the launcher
+// config map of its namespace.
+func (r *ResourceManager) getRunPipelineRoot(ctx context.Context, run *model.Run) (string, error) {
+	if run.PipelineSpec.RuntimeConfig.PipelineRoot != "" {
+		return run.PipelineSpec.RuntimeConfig.PipelineRoot, nil
+	}
+	namespace := run.Namespace
+	if namespace == "" {
+		namespace = common.GetPodNamespace()
+	}
+	launcherConfig, err := config.FromConfigMapClient(ctx, r.k8sCoreClient.ConfigMapClient(namespace), namespace)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to read the launcher config of namespace %v", namespace)
+	}
+	return launcherConfig.DefaultPipelineRoot(), nil
+}
+
 func failedToReconcileSwfCrsError(err error) error {
 	return util.Wrap(err, "Failed to reconcile ScheduledWorkflow Kubernetes resources")
 }
@@ -701,6 +1317,18 @@ func (r *ResourceManager) GetRun(runId string) (*model.Run, error) {
 	return run, nil
 }
 
+// Updates the description and the labels of a run.
+// The labels of the execution that has already been created are left untouched.
+func (r *ResourceManager) UpdateRun(run *model.Run) (*model.Run, error) {
+	if _, err := r.GetRun(run.UUID); err != nil {
+		return nil, util.Wrapf(err, "Failed to update run %v due to error fetching it", run.UUID)
+	}
+	if err := r.runStore.UpdateRunMetadata(run); err != nil {
+		return nil, util.Wrapf(err, "Failed to update run %v", run.UUID)
+	}
+	return r.GetRun(run.UUID)
+}
+
 // Fetches runs with a given set of filtering and listing options.
 func (r *ResourceManager) ListRuns(filterContext *model.FilterContext, opts *list.Options) ([]*model.Run, int, string, error) {
 	runs, totalSize, nextPageToken, err := r.runStore.ListRuns(filterContext, opts)
@@ -710,6 +1338,19 @@ func (r *ResourceManager) ListRuns(filterContext *model.FilterContext, opts *lis
 	return runs, totalSize, nextPageToken, nil
 }
 
+// Returns the resource version of the run changes. Watching runs from it
+// returns the changes after this call.
+func (r *ResourceManager) RunResourceVersion() (string, error) {
+	return r.runWatchHub.resourceVersion()
+}
+
+// Starts watching the changes of the runs and tasks matching a filter. If a
+// resource version is given, the changes after it are returned first. The
+// watcher must be stopped once it is no longer used.
+func (r *ResourceManager) WatchRuns(filter *RunWatchFilter, resourceVersion string) (*RunWatcher, error) {
+	return r.runWatchHub.watch(filter, resourceVersion)
+}
+
 // Archives a run with a given id.
 func (r *ResourceManager) ArchiveRun(runId string) error {
 	if _, err := r.GetRun(runId); err != nil {
@@ -723,6 +1364,17 @@ func (r *ResourceManager) ArchiveRun(runId string) error {
 
 // Un-archives a run with a given id.
 func (r *ResourceManager) UnarchiveRun(runId string) error {
+	if err := r.checkRunCanBeUnarchived(runId); err != nil {
+		return err
+	}
+	if err := r.runStore.UnarchiveRun(runId); err != nil {
+		return util.Wrapf(err, "Failed to unarchive run %v", runId)
+	}
+	return nil
+}
+
+// Checks that a run exists and that its experiment is not archived.
+func (r *ResourceManager) checkRunCanBeUnarchived(runId string) error {
 	run, err := r.GetRun(runId)
 	if err != nil {
 		return util.Wrapf(err, "Failed to unarchive run %v as it does not exist", runId)
@@ -745,9 +1397,6 @@ func (r *ResourceManager) UnarchiveRun(runId string) error {
 			"%s", fmt.Sprintf("Failed to unarchive run %v as experiment %v must be un-archived first", runId, run.ExperimentId),
 		)
 	}
-	if err := r.runStore.UnarchiveRun(runId); err != nil {
-		return util.Wrapf(err, "Failed to unarchive run %v", runId)
-	}
 	return nil
 }
 
@@ -778,25 +1427,131 @@ func (r *ResourceManager) DeleteRun(ctx context.Context, runId string) error {
 	if err != nil {
 		return util.Wrapf(err, "Failed to delete a run %v", runId)
 	}
+	r.decrementWorkflowRunCounters(run)
+	r.runWatchHub.publish(RunEventDeleted, run, nil, run.State)
+	return nil
+}
 
-	if r.options.CollectMetrics {
-		if run.Conditions == string(exec.ExecutionSucceeded) {
-			if util.GetMetricValue(workflowSuccessCounter) > 0 {
-				workflowSuccessCounter.WithLabelValues(run.Namespace, run.DisplayName).Dec()
-			}
-		} else {
-			if util.GetMetricValue(workflowFailedCounter) > 0 {
-				workflowFailedCounter.WithLabelValues(run.Namespace, run.DisplayName).Dec()
-			}
+// Updates the workflow run counters after a run is deleted.
+func (r *ResourceManager) decrementWorkflowRunCounters(run *model.Run) {
+	if !r.options.CollectMetrics {
+		return
+	}
+	if run.Conditions == string(exec.ExecutionSucceeded) {
+		if util.GetMetricValue(workflowSuccessCounter) > 0 {
+			workflowSuccessCounter.WithLabelValues(run.Namespace, run.DisplayName).Dec()
+		}
+	} else {
+		if util.GetMetricValue(workflowFailedCounter) > 0 {
+			workflowFailedCounter.WithLabelValues(run.Namespace, run.DisplayName).Dec()
 		}
 	}
-	return nil
 }
 
-// Creates a task entry.
-func (r *ResourceManager) CreateTask(t *model.Task) (*model.Task, error) {
-	run, err := r.GetRun(t.RunId)
-	if err != nil {
+// Archives runs in batches. Each batch is archived in a single transaction.
+// Returns the errors of the runs, in the order of the run IDs.
+func (r *ResourceManager) ArchiveRuns(runIds []string) []error {
+	errs := make([]error, len(runIds))
+	forEachBatch(runIds, errs, func(batch []string, batchErrs []error) {
+		var found []int
+		for i, runId := range batch {
+			if _, err := r.GetRun(runId); err != nil {
+				batchErrs[i] = util.Wrapf(err, "Failed to archive run %v as it failed to be retrieved", runId)
+				continue
+			}
+			found = append(found, i)
+		}
+		if err := r.runStore.ArchiveRuns(selectIds(batch, found)); err != nil {
+			for _, i := range found {
+				batchErrs[i] = util.Wrapf(err, "Failed to archive run %v", batch[i])
+			}
+		}
+	})
+	return errs
+}
+
+// Un-archives runs in batches. Each batch is un-archived in a single
+// transaction. Returns the errors of the runs, in the order of the run IDs.
+func (r *ResourceManager) UnarchiveRuns(runIds []string) []error {
+	errs := make([]error, len(runIds))
+	forEachBatch(runIds, errs, func(batch []string, batchErrs []error) {
+		var valid []int
+		for i, runId := range batch {
+			if err := r.checkRunCanBeUnarchived(runId); err != nil {
+				batchErrs[i] = err
+				continue
+			}
+			valid = append(valid, i)
+		}
+		if err := r.runStore.UnarchiveRuns(selectIds(batch, valid)); err != nil {
+			for _, i := range valid {
+				batchErrs[i] = util.Wrapf(err, "Failed to unarchive run %v", batch[i])
+			}
+		}
+	})
+	return errs
+}
+
+// Deletes runs in batches. The workflows of a batch are deleted with one
+// Kubernetes API call per namespace, and the runs in a single transaction.
+// Returns the errors of the runs, in the order of the run IDs.
+func (r *ResourceManager) DeleteRuns(ctx context.Context, runIds []string) []error {
+	errs := make([]error, len(runIds))
+	forEachBatch(runIds, errs, func(batch []string, batchErrs []error) {
+		var found []int
+		runs := make([]*model.Run, len(batch))
+		runIdsByNamespace := make(map[string][]string)
+		for i, runId := range batch {
+			run, err := r.GetRun(runId)
+			if err != nil {
+				batchErrs[i] = util.Wrapf(err, "Failed to delete run %v as it does not exist", runId)
+				continue
+			}
+			if run.Namespace == "" {
+				namespace, err := r.GetNamespaceFromExperimentId(run.ExperimentId)
+				if err != nil {
+					batchErrs[i] = util.Wrapf(err, "Failed to delete a run %v due to namespace fetching error", runId)
+					continue
+				}
+				run.Namespace = namespace
+			}
+			k8sNamespace := run.Namespace
+			if k8sNamespace == "" {
+				k8sNamespace = common.GetPodNamespace()
+			}
+			runIdsByNamespace[k8sNamespace] = append(runIdsByNamespace[k8sNamespace], run.UUID)
+			runs[i] = run
+			found = append(found, i)
+		}
+		for namespace, ids := range runIdsByNamespace {
+			selector, err := runIdsLabelSelector(ids)
+			if err == nil {
+				err = r.getWorkflowClient(namespace).DeleteCollection(ctx, v1.DeleteOptions{}, v1.ListOptions{LabelSelector: selector})
+			}
+			if err != nil {
+				// As for single runs, the persistence agent garbage collects the
+				// workflows that are left behind.
+				glog.Warningf("Failed to delete the workflows of %v runs in namespace %v. Error: %v", len(ids), namespace, err.Error())
+			}
+		}
+		if err := r.runStore.DeleteRuns(selectIds(batch, found)); err != nil {
+			for _, i := range found {
+				batchErrs[i] = util.Wrapf(err, "Failed to delete a run %v", batch[i])
+			}
+			return
+		}
+		for _, i := range found {
+			r.decrementWorkflowRunCounters(runs[i])
+			r.runWatchHub.publish(RunEventDeleted, runs[i], nil, runs[i].State)
+		}
+	})
+	return errs
+}
+
+// Creates a task entry.
+func (r *ResourceManager) CreateTask(t *model.Task) (*model.Task, error) {
+	run, err := r.GetRun(t.RunId)
+	if err != nil {
 		return nil, util.Wrapf(err, "Failed to create a task for run %v", t.RunId)
 	}
 	if run.ExperimentId == "" {
@@ -828,6 +1583,7 @@ func (r *ResourceManager) CreateTask(t *model.Task) (*model.Task, error) {
 	if err != nil {
 		return nil, util.Wrapf(err, "Failed to create a task in run %v", t.RunId)
 	}
+	r.runWatchHub.publish(RunEventCreated, run, newTask, run.State)
 	return newTask, nil
 }
 
@@ -840,6 +1596,16 @@ func (r *ResourceManager) ListTasks(filterContext *model.FilterContext, opts *li
 	return tasks, totalSize, nextPageToken, nil
 }
 
+// Invalidates the cache entries matching all non-empty selectors.
+// Returns the number of cache entries invalidated.
+func (r *ResourceManager) InvalidateCacheEntries(namespace string, fingerprint string, runId string, pipelineName string) (int64, error) {
+	count, err := r.taskStore.InvalidateCacheEntries(namespace, fingerprint, runId, pipelineName)
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to invalidate cache entries")
+	}
+	return count, nil
+}
+
 // Fetches recurring runs with given filtering and listing options.
 func (r *ResourceManager) ListJobs(filterContext *model.FilterContext, opts *list.Options) ([]*model.Job, int, string, error) {
 	return r.jobStore.ListJobs(filterContext, opts)
@@ -847,19 +1613,33 @@ func (r *ResourceManager) ListJobs(filterContext *model.FilterContext, opts *lis
 
 // Terminates a workflow by setting its activeDeadlineSeconds to 0.
 func TerminateWorkflow(ctx context.Context, wfClient util.ExecutionInterface, name string) error {
-	patchObj := util.GetTerminatePatch(util.CurrentExecutionType())
+	return patchWorkflow(ctx, wfClient, name, util.GetTerminatePatch(util.CurrentExecutionType()), "terminate")
+}
+
+// Pauses a workflow by suspending it.
+func PauseWorkflow(ctx context.Context, wfClient util.ExecutionInterface, name string) error {
+	return patchWorkflow(ctx, wfClient, name, util.GetPausePatch(util.CurrentExecutionType()), "pause")
+}
+
+// Resumes a suspended workflow.
+func ResumeWorkflow(ctx context.Context, wfClient util.ExecutionInterface, name string) error {
+	return patchWorkflow(ctx, wfClient, name, util.GetResumePatch(util.CurrentExecutionType()), "resume")
+}
+
+// Applies a merge patch to a workflow, retrying on patching errors.
+func patchWorkflow(ctx context.Context, wfClient util.ExecutionInterface, name string, patchObj interface{}, action string) error {
 	patch, err := json.Marshal(patchObj)
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to terminate workflow %s due to error parsing the patch", name)
+		return util.NewInternalServerError(err, "Failed to %s workflow %s due to error parsing the patch", action, name)
 	}
 	operation := func() error {
 		_, err = wfClient.Patch(ctx, name, types.MergePatchType, patch, v1.PatchOptions{})
-		return util.Wrapf(err, "Failed to terminate workflow %s due to patching error", name)
+		return util.Wrapf(err, "Failed to %s workflow %s due to patching error", action, name)
 	}
 	backoffPolicy := backoff.WithMaxRetries(backoff.NewConstantBackOff(100), 10)
 	err = backoff.Retry(operation, backoffPolicy)
 	if err != nil {
-		return util.Wrapf(err, "Failed to terminate workflow %s due to patching error after multiple retries", name)
+		return util.Wrapf(err, "Failed to %s workflow %s due to patching error after multiple retries", action, name)
 	}
 	return nil
 }
@@ -891,46 +1671,192 @@ func (r *ResourceManager) TerminateRun(ctx context.Context, runId string) error
 	return nil
 }
 
+// Terminates runs in batches. The runs of a batch are marked as cancelling in
+// a single transaction before their workflows are terminated. Returns the
+// errors of the runs, in the order of the run IDs.
+func (r *ResourceManager) TerminateRuns(ctx context.Context, runIds []string) []error {
+	errs := make([]error, len(runIds))
+	forEachBatch(runIds, errs, func(batch []string, batchErrs []error) {
+		var found []int
+		runs := make([]*model.Run, len(batch))
+		namespaces := make([]string, len(batch))
+		for i, runId := range batch {
+			run, err := r.GetRun(runId)
+			if err != nil {
+				batchErrs[i] = util.Wrapf(err, "Failed to terminate run %s due to error fetching the run", runId)
+				continue
+			}
+			namespace, err := r.GetNamespaceFromExperimentId(run.ExperimentId)
+			if err != nil {
+				batchErrs[i] = util.Wrapf(err, "Failed to terminate run %s due to error fetching its namespace", runId)
+				continue
+			}
+			if namespace == "" {
+				namespace = common.GetPodNamespace()
+			}
+			runs[i] = run
+			namespaces[i] = namespace
+			found = append(found, i)
+		}
+		terminatedIds, err := r.runStore.TerminateRuns(selectIds(batch, found))
+		if err != nil {
+			for _, i := range found {
+				batchErrs[i] = util.Wrapf(err, "Failed to terminate run %s", batch[i])
+			}
+			return
+		}
+		terminated := make(map[string]bool, len(terminatedIds))
+		for _, runId := range terminatedIds {
+			terminated[runId] = true
+		}
+		for _, i := range found {
+			if !terminated[batch[i]] {
+				batchErrs[i] = util.NewInvalidInputError("Failed to terminate a run %s. Row not found or the run is not active", batch[i])
+				continue
+			}
+			if err := TerminateWorkflow(ctx, r.getWorkflowClient(namespaces[i]), runs[i].K8SName); err != nil {
+				batchErrs[i] = util.NewInternalServerError(err, "Failed to terminate run %s due to error terminating its workflow", batch[i])
+			}
+		}
+	})
+	return errs
+}
+
+// Pauses a running run by suspending the corresponding workflow.
+func (r *ResourceManager) PauseRun(ctx context.Context, runId string) error {
+	run, err := r.GetRun(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to pause run %s due to error fetching the run", runId)
+	}
+	// TODO(gkcalat): consider using run.Namespace after migration logic will be available.
+	namespace, err := r.getNamespaceFromRunId(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to pause run %s due to error fetching its namespace", runId)
+	}
+
+	switch run.State.ToV2() {
+	case model.RuntimeStatePending, model.RuntimeStateRunning, model.RuntimeStateUnspecified:
+	default:
+		return util.NewInvalidInputError("Failed to pause run %s as the run is not active", runId)
+	}
+
+	if namespace == "" {
+		namespace = common.GetPodNamespace()
+	}
+	// The workflow is suspended first, so that the run is not paused while
+	// its workflow keeps running.
+	err = PauseWorkflow(ctx, r.getWorkflowClient(namespace), run.K8SName)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to pause run %s due to error suspending its workflow", runId)
+	}
+	err = r.runStore.PauseRun(runId)
+	if err != nil {
+		if resumeErr := ResumeWorkflow(ctx, r.getWorkflowClient(namespace), run.K8SName); resumeErr != nil {
+			glog.Errorf("Failed to resume the workflow of run %s after failing to pause the run: %v", runId, resumeErr)
+		}
+		return util.Wrapf(err, "Failed to pause run %s", runId)
+	}
+	return nil
+}
+
+// Resumes a paused run and the corresponding workflow.
+func (r *ResourceManager) ResumeRun(ctx context.Context, runId string) error {
+	run, err := r.GetRun(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to resume run %s due to error fetching the run", runId)
+	}
+	// TODO(gkcalat): consider using run.Namespace after migration logic will be available.
+	namespace, err := r.getNamespaceFromRunId(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to resume run %s due to error fetching its namespace", runId)
+	}
+
+	if run.State.ToV2() != model.RuntimeStatePaused {
+		return util.NewInvalidInputError("Failed to resume run %s as the run is not paused", runId)
+	}
+
+	if namespace == "" {
+		namespace = common.GetPodNamespace()
+	}
+	// The workflow is resumed first, so that the run is not running while its
+	// workflow stays suspended.
+	err = ResumeWorkflow(ctx, r.getWorkflowClient(namespace), run.K8SName)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to resume run %s due to error resuming its workflow", runId)
+	}
+	err = r.runStore.ResumeRun(runId)
+	if err != nil {
+		if pauseErr := PauseWorkflow(ctx, r.getWorkflowClient(namespace), run.K8SName); pauseErr != nil {
+			glog.Errorf("Failed to suspend the workflow of run %s after failing to resume the run: %v", runId, pauseErr)
+		}
+		return util.Wrapf(err, "Failed to resume run %s", runId)
+	}
+	return nil
+}
+
 // Retries a run given its id.
 func (r *ResourceManager) RetryRun(ctx context.Context, runId string) error {
+	newExecSpec, podsToDelete, namespace, err := r.prepareRunRetry(runId)
+	if err != nil {
+		return err
+	}
+	if err = deletePods(ctx, r.k8sCoreClient, podsToDelete, namespace); err != nil {
+		return util.NewInternalServerError(err, "Failed to retry run %s due to error cleaning up the failed pods from the previous attempt", runId)
+	}
+	retriedRun, err := r.retryWorkflow(ctx, runId, newExecSpec, namespace)
+	if err != nil {
+		return err
+	}
+	err = r.runStore.UpdateRun(retriedRun)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to retry run %s due to error updating entry", runId)
+	}
+	return nil
+}
+
+// Generates the workflow that retries a run. Returns it along with the failed
+// pods of the previous attempt and the namespace of the run.
+func (r *ResourceManager) prepareRunRetry(runId string) (util.ExecutionSpec, []string, string, error) {
 	run, err := r.GetRun(runId)
 	if err != nil {
-		return util.Wrapf(err, "Failed to retry run %s due to error fetching the run", runId)
+		return nil, nil, "", util.Wrapf(err, "Failed to retry run %s due to error fetching the run", runId)
 	}
 	// TODO(gkcalat): consider using run.Namespace after migration logic will be available.
 	namespace, err := r.getNamespaceFromRunId(runId)
 	if err != nil {
-		return util.Wrapf(err, "Failed to retry run %s due to error fetching its namespace", runId)
+		return nil, nil, "", util.Wrapf(err, "Failed to retry run %s due to error fetching its namespace", runId)
 	}
 
 	if run.RunDetails.WorkflowRuntimeManifest == "" {
-		return util.NewBadRequestError(util.NewInvalidInputError("Workflow manifest cannot be empty"), "Failed to retry run %s due to error fetching workflow manifest", runId)
+		return nil, nil, "", util.NewBadRequestError(util.NewInvalidInputError("Workflow manifest cannot be empty"), "Failed to retry run %s due to error fetching workflow manifest", runId)
 	}
-	execSpec, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(run.RunDetails.WorkflowRuntimeManifest))
+	execSpec, err := util.NewExecutionSpecJSON(util.CurrentExecutionType(), []byte(run.RunDetails.WorkflowRuntimeManifest))
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to retry run %s due to error parsing the workflow manifest", runId)
+		return nil, nil, "", util.NewInternalServerError(err, "Failed to retry run %s due to error parsing the workflow manifest", runId)
 	}
 
 	if err := execSpec.Decompress(); err != nil {
-		return util.NewInternalServerError(err, "Failed to retry run %s due to error decompressing execution spec", runId)
+		return nil, nil, "", util.NewInternalServerError(err, "Failed to retry run %s due to error decompressing execution spec", runId)
 	}
 
 	if err := execSpec.CanRetry(); err != nil {
-		return util.NewInternalServerError(err, "Failed to retry run %s as it does not allow retries", runId)
+		return nil, nil, "", util.NewInternalServerError(err, "Failed to retry run %s as it does not allow retries", runId)
 	}
 
 	newExecSpec, podsToDelete, err := execSpec.GenerateRetryExecution()
 	if err != nil {
-		return util.Wrapf(err, "Failed to retry run %s", runId)
+		return nil, nil, "", util.Wrapf(err, "Failed to retry run %s", runId)
 	}
 
 	if namespace == "" {
 		namespace = common.GetPodNamespace()
 	}
-	if err = deletePods(ctx, r.k8sCoreClient, podsToDelete, namespace); err != nil {
-		return util.NewInternalServerError(err, "Failed to retry run %s due to error cleaning up the failed pods from the previous attempt", runId)
-	}
+	return newExecSpec, podsToDelete, namespace, nil
+}
 
+// Replaces the workflow of a run with the workflow that retries it. Returns the
+// run update to store.
+func (r *ResourceManager) retryWorkflow(ctx context.Context, runId string, newExecSpec util.ExecutionSpec, namespace string) (*model.Run, error) {
 	// First try to update workflow
 	// If fail to get the workflow, return error.
 	latestWorkflow, updateError := r.getWorkflowClient(namespace).Get(ctx, newExecSpec.ExecutionName(), v1.GetOptions{})
@@ -945,18 +1871,66 @@ func (r *ResourceManager) RetryRun(ctx context.Context, runId string) error {
 		newCreatedWorkflow, createError := r.getWorkflowClient(namespace).Create(ctx, newExecSpec, v1.CreateOptions{})
 		if createError != nil {
 			if createError, ok := createError.(net.Error); ok && createError.Timeout() {
-				return util.NewUnavailableServerError(createError, "Failed to retry run %s due to error creating and updating a workflow - try again later. Update error: %s", runId, updateError.Error())
+				return nil, util.NewUnavailableServerError(createError, "Failed to retry run %s due to error creating and updating a workflow - try again later. Update error: %s", runId, updateError.Error())
 			}
-			return util.NewInternalServerError(createError, "Failed to retry run %s due to error updating and creating a workflow. Update error: %s", runId, updateError.Error())
+			return nil, util.NewInternalServerError(createError, "Failed to retry run %s due to error updating and creating a workflow. Update error: %s", runId, updateError.Error())
 		}
 		newExecSpec = newCreatedWorkflow
 	}
 	condition := string(newExecSpec.ExecutionStatus().Condition())
-	err = r.runStore.UpdateRun(&model.Run{UUID: runId, RunDetails: model.RunDetails{Conditions: condition, FinishedAtInSec: 0, WorkflowRuntimeManifest: newExecSpec.ToStringForStore(), State: model.RuntimeState(condition).ToV2()}})
-	if err != nil {
-		return util.NewInternalServerError(err, "Failed to retry run %s due to error updating entry", runId)
-	}
-	return nil
+	return &model.Run{UUID: runId, RunDetails: model.RunDetails{Conditions: condition, FinishedAtInSec: 0, WorkflowRuntimeManifest: newExecSpec.ToStringForStore(), State: model.RuntimeState(condition).ToV2()}}, nil
+}
+
+// Retries runs in batches. The failed pods of a batch are cleaned up per
+// namespace, each workflow is replaced, and the runs are updated in a single
+// transaction. Returns the errors of the runs, in the order of the run IDs.
+func (r *ResourceManager) RetryRuns(ctx context.Context, runIds []string) []error {
+	errs := make([]error, len(runIds))
+	forEachBatch(runIds, errs, func(batch []string, batchErrs []error) {
+		execSpecs := make([]util.ExecutionSpec, len(batch))
+		namespaces := make([]string, len(batch))
+		podsByNamespace := make(map[string][]string)
+		for i, runId := range batch {
+			newExecSpec, podsToDelete, namespace, err := r.prepareRunRetry(runId)
+			if err != nil {
+				batchErrs[i] = err
+				continue
+			}
+			execSpecs[i] = newExecSpec
+			namespaces[i] = namespace
+			podsByNamespace[namespace] = append(podsByNamespace[namespace], podsToDelete...)
+		}
+		cleanupErrs := make(map[string]error)
+		for namespace, podsToDelete := range podsByNamespace {
+			if err := deletePods(ctx, r.k8sCoreClient, podsToDelete, namespace); err != nil {
+				cleanupErrs[namespace] = err
+			}
+		}
+		var retried []int
+		var retriedRuns []*model.Run
+		for i, runId := range batch {
+			if execSpecs[i] == nil {
+				continue
+			}
+			if err := cleanupErrs[namespaces[i]]; err != nil {
+				batchErrs[i] = util.NewInternalServerError(err, "Failed to retry run %s due to error cleaning up the failed pods from the previous attempt", runId)
+				continue
+			}
+			retriedRun, err := r.retryWorkflow(ctx, runId, execSpecs[i], namespaces[i])
+			if err != nil {
+				batchErrs[i] = err
+				continue
+			}
+			retried = append(retried, i)
+			retriedRuns = append(retriedRuns, retriedRun)
+		}
+		if err := r.runStore.UpdateRuns(retriedRuns); err != nil {
+			for _, i := range retried {
+				batchErrs[i] = util.NewInternalServerError(err, "Failed to retry run %s due to error updating entry", batch[i])
+			}
+		}
+	})
+	return errs
 }
 
 // Fetches execution logs and writes to the destination.
@@ -985,21 +1959,109 @@ func (r *ResourceManager) ReadLog(ctx context.Context, runId string, nodeId stri
 	return nil
 }
 
+// TaskLogOptions configures reading the log of a run task.
+type TaskLogOptions struct {
+	// Container to read the log from. Defaults to TaskLogContainerUser.
+	Container TaskLogContainer
+	// Whether to keep streaming the log until the container terminates.
+	Follow bool
+	// If positive, only the given number of lines from the end of the log are read.
+	TailLines int64
+	// If set, only the lines written at or after this time are read.
+	SinceTime *time.Time
+}
+
+// Fetches the log of a run task and writes it to the destination.
+// The task is identified by its id or, if the id is empty, by its name.
+// 1. Attempts to read the log directly from the task pod.
+// 2. Attempts to read the log from archive if the task pod log is unavailable.
+func (r *ResourceManager) ReadTaskLog(ctx context.Context, runId string, taskId string, taskName string, opts TaskLogOptions, dst io.Writer) error {
+	run, err := r.GetRun(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read task log for run %v due to run fetching error", runId)
+	}
+	task, err := findRunTask(run, taskId, taskName)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read task log for run %v", runId)
+	}
+	if run.WorkflowRuntimeManifest == "" {
+		return util.NewFailedPreconditionError(errors.New("runtime workflow manifest is empty"), "Failed to read task log for run %v as it has not started yet", runId)
+	}
+	execSpec, err := util.NewExecutionSpecJSON(util.CurrentExecutionType(), []byte(run.WorkflowRuntimeManifest))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to read task log for run %v due to error reading execution spec", runId)
+	}
+	if opts.Container == "" {
+		opts.Container = TaskLogContainerUser
+	}
+	podName, containerName, err := taskLogPod(execSpec, task, opts.Container)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read task log for run %v", runId)
+	}
+	namespace, err := r.getNamespaceFromRunId(runId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read task log for run %v due to namespace fetching error", runId)
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container: containerName,
+		Follow:    opts.Follow,
+	}
+	if opts.TailLines > 0 {
+		logOptions.TailLines = &opts.TailLines
+	}
+	if opts.SinceTime != nil {
+		sinceTime := v1.NewTime(*opts.SinceTime)
+		logOptions.SinceTime = &sinceTime
+	}
+	podLogs, err := r.openPodLog(ctx, namespace, podName, logOptions)
+	if err == nil {
+		defer podLogs.Close()
+		_, err = io.Copy(dst, podLogs)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return util.NewInternalServerError(err, "Failed to read task log for run %v due to error in streaming the log of pod %v", runId, podName)
+		}
+		return nil
+	}
+	// Only the main containers are archived.
+	if r.logArchive == nil || containerName != mainContainerName {
+		return util.Wrapf(err, "Failed to read task log for run %v", runId)
+	}
+	err = r.readLogFromArchive(execSpec, podName, archive.ExtractLogOptions{
+		LogFormat: archive.LogFormatText,
+		SinceTime: opts.SinceTime,
+		TailLines: opts.TailLines,
+	}, dst)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read task log for run %v", runId)
+	}
+	return nil
+}
+
+// Opens a log stream of a pod container.
+func (r *ResourceManager) openPodLog(ctx context.Context, namespace string, podName string, logOptions *corev1.PodLogOptions) (io.ReadCloser, error) {
+	req := r.k8sCoreClient.PodClient(namespace).GetLogs(podName, logOptions)
+	podLogs, err := req.Stream(ctx)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			glog.Errorf("Failed to read logs from pod %v: %v", podName, err)
+		}
+		return nil, util.NewInternalServerError(err, "Failed to read logs from pod %v due to error opening log stream", podName)
+	}
+	return podLogs, nil
+}
+
 // Fetches execution logs from a pod.
 func (r *ResourceManager) readRunLogFromPod(ctx context.Context, namespace string, nodeId string, follow bool, dst io.Writer) error {
 	logOptions := corev1.PodLogOptions{
-		Container:  "main",
+		Container:  mainContainerName,
 		Timestamps: false,
 		Follow:     follow,
 	}
 
-	req := r.k8sCoreClient.PodClient(namespace).GetLogs(nodeId, &logOptions)
-	podLogs, err := req.Stream(ctx)
+	podLogs, err := r.openPodLog(ctx, namespace, nodeId, &logOptions)
 	if err != nil {
-		if !apierrors.IsNotFound(err) {
-			glog.Errorf("Failed to read logs from pod %v: %v", nodeId, err)
-		}
-		return util.NewInternalServerError(err, "Failed to read logs from pod %v due to error opening log stream", nodeId)
+		return err
 	}
 	defer podLogs.Close()
 
@@ -1021,6 +2083,11 @@ func (r *ResourceManager) readRunLogFromArchive(workflowManifest string, nodeId
 		return util.NewInternalServerError(err, "Failed to read logs from archive %v due error reading execution spec", nodeId)
 	}
 
+	return r.readLogFromArchive(execSpec, nodeId, archive.ExtractLogOptions{LogFormat: archive.LogFormatText, Timestamps: false}, dst)
+}
+
+// Fetches the archived main container log of a pod.
+func (r *ResourceManager) readLogFromArchive(execSpec util.ExecutionSpec, nodeId string, opts archive.ExtractLogOptions, dst io.Writer) error {
 	logPath, err := r.logArchive.GetLogObjectKey(execSpec, nodeId)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to read logs from archive %v", nodeId)
@@ -1031,7 +2098,7 @@ func (r *ResourceManager) readRunLogFromArchive(workflowManifest string, nodeId
 		return util.NewInternalServerError(err, "Failed to read logs from archive %v due to error fetching the log file", nodeId)
 	}
 
-	err = r.logArchive.CopyLogFromArchive(logContent, dst, archive.ExtractLogOptions{LogFormat: archive.LogFormatText, Timestamps: false})
+	err = r.logArchive.CopyLogFromArchive(logContent, dst, opts)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to read logs from archive %v due to error copying the log file", nodeId)
 	}
@@ -1104,6 +2171,39 @@ func (r *ResourceManager) CreateJob(ctx context.Context, job *model.Job) (*model
 
 	job.Namespace = k8sNamespace
 
+	if err := r.checkJobNotifications(job); err != nil {
+		return nil, util.Wrap(err, "Failed to create a recurring run")
+	}
+
+	scheduledWorkflow, tmpl, manifest, err := r.scheduledWorkflowFromJob(job)
+	if err != nil {
+		return nil, err
+	}
+
+	newScheduledWorkflow, err := r.getScheduledWorkflowClient(k8sNamespace).Create(ctx, scheduledWorkflow)
+	if err != nil {
+		if err, ok := err.(net.Error); ok && err.Timeout() {
+			return nil, util.NewUnavailableServerError(err, "Failed to create a recurring run during scheduling a workflow - try again later")
+		}
+		return nil, util.Wrap(err, "Failed to create a recurring run during scheduling a workflow")
+	}
+	// Complete modelJob with info coming back from ScheduledWorkflow client.
+	swf := util.NewScheduledWorkflow(newScheduledWorkflow)
+	job.UUID = string(swf.UID)
+	job.K8SName = swf.Name
+	job.Conditions = model.StatusState(swf.ConditionSummary()).ToString()
+	for _, modelRef := range job.ResourceReferences {
+		modelRef.ResourceUUID = string(swf.UID)
+	}
+
+	setJobPipelineManifest(job, swf, tmpl, manifest)
+	return r.jobStore.CreateJob(job)
+}
+
+// Converts a recurring run to a ScheduledWorkflow. If the recurring run is pinned to a pipeline version
+// or a pipeline spec, the template and the manifest of the pinned pipeline are returned as well.
+// Otherwise, the returned template is nil and the ScheduledWorkflow controller picks the latest pipeline version.
+func (r *ResourceManager) scheduledWorkflowFromJob(job *model.Job) (*scheduledworkflow.ScheduledWorkflow, template.Template, string, error) {
 	var manifest string
 	var scheduledWorkflow *scheduledworkflow.ScheduledWorkflow
 	var tmpl template.Template
@@ -1116,71 +2216,58 @@ func (r *ResourceManager) CreateJob(ctx context.Context, job *model.Job) (*model
 		// Update the job.PipelineSpec if an existing pipeline version is used.
 		tmpl, manifest, err = r.fetchTemplateFromPipelineSpec(&job.PipelineSpec)
 		if err != nil {
-			return nil, util.NewInternalServerError(err, "Failed to create a recurring run with an invalid pipeline spec manifest")
+			return nil, nil, "", util.NewInternalServerError(err, "Failed to create a recurring run with an invalid pipeline spec manifest")
 		}
 
 		// TODO(gkcalat): consider changing the flow. Other resource UUIDs are assigned by their respective stores (DB).
 		// Convert modelJob into scheduledWorkflow.
 		scheduledWorkflow, err = tmpl.ScheduledWorkflow(job)
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to create a recurring run during scheduled workflow creation")
+			return nil, nil, "", util.Wrap(err, "Failed to create a recurring run during scheduled workflow creation")
 		}
 	} else if job.PipelineId == "" {
-		return nil, errors.New("Cannot create a job with an empty pipeline ID")
+		return nil, nil, "", errors.New("Cannot create a job with an empty pipeline ID")
 	} else {
 		// Validate the input parameters on the latest pipeline version. The latest pipeline version is not stored
 		// in the ScheduledWorkflow. It's just to help the user with up front validation at recurring run creation
 		// time.
 		manifest, err := r.GetPipelineLatestTemplate(job.PipelineId)
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to validate the input parameters on the latest pipeline version")
+			return nil, nil, "", util.Wrap(err, "Failed to validate the input parameters on the latest pipeline version")
 		}
 
 		tmpl, err := template.New(manifest, r.options.CacheDisabled, r.options.DefaultWorkspace)
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to fetch a template with an invalid pipeline spec manifest")
+			return nil, nil, "", util.Wrap(err, "Failed to fetch a template with an invalid pipeline spec manifest")
 		}
 
 		_, err = tmpl.ScheduledWorkflow(job)
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to validate the input parameters on the latest pipeline version")
+			return nil, nil, "", util.Wrap(err, "Failed to validate the input parameters on the latest pipeline version")
 		}
 
 		scheduledWorkflow, err = template.NewGenericScheduledWorkflow(job)
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to create a recurring run during scheduled workflow creation")
+			return nil, nil, "", util.Wrap(err, "Failed to create a recurring run during scheduled workflow creation")
 		}
 
 		parameters, err := template.StringMapToCRDParameters(job.RuntimeConfig.Parameters)
 		if err != nil {
-			return nil, util.Wrap(err, "Converting runtime config's parameters to CDR parameters failed")
+			return nil, nil, "", util.Wrap(err, "Converting runtime config's parameters to CDR parameters failed")
 		}
 
 		scheduledWorkflow.Spec.Workflow = &scheduledworkflow.WorkflowResource{
 			Parameters: parameters, PipelineRoot: job.PipelineRoot,
 		}
 	}
+	return scheduledWorkflow, tmpl, manifest, nil
+}
 
-	newScheduledWorkflow, err := r.getScheduledWorkflowClient(k8sNamespace).Create(ctx, scheduledWorkflow)
-	if err != nil {
-		if err, ok := err.(net.Error); ok && err.Timeout() {
-			return nil, util.NewUnavailableServerError(err, "Failed to create a recurring run during scheduling a workflow - try again later")
-		}
-		return nil, util.Wrap(err, "Failed to create a recurring run during scheduling a workflow")
-	}
-	// Complete modelJob with info coming back from ScheduledWorkflow client.
-	swf := util.NewScheduledWorkflow(newScheduledWorkflow)
-	job.UUID = string(swf.UID)
-	job.K8SName = swf.Name
-	job.Conditions = model.StatusState(swf.ConditionSummary()).ToString()
-	for _, modelRef := range job.ResourceReferences {
-		modelRef.ResourceUUID = string(swf.UID)
-	}
-
+// Stores the manifest of the pinned pipeline and the service account of the ScheduledWorkflow in the recurring run.
+func setJobPipelineManifest(job *model.Job, swf *util.ScheduledWorkflow, tmpl template.Template, manifest string) {
 	if tmpl == nil {
-		return r.jobStore.CreateJob(job)
+		return
 	}
-
 	if tmpl.GetTemplateType() == template.V1 {
 		// Get the service account
 		serviceAccount := ""
@@ -1193,10 +2280,100 @@ func (r *ResourceManager) CreateJob(ctx context.Context, job *model.Job) (*model
 		job.ServiceAccount = serviceAccount
 		job.PipelineSpec.WorkflowSpecManifest = manifest
 	} else {
-		job.ServiceAccount = newScheduledWorkflow.Spec.ServiceAccount
+		job.ServiceAccount = swf.Spec.ServiceAccount
 		job.PipelineSpec.PipelineSpecManifest = manifest
 	}
-	return r.jobStore.CreateJob(job)
+}
+
+// Checks that the webhooks notified about the runs of a recurring run may be
+// notified.
+func (r *ResourceManager) checkJobNotifications(job *model.Job) error {
+	subscriptions, err := job.GetNotifications()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to parse the notifications of recurring run %v", job.UUID)
+	}
+	return r.notifier.checkWebhooks(subscriptions)
+}
+
+// Updates a recurring run in place. The ScheduledWorkflow is rebuilt from the updated recurring run,
+// so that the changes apply to the runs scheduled after the update.
+func (r *ResourceManager) UpdateJob(ctx context.Context, job *model.Job) (*model.Job, error) {
+	k8sNamespace := job.Namespace
+	if k8sNamespace == "" {
+		k8sNamespace = common.GetPodNamespace()
+	}
+	if err := r.checkJobNotifications(job); err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v", job.UUID)
+	}
+	newScheduledWorkflow, tmpl, manifest, err := r.scheduledWorkflowFromJob(job)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v", job.UUID)
+	}
+
+	updatedScheduledWorkflow, previousScheduledWorkflow, err := r.modifyScheduledWorkflow(ctx, k8sNamespace, job.K8SName, job.UUID, "update", func(swf *scheduledworkflow.ScheduledWorkflow) error {
+		// The backfill is not stored with the recurring run, so keep the one of the ScheduledWorkflow.
+		newScheduledWorkflow.Spec.Backfill = swf.Spec.Backfill
+		swf.Spec = newScheduledWorkflow.Spec
+		swf.Labels = newScheduledWorkflow.Labels
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Clear the manifests stored for the previously pinned pipeline.
+	job.PipelineSpec.PipelineSpecManifest = ""
+	job.PipelineSpec.WorkflowSpecManifest = ""
+	setJobPipelineManifest(job, util.NewScheduledWorkflow(updatedScheduledWorkflow), tmpl, manifest)
+	if err := r.jobStore.UpdateJobSpec(job); err != nil {
+		// Restore the ScheduledWorkflow, so that it keeps matching the stored recurring run.
+		_, _, rollbackErr := r.modifyScheduledWorkflow(ctx, k8sNamespace, job.K8SName, job.UUID, "roll back", func(swf *scheduledworkflow.ScheduledWorkflow) error {
+			swf.Spec = previousScheduledWorkflow.Spec
+			swf.Labels = previousScheduledWorkflow.Labels
+			return nil
+		})
+		if rollbackErr != nil {
+			glog.Errorf("Failed to roll back the ScheduledWorkflow of recurring run %v after failing to update it: %v", job.UUID, rollbackErr)
+		}
+		return nil, util.Wrapf(err, "Failed to update recurring run %v", job.UUID)
+	}
+	return r.GetJob(job.UUID)
+}
+
+// Fetches the ScheduledWorkflow of a recurring run, applies a change to it and
+// updates it. The change is applied again to the latest ScheduledWorkflow when
+// the update conflicts with a concurrent one, up to maxScheduledWorkflowUpdateAttempts
+// times. Returns the updated ScheduledWorkflow and a copy of it before the change.
+func (r *ResourceManager) modifyScheduledWorkflow(ctx context.Context, k8sNamespace string, k8sName string, jobId string, action string, modify func(*scheduledworkflow.ScheduledWorkflow) error) (*scheduledworkflow.ScheduledWorkflow, *scheduledworkflow.ScheduledWorkflow, error) {
+	for attempt := 1; ; attempt++ {
+		scheduledWorkflow, err := r.getScheduledWorkflowClient(k8sNamespace).Get(ctx, k8sName, v1.GetOptions{})
+		if err != nil {
+			if util.IsNotFound(err) {
+				return nil, nil, util.Wrapf(util.NewResourceNotFoundError("recurring run", k8sName), "Failed to %v recurring run %v. Check if its k8s resource exists", action, jobId)
+			}
+			return nil, nil, util.NewInternalServerError(err, "Failed to %v recurring run %v. Check if the scheduled workflow exists", action, jobId)
+		}
+		if string(scheduledWorkflow.UID) != jobId {
+			return nil, nil, util.Wrapf(util.NewResourceNotFoundError("recurring run", k8sName), "Failed to %v recurring run %v. Check if its k8s resource exists", action, jobId)
+		}
+		// The fetched object may be shared with the client, so only a copy
+		// of it is modified.
+		previousScheduledWorkflow := scheduledWorkflow
+		scheduledWorkflow = scheduledWorkflow.DeepCopy()
+		if err := modify(scheduledWorkflow); err != nil {
+			return nil, nil, err
+		}
+		err = r.updateSwfCrSpec(ctx, k8sNamespace, scheduledWorkflow)
+		if err == nil {
+			return scheduledWorkflow, previousScheduledWorkflow, nil
+		}
+		if !apierrors.IsConflict(errors.Unwrap(err)) {
+			return nil, nil, util.Wrapf(err, "Failed to %v recurring run %v", action, jobId)
+		}
+		if attempt >= maxScheduledWorkflowUpdateAttempts {
+			return nil, nil, util.NewBadRequestError(err, "Failed to %v recurring run %v as it was concurrently modified %v times. Try again", action, jobId, attempt)
+		}
+	}
 }
 
 // Enables or disables a recurring run with given id.
@@ -1236,6 +2413,201 @@ func (r *ResourceManager) ChangeJobMode(ctx context.Context, jobId string, enabl
 	return nil
 }
 
+// Requests runs for the trigger times of a recurring run between startTime and endTime.
+// The ScheduledWorkflow controller creates the runs, and replaces any previously requested window.
+func (r *ResourceManager) BackfillJob(ctx context.Context, jobId string, startTime time.Time, endTime time.Time) error {
+	if endTime.After(r.time.Now()) {
+		return util.NewInvalidInputError("Failed to backfill recurring run %v. The end time %v of the backfill window is in the future", jobId, endTime.UTC().Format(time.RFC3339))
+	}
+	job, err := r.GetJob(jobId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to backfill recurring run %v. Check if it exists", jobId)
+	}
+	k8sNamespace := job.Namespace
+	if k8sNamespace == "" {
+		k8sNamespace = common.GetPodNamespace()
+	}
+	maxRuns := r.backfillMaxRuns()
+	_, _, err = r.modifyScheduledWorkflow(ctx, k8sNamespace, job.K8SName, jobId, "backfill", func(scheduledWorkflow *scheduledworkflow.ScheduledWorkflow) error {
+		if scheduledWorkflow.Spec.Trigger.CronSchedule == nil && scheduledWorkflow.Spec.Trigger.PeriodicSchedule == nil {
+			return util.NewInvalidInputError("Failed to backfill recurring run %v. Only recurring runs with a cron or a periodic schedule can be backfilled", jobId)
+		}
+		location, err := getBackfillLocation(scheduledWorkflow.Spec.Trigger.CronSchedule)
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to backfill recurring run %v. Check the time zone of its cron schedule", jobId)
+		}
+		scheduledWorkflow.Spec.Backfill = &scheduledworkflow.Backfill{
+			StartTime: v1.NewTime(startTime.UTC()),
+			EndTime:   v1.NewTime(endTime.UTC()),
+		}
+		// Counting one more trigger time than allowed is enough to reject
+		// the window without enumerating all of it.
+		if count := swfutil.NewScheduledWorkflow(scheduledWorkflow).CountBackfillEpochs(maxRuns+1, location); count > maxRuns {
+			return util.NewInvalidInputError("Failed to backfill recurring run %v. The backfill window has more than %v trigger times. Use a shorter window", jobId, maxRuns)
+		}
+		return nil
+	})
+	return err
+}
+
+// Returns the location the trigger times of a backfill are counted in, the
+// same as the ScheduledWorkflow controller: the time zone of the cron
+// schedule if set, or the default time zone of cron schedules.
+func getBackfillLocation(cronSchedule *scheduledworkflow.CronSchedule) (*time.Location, error) {
+	if cronSchedule != nil && cronSchedule.TimeZone != "" {
+		return swfutil.NewCronSchedule(cronSchedule).GetLocation(nil)
+	}
+	return swfutil.GetLocation()
+}
+
+// Returns the maximum number of runs a backfill may request.
+func (r *ResourceManager) backfillMaxRuns() int64 {
+	maxRuns := int64(defaultBackfillMaxRuns)
+	if r.options != nil && r.options.BackfillMaxRuns > 0 {
+		maxRuns = int64(r.options.BackfillMaxRuns)
+	}
+	if maxRuns > swfutil.MaxBackfillRuns {
+		maxRuns = swfutil.MaxBackfillRuns
+	}
+	return maxRuns
+}
+
+// Creates a run of a recurring run with a webhook trigger. The timestamp and the
+// payload must be signed with the HMAC key of the trigger, and the fields of the
+// payload are mapped to the parameters of the run. Each signed request creates
+// at most one run, and only while the recurring run has fewer active runs than
+// its maximum concurrency. The limit is best-effort: the active runs are counted
+// before the run is created, and creating a run cannot join a transaction since
+// it also creates the workflow, so requests delivered at the same time may each
+// create a run.
+func (r *ResourceManager) TriggerJob(ctx context.Context, jobId string, payload []byte, timestamp string, signature string) (*model.Run, error) {
+	job, err := r.GetJob(jobId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to trigger recurring run %v. Check if it exists", jobId)
+	}
+	eventTrigger, err := job.GetEventTrigger()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to trigger recurring run %v. Check its event trigger", jobId)
+	}
+	if eventTrigger == nil || eventTrigger.Webhook == nil {
+		return nil, util.NewFailedPreconditionError(errors.New("no webhook trigger"), "Failed to trigger recurring run %v. It does not have a webhook trigger", jobId)
+	}
+	if !job.Enabled {
+		return nil, util.NewFailedPreconditionError(errors.New("recurring run is disabled"), "Failed to trigger recurring run %v. It is disabled", jobId)
+	}
+	webhook := eventTrigger.Webhook
+
+	k8sNamespace := job.Namespace
+	if k8sNamespace == "" {
+		k8sNamespace = common.GetPodNamespace()
+	}
+	secret, err := r.k8sCoreClient.SecretClient(k8sNamespace).Get(ctx, webhook.SecretName, v1.GetOptions{})
+	if err != nil {
+		if util.IsNotFound(err) {
+			return nil, util.NewFailedPreconditionError(err, "Failed to trigger recurring run %v. Secret %v of its webhook trigger does not exist", jobId, webhook.SecretName)
+		}
+		return nil, util.NewInternalServerError(err, "Failed to trigger recurring run %v. Check if secret %v exists", jobId, webhook.SecretName)
+	}
+	secretKey := webhook.SecretKey
+	if secretKey == "" {
+		secretKey = defaultWebhookSecretKey
+	}
+	hmacKey := secret.Data[secretKey]
+	if len(hmacKey) == 0 {
+		return nil, util.NewFailedPreconditionError(errors.New("no HMAC key"), "Failed to trigger recurring run %v. Secret %v has no key %v", jobId, webhook.SecretName, secretKey)
+	}
+	timestampSec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, util.NewUnauthenticatedError(errors.New("invalid timestamp"), "Failed to trigger recurring run %v. The timestamp of the request is missing or invalid", jobId)
+	}
+	if !verifyWebhookSignature(hmacKey, timestamp, payload, signature) {
+		return nil, util.NewUnauthenticatedError(errors.New("invalid signature"), "Failed to trigger recurring run %v. The signature of the payload is invalid", jobId)
+	}
+	now := r.time.Now()
+	if delay := now.Sub(time.Unix(timestampSec, 0)); delay > webhookTimestampTolerance || delay < -webhookTimestampTolerance {
+		return nil, util.NewUnauthenticatedError(errors.New("stale timestamp"), "Failed to trigger recurring run %v. The timestamp of the request is more than %v away from the time of the server", jobId, webhookTimestampTolerance)
+	}
+
+	var event map[string]interface{}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, util.NewInvalidInputError("Failed to trigger recurring run %v. The payload is not a JSON object: %v", jobId, err)
+	}
+	parameters, err := util.PayloadParameters(event, webhook.PayloadParameters)
+	if err != nil {
+		return nil, util.NewInvalidInputError("Failed to trigger recurring run %v. %v", jobId, err)
+	}
+	run := &model.Run{
+		DisplayName:    job.DisplayName,
+		Namespace:      job.Namespace,
+		ExperimentId:   job.ExperimentId,
+		RecurringRunId: job.UUID,
+		ServiceAccount: job.ServiceAccount,
+		StorageState:   model.StorageStateAvailable,
+		PipelineSpec:   job.PipelineSpec,
+	}
+	if err := applyEventParameters(&run.PipelineSpec, parameters); err != nil {
+		return nil, util.Wrapf(err, "Failed to trigger recurring run %v", jobId)
+	}
+
+	// Like the runs of the other triggers, the runs of a webhook trigger are
+	// limited by the maximum concurrency of the recurring run, on a best-effort
+	// basis.
+	activeRuns, err := r.countActiveJobRuns(jobId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to trigger recurring run %v. Check its active runs", jobId)
+	}
+	maxConcurrency := job.MaxConcurrency
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	if int64(activeRuns) >= maxConcurrency {
+		return nil, util.NewUnavailableServerError(errors.New("too many active runs"), "Failed to trigger recurring run %v. It has %v active runs, its maximum concurrency. Try again later", jobId, activeRuns)
+	}
+	err = r.jobStore.CreateWebhookDelivery(&model.WebhookDelivery{
+		JobUUID:          jobId,
+		Signature:        signature,
+		DeliveredAtInSec: now.Unix(),
+	}, now.Add(-2*webhookTimestampTolerance).Unix())
+	if err != nil {
+		if util.IsUserErrorCodeMatch(err, codes.AlreadyExists) {
+			return nil, util.NewUnauthenticatedError(err, "Failed to trigger recurring run %v. The request was already delivered", jobId)
+		}
+		return nil, util.Wrapf(err, "Failed to trigger recurring run %v", jobId)
+	}
+	return r.CreateRun(ctx, run)
+}
+
+// The states of the runs that have not reached a final state.
+var activeRunStates = []string{
+	string(model.RuntimeStatePending),
+	string(model.RuntimeStateRunning),
+	string(model.RuntimeStateCancelling),
+	string(model.RuntimeStatePaused),
+}
+
+// Counts the runs of a recurring run that have not reached a final state.
+func (r *ResourceManager) countActiveJobRuns(jobId string) (int, error) {
+	activeFilter, err := filter.New(&apiv2beta1.Filter{
+		Predicates: []*apiv2beta1.Predicate{{
+			Key:       "state",
+			Operation: apiv2beta1.Predicate_IN,
+			Value: &apiv2beta1.Predicate_StringValues_{
+				StringValues: &apiv2beta1.Predicate_StringValues{Values: activeRunStates},
+			},
+		}},
+	})
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to create a filter of active runs")
+	}
+	opts, err := list.NewOptions(&model.Run{}, 1, "", activeFilter)
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to create list options")
+	}
+	filterContext := &model.FilterContext{ReferenceKey: &model.ReferenceKey{Type: model.JobResourceType, ID: jobId}}
+	_, totalSize, _, err := r.runStore.ListRuns(filterContext, opts)
+	return totalSize, err
+}
+
 // Deletes a recurring run with given id.
 func (r *ResourceManager) DeleteJob(ctx context.Context, jobId string) error {
 	job, err := r.GetJob(jobId)
@@ -1268,13 +2640,39 @@ func (r *ResourceManager) DeleteJob(ctx context.Context, jobId string) error {
 // Creates new tasks or updates existing ones.
 // This is not a part of internal API exposed to persistence agent only.
 func (r *ResourceManager) CreateOrUpdateTasks(t []*model.Task) ([]*model.Task, error) {
-	tasks, err := r.taskStore.CreateOrUpdateTasks(t)
+	tasks, existingTasks, err := r.taskStore.UpsertTasks(t)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create or update tasks")
 	}
+	r.publishTaskChanges(tasks, existingTasks)
 	return tasks, nil
 }
 
+// Sends the tasks that were created or changed state to the run watchers.
+func (r *ResourceManager) publishTaskChanges(tasks []*model.Task, existingTasks map[string]*model.Task) {
+	runs := make(map[string]*model.Run)
+	for _, task := range tasks {
+		eventType := RunEventUpdated
+		existingTask, ok := existingTasks[task.PodName]
+		if !ok {
+			eventType = RunEventCreated
+		} else if comparedTaskState(existingTask) == task.State.ToV2() {
+			continue
+		}
+		run, ok := runs[task.RunId]
+		if !ok {
+			var err error
+			if run, err = r.runStore.GetRun(task.RunId); err != nil {
+				glog.Warningf("Failed to send the changes of the tasks of run %v to watchers: %v", task.RunId, err)
+			}
+			runs[task.RunId] = run
+		}
+		if run != nil {
+			r.runWatchHub.publish(eventType, run, task, run.State)
+		}
+	}
+}
+
 // Reports a workflow CR.
 // This is called to update runs.
 func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec util.ExecutionSpec) (util.ExecutionSpec, error) {
@@ -1313,10 +2711,17 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 	state := model.RuntimeState(string(execStatus.Condition())).ToV2()
 	if execSpec.IsTerminating() {
 		state = model.RuntimeState(string(exec.ExecutionPhase(model.RunTerminatingConditionsV1))).ToV2()
+	} else if execSpec.IsPaused() {
+		// Argo keeps the phase of a suspended workflow as Running, so the
+		// paused state is derived from the spec.
+		state = model.RuntimeStatePaused
 	}
 	// If run already exists, simply update it
+	previousState := model.RuntimeStateUnspecified
+	runCreated := false
 	run, updateError := r.GetRun(runId)
 	if updateError == nil {
+		previousState = run.State.ToV2()
 		run.State = state
 		run.Conditions = string(state.ToV1())
 		run.FinishedAtInSec = execStatus.FinishedAt()
@@ -1403,6 +2808,7 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 			ExperimentId:   experimentId,
 			RecurringRunId: jobId,
 			DisplayName:    execSpec.ExecutionName(),
+			Labels:         existingJob.Labels,
 			K8SName:        execSpec.ExecutionName(),
 			StorageState:   model.StorageStateAvailable,
 			Namespace:      namespace,
@@ -1421,12 +2827,19 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, execSpec u
 			return nil, util.Wrapf(err, "Failed to report a workflow due to error creating run %s", runId)
 		} else {
 			runId = run.UUID
+			runCreated = true
 		}
 		// Upon run creation, update owning experiment
 		if updateError = r.experimentStore.SetLastRunTimestamp(run); updateError != nil {
 			return nil, util.Wrapf(updateError, "Failed to report a workflow for existing run %s during updating the owning experiment.", runId)
 		}
 	}
+	r.notifyRunStateChange(run, previousState)
+	if runCreated {
+		r.runWatchHub.publish(RunEventCreated, run, nil, previousState)
+	} else if previousState != run.State.ToV2() {
+		r.runWatchHub.publish(RunEventUpdated, run, nil, previousState)
+	}
 	if execStatus.IsInFinalState() {
 		err := addWorkflowLabel(ctx, r.getWorkflowClient(execSpec.ExecutionNamespace()), execSpec.ExecutionName(), util.LabelKeyWorkflowPersistedFinalState, "true")
 		if err != nil {
@@ -1606,8 +3019,6 @@ func (r *ResourceManager) CreateDefaultExperiment(namespace string) (string, err
 	return defaultExperiment.UUID, nil
 }
 
-// TODO(gkcalat): deprecate this as we no longer have metrics in the v2beta1 run message.
-// Read metrics as ordinary artifacts instead.
 // Creates a run metric entry.
 func (r *ResourceManager) ReportMetric(metric *model.RunMetric) error {
 	err := r.runStore.CreateMetric(metric)
@@ -1617,13 +3028,143 @@ func (r *ResourceManager) ReportMetric(metric *model.RunMetric) error {
 	return nil
 }
 
+// Fetches the metrics of a run.
+func (r *ResourceManager) ListRunMetrics(runId string) ([]*model.RunMetric, error) {
+	if _, err := r.runStore.GetRun(runId); err != nil {
+		return nil, util.Wrapf(err, "Failed to list the metrics of run %v", runId)
+	}
+	metrics, err := r.runStore.ListMetrics(runId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list the metrics of run %v", runId)
+	}
+	return metrics, nil
+}
+
+// Compares runs side by side. The comparison has one row per runtime parameter,
+// task state, task duration, metric and output artifact found in any of the runs.
+// Tasks are matched by their name prefixed with the names of their ancestors,
+// so that the tasks of each iteration of a ParallelFor are compared separately.
+func (r *ResourceManager) CompareRuns(ctx context.Context, runIds []string) (*model.RunComparison, error) {
+	if len(runIds) < 2 || len(runIds) > maxComparedRuns {
+		return nil, util.NewInvalidInputError("Failed to compare runs: between 2 and %v runs can be compared, got %v", maxComparedRuns, len(runIds))
+	}
+	table := newRunComparisonTable(len(runIds))
+	seen := make(map[string]bool, len(runIds))
+	for column, runId := range runIds {
+		if seen[runId] {
+			return nil, util.NewInvalidInputError("Failed to compare runs: run %v is listed more than once", runId)
+		}
+		seen[runId] = true
+		run, err := r.runStore.GetRun(runId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to compare runs due to error fetching run %v", runId)
+		}
+		// v1 runs keep their parameters in the pipeline spec.
+		runParameters := run.RuntimeConfig.Parameters
+		if runParameters == "" {
+			runParameters = run.PipelineSpec.Parameters
+		}
+		parameters, err := parseRuntimeParameters(runParameters)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to compare runs due to error parsing the parameters of run %v", runId)
+		}
+		for name, value := range parameters {
+			table.set(model.RunComparisonRowKindParameter, "", name, column, value)
+		}
+		tasks, err := r.listAllRunTasks(runId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to compare runs due to error listing the tasks of run %v", runId)
+		}
+		taskNames := comparedTaskNames(tasks)
+		for _, task := range tasks {
+			if task.Name == "" {
+				continue
+			}
+			taskName := taskNames[task.UUID]
+			table.set(model.RunComparisonRowKindTaskState, taskName, "", column, structpb.NewStringValue(comparedTaskState(task).ToString()))
+			if task.StartedTimestamp > 0 && task.FinishedTimestamp >= task.StartedTimestamp {
+				duration := float64(task.FinishedTimestamp - task.StartedTimestamp)
+				table.set(model.RunComparisonRowKindTaskDuration, taskName, "", column, structpb.NewNumberValue(duration))
+			}
+			artifacts, err := r.getTaskOutputArtifacts(ctx, task)
+			if err != nil {
+				return nil, util.Wrapf(err, "Failed to compare runs due to error fetching the output artifacts of task %v", task.UUID)
+			}
+			for name, artifact := range artifacts {
+				table.set(model.RunComparisonRowKindArtifact, taskName, name, column, structpb.NewStringValue(artifact.Artifact.GetUri()))
+			}
+		}
+		metrics, err := r.runStore.ListMetrics(runId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to compare runs due to error fetching the metrics of run %v", runId)
+		}
+		for _, metric := range metrics {
+			table.set(model.RunComparisonRowKindMetric, metric.NodeID, metric.Name, column, structpb.NewNumberValue(metric.NumberValue))
+		}
+	}
+	return &model.RunComparison{RunIds: runIds, Rows: table.rows()}, nil
+}
+
+// Fetches all tasks of a run, oldest first.
+func (r *ResourceManager) listAllRunTasks(runId string) ([]*model.Task, error) {
+	filterContext := &model.FilterContext{ReferenceKey: &model.ReferenceKey{Type: model.RunResourceType, ID: runId}}
+	opts, err := list.NewOptions(&model.Task{}, 100, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var tasks []*model.Task
+	for {
+		page, _, nextPageToken, err := r.taskStore.ListTasks(filterContext, opts)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, page...)
+		if nextPageToken == "" {
+			return tasks, nil
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, 100); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Fetches the output artifacts of a task from ML Metadata. Tasks without an
+// execution, e.g. v1 tasks, have no output artifacts.
+func (r *ResourceManager) getTaskOutputArtifacts(ctx context.Context, task *model.Task) (map[string]*metadata.OutputArtifact, error) {
+	if r.metadataClient == nil || task.MLMDExecutionID == "" {
+		return nil, nil
+	}
+	executionId, err := strconv.ParseInt(task.MLMDExecutionID, 10, 64)
+	if err != nil || executionId <= 0 {
+		return nil, nil
+	}
+	artifacts, err := r.metadataClient.GetOutputArtifactsByExecutionId(ctx, executionId)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to fetch the output artifacts of execution %v", executionId)
+	}
+	return artifacts, nil
+}
+
 // ReadArtifact parses run's workflow to find artifact file path and reads the content of the file
-// from object store.
+// from object store. Output artifacts of v2 runs with a file:// pipeline root are read from the
+// filesystem, which must be mounted into the API server at the same path as into the pipeline pods.
 func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName string) ([]byte, error) {
 	run, err := r.runStore.GetRun(runID)
 	if err != nil {
 		return nil, err
 	}
+	if run.PipelineSpec.PipelineSpecManifest != "" {
+		uri, err := r.findFileArtifactURI(context.TODO(), run, nodeID, artifactName)
+		if err != nil {
+			glog.Warningf("Reading artifact %v of run %v from the object store, as its file could not be looked up: %v", artifactName, runID, err)
+		} else if uri != "" {
+			content, err := r.readFilePipelineRootURI(uri)
+			if err != nil {
+				return nil, util.Wrapf(err, "Failed to read artifact %v of run %v", artifactName, runID)
+			}
+			return content, nil
+		}
+	}
 	if run.WorkflowRuntimeManifest == "" {
 		return nil, util.NewInvalidInputError("read artifact from run with v2 IR spec is not supported")
 	}
@@ -1641,6 +3182,80 @@ func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName
 	return r.objectStore.GetFile(context.TODO(), artifactPath)
 }
 
+// Returns the file:// URI ML Metadata records for an output artifact of the
+// v2 task with the given node id, if the pipeline root of the run is a
+// file:// root. Returns an empty URI if there is no such artifact.
+func (r *ResourceManager) findFileArtifactURI(ctx context.Context, run *model.Run, nodeId string, artifactName string) (string, error) {
+	pipelineRoot, err := r.getRunPipelineRoot(ctx, run)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(pipelineRoot, objectstore.FileScheme) {
+		return "", nil
+	}
+	tasks, err := r.listAllRunTasks(run.UUID)
+	if err != nil {
+		return "", util.Wrapf(err, "Failed to list the tasks of run %v", run.UUID)
+	}
+	for _, task := range tasks {
+		if task.PodName != nodeId && task.UUID != nodeId {
+			continue
+		}
+		artifacts, err := r.getTaskOutputArtifacts(ctx, task)
+		if err != nil {
+			return "", err
+		}
+		artifact, ok := artifacts[artifactName]
+		if ok && strings.HasPrefix(artifact.Artifact.GetUri(), objectstore.FileScheme) {
+			return artifact.Artifact.GetUri(), nil
+		}
+	}
+	return "", nil
+}
+
+// Reads the file at a file:// URI if it is under one of the file:// pipeline
+// roots served by the API server. The path is checked both as is and once its
+// symbolic links are resolved, so that it cannot point outside of the roots.
+func (r *ResourceManager) readFilePipelineRootURI(uri string) ([]byte, error) {
+	artifactPath := filepath.Clean(strings.TrimPrefix(uri, "file://"))
+	for _, root := range r.getFilePipelineRoots() {
+		rootPath := filepath.Clean(strings.TrimPrefix(root, "file://"))
+		if !isPathUnder(rootPath, artifactPath) {
+			continue
+		}
+		resolvedRootPath, err := filepath.EvalSymlinks(rootPath)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to resolve pipeline root %v. It must be mounted into the API server", root)
+		}
+		resolvedArtifactPath, err := filepath.EvalSymlinks(artifactPath)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, util.NewResourceNotFoundError("artifact", uri)
+			}
+			return nil, util.NewInternalServerError(err, "Failed to resolve artifact %v", uri)
+		}
+		if !isPathUnder(resolvedRootPath, resolvedArtifactPath) {
+			break
+		}
+		content, err := os.ReadFile(resolvedArtifactPath)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to read artifact %v", uri)
+		}
+		return content, nil
+	}
+	return nil, util.NewPermissionDeniedError(errors.New("artifact is outside of the file pipeline roots"), "Artifact %v is not under a file:// pipeline root served by the API server", uri)
+}
+
+// Returns the file:// pipeline roots served by the API server: the configured
+// ones and the default pipeline root if it is on a filesystem.
+func (r *ResourceManager) getFilePipelineRoots() []string {
+	roots := r.options.FilePipelineRoots
+	if defaultRoot := common.GetDefaultPipelineRoot(); strings.HasPrefix(defaultRoot, objectstore.FileScheme) {
+		roots = append(append([]string(nil), roots...), defaultRoot)
+	}
+	return roots
+}
+
 // Fetches the default experiment id.
 func (r *ResourceManager) GetDefaultExperimentId() (string, error) {
 	return r.defaultExperimentStore.GetDefaultExperimentId()
@@ -1824,6 +3439,36 @@ func (r *ResourceManager) GetPipelineVersionTemplate(pipelineVersionId string) (
 	}
 }
 
+// Compares the templates of two pipeline versions. Both versions must have
+// templates of the same type.
+func (r *ResourceManager) DiffPipelineVersions(basePipelineVersionId string, targetPipelineVersionId string) ([]*model.PipelineVersionChange, error) {
+	baseTemplate, err := r.parsePipelineVersionTemplate(basePipelineVersionId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to diff pipeline versions due to an error with the base pipeline version")
+	}
+	targetTemplate, err := r.parsePipelineVersionTemplate(targetPipelineVersionId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to diff pipeline versions due to an error with the target pipeline version")
+	}
+	changes, err := template.Diff(baseTemplate, targetTemplate)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to diff pipeline version %v against pipeline version %v", targetPipelineVersionId, basePipelineVersionId)
+	}
+	return changes, nil
+}
+
+func (r *ResourceManager) parsePipelineVersionTemplate(pipelineVersionId string) (template.Template, error) {
+	bytes, err := r.GetPipelineVersionTemplate(pipelineVersionId)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(bytes, r.options.CacheDisabled, r.options.DefaultWorkspace)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to parse the template of pipeline version %v", pipelineVersionId)
+	}
+	return tmpl, nil
+}
+
 // Verifies whether the user identity, which is contained in the context object,
 // can perform some action (verb) on a resource (resourceType/resourceName) living in the
 // target namespace. If the returned error is nil, the authorization passes. Otherwise,
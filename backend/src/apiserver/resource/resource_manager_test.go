@@ -16,8 +16,14 @@ package resource
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -34,13 +40,20 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	scheduledworkflowclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	mlmd "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -63,6 +76,14 @@ func (m *FakeBadObjectStore) DeleteFile(ctx context.Context, filePath string) er
 	return errors.New("Not implemented")
 }
 
+func (m *FakeBadObjectStore) DeleteFolder(ctx context.Context, folderPath string) error {
+	return errors.New("Not implemented")
+}
+
+func (m *FakeBadObjectStore) GetBucketName() string {
+	return ""
+}
+
 func (m *FakeBadObjectStore) GetFile(ctx context.Context, filePath string) ([]byte, error) {
 	return []byte(""), nil
 }
@@ -1722,6 +1743,40 @@ func TestCreateRun_ThroughWorkflowSpecV2(t *testing.T) {
 	assert.Equal(t, expectedRunDetail.ToV1(), runDetail.ToV1(), "CreateRun stored invalid data in database")
 }
 
+func TestCreateRun_Labels(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	run := &model.Run{
+		DisplayName: "run1",
+		Labels:      map[string]string{"team": "vision"},
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorld,
+			RuntimeConfig: model.RuntimeConfig{
+				Parameters: "{\"text\":\"world\"}",
+			},
+		},
+		ExperimentId: exp.UUID,
+	}
+	createdRun, err := manager.CreateRun(context.Background(), run)
+	require.Nil(t, err)
+
+	wf, err := store.ExecClientFake.Execution("ns1").Get(context.Background(), createdRun.K8SName, v1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "vision", wf.ExecutionObjectMeta().Labels["team"])
+	assert.Equal(t, createdRun.UUID, wf.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowRunId])
+
+	createdRun.Description = "updated run"
+	createdRun.Labels = map[string]string{"team": "nlp"}
+	updatedRun, err := manager.UpdateRun(createdRun)
+	require.Nil(t, err)
+	assert.Equal(t, "updated run", updatedRun.Description)
+	assert.Equal(t, map[string]string{"team": "nlp"}, updatedRun.Labels)
+
+	_, err = manager.UpdateRun(&model.Run{UUID: "non-existent"})
+	require.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestCreateRun_ThroughWorkflowSpec(t *testing.T) {
 	store, manager, runDetail := initWithOneTimeRun(t)
 	expectedExperimentUUID := runDetail.ExperimentId
@@ -2160,6 +2215,330 @@ func TestDeleteRun_DbFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), "database is closed")
 }
 
+func TestDeleteRuns(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	errs := manager.DeleteRuns(context.Background(), []string{runDetail.UUID, "does-not-exist"})
+	assert.Len(t, errs, 2)
+	assert.Nil(t, errs[0])
+	assert.Equal(t, codes.NotFound, errs[1].(*util.UserError).ExternalStatusCode())
+
+	_, err := manager.GetRun(runDetail.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Equal(t, 0, store.ExecClientFake.GetWorkflowCount())
+}
+
+func TestArchiveAndUnarchiveRuns(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	errs := manager.ArchiveRuns([]string{runDetail.UUID, "does-not-exist"})
+	assert.Nil(t, errs[0])
+	assert.Equal(t, codes.NotFound, errs[1].(*util.UserError).ExternalStatusCode())
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateArchived, actualRunDetail.StorageState)
+
+	errs = manager.UnarchiveRuns([]string{runDetail.UUID})
+	assert.Nil(t, errs[0])
+	actualRunDetail, err = manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateAvailable, actualRunDetail.StorageState)
+}
+
+func TestUnarchiveRuns_Failed_ExperimentArchived(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	err := manager.ArchiveExperiment(context.Background(), runDetail.ExperimentId)
+	assert.Nil(t, err)
+
+	errs := manager.UnarchiveRuns([]string{runDetail.UUID})
+	assert.NotNil(t, errs[0])
+	assert.Contains(t, errs[0].Error(), "must be un-archived first")
+}
+
+func TestTerminateRuns(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	errs := manager.TerminateRuns(context.Background(), []string{runDetail.UUID, "does-not-exist"})
+	assert.Nil(t, errs[0])
+	assert.Equal(t, codes.NotFound, errs[1].(*util.UserError).ExternalStatusCode())
+
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Terminating", actualRunDetail.Conditions)
+	isTerminated, err := store.ExecClientFake.IsTerminated(runDetail.K8SName)
+	assert.Nil(t, err)
+	assert.True(t, isTerminated)
+
+	// A terminating run cannot be terminated again.
+	errs = manager.TerminateRuns(context.Background(), []string{runDetail.UUID})
+	assert.Equal(t, codes.InvalidArgument, errs[0].(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, errs[0].Error(), "not active")
+}
+
+func TestTerminateRuns_DbFailure(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	store.DB().Close()
+	errs := manager.TerminateRuns(context.Background(), []string{runDetail.UUID})
+	assert.Equal(t, codes.Internal, errs[0].(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, errs[0].Error(), "database is closed")
+}
+
+func TestEnforceRunRetentionPolicies_MaxAge(t *testing.T) {
+	store, manager, failedRun := initWithOneTimeFailedRun(t)
+	defer store.Close()
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store, &ResourceManagerOptions{
+		RunRetentionPolicies: []*RunRetentionPolicy{{ExperimentId: failedRun.ExperimentId, MaxAge: time.Hour}},
+	})
+	runningRun, err := manager.CreateRun(context.Background(), &model.Run{
+		DisplayName:  "run2",
+		ExperimentId: failedRun.ExperimentId,
+		PipelineSpec: model.PipelineSpec{WorkflowSpecManifest: testWorkflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+
+	// Nothing is old enough yet.
+	err = manager.EnforceRunRetentionPolicies(context.Background())
+	assert.Nil(t, err)
+	_, err = manager.GetRun(failedRun.UUID)
+	assert.Nil(t, err)
+
+	manager.time = util.NewFakeTime(time.Unix(0, 0).Add(2 * time.Hour))
+	err = manager.EnforceRunRetentionPolicies(context.Background())
+	assert.Nil(t, err)
+	_, err = manager.GetRun(failedRun.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	// Runs that have not finished are kept.
+	_, err = manager.GetRun(runningRun.UUID)
+	assert.Nil(t, err)
+}
+
+func TestEnforceRunRetentionPolicies_ArchiveAfter(t *testing.T) {
+	store, manager, failedRun := initWithOneTimeFailedRun(t)
+	defer store.Close()
+	manager.options.RunRetentionPolicies = []*RunRetentionPolicy{{ArchiveAfter: time.Hour}}
+	manager.time = util.NewFakeTime(time.Unix(0, 0).Add(2 * time.Hour))
+
+	err := manager.EnforceRunRetentionPolicies(context.Background())
+	assert.Nil(t, err)
+	run, err := manager.GetRun(failedRun.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateArchived, run.StorageState)
+}
+
+func TestEnforceRunRetentionPolicies_MaxRuns(t *testing.T) {
+	store, manager, olderRun := initWithOneTimeFailedRun(t)
+	defer store.Close()
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store, &ResourceManagerOptions{
+		RunRetentionPolicies: []*RunRetentionPolicy{{MaxRuns: 1}},
+	})
+	newerRun, err := manager.CreateRun(context.Background(), &model.Run{
+		DisplayName:  "run2",
+		ExperimentId: olderRun.ExperimentId,
+		PipelineSpec: model.PipelineSpec{WorkflowSpecManifest: testWorkflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+	workflow := util.NewWorkflow(testWorkflow.DeepCopy())
+	workflow.SetLabels(util.LabelKeyWorkflowRunId, newerRun.UUID)
+	workflow.Status.Phase = v1alpha1.WorkflowSucceeded
+	_, err = manager.ReportWorkflowResource(context.Background(), workflow)
+	assert.Nil(t, err)
+
+	// Runs that have not finished do not count towards the maximum.
+	manager.uuid = util.NewFakeUUIDGeneratorOrFatal(NonDefaultFakeUUID, nil)
+	runningRun, err := manager.CreateRun(context.Background(), &model.Run{
+		DisplayName:  "run3",
+		ExperimentId: olderRun.ExperimentId,
+		PipelineSpec: model.PipelineSpec{WorkflowSpecManifest: testWorkflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+
+	err = manager.EnforceRunRetentionPolicies(context.Background())
+	assert.Nil(t, err)
+	_, err = manager.GetRun(olderRun.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	_, err = manager.GetRun(newerRun.UUID)
+	assert.Nil(t, err)
+	_, err = manager.GetRun(runningRun.UUID)
+	assert.Nil(t, err)
+}
+
+func TestEnforceRunRetentionPolicies_MaxRuns_Pages(t *testing.T) {
+	defer func(pageSize int) { runRetentionPageSize = pageSize }(runRetentionPageSize)
+	runRetentionPageSize = 1
+	store, manager, olderRun := initWithOneTimeFailedRun(t)
+	defer store.Close()
+	manager.options.RunRetentionPolicies = []*RunRetentionPolicy{{MaxRuns: 1}}
+	var newerRuns []*model.Run
+	for _, uuid := range []string{FakeUUIDOne, NonDefaultFakeUUID} {
+		manager.uuid = util.NewFakeUUIDGeneratorOrFatal(uuid, nil)
+		run, err := manager.CreateRun(context.Background(), &model.Run{
+			DisplayName:  "run-" + uuid,
+			ExperimentId: olderRun.ExperimentId,
+			PipelineSpec: model.PipelineSpec{WorkflowSpecManifest: testWorkflow.ToStringForStore()},
+		})
+		assert.Nil(t, err)
+		workflow := util.NewWorkflow(testWorkflow.DeepCopy())
+		workflow.SetLabels(util.LabelKeyWorkflowRunId, run.UUID)
+		workflow.Status.Phase = v1alpha1.WorkflowSucceeded
+		_, err = manager.ReportWorkflowResource(context.Background(), workflow)
+		assert.Nil(t, err)
+		newerRuns = append(newerRuns, run)
+	}
+
+	err := manager.EnforceRunRetentionPolicies(context.Background())
+	assert.Nil(t, err)
+	_, err = manager.GetRun(olderRun.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	_, err = manager.GetRun(newerRuns[0].UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	_, err = manager.GetRun(newerRuns[1].UUID)
+	assert.Nil(t, err)
+}
+
+func TestPurgeRunArtifacts(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	minioClient := storage.NewFakeMinioClient()
+	manager.objectStore = storage.NewMinioObjectStore(minioClient, "mlpipeline", "pipelines", false)
+	manager.objectStore.AddFile(context.Background(), []byte("abc"), "v2/artifacts/hello-world/run1/task/output")
+	manager.objectStore.AddFile(context.Background(), []byte("abc"), "v2/artifacts/hello-world/run2/task/output")
+	run := &model.Run{
+		UUID: "run1",
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorld,
+			RuntimeConfig:        model.RuntimeConfig{PipelineRoot: "minio://mlpipeline/v2/artifacts"},
+		},
+	}
+
+	err := manager.purgeRunArtifacts(context.Background(), run)
+	assert.Nil(t, err)
+	assert.False(t, minioClient.ExistObject("v2/artifacts/hello-world/run1/task/output"))
+	assert.True(t, minioClient.ExistObject("v2/artifacts/hello-world/run2/task/output"))
+
+	run.PipelineSpec.RuntimeConfig.PipelineRoot = "gs://other-bucket/v2/artifacts"
+	err = manager.purgeRunArtifacts(context.Background(), run)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not in bucket mlpipeline")
+}
+
+func TestPurgeRunArtifacts_LauncherConfig(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	minioClient := storage.NewFakeMinioClient()
+	manager.objectStore = storage.NewMinioObjectStore(minioClient, "mlpipeline", "pipelines", false)
+	manager.objectStore.AddFile(context.Background(), []byte("abc"), "v2/artifacts/hello-world/run1/task/output")
+	manager.objectStore.AddFile(context.Background(), []byte("abc"), "ns1/artifacts/hello-world/run1/task/output")
+	_, err := store.KubernetesCoreClient().ConfigMapClient("ns1").Create(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: "kfp-launcher", Namespace: "ns1"},
+		Data:       map[string]string{"defaultPipelineRoot": "minio://mlpipeline/ns1/artifacts"},
+	}, v1.CreateOptions{})
+	assert.Nil(t, err)
+	run := &model.Run{
+		UUID:         "run1",
+		Namespace:    "ns1",
+		PipelineSpec: model.PipelineSpec{PipelineSpecManifest: v2SpecHelloWorld},
+	}
+
+	// The run has no pipeline root, so the one of the launcher config applies.
+	err = manager.purgeRunArtifacts(context.Background(), run)
+	assert.Nil(t, err)
+	assert.False(t, minioClient.ExistObject("ns1/artifacts/hello-world/run1/task/output"))
+	assert.True(t, minioClient.ExistObject("v2/artifacts/hello-world/run1/task/output"))
+
+	// Without a launcher config, the default pipeline root of the launcher applies.
+	run.Namespace = "ns2"
+	err = manager.purgeRunArtifacts(context.Background(), run)
+	assert.Nil(t, err)
+	assert.False(t, minioClient.ExistObject("v2/artifacts/hello-world/run1/task/output"))
+}
+
+func TestRunRetentionPolicy_Validate(t *testing.T) {
+	assert.Nil(t, (&RunRetentionPolicy{Namespace: "ns1", MaxRuns: 10}).Validate())
+	assert.NotNil(t, (&RunRetentionPolicy{Namespace: "ns1", ExperimentId: "exp1", MaxRuns: 10}).Validate())
+	assert.NotNil(t, (&RunRetentionPolicy{Namespace: "ns1"}).Validate())
+	assert.NotNil(t, (&RunRetentionPolicy{MaxAge: -time.Hour}).Validate())
+}
+
+func TestRetryRuns(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeFailedRun(t)
+	defer store.Close()
+
+	errs := manager.RetryRuns(context.Background(), []string{runDetail.UUID, "does-not-exist"})
+	assert.Len(t, errs, 2)
+	assert.Nil(t, errs[0])
+	assert.Equal(t, codes.NotFound, errs[1].(*util.UserError).ExternalStatusCode())
+
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Contains(t, actualRunDetail.WorkflowRuntimeManifest, "Running")
+	assert.Equal(t, model.RuntimeStateRunning, actualRunDetail.RunDetails.State)
+}
+
+func TestRetryRuns_FailedDeletePods(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeFailedRun(t)
+	defer store.Close()
+
+	manager.k8sCoreClient = client.NewFakeKubernetesCoreClientWithBadPodClient()
+	errs := manager.RetryRuns(context.Background(), []string{runDetail.UUID})
+	assert.NotNil(t, errs[0])
+	assert.Contains(t, errs[0].Error(), "failed to delete pod")
+
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Contains(t, actualRunDetail.WorkflowRuntimeManifest, "Failed")
+}
+
+func TestArchiveAndUnarchiveExperiments(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	errs := manager.ArchiveExperiments(context.Background(), []string{runDetail.ExperimentId, "does-not-exist"})
+	assert.Len(t, errs, 2)
+	assert.Nil(t, errs[0])
+	assert.Equal(t, codes.NotFound, errs[1].(*util.UserError).ExternalStatusCode())
+	experiment, err := manager.GetExperiment(runDetail.ExperimentId)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateArchived, experiment.StorageState)
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateArchived, actualRunDetail.StorageState)
+
+	errs = manager.UnarchiveExperiments([]string{runDetail.ExperimentId, "does-not-exist"})
+	assert.Nil(t, errs[0])
+	assert.Equal(t, codes.NotFound, errs[1].(*util.UserError).ExternalStatusCode())
+	experiment, err = manager.GetExperiment(runDetail.ExperimentId)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateAvailable, experiment.StorageState)
+	// The runs of the experiment stay archived.
+	actualRunDetail, err = manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateArchived, actualRunDetail.StorageState)
+}
+
+func TestDeleteExperiments(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	errs := manager.DeleteExperiments([]string{experiment.UUID, "does-not-exist"})
+	assert.Len(t, errs, 2)
+	assert.Nil(t, errs[0])
+	assert.Equal(t, codes.NotFound, errs[1].(*util.UserError).ExternalStatusCode())
+
+	_, err := manager.GetExperiment(experiment.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestDeleteExperiment(t *testing.T) {
 	store, manager, experiment := initWithExperiment(t)
 	defer store.Close()
@@ -2171,6 +2550,17 @@ func TestDeleteExperiment(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func TestGetExperimentByNameAndNamespace(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+	found, err := manager.GetExperimentByNameAndNamespace("e1", "ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, experiment.UUID, found.UUID)
+
+	_, err = manager.GetExperimentByNameAndNamespace("e1", "ns2")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestDeleteExperiment_ClearsDefaultExperiment(t *testing.T) {
 	store, manager, experiment := initWithExperiment(t)
 	defer store.Close()
@@ -2250,6 +2640,82 @@ func TestTerminateRun_DbFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), "database is closed")
 }
 
+func TestPauseAndResumeRun(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	err := manager.PauseRun(context.Background(), runDetail.UUID)
+	assert.Nil(t, err)
+
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStatePaused, actualRunDetail.State)
+
+	isPaused, err := store.ExecClientFake.IsPaused(runDetail.K8SName)
+	assert.Nil(t, err)
+	assert.True(t, isPaused)
+
+	err = manager.ResumeRun(context.Background(), runDetail.UUID)
+	assert.Nil(t, err)
+
+	actualRunDetail, err = manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStateRunning, actualRunDetail.State)
+
+	isPaused, err = store.ExecClientFake.IsPaused(runDetail.K8SName)
+	assert.Nil(t, err)
+	assert.False(t, isPaused)
+}
+
+func TestPauseAndResumeRun_WorkflowFailure(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	manager.execClient = client.NewFakeExecClientWithBadWorkflow()
+	err := manager.PauseRun(context.Background(), runDetail.UUID)
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "suspending its workflow")
+
+	// The run is left as is when its workflow cannot be suspended.
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, runDetail.State, actualRunDetail.State)
+
+	manager.execClient = store.ExecClientFake
+	err = manager.PauseRun(context.Background(), runDetail.UUID)
+	assert.Nil(t, err)
+	manager.execClient = client.NewFakeExecClientWithBadWorkflow()
+	err = manager.ResumeRun(context.Background(), runDetail.UUID)
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "resuming its workflow")
+
+	// The run stays paused when its workflow cannot be resumed.
+	actualRunDetail, err = manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStatePaused, actualRunDetail.State)
+	isPaused, err := store.ExecClientFake.IsPaused(runDetail.K8SName)
+	assert.Nil(t, err)
+	assert.True(t, isPaused)
+}
+
+func TestPauseRun_RunNotExist(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	err := manager.PauseRun(context.Background(), "1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestResumeRun_RunNotPaused(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	err := manager.ResumeRun(context.Background(), runDetail.UUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "not paused")
+}
+
 func TestRetryRun(t *testing.T) {
 	store, manager, runDetail := initWithOneTimeFailedRun(t)
 	defer store.Close()
@@ -2866,6 +3332,26 @@ func TestReportWorkflowResource_ScheduledWorkflowIDEmpty_Success(t *testing.T) {
 	assert.Equal(t, expectedRun.ToV1(), run.ToV1())
 }
 
+func TestReportWorkflowResource_WorkflowPaused(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	suspend := true
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+			Namespace: "ns1",
+		},
+		Spec:   v1alpha1.WorkflowSpec{Suspend: &suspend},
+		Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning},
+	})
+	_, err := manager.ReportWorkflowResource(context.Background(), workflow)
+	assert.Nil(t, err)
+	run, err = manager.GetRun(run.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStatePaused, run.State)
+}
+
 func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_Success(t *testing.T) {
 	store, manager, job := initWithJob(t)
 	defer store.Close()
@@ -3250,13 +3736,424 @@ func TestReconcileSwfCrs(t *testing.T) {
 	require.NotNil(t, swf.Spec.Workflow.Spec)
 }
 
-func TestReportScheduledWorkflowResource_Error(t *testing.T) {
-	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+func TestUpdateJob(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
 	defer store.Close()
-	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
-	manager.CreateDefaultExperiment("")
-	// Create pipeline
-	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+
+	job.DisplayName = "j1-updated"
+	job.MaxConcurrency = 5
+	job.Trigger = model.Trigger{
+		CronSchedule: model.CronSchedule{
+			Cron: util.StringPointer("0 0 * * *"),
+		},
+	}
+	job.RuntimeConfig.Parameters = "{\"text\":\"world-updated\"}"
+	updatedJob, err := manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+
+	expectedJob := &model.Job{
+		UUID:           "123e4567-e89b-12d3-a456-426655440000",
+		DisplayName:    "j1-updated",
+		K8SName:        "job-",
+		Namespace:      "ns1",
+		ServiceAccount: "pipeline-runner",
+		Enabled:        true,
+		MaxConcurrency: 5,
+		ExperimentId:   DefaultFakeUUID,
+		CreatedAtInSec: 2,
+		UpdatedAtInSec: 3,
+		Conditions:     "STATUS_UNSPECIFIED",
+		Trigger: model.Trigger{
+			CronSchedule: model.CronSchedule{
+				Cron: util.StringPointer("0 0 * * *"),
+			},
+		},
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorld,
+			PipelineName:         job.PipelineSpec.PipelineName,
+			RuntimeConfig: model.RuntimeConfig{
+				Parameters:   "{\"text\":\"world-updated\"}",
+				PipelineRoot: "job-1-root",
+			},
+		},
+	}
+	assert.Equal(t, expectedJob.ToV1(), updatedJob.ToV1())
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), *swf.Spec.MaxConcurrency)
+	assert.Equal(t, "0 0 * * *", swf.Spec.Trigger.CronSchedule.Cron)
+	assert.Equal(t, `"world-updated"`, swf.Spec.Workflow.Parameters[0].Value)
+}
+
+func TestUpdateJob_Labels(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+
+	job.Labels = map[string]string{"team": "vision"}
+	updatedJob, err := manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, updatedJob.Labels)
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, swf.Labels)
+}
+
+func TestUpdateJob_ScheduledWorkflowNotFound(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+
+	err := store.SwfClient().ScheduledWorkflow("ns1").Delete(context.Background(), "job-", &v1.DeleteOptions{})
+	require.Nil(t, err)
+
+	job.MaxConcurrency = 5
+	_, err = manager.UpdateJob(context.Background(), job)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+// Fails the given number of ScheduledWorkflow updates with a conflict.
+type conflictingSwfClient struct {
+	client.SwfClientInterface
+	conflicts int
+}
+
+func (c *conflictingSwfClient) ScheduledWorkflow(namespace string) scheduledworkflowclient.ScheduledWorkflowInterface {
+	return &conflictingScheduledWorkflowClient{ScheduledWorkflowInterface: c.SwfClientInterface.ScheduledWorkflow(namespace), swfClient: c}
+}
+
+type conflictingScheduledWorkflowClient struct {
+	scheduledworkflowclient.ScheduledWorkflowInterface
+	swfClient *conflictingSwfClient
+}
+
+func (c *conflictingScheduledWorkflowClient) Update(ctx context.Context, scheduledWorkflow *swfapi.ScheduledWorkflow) (*swfapi.ScheduledWorkflow, error) {
+	if c.swfClient.conflicts > 0 {
+		c.swfClient.conflicts--
+		return nil, apierrors.NewConflict(schema.GroupResource{Group: "kubeflow.org", Resource: "scheduledworkflows"}, scheduledWorkflow.Name, errors.New("object was modified"))
+	}
+	return c.ScheduledWorkflowInterface.Update(ctx, scheduledWorkflow)
+}
+
+func TestUpdateJob_RetriesConflicts(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+	manager.swfClient = &conflictingSwfClient{SwfClientInterface: store.SwfClient(), conflicts: maxScheduledWorkflowUpdateAttempts - 1}
+
+	job.MaxConcurrency = 5
+	_, err := manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), *swf.Spec.MaxConcurrency)
+}
+
+func TestUpdateJob_TooManyConflicts(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+	manager.swfClient = &conflictingSwfClient{SwfClientInterface: store.SwfClient(), conflicts: maxScheduledWorkflowUpdateAttempts}
+
+	job.MaxConcurrency = 5
+	_, err := manager.UpdateJob(context.Background(), job)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Aborted, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestUpdateJob_RollsBackScheduledWorkflow(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	previousSpec := swf.Spec.DeepCopy()
+
+	// Fail the update of the recurring run in the DB. The fake ScheduledWorkflow
+	// shares fields with the created recurring run, so update a copy.
+	_, err = store.DB().Exec("DROP TABLE jobs")
+	require.Nil(t, err)
+	updatedJob := *job
+	updatedJob.MaxConcurrency = 5
+	_, err = manager.UpdateJob(context.Background(), &updatedJob)
+	require.NotNil(t, err)
+
+	swf, err = store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, previousSpec, &swf.Spec)
+}
+
+func TestBackfillJob(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+
+	job.Trigger = model.Trigger{
+		CronSchedule: model.CronSchedule{
+			Cron: util.StringPointer("0 * * * * *"),
+		},
+	}
+	job, err := manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+
+	err = manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(1, 0))
+	require.Nil(t, err)
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	expectedBackfill := &swfapi.Backfill{
+		StartTime: v1.NewTime(time.Unix(0, 0).UTC()),
+		EndTime:   v1.NewTime(time.Unix(1, 0).UTC()),
+	}
+	assert.Equal(t, expectedBackfill, swf.Spec.Backfill)
+
+	// Updating the recurring run keeps the backfill.
+	job.MaxConcurrency = 5
+	_, err = manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+	swf, err = store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, expectedBackfill, swf.Spec.Backfill)
+}
+
+func TestBackfillJob_TimeZone(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+	manager.options.BackfillMaxRuns = 1
+	manager.time = util.NewFakeTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	// Every day at 09:00 in Tokyo, which is 00:00 UTC.
+	job.Trigger = model.Trigger{
+		CronSchedule: model.CronSchedule{
+			Cron:                 util.StringPointer("0 0 9 * * *"),
+			CronScheduleTimeZone: util.StringPointer("Asia/Tokyo"),
+		},
+	}
+	job, err := manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+
+	// The window has 1 trigger time, at its start.
+	err = manager.BackfillJob(context.Background(), job.UUID, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC))
+	require.Nil(t, err)
+
+	// The window has 2 trigger times, at its start and at its end.
+	err = manager.BackfillJob(context.Background(), job.UUID, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "more than 1 trigger times")
+}
+
+func TestBackfillJob_InvalidInput(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+
+	// The end time is in the future.
+	err := manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(1000, 0))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	// The recurring run has no schedule.
+	err = manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(1, 0))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "cron or a periodic schedule")
+
+	err = manager.BackfillJob(context.Background(), "non-existent", time.Unix(0, 0), time.Unix(1, 0))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestBackfillJob_TooManyRuns(t *testing.T) {
+	store, manager, job := initWithJobV2(t)
+	defer store.Close()
+	manager.options.BackfillMaxRuns = 2
+
+	job.Trigger = model.Trigger{
+		PeriodicSchedule: model.PeriodicSchedule{
+			IntervalSecond: util.Int64Pointer(1),
+		},
+	}
+	job, err := manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+
+	// The window has 2 trigger times.
+	err = manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(1, 0))
+	require.Nil(t, err)
+
+	// The window has 3 trigger times.
+	err = manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(2, 0))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "more than 2 trigger times")
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), "job-", v1.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, v1.NewTime(time.Unix(1, 0).UTC()), swf.Spec.Backfill.EndTime)
+}
+
+func initWithWebhookJob(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job) {
+	store, manager, job := initWithJobV2(t)
+	job.Trigger = model.Trigger{}
+	err := job.SetEventTrigger(&model.EventTrigger{
+		Webhook: &model.WebhookTrigger{
+			SecretName:        "webhook-secret",
+			PayloadParameters: map[string]string{"text": "greeting.text"},
+		},
+	})
+	require.Nil(t, err)
+	job, err = manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+	_, err = store.KubernetesCoreClient().SecretClient("ns1").Create(context.Background(), &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "webhook-secret"},
+		Data:       map[string][]byte{"hmac-key": []byte("key")},
+	}, v1.CreateOptions{})
+	require.Nil(t, err)
+	return store, manager, job
+}
+
+// Returns the timestamp of a webhook request sent now and its signature.
+func signWebhookPayload(manager *ResourceManager, key string, payload []byte) (string, string) {
+	timestamp := strconv.FormatInt(manager.time.Now().Unix(), 10)
+	return timestamp, signWebhookRequest(key, timestamp, payload)
+}
+
+func signWebhookRequest(key string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestTriggerJob(t *testing.T) {
+	store, manager, job := initWithWebhookJob(t)
+	defer store.Close()
+
+	payload := []byte(`{"greeting": {"text": "hello"}}`)
+	timestamp, signature := signWebhookPayload(manager, "key", payload)
+	run, err := manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.Nil(t, err)
+	assert.Equal(t, job.UUID, run.RecurringRunId)
+	assert.Equal(t, job.ExperimentId, run.ExperimentId)
+	assert.Equal(t, "ns1", run.Namespace)
+	assert.JSONEq(t, `{"text": "hello"}`, run.PipelineSpec.RuntimeConfig.Parameters)
+
+	storedJob, err := manager.GetJob(job.UUID)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"text": "world"}`, storedJob.RuntimeConfig.Parameters)
+}
+
+func TestTriggerJob_Error(t *testing.T) {
+	store, manager, job := initWithWebhookJob(t)
+	defer store.Close()
+
+	payload := []byte(`{"greeting": {"text": "hello"}}`)
+	timestamp, signature := signWebhookPayload(manager, "other-key", payload)
+	_, err := manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Unauthenticated, err.(*util.UserError).ExternalStatusCode())
+
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, "")
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Unauthenticated, err.(*util.UserError).ExternalStatusCode())
+
+	// The signature does not cover the timestamp.
+	_, signature = signWebhookPayload(manager, "key", payload)
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, "", signature)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Unauthenticated, err.(*util.UserError).ExternalStatusCode())
+
+	// The request was signed too long ago.
+	staleTimestamp := strconv.FormatInt(manager.time.Now().Add(-2*webhookTimestampTolerance).Unix(), 10)
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, staleTimestamp, signWebhookRequest("key", staleTimestamp, payload))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Unauthenticated, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "away from the time of the server")
+
+	// The payload does not have the field of the parameter.
+	payload = []byte(`{"text": "hello"}`)
+	timestamp, signature = signWebhookPayload(manager, "key", payload)
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	// The recurring run does not have a webhook trigger.
+	scheduledStore, scheduledManager, scheduledJob := initWithJobV2(t)
+	defer scheduledStore.Close()
+	timestamp, signature = signWebhookPayload(scheduledManager, "key", payload)
+	_, err = scheduledManager.TriggerJob(context.Background(), scheduledJob.UUID, payload, timestamp, signature)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestTriggerJob_Replayed(t *testing.T) {
+	store, manager, job := initWithWebhookJob(t)
+	defer store.Close()
+	job.MaxConcurrency = 10
+	job, err := manager.UpdateJob(context.Background(), job)
+	require.Nil(t, err)
+
+	payload := []byte(`{"greeting": {"text": "hello"}}`)
+	timestamp, signature := signWebhookPayload(manager, "key", payload)
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.Nil(t, err)
+
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Unauthenticated, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "already delivered")
+
+	// The same payload signed at another time is another request.
+	manager.uuid = util.NewFakeUUIDGeneratorOrFatal(DefaultFakePipelineIdTwo, nil)
+	timestamp, signature = signWebhookPayload(manager, "key", payload)
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.Nil(t, err)
+}
+
+func TestTriggerJob_MaxConcurrency(t *testing.T) {
+	store, manager, job := initWithWebhookJob(t)
+	defer store.Close()
+	// A maximum concurrency of 0 allows one active run.
+
+	payload := []byte(`{"greeting": {"text": "hello"}}`)
+	timestamp, signature := signWebhookPayload(manager, "key", payload)
+	run, err := manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.Nil(t, err)
+
+	timestamp, signature = signWebhookPayload(manager, "key", payload)
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.NotNil(t, err)
+	assert.Equal(t, codes.Unavailable, err.(*util.UserError).ExternalStatusCode())
+
+	// A run that finished no longer counts.
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowSucceeded)
+	manager.uuid = util.NewFakeUUIDGeneratorOrFatal(DefaultFakePipelineIdTwo, nil)
+	_, err = manager.TriggerJob(context.Background(), job.UUID, payload, timestamp, signature)
+	require.Nil(t, err)
+}
+
+func TestApplyEventParameters(t *testing.T) {
+	parameters := map[string]interface{}{"param1": "hello", "count": float64(3)}
+
+	specV1 := &model.PipelineSpec{
+		WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+		Parameters:           `[{"name":"param1","value":"world"}]`,
+	}
+	require.Nil(t, applyEventParameters(specV1, parameters))
+	assert.JSONEq(t, `[{"name":"param1","value":"hello"},{"name":"count","value":"3"}]`, specV1.Parameters)
+
+	specV2 := &model.PipelineSpec{
+		PipelineSpecManifest: v2SpecHelloWorld,
+		RuntimeConfig:        model.RuntimeConfig{Parameters: `{"param1":"world","other":true}`},
+	}
+	require.Nil(t, applyEventParameters(specV2, parameters))
+	assert.JSONEq(t, `{"param1":"hello","other":true,"count":3}`, specV2.RuntimeConfig.Parameters)
+}
+
+func TestReportScheduledWorkflowResource_Error(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	manager.CreateDefaultExperiment("")
+	// Create pipeline
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
 		TypeMeta:   v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
 		ObjectMeta: v1.ObjectMeta{Name: "workflow-name"},
 	})
@@ -3390,6 +4287,138 @@ func TestReadArtifact_WorkflowNoStatus_NotFound(t *testing.T) {
 	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
 }
 
+func TestReadArtifact_FileURI(t *testing.T) {
+	initEnvVars()
+	root := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(root, "pipeline-root", "hello-world"), 0755))
+	require.Nil(t, os.WriteFile(filepath.Join(root, "pipeline-root", "hello-world", "model"), []byte("model content"), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(root, "secret"), []byte("secret"), 0644))
+	require.Nil(t, os.Symlink(filepath.Join(root, "secret"), filepath.Join(root, "pipeline-root", "hello-world", "link")))
+
+	store := NewFakeClientManagerOrFatalV2()
+	defer store.Close()
+	store.MetadataClientFake = &fakeOutputArtifactsClient{
+		FakeClient: metadata.NewFakeClient(),
+		outputArtifacts: map[int64]map[string]*metadata.OutputArtifact{
+			1: {
+				"model":     outputArtifact("model", "file://"+root+"/pipeline-root/hello-world/model"),
+				"missing":   outputArtifact("missing", "file://"+root+"/pipeline-root/hello-world/missing"),
+				"outside":   outputArtifact("outside", "file://"+root+"/secret"),
+				"traversal": outputArtifact("traversal", "file://"+root+"/pipeline-root/../secret"),
+				"link":      outputArtifact("link", "file://"+root+"/pipeline-root/hello-world/link"),
+			},
+		},
+	}
+	manager := NewResourceManager(store, &ResourceManagerOptions{
+		CollectMetrics:    false,
+		FilePipelineRoots: []string{"file://" + root + "/pipeline-root"},
+	})
+	run, err := manager.CreateRun(context.Background(), &model.Run{
+		DisplayName:  "run1",
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorld,
+			RuntimeConfig: model.RuntimeConfig{
+				Parameters:   `{"text":"hello"}`,
+				PipelineRoot: "file://" + root + "/pipeline-root",
+			},
+		},
+	})
+	require.Nil(t, err)
+	_, err = manager.CreateTask(&model.Task{
+		RunId:            run.UUID,
+		PodName:          "node-1",
+		Name:             "hello-world",
+		MLMDExecutionID:  "1",
+		CreatedTimestamp: 1,
+	})
+	require.Nil(t, err)
+
+	content, err := manager.ReadArtifact(run.UUID, "node-1", "model")
+	require.Nil(t, err)
+	assert.Equal(t, "model content", string(content))
+
+	_, err = manager.ReadArtifact(run.UUID, "node-1", "missing")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+
+	// Only the files under the served pipeline roots can be read.
+	for _, artifactName := range []string{"outside", "traversal", "link"} {
+		_, err = manager.ReadArtifact(run.UUID, "node-1", artifactName)
+		assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied), artifactName)
+	}
+	manager = NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	_, err = manager.ReadArtifact(run.UUID, "node-1", "model")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+}
+
+func TestReadArtifact_ObjectStoreFallback(t *testing.T) {
+	initEnvVars()
+	store := NewFakeClientManagerOrFatalV2()
+	defer store.Close()
+	metadataClient := &fakeOutputArtifactsClient{
+		FakeClient: metadata.NewFakeClient(),
+		outputArtifacts: map[int64]map[string]*metadata.OutputArtifact{
+			1: {"model": outputArtifact("model", "file:///pipeline-root/model")},
+		},
+	}
+	store.MetadataClientFake = metadataClient
+	manager := NewResourceManager(store, &ResourceManagerOptions{
+		CollectMetrics:    false,
+		FilePipelineRoots: []string{"file:///pipeline-root"},
+	})
+	require.Nil(t, store.ObjectStore().AddFile(context.TODO(), []byte("object content"), "run/model"))
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		Status: v1alpha1.WorkflowStatus{
+			Nodes: map[string]v1alpha1.NodeStatus{
+				"node-1": {
+					Outputs: &v1alpha1.Outputs{
+						Artifacts: []v1alpha1.Artifact{{
+							Name:             "model",
+							ArtifactLocation: v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{Key: "run/model"}},
+						}},
+					},
+				},
+			},
+		},
+	})
+	for _, pipelineRoot := range []string{"minio://mlpipeline/v2/artifacts", "file:///pipeline-root"} {
+		run, err := manager.CreateRun(context.Background(), &model.Run{
+			DisplayName: "run1",
+			PipelineSpec: model.PipelineSpec{
+				PipelineSpecManifest: v2SpecHelloWorld,
+				RuntimeConfig: model.RuntimeConfig{
+					Parameters:   `{"text":"hello"}`,
+					PipelineRoot: pipelineRoot,
+				},
+			},
+		})
+		require.Nil(t, err)
+		run.WorkflowRuntimeManifest = workflow.ToStringForStore()
+		require.Nil(t, store.RunStore().UpdateRun(run))
+		_, err = manager.CreateTask(&model.Task{
+			RunId:            run.UUID,
+			PodName:          "node-1",
+			Name:             "hello-world",
+			MLMDExecutionID:  "1",
+			CreatedTimestamp: 1,
+		})
+		require.Nil(t, err)
+
+		// The artifacts of runs with an object store root are read from it.
+		if strings.HasPrefix(pipelineRoot, "minio://") {
+			content, err := manager.ReadArtifact(run.UUID, "node-1", "model")
+			require.Nil(t, err)
+			assert.Equal(t, "object content", string(content))
+			continue
+		}
+
+		// The object store is read when the artifact cannot be looked up.
+		metadataClient.err = errors.New("metadata unavailable")
+		content, err := manager.ReadArtifact(run.UUID, "node-1", "model")
+		require.Nil(t, err)
+		assert.Equal(t, "object content", string(content))
+	}
+}
+
 func TestReadArtifact_NoRun_NotFound(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -4080,6 +5109,162 @@ func TestCreateTask(t *testing.T) {
 	assert.Equal(t, expectedTask, storedTask, "The StoredTask return has unexpected value")
 }
 
+// fakeOutputArtifactsClient returns the output artifacts of executions by ID.
+type fakeOutputArtifactsClient struct {
+	*metadata.FakeClient
+	outputArtifacts map[int64]map[string]*metadata.OutputArtifact
+	// Returned instead of the output artifacts if set.
+	err error
+}
+
+func (c *fakeOutputArtifactsClient) GetOutputArtifactsByExecutionId(ctx context.Context, executionId int64) (map[string]*metadata.OutputArtifact, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.outputArtifacts[executionId], nil
+}
+
+func outputArtifact(name string, uri string) *metadata.OutputArtifact {
+	return &metadata.OutputArtifact{Name: name, Artifact: &mlmd.Artifact{Uri: proto.String(uri)}}
+}
+
+func TestCompareRuns(t *testing.T) {
+	initEnvVars()
+	store := NewFakeClientManagerOrFatalV2()
+	defer store.Close()
+	store.MetadataClientFake = &fakeOutputArtifactsClient{
+		FakeClient: metadata.NewFakeClient(),
+		outputArtifacts: map[int64]map[string]*metadata.OutputArtifact{
+			1: {"model": outputArtifact("model", "minio://bucket/run1/model")},
+			2: {"model": outputArtifact("model", "minio://bucket/run2/model")},
+		},
+	}
+	manager := NewResourceManager(store, &ResourceManagerOptions{CollectMetrics: false})
+	experiment, err := manager.CreateExperiment(&model.Experiment{Name: "e1"})
+	require.Nil(t, err)
+
+	var runIds []string
+	for i, parameters := range []string{`{"text":"hello"}`, `{"text":"world"}`} {
+		run, err := manager.CreateRun(context.Background(), &model.Run{
+			DisplayName:  fmt.Sprintf("run%d", i+1),
+			ExperimentId: experiment.UUID,
+			PipelineSpec: model.PipelineSpec{
+				PipelineSpecManifest: v2SpecHelloWorld,
+				RuntimeConfig:        model.RuntimeConfig{Parameters: parameters},
+			},
+		})
+		require.Nil(t, err)
+		runIds = append(runIds, run.UUID)
+	}
+	_, err = manager.CreateTask(&model.Task{
+		RunId:             runIds[0],
+		Name:              "hello-world",
+		MLMDExecutionID:   "1",
+		State:             model.RuntimeStateSucceeded,
+		StateHistory:      []*model.RuntimeStatus{{UpdateTimeInSec: 40, State: model.RuntimeStateSucceeded}},
+		CreatedTimestamp:  1,
+		StartedTimestamp:  10,
+		FinishedTimestamp: 40,
+	})
+	require.Nil(t, err)
+	_, err = manager.CreateTask(&model.Task{
+		RunId:             runIds[1],
+		Name:              "hello-world",
+		MLMDExecutionID:   "2",
+		State:             model.RuntimeStateSucceeded,
+		StateHistory:      []*model.RuntimeStatus{{UpdateTimeInSec: 40, State: model.RuntimeStateSucceeded}},
+		CreatedTimestamp:  1,
+		StartedTimestamp:  10,
+		FinishedTimestamp: 40,
+	})
+	require.Nil(t, err)
+	require.Nil(t, manager.ReportMetric(&model.RunMetric{RunUUID: runIds[0], NodeID: "node-1", Name: "accuracy", NumberValue: 0.9}))
+	require.Nil(t, manager.ReportMetric(&model.RunMetric{RunUUID: runIds[1], NodeID: "node-1", Name: "accuracy", NumberValue: 0.9}))
+	require.Nil(t, manager.ReportMetric(&model.RunMetric{RunUUID: runIds[1], NodeID: "node-1", Name: "loss", NumberValue: 0.1}))
+
+	comparison, err := manager.CompareRuns(context.Background(), runIds)
+	require.Nil(t, err)
+	assert.Equal(t, runIds, comparison.RunIds)
+	expectedRows := []*model.RunComparisonRow{
+		{
+			Kind:    model.RunComparisonRowKindParameter,
+			Key:     "text",
+			Values:  []*structpb.Value{structpb.NewStringValue("hello"), structpb.NewStringValue("world")},
+			Differs: true,
+		},
+		{
+			Kind:     model.RunComparisonRowKindTaskState,
+			TaskName: "hello-world",
+			Values:   []*structpb.Value{structpb.NewStringValue("SUCCEEDED"), structpb.NewStringValue("SUCCEEDED")},
+		},
+		{
+			Kind:     model.RunComparisonRowKindTaskDuration,
+			TaskName: "hello-world",
+			Values:   []*structpb.Value{structpb.NewNumberValue(30), structpb.NewNumberValue(30)},
+		},
+		{
+			Kind:     model.RunComparisonRowKindMetric,
+			TaskName: "node-1",
+			Key:      "accuracy",
+			Values:   []*structpb.Value{structpb.NewNumberValue(0.9), structpb.NewNumberValue(0.9)},
+		},
+		{
+			Kind:     model.RunComparisonRowKindMetric,
+			TaskName: "node-1",
+			Key:      "loss",
+			Values:   []*structpb.Value{nil, structpb.NewNumberValue(0.1)},
+			Differs:  true,
+		},
+		{
+			Kind:     model.RunComparisonRowKindArtifact,
+			TaskName: "hello-world",
+			Key:      "model",
+			Values:   []*structpb.Value{structpb.NewStringValue("minio://bucket/run1/model"), structpb.NewStringValue("minio://bucket/run2/model")},
+			Differs:  true,
+		},
+	}
+	require.Len(t, comparison.Rows, len(expectedRows))
+	for i, expectedRow := range expectedRows {
+		row := comparison.Rows[i]
+		assert.Equal(t, expectedRow.Kind, row.Kind)
+		assert.Equal(t, expectedRow.TaskName, row.TaskName)
+		assert.Equal(t, expectedRow.Key, row.Key)
+		assert.Equal(t, expectedRow.Differs, row.Differs, "row %v/%v/%v", row.Kind, row.TaskName, row.Key)
+		require.Len(t, row.Values, len(expectedRow.Values))
+		for j := range expectedRow.Values {
+			assert.True(t, runComparisonValuesEqual(expectedRow.Values[j], row.Values[j]), "row %v/%v/%v, run %v: %v", row.Kind, row.TaskName, row.Key, j, row.Values[j])
+		}
+	}
+}
+
+func TestCompareRuns_InvalidInput(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+
+	_, err := manager.CompareRuns(context.Background(), []string{run.UUID})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	_, err = manager.CompareRuns(context.Background(), []string{run.UUID, run.UUID})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	_, err = manager.CompareRuns(context.Background(), []string{run.UUID, "unknown"})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
+
+func TestParseRuntimeParameters(t *testing.T) {
+	parameters, err := parseRuntimeParameters(`[{"name":"param1","value":"world"}]`)
+	require.Nil(t, err)
+	assert.Len(t, parameters, 1)
+	assert.Equal(t, "world", parameters["param1"].GetStringValue())
+
+	parameters, err = parseRuntimeParameters("")
+	require.Nil(t, err)
+	assert.Empty(t, parameters)
+
+	_, err = parseRuntimeParameters("not-json")
+	assert.NotNil(t, err)
+}
+
 var v2SpecHelloWorld = `
 components:
   comp-hello-world:
@@ -16,11 +16,25 @@ package resource
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 )
 
 func deletePods(ctx context.Context, k8sCoreClient client.KubernetesCoreInterface, podsToDelete []string, namespace string) error {
@@ -32,3 +46,461 @@ func deletePods(ctx context.Context, k8sCoreClient client.KubernetesCoreInterfac
 	}
 	return nil
 }
+
+// Names used by the v2 compiler for the nodes of a container task: the driver
+// runs in a "<task>-driver" node next to the "<task>" node, which runs the
+// executor pod in an "executor" child node (or in "executor(N)" retries of it).
+const (
+	driverNodeSuffix = "-driver"
+	executorNodeName = "executor"
+)
+
+// Names of the executor pod containers.
+const (
+	mainContainerName     = "main"
+	launcherContainerName = "kfp-launcher"
+)
+
+// TaskLogContainer selects the container of a run task whose log is read.
+type TaskLogContainer string
+
+const (
+	TaskLogContainerDriver   TaskLogContainer = "driver"
+	TaskLogContainerLauncher TaskLogContainer = "launcher"
+	TaskLogContainerUser     TaskLogContainer = "user"
+)
+
+// Finds a task of a run by its id or, if the id is empty, by its name.
+func findRunTask(run *model.Run, taskId string, taskName string) (*model.Task, error) {
+	if taskId == "" && taskName == "" {
+		return nil, util.NewInvalidInputError("Either task id or task name must be specified")
+	}
+	var found *model.Task
+	for _, task := range run.RunDetails.TaskDetails {
+		if taskId != "" {
+			if task.UUID == taskId {
+				return task, nil
+			}
+			continue
+		}
+		if task.Name == taskName {
+			if found != nil {
+				return nil, util.NewInvalidInputError("Task name %v matches multiple tasks of run %v. Use a task id instead", taskName, run.UUID)
+			}
+			found = task
+		}
+	}
+	if found == nil {
+		if taskId != "" {
+			return nil, util.NewResourceNotFoundError("Task", taskId)
+		}
+		return nil, util.NewResourceNotFoundError("Task", taskName)
+	}
+	return found, nil
+}
+
+// Returns the pod, and the container in it, that holds the log of a task.
+// The task may be given by any of the driver, task or executor nodes.
+func taskLogPod(execSpec util.ExecutionSpec, task *model.Task, container TaskLogContainer) (string, string, error) {
+	nodes := execSpec.ExecutionStatus().NodeStatuses()
+	parents := make(map[string][]string, len(nodes))
+	nodeId := ""
+	for id, node := range nodes {
+		if node.ID == task.PodName {
+			nodeId = id
+		}
+		for _, child := range node.Children {
+			parents[child] = append(parents[child], id)
+		}
+	}
+	if nodeId == "" {
+		return "", "", util.NewResourceNotFoundError("Task node", task.PodName)
+	}
+	// Walk up from an executor node to the task node.
+	for isExecutorNode(nodes[nodeId].DisplayName) && len(parents[nodeId]) > 0 {
+		nodeId = parents[nodeId][0]
+	}
+
+	driverId, taskNodeId := "", ""
+	if name := nodes[nodeId].DisplayName; strings.HasSuffix(name, driverNodeSuffix) {
+		driverId = nodeId
+		for _, child := range nodes[nodeId].Children {
+			if nodes[child].DisplayName == strings.TrimSuffix(name, driverNodeSuffix) {
+				taskNodeId = child
+			}
+		}
+	} else {
+		taskNodeId = nodeId
+		for _, parent := range parents[nodeId] {
+			if nodes[parent].DisplayName == name+driverNodeSuffix {
+				driverId = parent
+			}
+		}
+	}
+
+	if container == TaskLogContainerDriver {
+		if driverId == "" {
+			return "", "", util.NewNotFoundError(fmt.Errorf("no driver node found"), "Task %v has no driver", task.UUID)
+		}
+		return nodes[driverId].ID, mainContainerName, nil
+	}
+	if taskNodeId == "" {
+		return "", "", util.NewNotFoundError(fmt.Errorf("no executor node found"), "Task %v has no executor", task.UUID)
+	}
+	// Descend to the latest executor attempt. Tasks without an executor node,
+	// e.g. importers, run in the task node itself.
+	for {
+		next := ""
+		for _, child := range nodes[taskNodeId].Children {
+			if isExecutorNode(nodes[child].DisplayName) {
+				next = child
+			}
+		}
+		if next == "" {
+			break
+		}
+		taskNodeId = next
+	}
+	if container == TaskLogContainerLauncher {
+		return nodes[taskNodeId].ID, launcherContainerName, nil
+	}
+	return nodes[taskNodeId].ID, mainContainerName, nil
+}
+
+func isExecutorNode(displayName string) bool {
+	return displayName == executorNodeName || strings.HasPrefix(displayName, executorNodeName+"(")
+}
+
+// The number of resources that bulk operations update in a single
+// transaction, and whose workflows they delete with a single Kubernetes API
+// call.
+const bulkBatchSize = 50
+
+// Calls fn with consecutive batches of the IDs, and the matching sub-slices of
+// errs, in which fn records the errors of the resources.
+func forEachBatch(ids []string, errs []error, fn func(batch []string, batchErrs []error)) {
+	for start := 0; start < len(ids); start += bulkBatchSize {
+		end := start + bulkBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		fn(ids[start:end], errs[start:end])
+	}
+}
+
+// Returns the IDs at the given indices.
+func selectIds(ids []string, indices []int) []string {
+	selected := make([]string, 0, len(indices))
+	for _, i := range indices {
+		selected = append(selected, ids[i])
+	}
+	return selected
+}
+
+// Returns a label selector that matches the workflows of the runs.
+func runIdsLabelSelector(runIds []string) (string, error) {
+	requirement, err := labels.NewRequirement(util.LabelKeyWorkflowRunId, selection.In, runIds)
+	if err != nil {
+		return "", err
+	}
+	return labels.NewSelector().Add(*requirement).String(), nil
+}
+
+// Merges two sets of user-defined labels. Labels in overrides take precedence.
+// Returns nil if neither set has labels.
+func mergeLabels(base map[string]string, overrides map[string]string) map[string]string {
+	if len(base) == 0 && len(overrides) == 0 {
+		return nil
+	}
+	merged := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
+
+// The page size used to list the runs that a run retention policy applies to.
+var runRetentionPageSize = 200
+
+// Checks whether a run has reached a final state.
+func isRunFinished(run *model.Run) bool {
+	switch run.State.ToV2() {
+	case model.RuntimeStateSucceeded, model.RuntimeStateSkipped, model.RuntimeStateFailed, model.RuntimeStateCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// The maximum number of runs that can be compared at once.
+const maxComparedRuns = 10
+
+// The order in which the row kinds are listed in a run comparison.
+var runComparisonRowKindOrder = map[model.RunComparisonRowKind]int{
+	model.RunComparisonRowKindParameter:    0,
+	model.RunComparisonRowKindTaskState:    1,
+	model.RunComparisonRowKindTaskDuration: 2,
+	model.RunComparisonRowKindMetric:       3,
+	model.RunComparisonRowKindArtifact:     4,
+}
+
+type runComparisonRowKey struct {
+	kind     model.RunComparisonRowKind
+	taskName string
+	key      string
+}
+
+// runComparisonTable collects the rows of a run comparison.
+type runComparisonTable struct {
+	columns    int
+	rowsByKeys map[runComparisonRowKey]*model.RunComparisonRow
+}
+
+func newRunComparisonTable(columns int) *runComparisonTable {
+	return &runComparisonTable{
+		columns:    columns,
+		rowsByKeys: make(map[runComparisonRowKey]*model.RunComparisonRow),
+	}
+}
+
+// Sets the value of a row in the given column, adding the row if needed.
+func (t *runComparisonTable) set(kind model.RunComparisonRowKind, taskName string, key string, column int, value *structpb.Value) {
+	rowKey := runComparisonRowKey{kind: kind, taskName: taskName, key: key}
+	row, ok := t.rowsByKeys[rowKey]
+	if !ok {
+		row = &model.RunComparisonRow{
+			Kind:     kind,
+			TaskName: taskName,
+			Key:      key,
+			Values:   make([]*structpb.Value, t.columns),
+		}
+		t.rowsByKeys[rowKey] = row
+	}
+	row.Values[column] = value
+}
+
+// Returns the rows ordered by kind, task name and key, and flags the rows
+// whose values differ.
+func (t *runComparisonTable) rows() []*model.RunComparisonRow {
+	rows := make([]*model.RunComparisonRow, 0, len(t.rowsByKeys))
+	for _, row := range t.rowsByKeys {
+		row.Differs = false
+		for _, value := range row.Values[1:] {
+			if !runComparisonValuesEqual(row.Values[0], value) {
+				row.Differs = true
+				break
+			}
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Kind != rows[j].Kind {
+			return runComparisonRowKindOrder[rows[i].Kind] < runComparisonRowKindOrder[rows[j].Kind]
+		}
+		if rows[i].TaskName != rows[j].TaskName {
+			return rows[i].TaskName < rows[j].TaskName
+		}
+		return rows[i].Key < rows[j].Key
+	})
+	return rows
+}
+
+// Returns the state of a task in the v2 API. Stored tasks carry their state in
+// their state history.
+func comparedTaskState(task *model.Task) model.RuntimeState {
+	if len(task.StateHistory) > 0 {
+		return task.StateHistory[len(task.StateHistory)-1].State.ToV2()
+	}
+	return task.State.ToV2()
+}
+
+// Returns the names the tasks of a run are compared by, keyed by task ID. The
+// name of a task is prefixed with the names of its ancestors, so that tasks
+// with the same name, e.g. in the iterations of a ParallelFor, are compared
+// apart. The root task is left out of the prefixes, as it is named after the run.
+func comparedTaskNames(tasks []*model.Task) map[string]string {
+	byId := make(map[string]*model.Task, len(tasks))
+	byNodeId := make(map[string]*model.Task, len(tasks))
+	for _, task := range tasks {
+		byId[task.UUID] = task
+		if task.PodName != "" {
+			byId[task.PodName] = task
+			byNodeId[nodeIdSuffix(task.PodName)] = task
+		}
+	}
+	// Workflow nodes list their children by node ID, which shares its
+	// suffix with the name of the pod of the node.
+	findTask := func(id string) *model.Task {
+		if task, ok := byId[id]; ok {
+			return task
+		}
+		return byNodeId[nodeIdSuffix(id)]
+	}
+	parents := make(map[*model.Task]*model.Task, len(tasks))
+	for _, task := range tasks {
+		if task.ParentTaskId != "" {
+			if parent := findTask(task.ParentTaskId); parent != nil && parent != task {
+				parents[task] = parent
+			}
+		}
+		for _, childId := range task.ChildrenPods {
+			if child := findTask(childId); child != nil && child != task {
+				if _, ok := parents[child]; !ok {
+					parents[child] = task
+				}
+			}
+		}
+	}
+	names := make(map[string]string, len(tasks))
+	for _, task := range tasks {
+		name := task.Name
+		visited := map[*model.Task]bool{task: true}
+		for parent := parents[task]; parent != nil && !visited[parent]; parent = parents[parent] {
+			visited[parent] = true
+			if _, hasParent := parents[parent]; !hasParent || parent.Name == "" {
+				continue
+			}
+			name = parent.Name + "/" + name
+		}
+		names[task.UUID] = name
+	}
+	return names
+}
+
+func nodeIdSuffix(id string) string {
+	return id[strings.LastIndex(id, "-")+1:]
+}
+
+// Missing values are only equal to missing values.
+func runComparisonValuesEqual(a *structpb.Value, b *structpb.Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return proto.Equal(a, b)
+}
+
+// Parses the runtime parameters of a run, which are stored as a JSON map by
+// the v2beta1 API, or as a list of string parameters by the v1beta1 API.
+func parseRuntimeParameters(parameters string) (map[string]*structpb.Value, error) {
+	values := make(map[string]*structpb.Value)
+	if parameters == "" || parameters == "null" || parameters == "[]" {
+		return values, nil
+	}
+	if err := json.Unmarshal([]byte(parameters), &values); err == nil {
+		return values, nil
+	}
+	specParameters, err := util.UnmarshalParameters(util.ArgoWorkflow, parameters)
+	if err != nil {
+		return nil, err
+	}
+	values = make(map[string]*structpb.Value, len(specParameters))
+	for _, parameter := range specParameters {
+		value := ""
+		if parameter.Value != nil {
+			value = *parameter.Value
+		}
+		values[parameter.Name] = structpb.NewStringValue(value)
+	}
+	return values, nil
+}
+
+const (
+	defaultWebhookSecretKey = "hmac-key"
+	webhookSignaturePrefix  = "sha256="
+	// The maximum difference between the timestamp of a webhook request and the
+	// time it is received. Older requests are rejected, and the accepted ones are
+	// remembered for as long, so that they cannot be replayed.
+	webhookTimestampTolerance = 5 * time.Minute
+)
+
+// Verifies that the signature of a webhook request, sha256=<hex digest>, is the
+// HMAC-SHA256 of its timestamp and its payload joined by a dot.
+func verifyWebhookSignature(key []byte, timestamp string, payload []byte, signature string) bool {
+	if !strings.HasPrefix(signature, webhookSignaturePrefix) {
+		return false
+	}
+	digest, err := hex.DecodeString(strings.TrimPrefix(signature, webhookSignaturePrefix))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), digest)
+}
+
+// Overrides the parameters of a pipeline spec with the parameters of an event.
+// Parameters of v1 pipelines are strings, so other values are JSON encoded.
+func applyEventParameters(spec *model.PipelineSpec, parameters map[string]interface{}) error {
+	if len(parameters) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if spec.WorkflowSpecManifest != "" {
+		var specParameters util.SpecParameters
+		if spec.Parameters != "" {
+			var err error
+			if specParameters, err = util.UnmarshalParameters(util.ArgoWorkflow, spec.Parameters); err != nil {
+				return err
+			}
+		}
+		for _, name := range names {
+			value, ok := parameters[name].(string)
+			if !ok {
+				bytes, err := json.Marshal(parameters[name])
+				if err != nil {
+					return util.NewInvalidInputError("Event parameter %v is not a valid value: %v", name, err)
+				}
+				value = string(bytes)
+			}
+			found := false
+			for i := range specParameters {
+				if specParameters[i].Name == name {
+					specParameters[i].Value = util.StringPointer(value)
+					found = true
+				}
+			}
+			if !found {
+				specParameters = append(specParameters, util.SpecParameter{Name: name, Value: util.StringPointer(value)})
+			}
+		}
+		marshaled, err := util.MarshalParameters(util.ArgoWorkflow, specParameters)
+		if err != nil {
+			return err
+		}
+		spec.Parameters = marshaled
+		return nil
+	}
+
+	values, err := parseRuntimeParameters(spec.RuntimeConfig.Parameters)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to parse the runtime parameters")
+	}
+	for _, name := range names {
+		value, err := structpb.NewValue(parameters[name])
+		if err != nil {
+			return util.NewInvalidInputError("Event parameter %v is not a valid value: %v", name, err)
+		}
+		values[name] = value
+	}
+	marshaled, err := json.Marshal(values)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal the runtime parameters")
+	}
+	spec.RuntimeConfig.Parameters = string(marshaled)
+	return nil
+}
+
+// Returns whether a cleaned path is a directory or a path under it.
+func isPathUnder(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
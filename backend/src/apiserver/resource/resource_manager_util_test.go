@@ -17,8 +17,12 @@ package resource
 import (
 	"testing"
 
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
 
@@ -222,3 +226,120 @@ status:
 
 	assert.Equal(t, expectedNewWfString, actualNewWfString)
 }
+
+func taskLogTestWorkflow() util.ExecutionSpec {
+	node := func(id string, displayName string, templateName string, children ...string) v1alpha1.NodeStatus {
+		return v1alpha1.NodeStatus{ID: id, Name: "wf." + displayName, DisplayName: displayName, TemplateName: templateName, Children: children}
+	}
+	return util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf"},
+		Status: v1alpha1.WorkflowStatus{
+			Nodes: map[string]v1alpha1.NodeStatus{
+				"wf":      {ID: "wf", Name: "wf", DisplayName: "wf", Children: []string{"wf-2222", "wf-7777"}},
+				"wf-2222": node("wf-2222", "hello-world-driver", "system-container-driver", "wf-3333"),
+				"wf-3333": node("wf-3333", "hello-world", "system-container-executor", "wf-4444"),
+				"wf-4444": node("wf-4444", "executor", "system-container-impl", "wf-5555", "wf-6666"),
+				"wf-5555": node("wf-5555", "executor(0)", "system-container-impl"),
+				"wf-6666": node("wf-6666", "executor(1)", "system-container-impl"),
+				"wf-7777": node("wf-7777", "importer", "system-importer"),
+			},
+		},
+	})
+}
+
+func TestFindRunTask(t *testing.T) {
+	run := &model.Run{
+		UUID: "run1",
+		RunDetails: model.RunDetails{TaskDetails: []*model.Task{
+			{UUID: "task1", Name: "hello-world"},
+			{UUID: "task2", Name: "executor"},
+			{UUID: "task3", Name: "executor"},
+		}},
+	}
+
+	task, err := findRunTask(run, "task2", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "task2", task.UUID)
+
+	task, err = findRunTask(run, "", "hello-world")
+	assert.Nil(t, err)
+	assert.Equal(t, "task1", task.UUID)
+
+	_, err = findRunTask(run, "", "executor")
+	assert.Contains(t, err.Error(), "matches multiple tasks")
+
+	_, err = findRunTask(run, "task4", "")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	_, err = findRunTask(run, "", "")
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestTaskLogPod(t *testing.T) {
+	execSpec := taskLogTestWorkflow()
+	tests := []struct {
+		name          string
+		podName       string
+		container     TaskLogContainer
+		wantPod       string
+		wantContainer string
+	}{
+		{"user log of task", "wf-system-container-executor-3333", TaskLogContainerUser, "wf-system-container-impl-6666", "main"},
+		{"launcher log of task", "wf-system-container-executor-3333", TaskLogContainerLauncher, "wf-system-container-impl-6666", "kfp-launcher"},
+		{"driver log of task", "wf-system-container-executor-3333", TaskLogContainerDriver, "wf-system-container-driver-2222", "main"},
+		{"user log of driver task", "wf-system-container-driver-2222", TaskLogContainerUser, "wf-system-container-impl-6666", "main"},
+		{"driver log of executor attempt", "wf-system-container-impl-5555", TaskLogContainerDriver, "wf-system-container-driver-2222", "main"},
+		{"user log of importer", "wf-system-importer-7777", TaskLogContainerUser, "wf-system-importer-7777", "main"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod, container, err := taskLogPod(execSpec, &model.Task{UUID: "task1", PodName: tt.podName}, tt.container)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantPod, pod)
+			assert.Equal(t, tt.wantContainer, container)
+		})
+	}
+}
+
+func TestTaskLogPod_Errors(t *testing.T) {
+	execSpec := taskLogTestWorkflow()
+
+	_, _, err := taskLogPod(execSpec, &model.Task{UUID: "task1", PodName: "wf-system-importer-7777"}, TaskLogContainerDriver)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	_, _, err = taskLogPod(execSpec, &model.Task{UUID: "task1", PodName: "unknown"}, TaskLogContainerUser)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestComparedTaskNames(t *testing.T) {
+	tasks := []*model.Task{
+		{UUID: "1", PodName: "wf", Name: "wf", ChildrenPods: []string{"wf-1000", "wf-2000"}},
+		{UUID: "2", PodName: "wf-for-loop-1000", Name: "for-loop(0)", ChildrenPods: []string{"wf-3000"}},
+		{UUID: "3", PodName: "wf-for-loop-2000", Name: "for-loop(1)", ChildrenPods: []string{"wf-4000"}},
+		{UUID: "4", PodName: "wf-train-3000", Name: "train"},
+		{UUID: "5", PodName: "wf-train-4000", Name: "train"},
+		// Tasks reported through the v2beta1 API refer to their parent.
+		{UUID: "6", Name: "evaluate", ParentTaskId: "2"},
+		{UUID: "7", Name: "unrelated"},
+	}
+	assert.Equal(t, map[string]string{
+		"1": "wf",
+		"2": "for-loop(0)",
+		"3": "for-loop(1)",
+		"4": "for-loop(0)/train",
+		"5": "for-loop(1)/train",
+		"6": "for-loop(0)/evaluate",
+		"7": "unrelated",
+	}, comparedTaskNames(tasks))
+}
+
+func TestComparedTaskState(t *testing.T) {
+	task := &model.Task{
+		StateHistory: []*model.RuntimeStatus{
+			{UpdateTimeInSec: 1, State: model.RuntimeStateRunning},
+			{UpdateTimeInSec: 2, State: model.RuntimeStateSucceededV1},
+		},
+	}
+	assert.Equal(t, model.RuntimeStateSucceeded, comparedTaskState(task))
+	assert.Equal(t, model.RuntimeStateFailed, comparedTaskState(&model.Task{State: model.RuntimeStateFailedV1}))
+}
@@ -0,0 +1,414 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CloudEvents attributes of run notifications.
+	runNotificationSpecVersion = "1.0"
+	runNotificationSource      = "/apis/v2beta1/runs"
+	runNotificationTypePrefix  = "org.kubeflow.pipelines.run."
+	runNotificationContentType = "application/cloudevents+json"
+
+	// The header holding the HMAC-SHA256 signature of a notification, in the
+	// same format as the signature of recurring run webhook triggers.
+	runNotificationSignatureHeader = "X-Kfp-Signature-256"
+
+	defaultRunNotificationRetryTimeout = 10 * time.Minute
+	runNotificationRequestTimeout      = 30 * time.Second
+)
+
+// DefaultRunNotificationDeniedNetworks are the networks webhooks may not be
+// reached at unless their host is explicitly allowed: the loopback,
+// link-local (including cloud metadata servers), private and unspecified
+// addresses, so that notifications cannot probe the cluster network.
+var DefaultRunNotificationDeniedNetworks = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+}
+
+// The error of dialing a webhook at an address in a denied network.
+type deniedWebhookAddressError struct {
+	address string
+}
+
+func (e *deniedWebhookAddressError) Error() string {
+	return fmt.Sprintf("webhook address %v is in a denied network", e.address)
+}
+
+// A CloudEvent in structured content mode announcing a state of a run.
+type runNotificationEvent struct {
+	SpecVersion     string               `json:"specversion"`
+	ID              string               `json:"id"`
+	Source          string               `json:"source"`
+	Type            string               `json:"type"`
+	Subject         string               `json:"subject"`
+	Time            string               `json:"time"`
+	DataContentType string               `json:"datacontenttype"`
+	Data            *runNotificationData `json:"data"`
+}
+
+type runNotificationData struct {
+	RunId             string `json:"runId"`
+	DisplayName       string `json:"displayName"`
+	ExperimentId      string `json:"experimentId,omitempty"`
+	RecurringRunId    string `json:"recurringRunId,omitempty"`
+	PipelineId        string `json:"pipelineId,omitempty"`
+	PipelineVersionId string `json:"pipelineVersionId,omitempty"`
+	Namespace         string `json:"namespace,omitempty"`
+	State             string `json:"state"`
+	CreatedAt         string `json:"createdAt"`
+	FinishedAt        string `json:"finishedAt,omitempty"`
+	DurationSeconds   int64  `json:"durationSeconds,omitempty"`
+	URL               string `json:"url,omitempty"`
+}
+
+// Delivers run notifications to webhooks. Deliveries are asynchronous and
+// retried with an exponential backoff, so that a slow or unavailable webhook
+// does not hold up the reporting of runs.
+type runNotifier struct {
+	k8sCoreClient client.KubernetesCoreInterface
+	// The client of the webhooks with an allowed host.
+	httpClient *http.Client
+	// The client of the other webhooks, which refuses to connect to the
+	// denied networks.
+	guardedHTTPClient *http.Client
+	time              util.TimeInterface
+	// The base URL of the UI, used to link to the run. No link is sent if empty.
+	uiBaseURL string
+	// The time after which a failing delivery is given up.
+	retryTimeout time.Duration
+	// The initial interval between delivery attempts.
+	retryInterval time.Duration
+
+	// The hosts webhooks may have. Any host is allowed if empty. The allowed
+	// hosts may be reached in the denied networks.
+	allowedHosts []string
+	// The networks webhooks may not be reached at.
+	deniedNetworks []*net.IPNet
+
+	mu sync.Mutex
+	// Whether the notifier was stopped and accepts no more notifications.
+	stopped bool
+	// Cancels the deliveries in flight.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// Tracks the deliveries in flight.
+	deliveries sync.WaitGroup
+}
+
+func newRunNotifier(k8sCoreClient client.KubernetesCoreInterface, time util.TimeInterface, options *ResourceManagerOptions) *runNotifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &runNotifier{
+		k8sCoreClient: k8sCoreClient,
+		time:          time,
+		retryTimeout:  defaultRunNotificationRetryTimeout,
+		retryInterval: backoff.DefaultInitialInterval,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+	deniedNetworks := DefaultRunNotificationDeniedNetworks
+	if options != nil {
+		n.uiBaseURL = strings.TrimSuffix(options.RunNotificationUIBaseURL, "/")
+		if options.RunNotificationRetryTimeout > 0 {
+			n.retryTimeout = options.RunNotificationRetryTimeout
+		}
+		n.allowedHosts = options.RunNotificationAllowedHosts
+		if options.RunNotificationDeniedNetworks != nil {
+			deniedNetworks = options.RunNotificationDeniedNetworks
+		}
+	}
+	for _, cidr := range deniedNetworks {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			// The networks are validated when the options are loaded.
+			glog.Errorf("Ignoring invalid denied network %q of run notifications: %v", cidr, err)
+			continue
+		}
+		n.deniedNetworks = append(n.deniedNetworks, network)
+	}
+	n.httpClient = n.newHTTPClient(http.DefaultTransport.(*http.Transport).Clone())
+	guardedTransport := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{
+		Timeout: runNotificationRequestTimeout,
+		// Checks the address after the host is resolved, so that a host
+		// cannot be pointed at a denied network after it was validated.
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || n.isDeniedIP(ip) {
+				return &deniedWebhookAddressError{address: host}
+			}
+			return nil
+		},
+	}
+	guardedTransport.DialContext = dialer.DialContext
+	n.guardedHTTPClient = n.newHTTPClient(guardedTransport)
+	return n
+}
+
+func (n *runNotifier) newHTTPClient(transport *http.Transport) *http.Client {
+	return &http.Client{
+		Transport: transport,
+		Timeout:   runNotificationRequestTimeout,
+		// A redirect could lead to a host that is not allowed.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Returns whether the host of a webhook is allowed. An allowed host matches
+// an entry exactly, or one of its subdomains if the entry starts with "*.".
+// The entry "*" matches any host.
+func (n *runNotifier) isAllowedHost(host string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range n.allowedHosts {
+		allowed = strings.ToLower(allowed)
+		switch {
+		case allowed == "*", allowed == host:
+			return true
+		case strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:]):
+			return true
+		}
+	}
+	return false
+}
+
+func (n *runNotifier) isDeniedIP(ip net.IP) bool {
+	for _, network := range n.deniedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Checks that the webhooks of the subscriptions may be notified. The host of a
+// webhook must be allowed if there is an allow list, and a host that is not
+// explicitly allowed may not be an address in a denied network. Hosts
+// resolving to a denied network are refused when they are notified.
+func (n *runNotifier) checkWebhooks(subscriptions []*model.NotificationSubscription) error {
+	for _, subscription := range subscriptions {
+		webhookURL, err := url.Parse(subscription.WebhookURL)
+		if err != nil {
+			return util.NewInvalidInputError("Notification webhook URL %q is invalid: %v", subscription.WebhookURL, err)
+		}
+		host := webhookURL.Hostname()
+		if n.isAllowedHost(host) {
+			continue
+		}
+		if len(n.allowedHosts) > 0 {
+			return util.NewInvalidInputError("Notification webhook host %q is not allowed. Allowed hosts: %v", host, strings.Join(n.allowedHosts, ", "))
+		}
+		if ip := net.ParseIP(host); ip != nil && n.isDeniedIP(ip) {
+			return util.NewInvalidInputError("Notification webhook host %q is in a denied network", host)
+		}
+	}
+	return nil
+}
+
+// Sends the state of a run to the webhook of a subscription in the background.
+// Calls done, if set, once the delivery finishes, unless the notifier was
+// stopped first.
+func (n *runNotifier) notify(subscription *model.NotificationSubscription, run *model.Run, state model.RuntimeState, done func()) {
+	event := n.newEvent(run, state)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.stopped {
+		glog.Warningf("Dropping the notification of %v about state %v of run %v, as notifications are stopped", subscription.WebhookURL, state, run.UUID)
+		return
+	}
+	n.deliveries.Add(1)
+	go func() {
+		defer n.deliveries.Done()
+		ctx, cancel := context.WithTimeout(n.ctx, n.retryTimeout)
+		defer cancel()
+		if err := n.deliver(ctx, subscription, run.Namespace, event); err != nil {
+			glog.Errorf("Failed to notify %v about state %v of run %v: %v", subscription.WebhookURL, state, run.UUID, err)
+		}
+		if done != nil && n.ctx.Err() == nil {
+			done()
+		}
+	}()
+}
+
+// Stops accepting notifications and waits for the deliveries in flight. The
+// deliveries still in flight after the timeout are canceled.
+func (n *runNotifier) stop(timeout time.Duration) {
+	n.mu.Lock()
+	n.stopped = true
+	n.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		n.deliveries.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		glog.Warningf("Canceling the run notifications still in flight after %v", timeout)
+		n.cancel()
+		<-done
+	}
+	n.cancel()
+}
+
+func (n *runNotifier) newEvent(run *model.Run, state model.RuntimeState) *runNotificationEvent {
+	data := &runNotificationData{
+		RunId:             run.UUID,
+		DisplayName:       run.DisplayName,
+		ExperimentId:      run.ExperimentId,
+		RecurringRunId:    run.RecurringRunId,
+		PipelineId:        run.PipelineSpec.PipelineId,
+		PipelineVersionId: run.PipelineSpec.PipelineVersionId,
+		Namespace:         run.Namespace,
+		State:             string(state),
+		CreatedAt:         time.Unix(run.CreatedAtInSec, 0).UTC().Format(time.RFC3339),
+	}
+	if run.FinishedAtInSec > 0 {
+		data.FinishedAt = time.Unix(run.FinishedAtInSec, 0).UTC().Format(time.RFC3339)
+		data.DurationSeconds = run.FinishedAtInSec - run.CreatedAtInSec
+	}
+	if n.uiBaseURL != "" {
+		data.URL = fmt.Sprintf("%s/#/runs/details/%s", n.uiBaseURL, run.UUID)
+	}
+	return &runNotificationEvent{
+		SpecVersion: runNotificationSpecVersion,
+		// The ID is the same for every delivery of a state of a run, so that
+		// receivers can drop duplicates.
+		ID:              fmt.Sprintf("%s.%s", run.UUID, state),
+		Source:          runNotificationSource,
+		Type:            runNotificationTypePrefix + strings.ToLower(string(state)),
+		Subject:         run.UUID,
+		Time:            n.time.Now().UTC().Format(time.RFC3339),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// Posts an event to the webhook of a subscription until it is accepted, it is
+// rejected by the webhook or the context is done.
+func (n *runNotifier) deliver(ctx context.Context, subscription *model.NotificationSubscription, namespace string, event *runNotificationEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal the notification")
+	}
+	var signature string
+	if subscription.SecretName != "" {
+		hmacKey, err := n.getHMACKey(ctx, subscription, namespace)
+		if err != nil {
+			return err
+		}
+		mac := hmac.New(sha256.New, hmacKey)
+		mac.Write(payload)
+		signature = webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	httpClient := n.guardedHTTPClient
+	if webhookURL, err := url.Parse(subscription.WebhookURL); err == nil && n.isAllowedHost(webhookURL.Hostname()) {
+		httpClient = n.httpClient
+	}
+	operation := func() error {
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.WebhookURL, bytes.NewReader(payload))
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		request.Header.Set("Content-Type", runNotificationContentType)
+		if signature != "" {
+			request.Header.Set(runNotificationSignatureHeader, signature)
+		}
+		response, err := httpClient.Do(request)
+		if err != nil {
+			var deniedErr *deniedWebhookAddressError
+			if errors.As(err, &deniedErr) {
+				return backoff.Permanent(err)
+			}
+			return err
+		}
+		defer response.Body.Close()
+		io.Copy(io.Discard, response.Body)
+		switch {
+		case response.StatusCode >= 200 && response.StatusCode < 300:
+			return nil
+		case response.StatusCode >= 300 && response.StatusCode < 500 && response.StatusCode != http.StatusTooManyRequests:
+			// The webhook rejected or redirected the notification, sending it
+			// again would not help.
+			return backoff.Permanent(fmt.Errorf("webhook responded with status %v", response.Status))
+		default:
+			return fmt.Errorf("webhook responded with status %v", response.Status)
+		}
+	}
+	retryPolicy := backoff.NewExponentialBackOff()
+	retryPolicy.InitialInterval = n.retryInterval
+	retryPolicy.MaxElapsedTime = n.retryTimeout
+	return backoff.Retry(operation, backoff.WithContext(retryPolicy, ctx))
+}
+
+func (n *runNotifier) getHMACKey(ctx context.Context, subscription *model.NotificationSubscription, namespace string) ([]byte, error) {
+	if namespace == "" {
+		namespace = common.GetPodNamespace()
+	}
+	secret, err := n.k8sCoreClient.SecretClient(namespace).Get(ctx, subscription.SecretName, v1.GetOptions{})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get secret %v of the notification", subscription.SecretName)
+	}
+	secretKey := subscription.SecretKey
+	if secretKey == "" {
+		secretKey = defaultWebhookSecretKey
+	}
+	hmacKey := secret.Data[secretKey]
+	if len(hmacKey) == 0 {
+		return nil, util.NewInvalidInputError("Secret %v of the notification has no key %v", subscription.SecretName, secretKey)
+	}
+	return hmacKey, nil
+}
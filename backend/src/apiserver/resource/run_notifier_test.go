@@ -0,0 +1,405 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type notificationRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// Starts a webhook that records the notifications it receives and responds
+// with the given status codes in turn, then with 200.
+func newNotificationWebhook(t *testing.T, statusCodes ...int) (*httptest.Server, func() []notificationRequest) {
+	var mu sync.Mutex
+	var requests []notificationRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, notificationRequest{header: r.Header, body: body})
+		if len(requests) <= len(statusCodes) {
+			w.WriteHeader(statusCodes[len(requests)-1])
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []notificationRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]notificationRequest(nil), requests...)
+	}
+}
+
+func initWithNotifiedRun(t *testing.T, subscription *model.NotificationSubscription) (*FakeClientManager, *ResourceManager, *model.Run) {
+	store, manager, run := initWithOneTimeRun(t)
+	// The test webhooks listen on the loopback network.
+	manager.notifier.allowedHosts = []string{"127.0.0.1"}
+	experiment, err := manager.GetExperiment(run.ExperimentId)
+	require.Nil(t, err)
+	require.Nil(t, experiment.SetNotifications([]*model.NotificationSubscription{subscription}))
+	_, err = manager.UpdateExperiment(experiment)
+	require.Nil(t, err)
+	_, err = store.KubernetesCoreClient().SecretClient("ns1").Create(context.Background(), &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "notification-secret"},
+		Data:       map[string][]byte{"hmac-key": []byte("key")},
+	}, v1.CreateOptions{})
+	require.Nil(t, err)
+	return store, manager, run
+}
+
+func reportRunPhase(t *testing.T, manager *ResourceManager, run *model.Run, phase v1alpha1.WorkflowPhase) {
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      run.K8SName,
+			Namespace: "ns1",
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: phase},
+	})
+	_, err := manager.ReportWorkflowResource(context.Background(), workflow)
+	require.Nil(t, err)
+}
+
+func TestReportWorkflowResource_NotifiesFinalState(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t)
+	store, manager, run := initWithNotifiedRun(t, &model.NotificationSubscription{
+		WebhookURL: webhook.URL,
+		SecretName: "notification-secret",
+	})
+	defer store.Close()
+	manager.notifier.uiBaseURL = "https://kfp.example.com"
+
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowRunning)
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowFailed)
+	// Reporting the same state again does not notify again.
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowFailed)
+	manager.notifier.deliveries.Wait()
+
+	received := requests()
+	require.Len(t, received, 1)
+	assert.Equal(t, "application/cloudevents+json", received[0].header.Get("Content-Type"))
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write(received[0].body)
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), received[0].header.Get("X-Kfp-Signature-256"))
+
+	var event runNotificationEvent
+	require.Nil(t, json.Unmarshal(received[0].body, &event))
+	assert.Equal(t, "1.0", event.SpecVersion)
+	assert.Equal(t, run.UUID+".FAILED", event.ID)
+	assert.Equal(t, "org.kubeflow.pipelines.run.failed", event.Type)
+	assert.Equal(t, run.UUID, event.Subject)
+	assert.Equal(t, run.UUID, event.Data.RunId)
+	assert.Equal(t, "run1", event.Data.DisplayName)
+	assert.Equal(t, run.ExperimentId, event.Data.ExperimentId)
+	assert.Equal(t, "FAILED", event.Data.State)
+	assert.Equal(t, "https://kfp.example.com/#/runs/details/"+run.UUID, event.Data.URL)
+}
+
+func TestReportWorkflowResource_SkipsUnsubscribedState(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t)
+	store, manager, run := initWithNotifiedRun(t, &model.NotificationSubscription{
+		WebhookURL: webhook.URL,
+		States:     []model.RuntimeState{model.RuntimeStateSucceeded},
+	})
+	defer store.Close()
+
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowFailed)
+	manager.notifier.deliveries.Wait()
+
+	assert.Empty(t, requests())
+}
+
+func TestRedeliverRunNotifications(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t)
+	store, manager, run := initWithNotifiedRun(t, &model.NotificationSubscription{WebhookURL: webhook.URL})
+	defer store.Close()
+
+	// The API server stops before delivering the notification.
+	manager.notifier.stop(0)
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowFailed)
+	assert.Empty(t, requests())
+
+	// Another API server delivers it once it could no longer be in flight.
+	manager = NewResourceManager(store, &ResourceManagerOptions{})
+	manager.notifier.allowedHosts = []string{"127.0.0.1"}
+	require.Nil(t, manager.RedeliverRunNotifications(context.Background()))
+	manager.notifier.deliveries.Wait()
+	assert.Empty(t, requests())
+	manager.time = util.NewFakeTime(time.Unix(0, 0).Add(time.Hour))
+	require.Nil(t, manager.RedeliverRunNotifications(context.Background()))
+	manager.notifier.deliveries.Wait()
+	received := requests()
+	require.Len(t, received, 1)
+	var event runNotificationEvent
+	require.Nil(t, json.Unmarshal(received[0].body, &event))
+	assert.Equal(t, run.UUID+".FAILED", event.ID)
+
+	// A delivered notification is not sent again.
+	manager.time = util.NewFakeTime(time.Unix(0, 0).Add(2 * time.Hour))
+	require.Nil(t, manager.RedeliverRunNotifications(context.Background()))
+	manager.notifier.deliveries.Wait()
+	assert.Len(t, requests(), 1)
+}
+
+func TestNotifyPendingRuns(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t)
+	store, manager, run := initWithNotifiedRun(t, &model.NotificationSubscription{
+		WebhookURL:            webhook.URL,
+		States:                []model.RuntimeState{model.RuntimeStatePending},
+		PendingTimeoutSeconds: 1,
+	})
+	defer store.Close()
+
+	require.Nil(t, manager.NotifyPendingRuns(context.Background()))
+	// A run is notified once while it stays pending.
+	require.Nil(t, manager.NotifyPendingRuns(context.Background()))
+	manager.notifier.deliveries.Wait()
+
+	received := requests()
+	require.Len(t, received, 1)
+	var event runNotificationEvent
+	require.Nil(t, json.Unmarshal(received[0].body, &event))
+	assert.Equal(t, run.UUID+".PENDING", event.ID)
+	assert.Equal(t, "org.kubeflow.pipelines.run.pending", event.Type)
+	// No signature is sent without a secret.
+	assert.Empty(t, received[0].header.Get("X-Kfp-Signature-256"))
+
+	// A new resource manager, as after a restart, does not notify again.
+	manager = NewResourceManager(store, &ResourceManagerOptions{})
+	require.Nil(t, manager.NotifyPendingRuns(context.Background()))
+	manager.notifier.deliveries.Wait()
+	assert.Len(t, requests(), 1)
+
+	// The notification is forgotten once the run is no longer pending.
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowRunning)
+	require.Nil(t, manager.NotifyPendingRuns(context.Background()))
+	webhookHash := sha256.Sum256([]byte(webhook.URL))
+	assert.Nil(t, store.RunStore().CreateRunNotification(&model.RunNotification{
+		RunUUID:     run.UUID,
+		WebhookHash: hex.EncodeToString(webhookHash[:]),
+		State:       model.RuntimeStatePending,
+	}))
+}
+
+func TestNotifyPendingRuns_BeforeTimeout(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t)
+	store, manager, _ := initWithNotifiedRun(t, &model.NotificationSubscription{
+		WebhookURL: webhook.URL,
+		States:     []model.RuntimeState{model.RuntimeStatePending},
+	})
+	defer store.Close()
+
+	require.Nil(t, manager.NotifyPendingRuns(context.Background()))
+	manager.notifier.deliveries.Wait()
+
+	assert.Empty(t, requests())
+}
+
+func newTestRunNotifier() *runNotifier {
+	notifier := newRunNotifier(client.NewFakeKuberneteCoresClient(), util.NewFakeTimeForEpoch(), &ResourceManagerOptions{
+		RunNotificationAllowedHosts: []string{"127.0.0.1"},
+	})
+	notifier.retryInterval = time.Millisecond
+	notifier.retryTimeout = 5 * time.Second
+	return notifier
+}
+
+func TestRunNotifierDeliver_RetriesServerErrors(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	notifier := newTestRunNotifier()
+	run := &model.Run{UUID: "run-1", DisplayName: "run"}
+
+	err := notifier.deliver(context.Background(), &model.NotificationSubscription{WebhookURL: webhook.URL}, "ns1", notifier.newEvent(run, model.RuntimeStateSucceeded))
+	assert.Nil(t, err)
+	received := requests()
+	require.Len(t, received, 3)
+	// Every attempt sends the same event.
+	assert.Equal(t, received[0].body, received[2].body)
+}
+
+func TestRunNotifierDeliver_DoesNotRetryClientErrors(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t, http.StatusBadRequest)
+	notifier := newTestRunNotifier()
+	run := &model.Run{UUID: "run-1", DisplayName: "run"}
+
+	err := notifier.deliver(context.Background(), &model.NotificationSubscription{WebhookURL: webhook.URL}, "ns1", notifier.newEvent(run, model.RuntimeStateSucceeded))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "400")
+	assert.Len(t, requests(), 1)
+}
+
+func TestRunNotifierDeliver_MissingSecret(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t)
+	notifier := newTestRunNotifier()
+	run := &model.Run{UUID: "run-1", DisplayName: "run"}
+
+	err := notifier.deliver(context.Background(), &model.NotificationSubscription{WebhookURL: webhook.URL, SecretName: "missing"}, "ns1", notifier.newEvent(run, model.RuntimeStateSucceeded))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "missing")
+	assert.Empty(t, requests())
+}
+
+func TestRunNotifierDeliver_DeniedNetwork(t *testing.T) {
+	webhook, requests := newNotificationWebhook(t)
+	notifier := newTestRunNotifier()
+	notifier.allowedHosts = nil
+	run := &model.Run{UUID: "run-1", DisplayName: "run"}
+
+	err := notifier.deliver(context.Background(), &model.NotificationSubscription{WebhookURL: webhook.URL}, "ns1", notifier.newEvent(run, model.RuntimeStateSucceeded))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "denied network")
+	assert.Empty(t, requests())
+}
+
+func TestRunNotifierDeliver_DoesNotFollowRedirects(t *testing.T) {
+	target, targetRequests := newNotificationWebhook(t)
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer redirect.Close()
+	notifier := newTestRunNotifier()
+	run := &model.Run{UUID: "run-1", DisplayName: "run"}
+
+	err := notifier.deliver(context.Background(), &model.NotificationSubscription{WebhookURL: redirect.URL}, "ns1", notifier.newEvent(run, model.RuntimeStateSucceeded))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "307")
+	assert.Empty(t, targetRequests())
+}
+
+func TestRunNotifierCheckWebhooks(t *testing.T) {
+	tests := []struct {
+		name         string
+		allowedHosts []string
+		webhookURL   string
+		wantErr      string
+	}{
+		{"public host", nil, "https://hooks.example.com/run", ""},
+		{"loopback address", nil, "http://127.0.0.1:8080/run", "denied network"},
+		{"metadata server", nil, "http://169.254.169.254/latest", "denied network"},
+		{"private IPv6 address", nil, "http://[fd00::1]/run", "denied network"},
+		{"allowed host", []string{"hooks.example.com"}, "https://HOOKS.example.com/run", ""},
+		{"allowed subdomain", []string{"*.example.com"}, "https://hooks.example.com/run", ""},
+		{"host not allowed", []string{"*.example.com"}, "https://example.org/run", "is not allowed"},
+		{"allowed address in a denied network", []string{"10.0.0.1"}, "http://10.0.0.1/run", ""},
+		{"any host allowed", []string{"*"}, "http://127.0.0.1/run", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier := newRunNotifier(client.NewFakeKuberneteCoresClient(), util.NewFakeTimeForEpoch(), &ResourceManagerOptions{RunNotificationAllowedHosts: tt.allowedHosts})
+			err := notifier.checkWebhooks([]*model.NotificationSubscription{{WebhookURL: tt.webhookURL}})
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestRunNotifierStop(t *testing.T) {
+	block := make(chan struct{})
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer webhook.Close()
+	defer close(block)
+	notifier := newTestRunNotifier()
+	run := &model.Run{UUID: "run-1", DisplayName: "run"}
+	subscription := &model.NotificationSubscription{WebhookURL: webhook.URL}
+
+	var finished bool
+	notifier.notify(subscription, run, model.RuntimeStateSucceeded, func() { finished = true })
+	// The delivery in flight is canceled after the timeout, and is not
+	// finished, so that it is sent again.
+	notifier.stop(10 * time.Millisecond)
+	assert.False(t, finished)
+	// Notifications are dropped once stopped.
+	notifier.notify(subscription, run, model.RuntimeStateFailed, nil)
+	notifier.deliveries.Wait()
+}
+
+func TestCreateExperiment_DeniedNotificationWebhook(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store, &ResourceManagerOptions{})
+	experiment := &model.Experiment{Name: "e1"}
+	require.Nil(t, experiment.SetNotifications([]*model.NotificationSubscription{{WebhookURL: "http://169.254.169.254/latest"}}))
+
+	_, err := manager.CreateExperiment(experiment)
+	require.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+}
+
+func TestRunNotifierNewEvent(t *testing.T) {
+	notifier := newRunNotifier(client.NewFakeKuberneteCoresClient(), util.NewFakeTimeForEpoch(), &ResourceManagerOptions{RunNotificationUIBaseURL: "http://ui/"})
+	run := &model.Run{
+		UUID:           "run-1",
+		DisplayName:    "run",
+		ExperimentId:   "exp-1",
+		RecurringRunId: "job-1",
+		Namespace:      "ns1",
+		PipelineSpec:   model.PipelineSpec{PipelineId: "p-1", PipelineVersionId: "pv-1"},
+		RunDetails:     model.RunDetails{CreatedAtInSec: 10, FinishedAtInSec: 70},
+	}
+	event := notifier.newEvent(run, model.RuntimeStateCanceled)
+	assert.Equal(t, &runNotificationEvent{
+		SpecVersion:     "1.0",
+		ID:              "run-1.CANCELED",
+		Source:          "/apis/v2beta1/runs",
+		Type:            "org.kubeflow.pipelines.run.canceled",
+		Subject:         "run-1",
+		Time:            "1970-01-01T00:00:01Z",
+		DataContentType: "application/json",
+		Data: &runNotificationData{
+			RunId:             "run-1",
+			DisplayName:       "run",
+			ExperimentId:      "exp-1",
+			RecurringRunId:    "job-1",
+			PipelineId:        "p-1",
+			PipelineVersionId: "pv-1",
+			Namespace:         "ns1",
+			State:             "CANCELED",
+			CreatedAt:         "1970-01-01T00:00:10Z",
+			FinishedAt:        "1970-01-01T00:01:10Z",
+			DurationSeconds:   60,
+			URL:               "http://ui/#/runs/details/run-1",
+		},
+	}, event)
+}
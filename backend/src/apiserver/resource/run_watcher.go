@@ -0,0 +1,532 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultRunWatchHistorySize = 1000
+	// The number of events a watcher may fall behind before it is closed.
+	runWatcherBufferSize = 100
+	// How often the changes recorded by all the API server instances are
+	// fetched while there are watchers.
+	runWatchPollInterval = time.Second
+	// How long a missing sequence is waited for. Sequences are assigned when
+	// the changes are inserted, so a change may be committed after a later
+	// one. A sequence missing for longer belongs to a rolled back insert.
+	runWatchGapTimeout = 5 * time.Second
+	runWatchPageSize   = 500
+	// The number of changes published by an API server between the deletions
+	// of the changes exceeding the history size.
+	runWatchRetentionInterval = 100
+)
+
+// RunEventType is the kind of change of a run or task.
+type RunEventType string
+
+const (
+	RunEventCreated RunEventType = "CREATED"
+	RunEventUpdated RunEventType = "UPDATED"
+	RunEventDeleted RunEventType = "DELETED"
+)
+
+// RunEvent is a change of a run, or of one of its tasks.
+type RunEvent struct {
+	Type RunEventType
+	// Identifies the position of the event in the stream of events.
+	ResourceVersion string
+	// The run that changed, or the run of the task that changed.
+	Run *model.Run
+	// The task that changed. Nil for the changes of runs.
+	Task *model.Task
+}
+
+// RunWatchFilter selects the events of the runs in a namespace, an
+// experiment and a set of states. Empty fields match all runs.
+type RunWatchFilter struct {
+	Namespace    string
+	ExperimentId string
+	States       []model.RuntimeState
+}
+
+// Returns the type of the event a change is sent as, if the filter matches
+// it. A run leaving the watched states is sent as deleted, so that watchers
+// can drop it.
+func (f *RunWatchFilter) eventType(change *model.RunChange) (RunEventType, bool) {
+	eventType := RunEventType(change.Type)
+	if f == nil {
+		return eventType, true
+	}
+	if f.Namespace != "" && f.Namespace != change.Namespace {
+		return "", false
+	}
+	if f.ExperimentId != "" && f.ExperimentId != change.ExperimentUUID {
+		return "", false
+	}
+	if len(f.States) == 0 || f.matchesState(change.State) {
+		return eventType, true
+	}
+	if eventType == RunEventUpdated && f.matchesState(change.PreviousState) {
+		return RunEventDeleted, true
+	}
+	return "", false
+}
+
+func (f *RunWatchFilter) matchesState(state model.RuntimeState) bool {
+	for _, watched := range f.States {
+		if watched.ToV2() == state.ToV2() {
+			return true
+		}
+	}
+	return false
+}
+
+// RunWatcher receives the events matching its filter. The events channel is
+// closed when the watcher is stopped, or when it falls too far behind, in
+// which case Err returns the reason.
+type RunWatcher struct {
+	hub    *runWatchHub
+	filter *RunWatchFilter
+	events chan *RunEvent
+	// The sequence of the last change the watcher received or skipped.
+	sequence int64
+	closed   bool
+	err      error
+}
+
+// Events returns the channel the events are delivered to.
+func (w *RunWatcher) Events() <-chan *RunEvent {
+	return w.events
+}
+
+// Err returns why the watcher was closed, if it was not stopped.
+func (w *RunWatcher) Err() error {
+	w.hub.mu.Lock()
+	defer w.hub.mu.Unlock()
+	return w.err
+}
+
+// Bookmark returns the resource version to resume the watch from, if all the
+// events sent to the watcher were received.
+func (w *RunWatcher) Bookmark() (string, bool) {
+	w.hub.mu.Lock()
+	defer w.hub.mu.Unlock()
+	if w.closed || len(w.events) > 0 {
+		return "", false
+	}
+	return formatResourceVersion(w.sequence), true
+}
+
+// Stop unregisters the watcher and closes its events channel.
+func (w *RunWatcher) Stop() {
+	w.hub.mu.Lock()
+	defer w.hub.mu.Unlock()
+	w.hub.close(w, nil)
+}
+
+// Sends the changes of runs and tasks to watchers. The changes are recorded
+// in the database by the API server instance that made them, and every
+// instance polls them while it has watchers, so that watchers see the changes
+// of all instances and can resume from a resource version after a restart.
+// The most recent changes are kept.
+type runWatchHub struct {
+	runStore    storage.RunStoreInterface
+	taskStore   storage.TaskStoreInterface
+	time        util.TimeInterface
+	historySize int
+	// Wakes the polling goroutine up when a change is recorded.
+	wake chan struct{}
+
+	// Serializes the polls and the registrations of watchers, so that the
+	// database is queried without holding mu. Guards the fields below.
+	pollMu sync.Mutex
+	// The sequence up to which all the changes were sent to the watchers, or
+	// given up. Only valid while there are watchers.
+	sequence int64
+	// The changes after the sequence that were sent already, because an
+	// earlier change is missing.
+	sent map[int64]bool
+	// The missing changes after the sequence, and when they were found to be
+	// missing.
+	gaps map[int64]time.Time
+
+	mu        sync.Mutex
+	published int
+	polling   bool
+	watchers  map[*RunWatcher]bool
+}
+
+func newRunWatchHub(runStore storage.RunStoreInterface, taskStore storage.TaskStoreInterface, time util.TimeInterface, options *ResourceManagerOptions) *runWatchHub {
+	h := &runWatchHub{
+		runStore:    runStore,
+		taskStore:   taskStore,
+		time:        time,
+		historySize: defaultRunWatchHistorySize,
+		wake:        make(chan struct{}, 1),
+		watchers:    make(map[*RunWatcher]bool),
+	}
+	if options != nil && options.RunWatchHistorySize > 0 {
+		h.historySize = options.RunWatchHistorySize
+	}
+	return h
+}
+
+// Records a change of a run, or of one of its tasks. The change is sent to
+// the watchers of this API server by the polling goroutine, so that the
+// caller is not held up by the watchers. The previous state is the state of
+// the run before the change.
+func (h *runWatchHub) publish(eventType RunEventType, run *model.Run, task *model.Task, previousState model.RuntimeState) {
+	if run == nil {
+		return
+	}
+	change := &model.RunChange{
+		Type:           string(eventType),
+		RunUUID:        run.UUID,
+		Namespace:      run.Namespace,
+		ExperimentUUID: run.ExperimentId,
+		State:          run.State.ToV2(),
+		PreviousState:  previousState.ToV2(),
+	}
+	if task != nil {
+		change.TaskUUID = task.UUID
+		change.PreviousState = change.State
+	}
+	if err := h.runStore.CreateRunChange(change); err != nil {
+		glog.Warningf("Failed to send a change of run %v to watchers: %v", run.UUID, err)
+		return
+	}
+	h.mu.Lock()
+	h.published++
+	trim := h.published%runWatchRetentionInterval == 0
+	watched := len(h.watchers) > 0
+	h.mu.Unlock()
+	if trim {
+		go h.trim()
+	}
+	if watched {
+		select {
+		case h.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Deletes the changes exceeding the history size.
+func (h *runWatchHub) trim() {
+	oldest, latest, err := h.runStore.GetRunChangeSequences()
+	if err != nil {
+		glog.Warningf("Failed to delete the old run changes: %v", err)
+		return
+	}
+	if latest-oldest < int64(h.historySize) {
+		return
+	}
+	if err := h.runStore.DeleteRunChanges(latest - int64(h.historySize)); err != nil {
+		glog.Warningf("Failed to delete the old run changes: %v", err)
+	}
+}
+
+// Sends the changes recorded since the last poll to the watchers whose
+// filter matches them. Watchers that fell behind are closed. A missing change
+// does not hold up the later ones, it is sent when it is committed, or given
+// up after a while.
+func (h *runWatchHub) poll() {
+	h.pollMu.Lock()
+	defer h.pollMu.Unlock()
+	after := h.sequence
+	for {
+		// Watchers are only registered while polls are held off, so the
+		// watchers the events are fetched for cannot miss any.
+		h.mu.Lock()
+		watchers := make([]*RunWatcher, 0, len(h.watchers))
+		for w := range h.watchers {
+			watchers = append(watchers, w)
+		}
+		h.mu.Unlock()
+		if len(watchers) == 0 {
+			return
+		}
+		changes, err := h.runStore.ListRunChanges(after, runWatchPageSize)
+		if err != nil {
+			glog.Warningf("Failed to fetch the run changes after %v: %v", after, err)
+			return
+		}
+		events := make([]*RunEvent, len(changes))
+		for i, change := range changes {
+			for missing := after + 1; missing < change.Sequence; missing++ {
+				if _, ok := h.gaps[missing]; !ok && !h.sent[missing] {
+					h.gaps[missing] = h.time.Now()
+				}
+			}
+			delete(h.gaps, change.Sequence)
+			after = change.Sequence
+			if h.sent[change.Sequence] {
+				continue
+			}
+			for _, w := range watchers {
+				if _, ok := w.filter.eventType(change); ok {
+					events[i] = h.newEvent(change)
+					break
+				}
+			}
+		}
+		h.mu.Lock()
+		for i, change := range changes {
+			if !h.sent[change.Sequence] {
+				h.dispatch(change, events[i])
+				h.sent[change.Sequence] = true
+			}
+		}
+		h.advance()
+		h.mu.Unlock()
+		if len(changes) < runWatchPageSize {
+			return
+		}
+	}
+}
+
+// Moves the sequence past the changes sent and the missing changes given up.
+// Requires both locks.
+func (h *runWatchHub) advance() {
+	var now time.Time
+	for {
+		next := h.sequence + 1
+		if h.sent[next] {
+			delete(h.sent, next)
+			h.sequence = next
+			continue
+		}
+		since, ok := h.gaps[next]
+		if !ok {
+			break
+		}
+		if now.IsZero() {
+			now = h.time.Now()
+		}
+		if now.Sub(since) < runWatchGapTimeout {
+			break
+		}
+		delete(h.gaps, next)
+		h.sequence = next
+	}
+	for w := range h.watchers {
+		if w.sequence < h.sequence {
+			w.sequence = h.sequence
+		}
+	}
+}
+
+// Polls the changes until there are no watchers left.
+func (h *runWatchHub) pollUntilUnwatched() {
+	ticker := time.NewTicker(runWatchPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-h.wake:
+		}
+		h.poll()
+		h.mu.Lock()
+		if len(h.watchers) == 0 {
+			h.polling = false
+			h.mu.Unlock()
+			return
+		}
+		h.mu.Unlock()
+	}
+}
+
+// Sends a change to the watchers whose filter matches it. The event is nil
+// if no watcher matched the change when it was fetched. Requires the lock.
+func (h *runWatchHub) dispatch(change *model.RunChange, event *RunEvent) {
+	for w := range h.watchers {
+		if change.Sequence <= w.sequence {
+			continue
+		}
+		eventType, ok := w.filter.eventType(change)
+		if !ok || event == nil {
+			continue
+		}
+		watcherEvent := event
+		if eventType != event.Type {
+			eventCopy := *event
+			eventCopy.Type = eventType
+			watcherEvent = &eventCopy
+		}
+		select {
+		case w.events <- watcherEvent:
+		default:
+			h.close(w, util.NewUnavailableServerError(errors.New("watcher fell behind"), "Watch closed at resource version %v. Watch again from the last received resource version", event.ResourceVersion))
+		}
+	}
+}
+
+// Creates the event of a change with the current run and task. The run and
+// the task may have changed again, or have been deleted, since the change,
+// in which case only their identifiers and the recorded state are sent.
+func (h *runWatchHub) newEvent(change *model.RunChange) *RunEvent {
+	event := &RunEvent{
+		Type:            RunEventType(change.Type),
+		ResourceVersion: formatResourceVersion(change.Sequence),
+		Run: &model.Run{
+			UUID:         change.RunUUID,
+			Namespace:    change.Namespace,
+			ExperimentId: change.ExperimentUUID,
+			RunDetails:   model.RunDetails{State: change.State},
+		},
+	}
+	if event.Type != RunEventDeleted || change.TaskUUID != "" {
+		if run, err := h.runStore.GetRun(change.RunUUID); err == nil {
+			event.Run = run
+		} else if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+			glog.Warningf("Failed to fetch run %v to send it to watchers: %v", change.RunUUID, err)
+		}
+	}
+	if change.TaskUUID != "" {
+		event.Task = &model.Task{UUID: change.TaskUUID, RunId: change.RunUUID}
+		if task, err := h.taskStore.GetTask(change.TaskUUID); err == nil {
+			task.State = comparedTaskState(task)
+			event.Task = task
+		} else if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+			glog.Warningf("Failed to fetch task %v to send it to watchers: %v", change.TaskUUID, err)
+		}
+	}
+	return event
+}
+
+// Registers a watcher. If a resource version is given, the recorded changes
+// after it are sent first.
+func (h *runWatchHub) watch(filter *RunWatchFilter, resourceVersion string) (*RunWatcher, error) {
+	h.pollMu.Lock()
+	defer h.pollMu.Unlock()
+	oldest, latest, err := h.runStore.GetRunChangeSequences()
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to fetch the run changes")
+	}
+	h.mu.Lock()
+	if len(h.watchers) == 0 {
+		// The changes were not polled without watchers.
+		h.sequence = latest
+		h.sent = make(map[int64]bool)
+		h.gaps = make(map[int64]time.Time)
+	}
+	h.mu.Unlock()
+	w := &RunWatcher{
+		hub:      h,
+		filter:   filter,
+		sequence: h.sequence,
+	}
+	var replay []*RunEvent
+	if resourceVersion != "" {
+		sequence, err := parseResourceVersion(resourceVersion)
+		if err != nil {
+			return nil, err
+		}
+		if sequence > latest {
+			return nil, util.NewOutOfRangeError("Resource version %v is unknown. List the runs again to get a new resource version", resourceVersion)
+		}
+		if sequence+1 < oldest {
+			return nil, util.NewOutOfRangeError("Resource version %v is too old. List the runs again to get a new resource version", resourceVersion)
+		}
+		// The changes sent to the other watchers already are replayed, the
+		// later ones are sent when they are polled.
+		lastSent := h.sequence
+		for sent := range h.sent {
+			if sent > lastSent {
+				lastSent = sent
+			}
+		}
+		for after := sequence; after < lastSent; {
+			changes, err := h.runStore.ListRunChanges(after, runWatchPageSize)
+			if err != nil {
+				return nil, util.Wrap(err, "Failed to fetch the run changes")
+			}
+			for _, change := range changes {
+				after = change.Sequence
+				if change.Sequence > h.sequence && !h.sent[change.Sequence] {
+					continue
+				}
+				if eventType, ok := filter.eventType(change); ok {
+					event := h.newEvent(change)
+					event.Type = eventType
+					replay = append(replay, event)
+				}
+			}
+			if len(changes) < runWatchPageSize {
+				break
+			}
+		}
+		w.sequence = sequence
+		if w.sequence < h.sequence {
+			w.sequence = h.sequence
+		}
+	}
+	w.events = make(chan *RunEvent, len(replay)+runWatcherBufferSize)
+	for _, event := range replay {
+		w.events <- event
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.watchers[w] = true
+	if !h.polling {
+		h.polling = true
+		go h.pollUntilUnwatched()
+	}
+	return w, nil
+}
+
+// Returns the current resource version. Watching from it returns the changes
+// after this call.
+func (h *runWatchHub) resourceVersion() (string, error) {
+	_, latest, err := h.runStore.GetRunChangeSequences()
+	if err != nil {
+		return "", util.Wrap(err, "Failed to fetch the run changes")
+	}
+	return formatResourceVersion(latest), nil
+}
+
+func formatResourceVersion(sequence int64) string {
+	return strconv.FormatInt(sequence, 10)
+}
+
+func parseResourceVersion(resourceVersion string) (int64, error) {
+	sequence, err := strconv.ParseInt(resourceVersion, 10, 64)
+	if err != nil || sequence < 0 {
+		return 0, util.NewInvalidInputError("Invalid resource version %v", resourceVersion)
+	}
+	return sequence, nil
+}
+
+// Closes a watcher. Requires the lock.
+func (h *runWatchHub) close(w *RunWatcher, err error) {
+	if w.closed {
+		return
+	}
+	delete(h.watchers, w)
+	w.closed = true
+	w.err = err
+	close(w.events)
+}
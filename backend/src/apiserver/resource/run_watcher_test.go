@@ -0,0 +1,328 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// Returns the events pending on a watcher without waiting for more.
+func pendingRunEvents(w *RunWatcher) []*RunEvent {
+	var events []*RunEvent
+	for {
+		select {
+		case event, ok := <-w.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+// Returns a hub backed by a fake database.
+func newTestRunWatchHub(t *testing.T, options *ResourceManagerOptions) (*FakeClientManager, *runWatchHub) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	t.Cleanup(func() { store.Close() })
+	return store, newRunWatchHub(store.RunStore(), store.TaskStore(), store.Time(), options)
+}
+
+func runInState(id string, namespace string, state model.RuntimeState) *model.Run {
+	return &model.Run{UUID: id, Namespace: namespace, RunDetails: model.RunDetails{State: state}}
+}
+
+func TestRunWatchHub_Filter(t *testing.T) {
+	_, hub := newTestRunWatchHub(t, &ResourceManagerOptions{})
+	watcher, err := hub.watch(&RunWatchFilter{
+		Namespace: "ns1",
+		States:    []model.RuntimeState{model.RuntimeStateRunning},
+	}, "")
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	hub.publish(RunEventUpdated, runInState("run1", "ns1", model.RuntimeStateRunning), nil, model.RuntimeStatePending)
+	hub.publish(RunEventUpdated, runInState("run2", "ns2", model.RuntimeStateRunning), nil, model.RuntimeStatePending)
+	hub.publish(RunEventUpdated, runInState("run3", "ns1", model.RuntimeStateSucceeded), nil, model.RuntimeStatePending)
+	// V1 states match their V2 equivalent.
+	hub.publish(RunEventUpdated, runInState("run4", "ns1", model.RuntimeStateRunningV1), nil, model.RuntimeStatePending)
+	// A run leaving the watched states is deleted.
+	hub.publish(RunEventUpdated, runInState("run1", "ns1", model.RuntimeStateSucceeded), nil, model.RuntimeStateRunning)
+	hub.poll()
+
+	events := pendingRunEvents(watcher)
+	require.Len(t, events, 3)
+	assert.Equal(t, RunEventUpdated, events[0].Type)
+	assert.Equal(t, "run1", events[0].Run.UUID)
+	assert.Equal(t, "1", events[0].ResourceVersion)
+	assert.Equal(t, RunEventUpdated, events[1].Type)
+	assert.Equal(t, "run4", events[1].Run.UUID)
+	assert.Equal(t, "4", events[1].ResourceVersion)
+	assert.Equal(t, RunEventDeleted, events[2].Type)
+	assert.Equal(t, "run1", events[2].Run.UUID)
+	assert.Equal(t, "5", events[2].ResourceVersion)
+}
+
+func TestRunWatchHub_Resume(t *testing.T) {
+	store, hub := newTestRunWatchHub(t, &ResourceManagerOptions{RunWatchHistorySize: 2})
+	hub.publish(RunEventCreated, &model.Run{UUID: "run1"}, nil, "")
+	resourceVersion, err := hub.resourceVersion()
+	require.Nil(t, err)
+	hub.publish(RunEventCreated, &model.Run{UUID: "run2"}, nil, "")
+	hub.publish(RunEventDeleted, &model.Run{UUID: "run1"}, nil, "")
+	hub.trim()
+
+	// The changes are resumed from the database, e.g. after a restart.
+	restartedHub := newRunWatchHub(store.RunStore(), store.TaskStore(), store.Time(), &ResourceManagerOptions{RunWatchHistorySize: 2})
+	watcher, err := restartedHub.watch(nil, resourceVersion)
+	require.Nil(t, err)
+	defer watcher.Stop()
+	events := pendingRunEvents(watcher)
+	require.Len(t, events, 2)
+	assert.Equal(t, RunEventCreated, events[0].Type)
+	assert.Equal(t, "run2", events[0].Run.UUID)
+	assert.Equal(t, RunEventDeleted, events[1].Type)
+	assert.Equal(t, "run1", events[1].Run.UUID)
+
+	// The first change is no longer kept.
+	_, err = restartedHub.watch(nil, "0")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.OutOfRange))
+}
+
+func TestRunWatchHub_OtherInstance(t *testing.T) {
+	store, hub := newTestRunWatchHub(t, &ResourceManagerOptions{})
+	otherHub := newRunWatchHub(store.RunStore(), store.TaskStore(), store.Time(), &ResourceManagerOptions{})
+	watcher, err := hub.watch(nil, "")
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	otherHub.publish(RunEventCreated, &model.Run{UUID: "run1"}, nil, "")
+	assert.Empty(t, pendingRunEvents(watcher))
+	hub.poll()
+	events := pendingRunEvents(watcher)
+	require.Len(t, events, 1)
+	assert.Equal(t, "run1", events[0].Run.UUID)
+	assert.Equal(t, "1", events[0].ResourceVersion)
+}
+
+// Records a change with the given sequence, e.g. one committed after a later
+// change.
+func insertRunChange(t *testing.T, store *FakeClientManager, sequence int64, runID string) {
+	_, err := store.DB().Exec(
+		"INSERT INTO run_changes (Sequence, Type, RunUUID, TaskUUID, Namespace, ExperimentUUID, State, PreviousState) VALUES (?, ?, ?, '', '', '', '', '')",
+		sequence, string(RunEventCreated), runID)
+	require.Nil(t, err)
+}
+
+func TestRunWatchHub_MissingChange(t *testing.T) {
+	store, hub := newTestRunWatchHub(t, &ResourceManagerOptions{})
+	watcher, err := hub.watch(nil, "")
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	// The changes after a missing change are sent right away.
+	insertRunChange(t, store, 1, "run1")
+	insertRunChange(t, store, 3, "run3")
+	hub.poll()
+	events := pendingRunEvents(watcher)
+	require.Len(t, events, 2)
+	assert.Equal(t, "1", events[0].ResourceVersion)
+	assert.Equal(t, "3", events[1].ResourceVersion)
+	resourceVersion, ok := watcher.Bookmark()
+	assert.True(t, ok)
+	assert.Equal(t, "1", resourceVersion)
+
+	// A watcher resuming from before the missing change gets the changes
+	// sent already.
+	resumed, err := hub.watch(nil, "1")
+	require.Nil(t, err)
+	defer resumed.Stop()
+	events = pendingRunEvents(resumed)
+	require.Len(t, events, 1)
+	assert.Equal(t, "3", events[0].ResourceVersion)
+
+	// The missing change is sent once it is committed.
+	insertRunChange(t, store, 2, "run2")
+	hub.poll()
+	for _, w := range []*RunWatcher{watcher, resumed} {
+		events = pendingRunEvents(w)
+		require.Len(t, events, 1)
+		assert.Equal(t, "2", events[0].ResourceVersion)
+		resourceVersion, ok = w.Bookmark()
+		assert.True(t, ok)
+		assert.Equal(t, "3", resourceVersion)
+	}
+
+	// A change missing for too long is given up.
+	insertRunChange(t, store, 5, "run5")
+	for i := 0; i <= int(runWatchGapTimeout/time.Second); i++ {
+		hub.poll()
+	}
+	events = pendingRunEvents(watcher)
+	require.Len(t, events, 1)
+	assert.Equal(t, "5", events[0].ResourceVersion)
+	resourceVersion, ok = watcher.Bookmark()
+	assert.True(t, ok)
+	assert.Equal(t, "5", resourceVersion)
+}
+
+func TestRunWatchHub_InvalidResourceVersion(t *testing.T) {
+	_, hub := newTestRunWatchHub(t, &ResourceManagerOptions{})
+	hub.publish(RunEventCreated, &model.Run{UUID: "run1"}, nil, "")
+
+	tests := []struct {
+		name            string
+		resourceVersion string
+		code            codes.Code
+	}{
+		{"malformed", "invalid", codes.InvalidArgument},
+		{"negative", "-1", codes.InvalidArgument},
+		{"future sequence", "2", codes.OutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := hub.watch(nil, tt.resourceVersion)
+			assert.True(t, util.IsUserErrorCodeMatch(err, tt.code))
+		})
+	}
+}
+
+func TestRunWatchHub_FellBehind(t *testing.T) {
+	_, hub := newTestRunWatchHub(t, &ResourceManagerOptions{})
+	watcher, err := hub.watch(nil, "")
+	require.Nil(t, err)
+	for i := 0; i <= runWatcherBufferSize; i++ {
+		hub.publish(RunEventCreated, &model.Run{UUID: "run1"}, nil, "")
+	}
+	hub.poll()
+
+	assert.Len(t, pendingRunEvents(watcher), runWatcherBufferSize)
+	assert.True(t, util.IsUserErrorCodeMatch(watcher.Err(), codes.Unavailable))
+	_, ok := watcher.Bookmark()
+	assert.False(t, ok)
+	// Stopping a closed watcher keeps its error.
+	watcher.Stop()
+	assert.NotNil(t, watcher.Err())
+}
+
+func TestRunWatcher_Bookmark(t *testing.T) {
+	_, hub := newTestRunWatchHub(t, &ResourceManagerOptions{})
+	watcher, err := hub.watch(&RunWatchFilter{Namespace: "ns1"}, "")
+	require.Nil(t, err)
+	hub.publish(RunEventCreated, runInState("run1", "ns1", model.RuntimeStatePending), nil, "")
+	hub.poll()
+
+	_, ok := watcher.Bookmark()
+	assert.False(t, ok)
+	<-watcher.Events()
+	resourceVersion, ok := watcher.Bookmark()
+	assert.True(t, ok)
+	assert.Equal(t, "1", resourceVersion)
+
+	// The changes filtered out move the bookmark too.
+	hub.publish(RunEventCreated, runInState("run2", "ns2", model.RuntimeStatePending), nil, "")
+	hub.poll()
+	resourceVersion, ok = watcher.Bookmark()
+	assert.True(t, ok)
+	assert.Equal(t, "2", resourceVersion)
+
+	watcher.Stop()
+	_, ok = <-watcher.Events()
+	assert.False(t, ok)
+	assert.Nil(t, watcher.Err())
+}
+
+func TestWatchRuns_RunChanges(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	watcher, err := manager.WatchRuns(&RunWatchFilter{ExperimentId: run.ExperimentId}, "")
+	require.Nil(t, err)
+	defer watcher.Stop()
+	runningWatcher, err := manager.WatchRuns(&RunWatchFilter{States: []model.RuntimeState{model.RuntimeStateRunning}}, "")
+	require.Nil(t, err)
+	defer runningWatcher.Stop()
+
+	// The events carry the run as it is when they are sent.
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowRunning)
+	manager.runWatchHub.poll()
+	// Reporting the same state again is not a change.
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowRunning)
+	reportRunPhase(t, manager, run, v1alpha1.WorkflowSucceeded)
+	manager.runWatchHub.poll()
+	require.Nil(t, manager.DeleteRun(context.Background(), run.UUID))
+	manager.runWatchHub.poll()
+
+	events := pendingRunEvents(watcher)
+	require.Len(t, events, 3)
+	assert.Equal(t, RunEventUpdated, events[0].Type)
+	assert.Equal(t, model.RuntimeStateRunning, events[0].Run.State)
+	assert.Equal(t, RunEventUpdated, events[1].Type)
+	assert.Equal(t, model.RuntimeStateSucceeded, events[1].Run.State)
+	assert.Equal(t, RunEventDeleted, events[2].Type)
+	assert.Equal(t, run.UUID, events[2].Run.UUID)
+	assert.Nil(t, events[2].Task)
+
+	// The run is deleted for the watcher of running runs once it succeeds.
+	events = pendingRunEvents(runningWatcher)
+	require.Len(t, events, 2)
+	assert.Equal(t, RunEventUpdated, events[0].Type)
+	assert.Equal(t, RunEventDeleted, events[1].Type)
+	assert.Equal(t, run.UUID, events[1].Run.UUID)
+}
+
+func TestWatchRuns_TaskChanges(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	resourceVersion, err := manager.RunResourceVersion()
+	require.Nil(t, err)
+	watcher, err := manager.WatchRuns(nil, resourceVersion)
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	createTask := func(state model.RuntimeState) {
+		_, err := manager.CreateOrUpdateTasks([]*model.Task{{
+			PodName:      "pod1",
+			Namespace:    "ns1",
+			PipelineName: "pipeline1",
+			RunId:        run.UUID,
+			State:        state,
+		}})
+		require.Nil(t, err)
+		// The events carry the task as it is when they are sent.
+		manager.runWatchHub.poll()
+	}
+	createTask(model.RuntimeStateRunning)
+	createTask(model.RuntimeStateRunning)
+	createTask(model.RuntimeStateSucceeded)
+
+	events := pendingRunEvents(watcher)
+	require.Len(t, events, 2)
+	assert.Equal(t, RunEventCreated, events[0].Type)
+	assert.Equal(t, run.UUID, events[0].Run.UUID)
+	assert.Equal(t, "pod1", events[0].Task.PodName)
+	assert.Equal(t, model.RuntimeStateRunning, events[0].Task.State)
+	assert.Equal(t, RunEventUpdated, events[1].Type)
+	assert.Equal(t, model.RuntimeStateSucceeded, events[1].Task.State)
+}
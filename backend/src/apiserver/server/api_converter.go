@@ -17,8 +17,10 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
@@ -26,18 +28,116 @@ import (
 	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/robfig/cron"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// Validates user-defined labels of a pipeline, an experiment, a run or a recurring run.
+// Labels follow the Kubernetes label syntax as they are propagated to the Kubernetes
+// resources, and must not use the prefixes reserved for system labels.
+func toModelLabels(labels map[string]string) (map[string]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, util.NewInvalidInputError("Invalid label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, util.NewInvalidInputError("Invalid value %q of label %q: %s", value, key, strings.Join(errs, "; "))
+		}
+		if prefix, _, found := strings.Cut(key, "/"); found && isReservedLabelPrefix(prefix) {
+			return nil, util.NewInvalidInputError("Invalid label key %q: prefix %q is reserved for system labels", key, prefix)
+		}
+	}
+	return labels, nil
+}
+
+// Returns true if the label key prefix is reserved for labels set by Kubeflow Pipelines or Argo Workflows.
+func isReservedLabelPrefix(prefix string) bool {
+	return prefix == "pipeline" ||
+		prefix == "kubeflow.org" || strings.HasSuffix(prefix, ".kubeflow.org") ||
+		prefix == "argoproj.io" || strings.HasSuffix(prefix, ".argoproj.io")
+}
+
+// Validates the notification subscriptions of an experiment or a recurring run
+// and converts them to their internal representation.
+// Supports v2beta1 API.
+func toModelNotifications(notifications []*apiv2beta1.NotificationSubscription) ([]*model.NotificationSubscription, error) {
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+	modelNotifications := make([]*model.NotificationSubscription, 0, len(notifications))
+	for _, notification := range notifications {
+		webhookURL, err := url.Parse(notification.GetWebhookUrl())
+		if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+			return nil, util.NewInvalidInputError("Notification webhook URL %q must be an absolute http or https URL", notification.GetWebhookUrl())
+		}
+		if notification.GetPendingTimeoutSeconds() < 0 {
+			return nil, util.NewInvalidInputError("Notification for %q has a negative pending timeout: %v", notification.GetWebhookUrl(), notification.GetPendingTimeoutSeconds())
+		}
+		var states []model.RuntimeState
+		for _, apiState := range notification.GetStates() {
+			switch apiState {
+			case apiv2beta1.RuntimeState_PENDING, apiv2beta1.RuntimeState_SUCCEEDED, apiv2beta1.RuntimeState_FAILED, apiv2beta1.RuntimeState_CANCELED:
+			default:
+				return nil, util.NewInvalidInputError("Notification for %q subscribes to state %v. Only PENDING, SUCCEEDED, FAILED and CANCELED are supported", notification.GetWebhookUrl(), apiState)
+			}
+			state, err := toModelRuntimeState(apiState)
+			if err != nil {
+				return nil, util.Wrap(err, "Failed to convert notification states to their internal representation")
+			}
+			states = append(states, state)
+		}
+		if notification.GetSecretName() == "" && notification.GetSecretKey() != "" {
+			return nil, util.NewInvalidInputError("Notification for %q sets a secret key without a secret name", notification.GetWebhookUrl())
+		}
+		modelNotifications = append(modelNotifications, &model.NotificationSubscription{
+			WebhookURL:            notification.GetWebhookUrl(),
+			States:                states,
+			PendingTimeoutSeconds: notification.GetPendingTimeoutSeconds(),
+			SecretName:            notification.GetSecretName(),
+			SecretKey:             notification.GetSecretKey(),
+		})
+	}
+	return modelNotifications, nil
+}
+
+// Converts internal notification subscriptions to their API counterparts.
+// Supports v2beta1 API.
+func toApiNotifications(notifications []*model.NotificationSubscription) []*apiv2beta1.NotificationSubscription {
+	if len(notifications) == 0 {
+		return nil
+	}
+	apiNotifications := make([]*apiv2beta1.NotificationSubscription, 0, len(notifications))
+	for _, notification := range notifications {
+		var states []apiv2beta1.RuntimeState
+		for i := range notification.States {
+			states = append(states, toApiRuntimeState(&notification.States[i]))
+		}
+		apiNotifications = append(apiNotifications, &apiv2beta1.NotificationSubscription{
+			WebhookUrl:            notification.WebhookURL,
+			States:                states,
+			PendingTimeoutSeconds: notification.PendingTimeoutSeconds,
+			SecretName:            notification.SecretName,
+			SecretKey:             notification.SecretKey,
+		})
+	}
+	return apiNotifications
+}
+
 // Converts API experiment to its internal representation.
 // Supports both v1beta1 abd v2beta1 API.
 func toModelExperiment(e interface{}) (*model.Experiment, error) {
 	var namespace, name, description string
+	var labels map[string]string
+	var notifications []*model.NotificationSubscription
 	switch apiExperiment := e.(type) {
 	case *apiv1beta1.Experiment:
 		name = apiExperiment.GetName()
@@ -47,18 +147,30 @@ func toModelExperiment(e interface{}) (*model.Experiment, error) {
 		name = apiExperiment.GetDisplayName()
 		namespace = apiExperiment.GetNamespace()
 		description = apiExperiment.GetDescription()
+		var err error
+		if labels, err = toModelLabels(apiExperiment.GetLabels()); err != nil {
+			return nil, util.Wrap(err, "Failed to convert API experiment to model experiment")
+		}
+		if notifications, err = toModelNotifications(apiExperiment.GetNotifications()); err != nil {
+			return nil, util.Wrap(err, "Failed to convert API experiment to model experiment")
+		}
 	default:
 		return nil, util.NewUnknownApiVersionError("Experiment", e)
 	}
 	if name == "" {
 		return nil, util.NewInternalServerError(util.NewInvalidInputError("Experiment must have a non-empty name"), "Failed to convert API experiment to model experiment")
 	}
-	return &model.Experiment{
+	experiment := &model.Experiment{
 		Name:         name,
 		Description:  description,
 		Namespace:    namespace,
 		StorageState: model.StorageStateAvailable,
-	}, nil
+		Labels:       labels,
+	}
+	if err := experiment.SetNotifications(notifications); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to convert API experiment to model experiment")
+	}
+	return experiment, nil
 }
 
 // Converts internal experiment representation to its API counterpart.
@@ -112,6 +224,13 @@ func toApiExperiment(experiment *model.Experiment) *apiv2beta1.Experiment {
 	default:
 		storageState = apiv2beta1.Experiment_StorageState(apiv2beta1.Experiment_StorageState_value["STORAGE_STATE_UNSPECIFIED"])
 	}
+	notifications, err := experiment.GetNotifications()
+	if err != nil {
+		return &apiv2beta1.Experiment{
+			ExperimentId: experiment.UUID,
+			Error:        util.ToRpcStatus(util.NewInternalServerError(err, "Failed to convert experiment's internal representation to its API counterpart due to notifications parsing error")),
+		}
+	}
 	return &apiv2beta1.Experiment{
 		ExperimentId:     experiment.UUID,
 		DisplayName:      experiment.Name,
@@ -120,6 +239,8 @@ func toApiExperiment(experiment *model.Experiment) *apiv2beta1.Experiment {
 		LastRunCreatedAt: timestamppb.New(time.Unix(experiment.LastRunCreatedAtInSec, 0)),
 		Namespace:        experiment.Namespace,
 		StorageState:     storageState,
+		Labels:           experiment.Labels,
+		Notifications:    toApiNotifications(notifications),
 	}
 }
 
@@ -147,6 +268,7 @@ func toApiExperiments(experiments []*model.Experiment) []*apiv2beta1.Experiment
 // Supports both v1beta1 abd v2beta1 API.
 func toModelPipeline(p interface{}) (*model.Pipeline, error) {
 	var name, displayName, namespace, description string
+	var labels map[string]string
 	switch apiPipeline := p.(type) {
 	case *apiv1beta1.Pipeline:
 		namespace = getNamespaceFromResourceReferenceV1(apiPipeline.GetResourceReferences())
@@ -158,6 +280,10 @@ func toModelPipeline(p interface{}) (*model.Pipeline, error) {
 		name = apiPipeline.GetName()
 		displayName = apiPipeline.GetDisplayName()
 		description = apiPipeline.GetDescription()
+		var err error
+		if labels, err = toModelLabels(apiPipeline.GetLabels()); err != nil {
+			return nil, util.Wrap(err, "Failed to convert API pipeline to model pipeline")
+		}
 	default:
 		return nil, util.NewUnknownApiVersionError("Pipeline", p)
 	}
@@ -178,6 +304,7 @@ func toModelPipeline(p interface{}) (*model.Pipeline, error) {
 		Namespace:   namespace,
 		Description: description,
 		Status:      model.PipelineCreating,
+		Labels:      labels,
 	}, nil
 }
 
@@ -296,6 +423,7 @@ func toApiPipeline(pipeline *model.Pipeline) *apiv2beta1.Pipeline {
 		Description: pipeline.Description,
 		CreatedAt:   timestamppb.New(time.Unix(pipeline.CreatedAtInSec, 0)),
 		Namespace:   pipeline.Namespace,
+		Labels:      pipeline.Labels,
 	}
 }
 
@@ -713,6 +841,9 @@ func toModelTrigger(t interface{}) (*model.Trigger, error) {
 		if apiTrigger.GetCronSchedule() != nil {
 			cronSchedule := apiTrigger.GetCronSchedule()
 			modelTrigger.CronSchedule = model.CronSchedule{Cron: &cronSchedule.Cron}
+			if cronSchedule.TimeZone != "" {
+				modelTrigger.CronScheduleTimeZone = &cronSchedule.TimeZone
+			}
 			if cronSchedule.StartTime != nil {
 				modelTrigger.CronScheduleStartTimeInSec = &cronSchedule.StartTime.Seconds
 			}
@@ -732,6 +863,13 @@ func toModelTrigger(t interface{}) (*model.Trigger, error) {
 				modelTrigger.PeriodicScheduleEndTimeInSec = &periodicSchedule.EndTime.Seconds
 			}
 		}
+		eventTrigger, err := toModelEventTrigger(apiTrigger)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to convert API trigger to its internal representation")
+		}
+		if err := modelTrigger.SetEventTrigger(eventTrigger); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to convert API trigger to its internal representation")
+		}
 	case *apiv1beta1.Trigger:
 		if apiTrigger.GetCronSchedule() != nil {
 			cronSchedule := apiTrigger.GetCronSchedule()
@@ -761,6 +899,41 @@ func toModelTrigger(t interface{}) (*model.Trigger, error) {
 	return &modelTrigger, nil
 }
 
+// Converts the object store or webhook trigger of an API trigger to its internal
+// representation. Returns nil if the API trigger is a schedule.
+func toModelEventTrigger(apiTrigger *apiv2beta1.Trigger) (*model.EventTrigger, error) {
+	if objectStoreTrigger := apiTrigger.GetObjectStoreTrigger(); objectStoreTrigger != nil {
+		if objectStoreTrigger.GetBucketUrl() == "" {
+			return nil, util.NewInvalidInputError("Object store trigger must have a bucket URL")
+		}
+		if objectStoreTrigger.GetPollIntervalSecond() < 0 {
+			return nil, util.NewInvalidInputError("Object store trigger has a negative poll interval: %v", objectStoreTrigger.GetPollIntervalSecond())
+		}
+		return &model.EventTrigger{
+			ObjectStore: &model.ObjectStoreTrigger{
+				BucketURL:          objectStoreTrigger.GetBucketUrl(),
+				Provider:           objectStoreTrigger.GetProvider(),
+				ProviderParams:     objectStoreTrigger.GetProviderParams(),
+				PollIntervalSecond: objectStoreTrigger.GetPollIntervalSecond(),
+				PayloadParameters:  objectStoreTrigger.GetPayloadParameters(),
+			},
+		}, nil
+	}
+	if webhookTrigger := apiTrigger.GetWebhookTrigger(); webhookTrigger != nil {
+		if webhookTrigger.GetSecretName() == "" {
+			return nil, util.NewInvalidInputError("Webhook trigger must have the name of the secret holding its HMAC key")
+		}
+		return &model.EventTrigger{
+			Webhook: &model.WebhookTrigger{
+				SecretName:        webhookTrigger.GetSecretName(),
+				SecretKey:         webhookTrigger.GetSecretKey(),
+				PayloadParameters: webhookTrigger.GetPayloadParameters(),
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
 // Converts internal trigger representation to its API counterpart.
 // Supports v1beta1 API.
 // Note: returns nil if a parsing error occurs.
@@ -806,6 +979,9 @@ func toApiTrigger(trigger *model.Trigger) *apiv2beta1.Trigger {
 	if trigger.Cron != nil && *trigger.Cron != "" {
 		var cronSchedule apiv2beta1.CronSchedule
 		cronSchedule.Cron = *trigger.Cron
+		if trigger.CronScheduleTimeZone != nil {
+			cronSchedule.TimeZone = *trigger.CronScheduleTimeZone
+		}
 		if trigger.CronScheduleStartTimeInSec != nil {
 			cronSchedule.StartTime = timestamppb.New(time.Unix(*trigger.CronScheduleStartTimeInSec, 0))
 		}
@@ -825,6 +1001,26 @@ func toApiTrigger(trigger *model.Trigger) *apiv2beta1.Trigger {
 		}
 		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_PeriodicSchedule{PeriodicSchedule: &periodicSchedule}}
 	}
+	eventTrigger, err := trigger.GetEventTrigger()
+	if err != nil {
+		return nil
+	}
+	if eventTrigger != nil && eventTrigger.ObjectStore != nil {
+		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_ObjectStoreTrigger{ObjectStoreTrigger: &apiv2beta1.ObjectStoreTrigger{
+			BucketUrl:          eventTrigger.ObjectStore.BucketURL,
+			Provider:           eventTrigger.ObjectStore.Provider,
+			ProviderParams:     eventTrigger.ObjectStore.ProviderParams,
+			PollIntervalSecond: eventTrigger.ObjectStore.PollIntervalSecond,
+			PayloadParameters:  eventTrigger.ObjectStore.PayloadParameters,
+		}}}
+	}
+	if eventTrigger != nil && eventTrigger.Webhook != nil {
+		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_WebhookTrigger{WebhookTrigger: &apiv2beta1.WebhookTrigger{
+			SecretName:        eventTrigger.Webhook.SecretName,
+			SecretKey:         eventTrigger.Webhook.SecretKey,
+			PayloadParameters: eventTrigger.Webhook.PayloadParameters,
+		}}}
+	}
 	if trigger.IntervalSecond == nil && trigger.Cron == nil {
 		return &apiv2beta1.Trigger{}
 	}
@@ -1058,6 +1254,117 @@ func toApiRunMetricsV1(m []*model.RunMetric) []*apiv1beta1.RunMetric {
 	return apiMetrics
 }
 
+// Converts an array of internal run metric representations to an array of their API counterparts.
+// Supports v2beta1 API.
+// Returns nil if there are no metrics.
+func toApiRunMetrics(m []*model.RunMetric) []*apiv2beta1.RunMetric {
+	if len(m) == 0 {
+		return nil
+	}
+	apiMetrics := make([]*apiv2beta1.RunMetric, 0, len(m))
+	for _, metric := range m {
+		apiMetrics = append(apiMetrics, &apiv2beta1.RunMetric{
+			Name:        metric.Name,
+			NodeId:      metric.NodeID,
+			NumberValue: metric.NumberValue,
+		})
+	}
+	return apiMetrics
+}
+
+// Converts the errors of a bulk operation on runs to an API response.
+// Supports v2beta1 API.
+func toApiBulkRunsResponse(runIds []string, errs []error) *apiv2beta1.BulkRunsResponse {
+	results := make([]*apiv2beta1.BulkRunsResponse_Result, 0, len(runIds))
+	for i, runId := range runIds {
+		result := &apiv2beta1.BulkRunsResponse_Result{RunId: runId}
+		if errs[i] != nil {
+			result.Error = util.ToRpcStatus(errs[i])
+		}
+		results = append(results, result)
+	}
+	return &apiv2beta1.BulkRunsResponse{Results: results}
+}
+
+// Converts the errors of a bulk operation on experiments to an API response.
+// Supports v2beta1 API.
+func toApiBulkExperimentsResponse(experimentIds []string, errs []error) *apiv2beta1.BulkExperimentsResponse {
+	results := make([]*apiv2beta1.BulkExperimentsResponse_Result, 0, len(experimentIds))
+	for i, experimentId := range experimentIds {
+		result := &apiv2beta1.BulkExperimentsResponse_Result{ExperimentId: experimentId}
+		if errs[i] != nil {
+			result.Error = util.ToRpcStatus(errs[i])
+		}
+		results = append(results, result)
+	}
+	return &apiv2beta1.BulkExperimentsResponse{Results: results}
+}
+
+// Converts a run comparison to its API counterpart, optionally dropping the
+// rows whose values are the same in all runs.
+// Supports v2beta1 API.
+func toApiRunComparison(c *model.RunComparison, onlyDifferences bool) *apiv2beta1.CompareRunsResponse {
+	apiRows := make([]*apiv2beta1.RunComparisonRow, 0, len(c.Rows))
+	for _, row := range c.Rows {
+		if onlyDifferences && !row.Differs {
+			continue
+		}
+		values := make([]*structpb.Value, 0, len(row.Values))
+		for _, value := range row.Values {
+			if value == nil {
+				value = structpb.NewNullValue()
+			}
+			values = append(values, value)
+		}
+		apiRows = append(apiRows, &apiv2beta1.RunComparisonRow{
+			Kind:     apiv2beta1.RunComparisonRow_Kind(apiv2beta1.RunComparisonRow_Kind_value[string(row.Kind)]),
+			TaskName: row.TaskName,
+			Key:      row.Key,
+			Values:   values,
+			Differs:  row.Differs,
+		})
+	}
+	return &apiv2beta1.CompareRunsResponse{RunIds: c.RunIds, Rows: apiRows}
+}
+
+// Converts a change of a run or task to its API counterpart.
+// Supports v2beta1 API.
+func toApiWatchRunsResponse(event *resource.RunEvent) *apiv2beta1.WatchRunsResponse {
+	response := &apiv2beta1.WatchRunsResponse{
+		Type:            apiv2beta1.WatchRunsResponse_EventType(apiv2beta1.WatchRunsResponse_EventType_value[string(event.Type)]),
+		ResourceVersion: event.ResourceVersion,
+	}
+	if event.Task != nil {
+		response.Object = &apiv2beta1.WatchRunsResponse_TaskDetail{TaskDetail: toApiPipelineTaskDetail(event.Task)}
+	} else {
+		response.Object = &apiv2beta1.WatchRunsResponse_Run{Run: toApiRun(event.Run)}
+	}
+	return response
+}
+
+// Converts the changes between two pipeline versions to their API counterparts.
+// Supports v2beta1 API.
+func toApiPipelineVersionChanges(changes []*model.PipelineVersionChange) []*apiv2beta1.PipelineVersionChange {
+	apiChanges := make([]*apiv2beta1.PipelineVersionChange, 0, len(changes))
+	for _, change := range changes {
+		baseValue, targetValue := change.BaseValue, change.TargetValue
+		if baseValue == nil {
+			baseValue = structpb.NewNullValue()
+		}
+		if targetValue == nil {
+			targetValue = structpb.NewNullValue()
+		}
+		apiChanges = append(apiChanges, &apiv2beta1.PipelineVersionChange{
+			Type:        apiv2beta1.PipelineVersionChange_Type(apiv2beta1.PipelineVersionChange_Type_value[string(change.Type)]),
+			TaskName:    change.TaskName,
+			Key:         change.Key,
+			BaseValue:   baseValue,
+			TargetValue: targetValue,
+		})
+	}
+	return apiChanges
+}
+
 // Convert results of run metrics creation to API response.
 // Supports v1beta1 API.
 // Return nil if a parsing error occurs.
@@ -1137,6 +1444,7 @@ func toModelRun(r interface{}) (*model.Run, error) {
 	var state model.RuntimeState
 	var stateHistory []*model.RuntimeStatus
 	var tasks []*model.Task
+	var labels map[string]string
 	switch r := r.(type) {
 	case *apiv1beta1.Run:
 		return toModelRun(&apiv1beta1.RunDetail{Run: r})
@@ -1257,6 +1565,10 @@ func toModelRun(r interface{}) (*model.Run, error) {
 		}
 		runDesc = apiRunV2.GetDescription()
 		serviceAcc = apiRunV2.GetServiceAccount()
+		var err error
+		if labels, err = toModelLabels(apiRunV2.GetLabels()); err != nil {
+			return nil, util.Wrap(err, "Failed to convert API run to its internal representation")
+		}
 		if temp, err := toModelStorageState(apiRunV2.GetStorageState()); err == nil {
 			storageState = temp.ToString()
 		}
@@ -1298,6 +1610,7 @@ func toModelRun(r interface{}) (*model.Run, error) {
 		StorageState:   model.StorageState(storageState),
 		ServiceAccount: serviceAcc,
 		Metrics:        modelMetrics,
+		Labels:         labels,
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:           pipelineId,
 			PipelineVersionId:    pipelineVersionId,
@@ -1495,6 +1808,8 @@ func toApiRun(r *model.Run) *apiv2beta1.Run {
 		ScheduledAt:    timestamppb.New(time.Unix(r.RunDetails.ScheduledAtInSec, 0)),
 		FinishedAt:     timestamppb.New(time.Unix(r.RunDetails.FinishedAtInSec, 0)),
 		RunDetails:     apiRd,
+		Metrics:        toApiRunMetrics(r.Metrics),
+		Labels:         r.Labels,
 	}
 	err := util.NewInvalidInputError("Failed to parse the pipeline source")
 	if r.PipelineSpec.PipelineVersionId != "" {
@@ -1810,6 +2125,32 @@ func toApiPipelineTaskDetails(tasks []*model.Task) []*apiv2beta1.PipelineTaskDet
 	return apiTasks
 }
 
+// Converts a cached task to its API cache entry counterpart.
+// Supports v2beta1 API.
+func toApiCacheEntry(task *model.Task) *apiv2beta1.CacheEntry {
+	return &apiv2beta1.CacheEntry{
+		Fingerprint:  task.Fingerprint,
+		Namespace:    task.Namespace,
+		PipelineName: strings.TrimPrefix(task.PipelineName, util.CachePipelineNamePrefix),
+		RunId:        task.RunId,
+		ExecutionId:  task.MLMDExecutionID,
+		CreatedAt:    timestamppb.New(time.Unix(task.CreatedTimestamp, 0)),
+	}
+}
+
+// Converts an array of cached tasks to their API cache entry counterparts.
+// Supports v2beta1 API.
+func toApiCacheEntries(tasks []*model.Task) []*apiv2beta1.CacheEntry {
+	if len(tasks) == 0 {
+		return nil
+	}
+	apiCacheEntries := make([]*apiv2beta1.CacheEntry, 0)
+	for _, task := range tasks {
+		apiCacheEntries = append(apiCacheEntries, toApiCacheEntry(task))
+	}
+	return apiCacheEntries
+}
+
 // Converts API recurring run to its internal representation.
 // Supports both v1beta1 and v2beta1 API.
 func toModelJob(j interface{}) (*model.Job, error) {
@@ -1821,6 +2162,8 @@ func toModelJob(j interface{}) (*model.Job, error) {
 	var maxConcur, createTime, updateTime int64
 	var noCatchup, isEnabled bool
 	var trigger *model.Trigger
+	var labels map[string]string
+	var notifications []*model.NotificationSubscription
 	resRefs := make([]*model.ResourceReference, 0)
 	switch apiJob := j.(type) {
 	case *apiv1beta1.Job:
@@ -1916,6 +2259,14 @@ func toModelJob(j interface{}) (*model.Job, error) {
 
 		jobId = apiJob.GetRecurringRunId()
 		desc = apiJob.GetDescription()
+		labels, err = toModelLabels(apiJob.GetLabels())
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to convert a API recurring run to its internal representation")
+		}
+		notifications, err = toModelNotifications(apiJob.GetNotifications())
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to convert a API recurring run to its internal representation")
+		}
 		namespace = apiJob.GetNamespace()
 		experimentId = apiJob.GetExperimentId()
 		serviceAcc = apiJob.GetServiceAccount()
@@ -1939,6 +2290,12 @@ func toModelJob(j interface{}) (*model.Job, error) {
 				"Schedule cron is not a supported format(https://godoc.org/github.com/robfig/cron). Error: %v", err)
 		}
 	}
+	if trigger != nil && trigger.CronSchedule.CronScheduleTimeZone != nil {
+		if _, err := time.LoadLocation(*trigger.CronSchedule.CronScheduleTimeZone); err != nil {
+			return nil, util.NewInvalidInputError(
+				"Schedule time zone %v is not a valid IANA time zone. Error: %v", *trigger.CronSchedule.CronScheduleTimeZone, err)
+		}
+	}
 	if trigger != nil && trigger.PeriodicSchedule.IntervalSecond != nil {
 		if *trigger.PeriodicSchedule.IntervalSecond < 1 {
 			return nil, util.NewInvalidInputError(
@@ -1957,7 +2314,7 @@ func toModelJob(j interface{}) (*model.Job, error) {
 	} else {
 		status = model.StatusStateDisabled
 	}
-	return &model.Job{
+	job := &model.Job{
 		UUID:               jobId,
 		DisplayName:        jobName,
 		K8SName:            k8sName,
@@ -1973,6 +2330,7 @@ func toModelJob(j interface{}) (*model.Job, error) {
 		ExperimentId:       experimentId,
 		ResourceReferences: resRefs,
 		Trigger:            *trigger,
+		Labels:             labels,
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:           pipelineId,
 			PipelineName:         pipelineName,
@@ -1985,7 +2343,11 @@ func toModelJob(j interface{}) (*model.Job, error) {
 				PipelineRoot: pipelineRoot,
 			},
 		},
-	}, nil
+	}
+	if err := job.SetNotifications(notifications); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to convert API recurring run to its internal representation")
+	}
+	return job, nil
 }
 
 // Converts API recurring run's mode to its internal representation.
@@ -2192,6 +2554,14 @@ func toApiRecurringRun(j *model.Job) *apiv2beta1.RecurringRun {
 		}
 	}
 
+	notifications, err := j.GetNotifications()
+	if err != nil {
+		return &apiv2beta1.RecurringRun{
+			RecurringRunId: j.UUID,
+			Error:          util.ToRpcStatus(util.NewInternalServerError(err, "Failed to convert recurring run's internal representation to its API counterpart due to notifications parsing error")),
+		}
+	}
+
 	apiRecurringRunV2 := &apiv2beta1.RecurringRun{
 		RecurringRunId: j.UUID,
 		DisplayName:    j.DisplayName,
@@ -2206,6 +2576,8 @@ func toApiRecurringRun(j *model.Job) *apiv2beta1.RecurringRun {
 		RuntimeConfig:  runtimeConfig,
 		Namespace:      j.Namespace,
 		ExperimentId:   j.ExperimentId,
+		Labels:         j.Labels,
+		Notifications:  toApiNotifications(notifications),
 	}
 
 	if j.PipelineSpec.PipelineId == "" && j.PipelineSpec.PipelineVersionId == "" {
@@ -29,11 +29,87 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func TestToModelLabels(t *testing.T) {
+	tests := []struct {
+		name         string
+		labels       map[string]string
+		want         map[string]string
+		errorMessage string
+	}{
+		{"empty", map[string]string{}, nil, ""},
+		{"valid", map[string]string{"team": "vision", "example.com/project": "kfp", "empty": ""}, map[string]string{"team": "vision", "example.com/project": "kfp", "empty": ""}, ""},
+		{"invalid key", map[string]string{"team!": "vision"}, nil, "Invalid label key"},
+		{"invalid value", map[string]string{"team": "computer vision"}, nil, "Invalid value"},
+		{"reserved prefix", map[string]string{"pipelines.kubeflow.org/cache_enabled": "false"}, nil, "is reserved"},
+		{"reserved run id", map[string]string{"pipeline/runid": "1"}, nil, "is reserved"},
+		{"reserved argo prefix", map[string]string{"workflows.argoproj.io/phase": "Failed"}, nil, "is reserved"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toModelLabels(tt.labels)
+			if tt.errorMessage != "" {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.errorMessage)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToModelNotifications(t *testing.T) {
+	notifications := []*apiv2beta1.NotificationSubscription{
+		{WebhookUrl: "https://hooks.example.com/all"},
+		{
+			WebhookUrl:            "http://hooks.example.com:8080/stuck",
+			States:                []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_PENDING, apiv2beta1.RuntimeState_CANCELED},
+			PendingTimeoutSeconds: 60,
+			SecretName:            "hook-secret",
+			SecretKey:             "key",
+		},
+	}
+	modelNotifications, err := toModelNotifications(notifications)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.NotificationSubscription{
+		{WebhookURL: "https://hooks.example.com/all"},
+		{
+			WebhookURL:            "http://hooks.example.com:8080/stuck",
+			States:                []model.RuntimeState{model.RuntimeStatePending, model.RuntimeStateCanceled},
+			PendingTimeoutSeconds: 60,
+			SecretName:            "hook-secret",
+			SecretKey:             "key",
+		},
+	}, modelNotifications)
+	assert.Equal(t, notifications[1].String(), toApiNotifications(modelNotifications)[1].String())
+
+	tests := []struct {
+		name         string
+		notification *apiv2beta1.NotificationSubscription
+		errorMessage string
+	}{
+		{"missing URL", &apiv2beta1.NotificationSubscription{}, "must be an absolute http or https URL"},
+		{"relative URL", &apiv2beta1.NotificationSubscription{WebhookUrl: "/hooks"}, "must be an absolute http or https URL"},
+		{"unsupported scheme", &apiv2beta1.NotificationSubscription{WebhookUrl: "ftp://hooks.example.com"}, "must be an absolute http or https URL"},
+		{"unsupported state", &apiv2beta1.NotificationSubscription{WebhookUrl: "https://hooks.example.com", States: []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_RUNNING}}, "Only PENDING, SUCCEEDED, FAILED and CANCELED are supported"},
+		{"negative timeout", &apiv2beta1.NotificationSubscription{WebhookUrl: "https://hooks.example.com", PendingTimeoutSeconds: -1}, "negative pending timeout"},
+		{"secret key without secret", &apiv2beta1.NotificationSubscription{WebhookUrl: "https://hooks.example.com", SecretKey: "key"}, "without a secret name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toModelNotifications([]*apiv2beta1.NotificationSubscription{tt.notification})
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errorMessage)
+		})
+	}
+}
+
 func TestToModelExperiment(t *testing.T) {
 	tests := []struct {
 		name                    string
@@ -2081,6 +2157,14 @@ func TestToApiExperiments(t *testing.T) {
 	assert.Equal(t, expectedApiExps, apiExps)
 }
 
+func TestToApiExperiment_InvalidNotifications(t *testing.T) {
+	apiExperiment := toApiExperiment(&model.Experiment{UUID: "exp1", Name: "experiment1", Notifications: "not json"})
+	assert.Equal(t, "exp1", apiExperiment.GetExperimentId())
+	assert.Empty(t, apiExperiment.GetDisplayName())
+	assert.Equal(t, int32(codes.Internal), apiExperiment.GetError().GetCode())
+	assert.Contains(t, apiExperiment.GetError().GetMessage(), "notifications parsing error")
+}
+
 func TestToApiParameters(t *testing.T) {
 	expectedApiParameters := []*apiv1beta1.Parameter{{Name: "param2", Value: "world"}}
 	modelParameters := `[{"name":"param2","value":"world"}]`
@@ -2152,6 +2236,71 @@ func TestToApiRuntimeConfigV1(t *testing.T) {
 	assert.Equal(t, expectedRuntimeConfig.String(), actualRuntimeConfig.String())
 }
 
+func TestToModelTrigger_CronTimeZone(t *testing.T) {
+	apiTrigger := &apiv2beta1.Trigger{
+		Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+			Cron:     "0 0 2 * * *",
+			TimeZone: "Europe/Berlin",
+		}},
+	}
+	modelTrigger, err := toModelTrigger(apiTrigger)
+	assert.Nil(t, err)
+	assert.Equal(t, "Europe/Berlin", *modelTrigger.CronScheduleTimeZone)
+	assert.Equal(t, apiTrigger.String(), toApiTrigger(modelTrigger).String())
+
+	apiTrigger.GetCronSchedule().TimeZone = "Invalid/Zone"
+	_, err = toModelJob(&apiv2beta1.RecurringRun{
+		DisplayName:    "recurring run",
+		MaxConcurrency: 1,
+		Trigger:        apiTrigger,
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineVersionReference{
+			PipelineVersionReference: &apiv2beta1.PipelineVersionReference{PipelineId: "p1"},
+		},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "time zone")
+}
+
+func TestToModelTrigger_EventTriggers(t *testing.T) {
+	apiTriggers := []*apiv2beta1.Trigger{
+		{
+			Trigger: &apiv2beta1.Trigger_ObjectStoreTrigger{ObjectStoreTrigger: &apiv2beta1.ObjectStoreTrigger{
+				BucketUrl:          "s3://bucket/incoming",
+				Provider:           "s3",
+				ProviderParams:     map[string]string{"fromEnv": "true"},
+				PollIntervalSecond: 30,
+				PayloadParameters:  map[string]string{"path": "uri"},
+			}},
+		},
+		{
+			Trigger: &apiv2beta1.Trigger_WebhookTrigger{WebhookTrigger: &apiv2beta1.WebhookTrigger{
+				SecretName:        "webhook-secret",
+				SecretKey:         "key",
+				PayloadParameters: map[string]string{"text": "greeting.text"},
+			}},
+		},
+	}
+	for _, apiTrigger := range apiTriggers {
+		modelTrigger, err := toModelTrigger(apiTrigger)
+		assert.Nil(t, err)
+		assert.True(t, modelTrigger.CronSchedule.IsEmpty())
+		assert.True(t, modelTrigger.PeriodicSchedule.IsEmpty())
+		assert.Equal(t, apiTrigger.String(), toApiTrigger(modelTrigger).String())
+	}
+
+	_, err := toModelTrigger(&apiv2beta1.Trigger{
+		Trigger: &apiv2beta1.Trigger_ObjectStoreTrigger{ObjectStoreTrigger: &apiv2beta1.ObjectStoreTrigger{}},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "bucket URL")
+
+	_, err = toModelTrigger(&apiv2beta1.Trigger{
+		Trigger: &apiv2beta1.Trigger_WebhookTrigger{WebhookTrigger: &apiv2beta1.WebhookTrigger{}},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "secret")
+}
+
 func TestToApiRecurringRun(t *testing.T) {
 	modelJob := &model.Job{
 		UUID:        "job1",
@@ -4445,3 +4594,13 @@ func Test_toApiRun(t *testing.T) {
 		})
 	}
 }
+
+func TestToApiPipelineVersionChanges(t *testing.T) {
+	changes := toApiPipelineVersionChanges([]*model.PipelineVersionChange{
+		{Type: model.PipelineVersionChangeTypeTaskAdded, TaskName: "hello"},
+	})
+	assert.Len(t, changes, 1)
+	assert.Equal(t, apiv2beta1.PipelineVersionChange_TASK_ADDED, changes[0].GetType())
+	assert.Equal(t, structpb.NewNullValue(), changes[0].GetBaseValue())
+	assert.Equal(t, structpb.NewNullValue(), changes[0].GetTargetValue())
+}
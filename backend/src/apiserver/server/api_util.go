@@ -17,6 +17,7 @@ package server
 import (
 	"encoding/json"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
@@ -37,7 +38,7 @@ const (
 	// * Allows "_", "-" and numbers in the middle
 	// * Additionally, numbers are also allowed at the end
 	// * At most 64 characters.
-	metricNamePattern = "^[a-zA-Z]([-_a-zA-Z0-9]{0,62}[a-zA-Z0-9])?$"
+	metricNamePattern = model.RunMetricNamePattern
 )
 
 // Returns namespace inferred from v1beta1 API resource references.
@@ -433,6 +434,25 @@ func apiParametersToStringV1(params []*apiv1beta1.Parameter) string {
 	return s.String()
 }
 
+// Returns the set of fields listed in the update mask of a request that updates the
+// description and the labels of a pipeline, an experiment or a run. Resources with further
+// updatable fields pass them as extraFields.
+func metadataUpdateFields(resourceType string, resourceId string, paths []string, extraFields ...string) (map[string]bool, error) {
+	if len(paths) == 0 {
+		return nil, util.NewInvalidInputError("Failed to update %v %v due to an empty update mask", resourceType, resourceId)
+	}
+	fields := make(map[string]bool)
+	for _, path := range paths {
+		switch {
+		case path == "description", path == "labels", slices.Contains(extraFields, path):
+			fields[path] = true
+		default:
+			return nil, util.NewInvalidInputError("Failed to update %v %v as field %v cannot be updated", resourceType, resourceId, path)
+		}
+	}
+	return fields, nil
+}
+
 // Validates a run metric fields from request.
 func validateRunMetric(metric *model.RunMetric) error {
 	matched, err := regexp.MatchString(metricNamePattern, metric.Name)
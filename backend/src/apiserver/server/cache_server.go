@@ -0,0 +1,144 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/url"
+
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/protobuf/encoding/protojson"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type CacheServer struct {
+	resourceManager *resource.ResourceManager
+	apiv2beta1.UnimplementedCacheServiceServer
+}
+
+// Lists the cache entries recorded by cached tasks.
+// Supports v2beta1 behavior.
+func (s *CacheServer) ListCacheEntries(ctx context.Context, request *apiv2beta1.ListCacheEntriesRequest) (*apiv2beta1.ListCacheEntriesResponse, error) {
+	namespace := s.resourceManager.ReplaceNamespace(request.GetNamespace())
+	err := s.canAccessCache(ctx, namespace, common.RbacResourceVerbList)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list cache entries due to authorization error. Check if you have permission to access namespace %s", namespace)
+	}
+
+	filterSpec, err := cacheEntriesFilterSpec(namespace, request.GetFingerprint(), request.GetRunId(), request.GetPipelineName())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list cache entries")
+	}
+	opts, err := validatedListOptions(&model.Task{}, request.GetPageToken(), int(request.GetPageSize()), request.GetSortBy(), filterSpec, "v2beta1")
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+
+	tasks, totalSize, nextPageToken, err := s.resourceManager.ListTasks(&model.FilterContext{}, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list cache entries")
+	}
+	return &apiv2beta1.ListCacheEntriesResponse{
+		CacheEntries:  toApiCacheEntries(tasks),
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Invalidates the cache entries matching the request, so that later tasks
+// with the same fingerprint are executed again.
+// Supports v2beta1 behavior.
+func (s *CacheServer) InvalidateCacheEntries(ctx context.Context, request *apiv2beta1.InvalidateCacheEntriesRequest) (*apiv2beta1.InvalidateCacheEntriesResponse, error) {
+	if request.GetFingerprint() == "" && request.GetRunId() == "" && request.GetPipelineName() == "" {
+		return nil, util.NewInvalidInputError("Failed to invalidate cache entries: at least one of fingerprint, run_id and pipeline_name must be specified")
+	}
+	namespace := s.resourceManager.ReplaceNamespace(request.GetNamespace())
+	err := s.canAccessCache(ctx, namespace, common.RbacResourceVerbInvalidate)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to invalidate cache entries due to authorization error. Check if you have permission to access namespace %s", namespace)
+	}
+
+	pipelineName := ""
+	if request.GetPipelineName() != "" {
+		pipelineName = util.CachePipelineNamePrefix + request.GetPipelineName()
+	}
+	count, err := s.resourceManager.InvalidateCacheEntries(namespace, request.GetFingerprint(), request.GetRunId(), pipelineName)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to invalidate cache entries")
+	}
+	return &apiv2beta1.InvalidateCacheEntriesResponse{InvalidatedCount: int32(count)}, nil
+}
+
+// Builds the encoded filter selecting the cache entries, i.e. the tasks with a
+// non-empty fingerprint, that match all non-empty selectors.
+func cacheEntriesFilterSpec(namespace string, fingerprint string, runId string, pipelineName string) (string, error) {
+	stringPredicate := func(key string, op apiv2beta1.Predicate_Operation, value string) *apiv2beta1.Predicate {
+		return &apiv2beta1.Predicate{
+			Key:       key,
+			Operation: op,
+			Value:     &apiv2beta1.Predicate_StringValue{StringValue: value},
+		}
+	}
+	f := &apiv2beta1.Filter{}
+	if fingerprint != "" {
+		f.Predicates = append(f.Predicates, stringPredicate("fingerprint", apiv2beta1.Predicate_EQUALS, fingerprint))
+	} else {
+		f.Predicates = append(f.Predicates, stringPredicate("fingerprint", apiv2beta1.Predicate_NOT_EQUALS, ""))
+	}
+	if namespace != "" {
+		f.Predicates = append(f.Predicates, stringPredicate("namespace", apiv2beta1.Predicate_EQUALS, namespace))
+	}
+	if runId != "" {
+		f.Predicates = append(f.Predicates, stringPredicate("run_id", apiv2beta1.Predicate_EQUALS, runId))
+	}
+	if pipelineName != "" {
+		f.Predicates = append(f.Predicates, stringPredicate("pipeline_name", apiv2beta1.Predicate_EQUALS, util.CachePipelineNamePrefix+pipelineName))
+	}
+	filterJSON, err := protojson.Marshal(f)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to marshal the cache entries filter")
+	}
+	return url.QueryEscape(string(filterJSON)), nil
+}
+
+func (s *CacheServer) canAccessCache(ctx context.Context, namespace string, verb string) error {
+	if !common.IsMultiUserMode() {
+		// Skip authz if not multi-user mode.
+		return nil
+	}
+	if s.resourceManager.IsEmptyNamespace(namespace) {
+		return util.NewInvalidInputError("Cache entries cannot be accessed with an empty namespace in multi-user mode")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeCaches,
+	}
+	err := s.resourceManager.IsAuthorized(ctx, resourceAttributes)
+	if err != nil {
+		return util.Wrapf(err, "Failed to access cache entries. Check if you have access to namespace %s", namespace)
+	}
+	return nil
+}
+
+func NewCacheServer(resourceManager *resource.ResourceManager) *CacheServer {
+	return &CacheServer{resourceManager: resourceManager}
+}
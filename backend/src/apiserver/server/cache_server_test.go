@@ -0,0 +1,146 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func initCacheEntries(t *testing.T, resourceManager *resource.ResourceManager) {
+	tasks := []*model.Task{
+		{UUID: "task1", Namespace: "ns1", PipelineName: "pipeline/p1", RunId: "run1", MLMDExecutionID: "1", CreatedTimestamp: 1, Fingerprint: "fp1"},
+		{UUID: "task2", Namespace: "ns1", PipelineName: "pipeline/p1", RunId: "run2", MLMDExecutionID: "2", CreatedTimestamp: 2, Fingerprint: "fp1"},
+		{UUID: "task3", Namespace: "ns1", PipelineName: "pipeline/p2", RunId: "run2", MLMDExecutionID: "3", CreatedTimestamp: 3, Fingerprint: "fp2"},
+		{UUID: "task4", Namespace: "ns2", PipelineName: "pipeline/p1", RunId: "run3", MLMDExecutionID: "4", CreatedTimestamp: 4, Fingerprint: "fp1"},
+		// A task without a fingerprint is not a cache entry.
+		{UUID: "task5", Namespace: "ns1", PipelineName: "pipeline/p1", RunId: "run1", MLMDExecutionID: "5", CreatedTimestamp: 5},
+	}
+	_, err := resourceManager.CreateOrUpdateTasks(tasks)
+	require.Nil(t, err)
+}
+
+func TestListCacheEntries(t *testing.T) {
+	clients, resourceManager, _ := initWithExperiment(t)
+	defer clients.Close()
+	initCacheEntries(t, resourceManager)
+	server := NewCacheServer(resourceManager)
+
+	response, err := server.ListCacheEntries(context.Background(), &apiv2beta1.ListCacheEntriesRequest{PipelineName: "p1", SortBy: "create_time"})
+	require.Nil(t, err)
+	assert.Equal(t, int32(3), response.GetTotalSize())
+	require.Len(t, response.GetCacheEntries(), 3)
+	assert.Equal(t, "fp1", response.GetCacheEntries()[0].GetFingerprint())
+	assert.Equal(t, "p1", response.GetCacheEntries()[0].GetPipelineName())
+	assert.Equal(t, "run1", response.GetCacheEntries()[0].GetRunId())
+	assert.Equal(t, "1", response.GetCacheEntries()[0].GetExecutionId())
+	assert.Equal(t, int64(1), response.GetCacheEntries()[0].GetCreatedAt().GetSeconds())
+
+	response, err = server.ListCacheEntries(context.Background(), &apiv2beta1.ListCacheEntriesRequest{RunId: "run2", PageSize: 1, SortBy: "create_time"})
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), response.GetTotalSize())
+	require.Len(t, response.GetCacheEntries(), 1)
+	assert.Equal(t, "fp1", response.GetCacheEntries()[0].GetFingerprint())
+	pageToken := response.GetNextPageToken()
+	require.NotEmpty(t, pageToken)
+
+	response, err = server.ListCacheEntries(context.Background(), &apiv2beta1.ListCacheEntriesRequest{RunId: "run2", PageSize: 1, SortBy: "create_time", PageToken: pageToken})
+	require.Nil(t, err)
+	require.Len(t, response.GetCacheEntries(), 1)
+	assert.Equal(t, "fp2", response.GetCacheEntries()[0].GetFingerprint())
+	assert.Empty(t, response.GetNextPageToken())
+
+	// The page token cannot be reused with different selectors.
+	_, err = server.ListCacheEntries(context.Background(), &apiv2beta1.ListCacheEntriesRequest{RunId: "run1", PageSize: 1, SortBy: "create_time", PageToken: pageToken})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "page token does not match")
+}
+
+func TestInvalidateCacheEntries(t *testing.T) {
+	clients, resourceManager, _ := initWithExperiment(t)
+	defer clients.Close()
+	initCacheEntries(t, resourceManager)
+	server := NewCacheServer(resourceManager)
+
+	response, err := server.InvalidateCacheEntries(context.Background(), &apiv2beta1.InvalidateCacheEntriesRequest{Fingerprint: "fp1", PipelineName: "p1"})
+	require.Nil(t, err)
+	assert.Equal(t, int32(3), response.GetInvalidatedCount())
+
+	listResponse, err := server.ListCacheEntries(context.Background(), &apiv2beta1.ListCacheEntriesRequest{})
+	require.Nil(t, err)
+	require.Len(t, listResponse.GetCacheEntries(), 1)
+	assert.Equal(t, "fp2", listResponse.GetCacheEntries()[0].GetFingerprint())
+
+	// The task history of the runs is kept.
+	task, err := resourceManager.GetTask("task1")
+	require.Nil(t, err)
+	assert.Equal(t, "run1", task.RunId)
+	assert.Empty(t, task.Fingerprint)
+}
+
+func TestInvalidateCacheEntries_NoSelector(t *testing.T) {
+	clients, resourceManager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewCacheServer(resourceManager)
+
+	_, err := server.InvalidateCacheEntries(context.Background(), &apiv2beta1.InvalidateCacheEntriesRequest{Namespace: "ns1"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "at least one of fingerprint, run_id and pipeline_name must be specified")
+}
+
+func TestInvalidateCacheEntries_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, resourceManager, _ := initWithExperiment(t)
+	defer clients.Close()
+	initCacheEntries(t, resourceManager)
+	server := NewCacheServer(resourceManager)
+
+	_, err := server.InvalidateCacheEntries(ctx, &apiv2beta1.InvalidateCacheEntriesRequest{Fingerprint: "fp1"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "empty namespace")
+
+	// Only the entries in the requested namespace are invalidated.
+	response, err := server.InvalidateCacheEntries(ctx, &apiv2beta1.InvalidateCacheEntriesRequest{Namespace: "ns2", Fingerprint: "fp1"})
+	require.Nil(t, err)
+	assert.Equal(t, int32(1), response.GetInvalidatedCount())
+}
+
+func TestInvalidateCacheEntries_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, resourceManager, _ := initWithExperiment_SubjectAccessReview_Unauthorized(t)
+	defer clients.Close()
+	server := NewCacheServer(resourceManager)
+
+	_, err := server.InvalidateCacheEntries(ctx, &apiv2beta1.InvalidateCacheEntriesRequest{Namespace: "ns1", Fingerprint: "fp1"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied: User 'user@google.com' is not authorized with reason: this is not allowed")
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventStreamContentType is the media type of server-sent events.
+const EventStreamContentType = "text/event-stream"
+
+// NewEventStreamMarshaler returns a marshaler that sends the messages of
+// server-streaming RPCs as server-sent events, with the data encoded by the
+// given marshaler. The resource version of a run change is used as the event
+// ID, so that browsers resume watching runs after the last change they
+// received. Other responses are left to the given marshaler.
+func NewEventStreamMarshaler(marshaler runtime.Marshaler) runtime.Marshaler {
+	return &eventStreamMarshaler{Marshaler: marshaler}
+}
+
+type eventStreamMarshaler struct {
+	runtime.Marshaler
+}
+
+// Stream messages are wrapped by the gateway into a result or an error chunk.
+func isStreamChunk(v interface{}) bool {
+	switch chunk := v.(type) {
+	case map[string]interface{}:
+		_, ok := chunk["result"]
+		return ok
+	case map[string]proto.Message:
+		_, ok := chunk["error"]
+		return ok
+	default:
+		return false
+	}
+}
+
+func (m *eventStreamMarshaler) ContentType(v interface{}) string {
+	if isStreamChunk(v) {
+		return EventStreamContentType
+	}
+	return m.Marshaler.ContentType(v)
+}
+
+func (m *eventStreamMarshaler) StreamContentType(v interface{}) string {
+	return EventStreamContentType
+}
+
+// Delimiter follows every event, leaving a blank line after its data.
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil || !isStreamChunk(v) {
+		return data, err
+	}
+	var event bytes.Buffer
+	switch chunk := v.(type) {
+	case map[string]interface{}:
+		if response, ok := chunk["result"].(*apiv2beta1.WatchRunsResponse); ok && response.GetResourceVersion() != "" {
+			event.WriteString("id: " + response.GetResourceVersion() + "\n")
+		}
+	case map[string]proto.Message:
+		event.WriteString("event: error\n")
+	}
+	for _, line := range bytes.Split(bytes.TrimRight(data, "\n"), []byte("\n")) {
+		event.WriteString("data: ")
+		event.Write(line)
+		event.WriteString("\n")
+	}
+	return event.Bytes(), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventStreamMarshaler_Result(t *testing.T) {
+	marshaler := NewEventStreamMarshaler(&runtime.JSONPb{})
+	chunk := map[string]interface{}{"result": &apiv2beta1.WatchRunsResponse{
+		Type:            apiv2beta1.WatchRunsResponse_CREATED,
+		ResourceVersion: "1",
+		Object:          &apiv2beta1.WatchRunsResponse_Run{Run: &apiv2beta1.Run{RunId: "run1"}},
+	}}
+
+	data, err := marshaler.Marshal(chunk)
+	require.Nil(t, err)
+	assert.Equal(t, "id: 1\ndata: {\"result\":{\"type\":\"CREATED\",\"resourceVersion\":\"1\",\"run\":{\"runId\":\"run1\"}}}\n", string(data))
+	assert.Equal(t, "\n", string(marshaler.(runtime.Delimited).Delimiter()))
+	assert.Equal(t, EventStreamContentType, marshaler.ContentType(chunk))
+}
+
+func TestEventStreamMarshaler_Error(t *testing.T) {
+	marshaler := NewEventStreamMarshaler(&runtime.JSONPb{})
+	chunk := map[string]proto.Message{"error": status.New(codes.OutOfRange, "too old").Proto()}
+
+	data, err := marshaler.Marshal(chunk)
+	require.Nil(t, err)
+	assert.Equal(t, "event: error\ndata: {\"error\":{\"code\":11,\"message\":\"too old\"}}\n", string(data))
+}
+
+func TestEventStreamMarshaler_NotStreamed(t *testing.T) {
+	jsonMarshaler := &runtime.JSONPb{}
+	marshaler := NewEventStreamMarshaler(jsonMarshaler)
+	response := &apiv2beta1.Run{RunId: "run1"}
+
+	data, err := marshaler.Marshal(response)
+	require.Nil(t, err)
+	expected, err := jsonMarshaler.Marshal(response)
+	require.Nil(t, err)
+	assert.Equal(t, expected, data)
+	assert.Equal(t, jsonMarshaler.ContentType(response), marshaler.ContentType(response))
+}
@@ -32,6 +32,13 @@ import (
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
+const (
+	// The maximum number of experiments that a bulk operation can process.
+	maxBulkExperiments = 10000
+	// The page size used to list the experiments that match the filter of a bulk operation.
+	bulkExperimentsPageSize = maxPageSize
+)
+
 // Metric variables. Please prefix the metric names with experiment_server_.
 var (
 	// Used to calculate the request rate.
@@ -65,6 +72,26 @@ var (
 		Help: "The total number of UnarchiveExperiment requests",
 	})
 
+	updateExperimentRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "experiment_server_update_requests",
+		Help: "The total number of UpdateExperiment requests",
+	})
+
+	bulkArchiveExperimentsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "experiment_server_bulk_archive_requests",
+		Help: "The total number of BulkArchiveExperiments requests",
+	})
+
+	bulkUnarchiveExperimentsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "experiment_server_bulk_unarchive_requests",
+		Help: "The total number of BulkUnarchiveExperiments requests",
+	})
+
+	bulkDeleteExperimentsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "experiment_server_bulk_delete_requests",
+		Help: "The total number of BulkDeleteExperiments requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	experimentCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -211,6 +238,65 @@ func (s *ExperimentServer) GetExperiment(ctx context.Context, request *apiv2beta
 	return apiExperiment, nil
 }
 
+func (s *BaseExperimentServer) updateExperiment(ctx context.Context, experimentId string, update *apiv2beta1.Experiment, paths []string) (*model.Experiment, error) {
+	err := s.canAccessExperiment(ctx, experimentId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	fields, err := metadataUpdateFields("experiment", experimentId, paths, "notifications")
+	if err != nil {
+		return nil, err
+	}
+	labels, err := toModelLabels(update.GetLabels())
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update experiment %v", experimentId)
+	}
+	var notifications []*model.NotificationSubscription
+	if fields["notifications"] {
+		if notifications, err = toModelNotifications(update.GetNotifications()); err != nil {
+			return nil, util.Wrapf(err, "Failed to update experiment %v", experimentId)
+		}
+	}
+	experiment, err := s.resourceManager.GetExperiment(experimentId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to fetch experiment %v", experimentId)
+	}
+	if fields["description"] {
+		experiment.Description = update.GetDescription()
+	}
+	if fields["labels"] {
+		experiment.Labels = labels
+	}
+	if fields["notifications"] {
+		if err := experiment.SetNotifications(notifications); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to update experiment %v", experimentId)
+		}
+	}
+	return s.resourceManager.UpdateExperiment(experiment)
+}
+
+func (s *ExperimentServer) UpdateExperiment(ctx context.Context, request *apiv2beta1.UpdateExperimentRequest) (
+	*apiv2beta1.Experiment, error,
+) {
+	if s.options.CollectMetrics {
+		updateExperimentRequests.Inc()
+	}
+	experimentId := request.GetExperiment().GetExperimentId()
+	if experimentId == "" {
+		return nil, util.NewInvalidInputError("Failed to update an experiment due to an empty experiment id")
+	}
+	experiment, err := s.updateExperiment(ctx, experimentId, request.GetExperiment(), request.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to update experiment")
+	}
+
+	apiExperiment := toApiExperiment(experiment)
+	if apiExperiment == nil {
+		return nil, util.NewInternalServerError(errors.New("Failed to convert internal experiment representation to its API counterpart"), "Failed to update experiment")
+	}
+	return apiExperiment, nil
+}
+
 func (s *BaseExperimentServer) listExperiments(ctx context.Context, pageToken string, pageSize int32, sortBy string, opts *list.Options, namespace string) ([]*model.Experiment, int32, string, error) {
 	namespace = s.resourceManager.ReplaceNamespace(namespace)
 	resourceAttributes := &authorizationv1.ResourceAttributes{
@@ -425,6 +511,173 @@ func (s *ExperimentServer) UnarchiveExperiment(ctx context.Context, request *api
 	return &emptypb.Empty{}, nil
 }
 
+// Archives experiments in bulk.
+// Supports v2beta1 behavior.
+func (s *ExperimentServer) BulkArchiveExperiments(ctx context.Context, request *apiv2beta1.BulkExperimentsRequest) (*apiv2beta1.BulkExperimentsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkArchiveExperimentsRequests.Inc()
+	}
+	response, err := s.bulkExperimentOperation(ctx, request, common.RbacResourceVerbArchive, func(experimentIds []string) []error {
+		return s.resourceManager.ArchiveExperiments(ctx, experimentIds)
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to archive experiments")
+	}
+	return response, nil
+}
+
+// Un-archives experiments in bulk.
+// Supports v2beta1 behavior.
+func (s *ExperimentServer) BulkUnarchiveExperiments(ctx context.Context, request *apiv2beta1.BulkExperimentsRequest) (*apiv2beta1.BulkExperimentsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkUnarchiveExperimentsRequests.Inc()
+	}
+	response, err := s.bulkExperimentOperation(ctx, request, common.RbacResourceVerbUnarchive, func(experimentIds []string) []error {
+		return s.resourceManager.UnarchiveExperiments(experimentIds)
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to unarchive experiments")
+	}
+	return response, nil
+}
+
+// Deletes experiments in bulk.
+// Supports v2beta1 behavior.
+func (s *ExperimentServer) BulkDeleteExperiments(ctx context.Context, request *apiv2beta1.BulkExperimentsRequest) (*apiv2beta1.BulkExperimentsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkDeleteExperimentsRequests.Inc()
+	}
+	response, err := s.bulkExperimentOperation(ctx, request, common.RbacResourceVerbDelete, func(experimentIds []string) []error {
+		errs := s.resourceManager.DeleteExperiments(experimentIds)
+		if s.options.CollectMetrics {
+			for _, err := range errs {
+				if err == nil {
+					experimentCount.Dec()
+				}
+			}
+		}
+		return errs
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to delete experiments")
+	}
+	return response, nil
+}
+
+// Selects the experiments of a bulk request, authorizes the operation once per
+// namespace of the experiments, and performs it on the authorized experiments.
+func (s *ExperimentServer) bulkExperimentOperation(ctx context.Context, request *apiv2beta1.BulkExperimentsRequest, verb string, operation func(experimentIds []string) []error) (*apiv2beta1.BulkExperimentsResponse, error) {
+	experimentIds, err := s.selectBulkExperimentIds(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	errs := s.authorizeExperiments(ctx, experimentIds, verb)
+	var authorized []int
+	for i, err := range errs {
+		if err == nil {
+			authorized = append(authorized, i)
+		}
+	}
+	authorizedIds := make([]string, 0, len(authorized))
+	for _, i := range authorized {
+		authorizedIds = append(authorizedIds, experimentIds[i])
+	}
+	for i, err := range operation(authorizedIds) {
+		errs[authorized[i]] = err
+	}
+	return toApiBulkExperimentsResponse(experimentIds, errs), nil
+}
+
+// Returns the distinct experiment IDs of a bulk request, or the IDs of the
+// experiments that match its filter.
+func (s *ExperimentServer) selectBulkExperimentIds(ctx context.Context, request *apiv2beta1.BulkExperimentsRequest) ([]string, error) {
+	if len(request.GetExperimentIds()) > 0 {
+		if request.GetFilter() != "" || request.GetNamespace() != "" {
+			return nil, util.NewInvalidInputError("Experiment ids cannot be combined with a filter or a namespace")
+		}
+		if len(request.GetExperimentIds()) > maxBulkExperiments {
+			return nil, util.NewInvalidInputError("At most %v experiments can be processed at once, got %v", maxBulkExperiments, len(request.GetExperimentIds()))
+		}
+		experimentIds := make([]string, 0, len(request.GetExperimentIds()))
+		seen := make(map[string]bool, len(request.GetExperimentIds()))
+		for _, experimentId := range request.GetExperimentIds() {
+			if experimentId == "" {
+				return nil, util.NewInvalidInputError("Experiment ids cannot be empty")
+			}
+			if !seen[experimentId] {
+				seen[experimentId] = true
+				experimentIds = append(experimentIds, experimentId)
+			}
+		}
+		return experimentIds, nil
+	}
+	if request.GetFilter() == "" {
+		return nil, util.NewInvalidInputError("Either experiment ids or a filter must be set")
+	}
+	opts, err := validatedListOptions(&model.Experiment{}, "", bulkExperimentsPageSize, "", request.GetFilter(), "v2beta1")
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	var experimentIds []string
+	for {
+		experiments, totalSize, nextPageToken, err := s.listExperiments(ctx, "", bulkExperimentsPageSize, "", opts, request.GetNamespace())
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to list the experiments matching the filter")
+		}
+		if totalSize > maxBulkExperiments {
+			return nil, util.NewInvalidInputError("At most %v experiments can be processed at once, but %v experiments match the filter", maxBulkExperiments, totalSize)
+		}
+		for _, experiment := range experiments {
+			experimentIds = append(experimentIds, experiment.UUID)
+		}
+		if nextPageToken == "" {
+			return experimentIds, nil
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, bulkExperimentsPageSize); err != nil {
+			return nil, util.Wrap(err, "Failed to create list options from the page token")
+		}
+	}
+}
+
+// Authorizes an operation on experiments with a single access review per
+// namespace of the experiments. Returns the errors of the experiments, in the
+// order of the experiment IDs.
+func (s *BaseExperimentServer) authorizeExperiments(ctx context.Context, experimentIds []string, verb string) []error {
+	errs := make([]error, len(experimentIds))
+	if !common.IsMultiUserMode() {
+		// Skip authorization if not multi-user mode.
+		return errs
+	}
+	indicesByNamespace := make(map[string][]int)
+	for i, experimentId := range experimentIds {
+		experiment, err := s.resourceManager.GetExperiment(experimentId)
+		if err != nil {
+			errs[i] = util.Wrapf(err, "Failed to authorize with the experiment ID %v", experimentId)
+			continue
+		}
+		if s.resourceManager.IsEmptyNamespace(experiment.Namespace) {
+			errs[i] = util.NewInvalidInputError("An experiment cannot have an empty namespace in multi-user mode")
+			continue
+		}
+		indicesByNamespace[experiment.Namespace] = append(indicesByNamespace[experiment.Namespace], i)
+	}
+	for namespace, indices := range indicesByNamespace {
+		err := s.resourceManager.IsAuthorized(ctx, &authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Group:     common.RbacPipelinesGroup,
+			Version:   common.RbacPipelinesVersion,
+			Resource:  common.RbacResourceTypeExperiments,
+		})
+		if err != nil {
+			for _, i := range indices {
+				errs[i] = util.Wrapf(err, "Failed to access experiment %s. Check if you have access to namespace %s", experimentIds[i], namespace)
+			}
+		}
+	}
+	return errs
+}
+
 func NewExperimentServer(resourceManager *resource.ResourceManager, options *ExperimentServerOptions) *ExperimentServer {
 	return &ExperimentServer{
 		BaseExperimentServer: &BaseExperimentServer{
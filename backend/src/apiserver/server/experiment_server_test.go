@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"google.golang.org/protobuf/types/known/structpb"
@@ -34,6 +35,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
 )
@@ -522,6 +524,120 @@ func TestGetExperiment(t *testing.T) {
 	assert.Equal(t, expectedExperiment, result)
 }
 
+func TestUpdateExperiment(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	server := createExperimentServer(resourceManager)
+	experiment := &apiV2beta1.Experiment{DisplayName: "ex1", Description: "first experiment", Labels: map[string]string{"team": "vision"}}
+
+	createResult, err := server.CreateExperiment(nil, &apiV2beta1.CreateExperimentRequest{Experiment: experiment})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, createResult.Labels)
+
+	// The description is not in the update mask, so it is not updated.
+	result, err := server.UpdateExperiment(nil, &apiV2beta1.UpdateExperimentRequest{
+		Experiment: &apiV2beta1.Experiment{
+			ExperimentId: createResult.ExperimentId,
+			Description:  "updated experiment",
+			Labels:       map[string]string{"team": "nlp", "project": "kfp"},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+	})
+	assert.Nil(t, err)
+	expectedExperiment := &apiV2beta1.Experiment{
+		ExperimentId:     createResult.ExperimentId,
+		DisplayName:      "ex1",
+		Description:      "first experiment",
+		CreatedAt:        timestamppb.New(time.Unix(1, 0)),
+		LastRunCreatedAt: timestamppb.New(time.Unix(0, 0)),
+		StorageState:     apiV2beta1.Experiment_AVAILABLE,
+		Labels:           map[string]string{"team": "nlp", "project": "kfp"},
+	}
+	assert.Equal(t, expectedExperiment, result)
+
+	result, err = server.GetExperiment(nil, &apiV2beta1.GetExperimentRequest{ExperimentId: createResult.ExperimentId})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedExperiment, result)
+}
+
+func TestUpdateExperiment_Notifications(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	server := createExperimentServer(resourceManager)
+	notifications := []*apiV2beta1.NotificationSubscription{{WebhookUrl: "https://hooks.example.com/all"}}
+	createResult, err := server.CreateExperiment(nil, &apiV2beta1.CreateExperimentRequest{Experiment: &apiV2beta1.Experiment{
+		DisplayName:   "ex1",
+		Notifications: notifications,
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, notifications, createResult.Notifications)
+
+	notifications = []*apiV2beta1.NotificationSubscription{{
+		WebhookUrl:            "https://hooks.example.com/stuck",
+		States:                []apiV2beta1.RuntimeState{apiV2beta1.RuntimeState_PENDING, apiV2beta1.RuntimeState_FAILED},
+		PendingTimeoutSeconds: 300,
+		SecretName:            "hook-secret",
+		SecretKey:             "key",
+	}}
+	result, err := server.UpdateExperiment(nil, &apiV2beta1.UpdateExperimentRequest{
+		Experiment: &apiV2beta1.Experiment{
+			ExperimentId:  createResult.ExperimentId,
+			Description:   "not updated",
+			Notifications: notifications,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"notifications"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, notifications, result.Notifications)
+	assert.Empty(t, result.Description)
+
+	// An empty list removes the notifications.
+	result, err = server.UpdateExperiment(nil, &apiV2beta1.UpdateExperimentRequest{
+		Experiment: &apiV2beta1.Experiment{ExperimentId: createResult.ExperimentId},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"notifications"}},
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, result.Notifications)
+
+	result, err = server.GetExperiment(nil, &apiV2beta1.GetExperimentRequest{ExperimentId: createResult.ExperimentId})
+	assert.Nil(t, err)
+	assert.Empty(t, result.Notifications)
+}
+
+func TestUpdateExperiment_Failed(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	server := createExperimentServer(resourceManager)
+	createResult, err := server.CreateExperiment(nil, &apiV2beta1.CreateExperimentRequest{Experiment: &apiV2beta1.Experiment{DisplayName: "ex1"}})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		id     string
+		paths  []string
+		labels map[string]string
+		errMsg string
+	}{
+		{"empty id", "", []string{"description"}, nil, "empty experiment id"},
+		{"empty update mask", createResult.ExperimentId, nil, nil, "empty update mask"},
+		{"field cannot be updated", createResult.ExperimentId, []string{"display_name"}, nil, "field display_name cannot be updated"},
+		{"invalid label", createResult.ExperimentId, []string{"labels"}, map[string]string{"team": "a b"}, "Invalid value"},
+		{"reserved label", createResult.ExperimentId, []string{"labels"}, map[string]string{"pipelines.kubeflow.org/team": "a"}, "reserved"},
+		{"invalid notification", createResult.ExperimentId, []string{"notifications"}, nil, "must be an absolute http or https URL"},
+		{"not found", "non-existent", []string{"description"}, nil, "not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.UpdateExperiment(nil, &apiV2beta1.UpdateExperimentRequest{
+				Experiment: &apiV2beta1.Experiment{ExperimentId: tt.id, Labels: tt.labels, Notifications: []*apiV2beta1.NotificationSubscription{{WebhookUrl: "ftp://hooks.example.com"}}},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
 func TestGetExperimentV1_Failed(t *testing.T) {
 	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
@@ -1308,6 +1424,81 @@ func TestDeleteExperimentsV1_MultiUser(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestBulkArchiveUnarchiveAndDeleteExperiments(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	server := createExperimentServer(resourceManager)
+	experiment1, err := server.CreateExperiment(ctx, &apiV2beta1.CreateExperimentRequest{Experiment: &apiV2beta1.Experiment{DisplayName: "ex1", Namespace: "ns1"}})
+	assert.Nil(t, err)
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	resourceManager = resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	server = createExperimentServer(resourceManager)
+	experiment2, err := server.CreateExperiment(ctx, &apiV2beta1.CreateExperimentRequest{Experiment: &apiV2beta1.Experiment{DisplayName: "ex2", Namespace: "ns1"}})
+	assert.Nil(t, err)
+
+	response, err := server.BulkArchiveExperiments(ctx, &apiV2beta1.BulkExperimentsRequest{
+		ExperimentIds: []string{experiment1.ExperimentId, experiment2.ExperimentId, experiment1.ExperimentId, "unknown"},
+	})
+	assert.Nil(t, err)
+	// Duplicate experiment IDs are processed once.
+	assert.Len(t, response.GetResults(), 3)
+	assert.Nil(t, response.GetResults()[0].GetError())
+	assert.Nil(t, response.GetResults()[1].GetError())
+	assert.Equal(t, "unknown", response.GetResults()[2].GetExperimentId())
+	assert.Equal(t, int32(codes.NotFound), response.GetResults()[2].GetError().GetCode())
+	for _, experimentId := range []string{experiment1.ExperimentId, experiment2.ExperimentId} {
+		experiment, err := server.GetExperiment(ctx, &apiV2beta1.GetExperimentRequest{ExperimentId: experimentId})
+		assert.Nil(t, err)
+		assert.Equal(t, apiV2beta1.Experiment_ARCHIVED, experiment.GetStorageState())
+	}
+
+	// Selects the experiments matching a filter.
+	response, err = server.BulkUnarchiveExperiments(ctx, &apiV2beta1.BulkExperimentsRequest{
+		Filter:    `{"predicates":[{"operation":"EQUALS","key":"display_name","stringValue":"ex2"}]}`,
+		Namespace: "ns1",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, response.GetResults(), 1)
+	assert.Equal(t, experiment2.ExperimentId, response.GetResults()[0].GetExperimentId())
+	assert.Nil(t, response.GetResults()[0].GetError())
+	experiment, err := server.GetExperiment(ctx, &apiV2beta1.GetExperimentRequest{ExperimentId: experiment2.ExperimentId})
+	assert.Nil(t, err)
+	assert.Equal(t, apiV2beta1.Experiment_AVAILABLE, experiment.GetStorageState())
+
+	response, err = server.BulkDeleteExperiments(ctx, &apiV2beta1.BulkExperimentsRequest{
+		ExperimentIds: []string{experiment1.ExperimentId, experiment2.ExperimentId},
+	})
+	assert.Nil(t, err)
+	for _, result := range response.GetResults() {
+		assert.Nil(t, result.GetError())
+		_, err = server.GetExperiment(ctx, &apiV2beta1.GetExperimentRequest{ExperimentId: result.GetExperimentId()})
+		AssertUserError(t, err, codes.NotFound)
+	}
+}
+
+func TestBulkDeleteExperiments_InvalidRequest(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	server := createExperimentServer(resourceManager)
+
+	_, err := server.BulkDeleteExperiments(context.Background(), &apiV2beta1.BulkExperimentsRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.BulkDeleteExperiments(context.Background(), &apiV2beta1.BulkExperimentsRequest{
+		ExperimentIds: []string{experiment.UUID},
+		Filter:        `{"predicates":[{"operation":"EQUALS","key":"display_name","stringValue":"ex2"}]}`,
+	})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.BulkDeleteExperiments(context.Background(), &apiV2beta1.BulkExperimentsRequest{ExperimentIds: []string{experiment.UUID, ""}})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
 func TestListExperimentsV1_Unauthenticated(t *testing.T) {
 	viper.Set(common.MultiUserMode, "true")
 	defer viper.Set(common.MultiUserMode, "false")
@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -64,6 +65,16 @@ var (
 		Help: "The total number of EnableJob requests",
 	})
 
+	updateJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_update_requests",
+		Help: "The total number of UpdateRecurringRun requests",
+	})
+
+	backfillJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_backfill_requests",
+		Help: "The total number of BackfillRecurringRun requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	jobCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -317,6 +328,112 @@ func (s *BaseJobServer) enableJob(ctx context.Context, jobId string) error {
 	return s.resourceManager.ChangeJobMode(ctx, jobId, true)
 }
 
+func (s *BaseJobServer) updateJob(ctx context.Context, jobId string, update *apiv2beta1.RecurringRun, paths []string) (*model.Job, error) {
+	err := s.canAccessJob(ctx, jobId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	job, err := s.resourceManager.GetJob(jobId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to fetch recurring run %v", jobId)
+	}
+	updatedJob, err := applyRecurringRunUpdateMask(job, update, paths)
+	if err != nil {
+		return nil, err
+	}
+	return s.resourceManager.UpdateJob(ctx, updatedJob)
+}
+
+// Returns a copy of the recurring run with the fields listed in the update mask taken from the update.
+// A nested path, e.g. trigger.cron_schedule.cron, replaces the whole top-level field.
+func applyRecurringRunUpdateMask(job *model.Job, update *apiv2beta1.RecurringRun, paths []string) (*model.Job, error) {
+	if len(paths) == 0 {
+		return nil, util.NewInvalidInputError("Failed to update recurring run %v due to an empty update mask", job.UUID)
+	}
+	// The update is merged into the API representation of the recurring run, so that the merged
+	// recurring run goes through the same validation as a new one.
+	merged := toApiRecurringRun(job)
+	if merged.GetError() != nil {
+		return nil, util.NewInternalServerError(util.NewInvalidInputError("%v", merged.GetError().GetMessage()), "Failed to update recurring run %v", job.UUID)
+	}
+	fields := make(map[string]bool)
+	for _, path := range paths {
+		field := strings.SplitN(path, ".", 2)[0]
+		switch field {
+		case "display_name":
+			merged.DisplayName = update.GetDisplayName()
+		case "description":
+			merged.Description = update.GetDescription()
+		case "pipeline_version_reference", "pipeline_spec":
+			if update.GetPipelineVersionReference() == nil && update.GetPipelineSpec() == nil {
+				return nil, util.NewInvalidInputError("Failed to update recurring run %v as %v is listed in the update mask, but neither pipeline_version_reference nor pipeline_spec is set", job.UUID, path)
+			}
+			merged.PipelineSource = update.GetPipelineSource()
+		case "runtime_config":
+			merged.RuntimeConfig = update.GetRuntimeConfig()
+		case "service_account":
+			merged.ServiceAccount = update.GetServiceAccount()
+		case "max_concurrency":
+			merged.MaxConcurrency = update.GetMaxConcurrency()
+		case "trigger":
+			merged.Trigger = update.GetTrigger()
+		case "no_catchup":
+			merged.NoCatchup = update.GetNoCatchup()
+		case "labels":
+			merged.Labels = update.GetLabels()
+		case "notifications":
+			merged.Notifications = update.GetNotifications()
+		default:
+			return nil, util.NewInvalidInputError("Failed to update recurring run %v as field %v cannot be updated", job.UUID, path)
+		}
+		fields[field] = true
+	}
+	mergedJob, err := toModelJob(merged)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update recurring run %v due to conversion error", job.UUID)
+	}
+
+	// Only the fields in the update mask are taken from the merged recurring run. The rest are kept as
+	// they are, as some of them, e.g. the pipeline manifests, do not round trip through the API.
+	updatedJob := *job
+	if fields["display_name"] {
+		updatedJob.DisplayName = mergedJob.DisplayName
+	}
+	if fields["description"] {
+		updatedJob.Description = mergedJob.Description
+	}
+	if fields["pipeline_version_reference"] || fields["pipeline_spec"] {
+		updatedJob.PipelineId = mergedJob.PipelineId
+		updatedJob.PipelineName = mergedJob.PipelineName
+		updatedJob.PipelineVersionId = mergedJob.PipelineVersionId
+		updatedJob.PipelineSpecManifest = mergedJob.PipelineSpecManifest
+		updatedJob.WorkflowSpecManifest = ""
+		updatedJob.Parameters = ""
+	}
+	if fields["runtime_config"] {
+		updatedJob.RuntimeConfig = mergedJob.RuntimeConfig
+	}
+	if fields["service_account"] {
+		updatedJob.ServiceAccount = mergedJob.ServiceAccount
+	}
+	if fields["max_concurrency"] {
+		updatedJob.MaxConcurrency = mergedJob.MaxConcurrency
+	}
+	if fields["trigger"] {
+		updatedJob.Trigger = mergedJob.Trigger
+	}
+	if fields["no_catchup"] {
+		updatedJob.NoCatchup = mergedJob.NoCatchup
+	}
+	if fields["labels"] {
+		updatedJob.Labels = mergedJob.Labels
+	}
+	if fields["notifications"] {
+		updatedJob.Notifications = mergedJob.Notifications
+	}
+	return &updatedJob, nil
+}
+
 func (s *JobServer) CreateRecurringRun(ctx context.Context, request *apiv2beta1.CreateRecurringRunRequest) (*apiv2beta1.RecurringRun, error) {
 	if s.options.CollectMetrics {
 		createJobRequests.Inc()
@@ -384,6 +501,26 @@ func (s *JobServer) ListRecurringRuns(ctx context.Context, r *apiv2beta1.ListRec
 	}, nil
 }
 
+func (s *JobServer) UpdateRecurringRun(ctx context.Context, request *apiv2beta1.UpdateRecurringRunRequest) (*apiv2beta1.RecurringRun, error) {
+	if s.options.CollectMetrics {
+		updateJobRequests.Inc()
+	}
+	recurringRunId := request.GetRecurringRun().GetRecurringRunId()
+	if recurringRunId == "" {
+		return nil, util.NewInvalidInputError("Failed to update a recurring run due to an empty recurring run id")
+	}
+	updatedRecurringRun, err := s.updateJob(ctx, recurringRunId, request.GetRecurringRun(), request.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to update a recurring run")
+	}
+
+	apiRecurringRun := toApiRecurringRun(updatedRecurringRun)
+	if apiRecurringRun == nil {
+		return nil, util.NewInternalServerError(util.NewInvalidInputError("Failed to convert internal recurring run representation to its API counterpart"), "Failed to update a recurring run")
+	}
+	return apiRecurringRun, nil
+}
+
 func (s *JobServer) EnableRecurringRun(ctx context.Context, request *apiv2beta1.EnableRecurringRunRequest) (*emptypb.Empty, error) {
 	if s.options.CollectMetrics {
 		enableJobRequests.Inc()
@@ -407,6 +544,32 @@ func (s *JobServer) DisableRecurringRun(ctx context.Context, request *apiv2beta1
 	return &emptypb.Empty{}, nil
 }
 
+func (s *JobServer) BackfillRecurringRun(ctx context.Context, request *apiv2beta1.BackfillRecurringRunRequest) (*emptypb.Empty, error) {
+	if s.options.CollectMetrics {
+		backfillJobRequests.Inc()
+	}
+	recurringRunId := request.GetRecurringRunId()
+	if recurringRunId == "" {
+		return nil, util.NewInvalidInputError("Failed to backfill a recurring run due to an empty recurring run id")
+	}
+	if request.GetStartTime() == nil || request.GetEndTime() == nil {
+		return nil, util.NewInvalidInputError("Failed to backfill recurring run %v. Both the start time and the end time of the backfill window must be specified", recurringRunId)
+	}
+	startTime := request.GetStartTime().AsTime()
+	endTime := request.GetEndTime().AsTime()
+	if startTime.After(endTime) {
+		return nil, util.NewInvalidInputError("Failed to backfill recurring run %v. The start time of the backfill window must not be after its end time", recurringRunId)
+	}
+	err := s.canAccessJob(ctx, recurringRunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	if err := s.resourceManager.BackfillJob(ctx, recurringRunId, startTime, endTime); err != nil {
+		return nil, util.Wrap(err, "Failed to backfill a recurring run")
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *JobServer) DeleteRecurringRun(ctx context.Context, request *apiv2beta1.DeleteRecurringRunRequest) (*emptypb.Empty, error) {
 	if s.options.CollectMetrics {
 		deleteJobRequests.Inc()
@@ -33,8 +33,10 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"sigs.k8s.io/yaml"
 )
@@ -1173,6 +1175,209 @@ func TestListRecurringRuns(t *testing.T) {
 	assert.Equal(t, expectedRecurringRunsList, actualRecurringRunsList2.RecurringRuns)
 }
 
+func TestUpdateRecurringRun(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createJobServer(manager)
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+
+	apiRecurringRun := &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				StartTime: timestamppb.New(time.Unix(1, 0)),
+				Cron:      "1 * * * *",
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			PipelineRoot: "model-pipeline-root",
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}
+
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: apiRecurringRun})
+	assert.Nil(t, err)
+
+	// The display name is not in the update mask, so it is not updated.
+	recurringRun, err := server.UpdateRecurringRun(nil, &apiv2beta1.UpdateRecurringRunRequest{
+		RecurringRun: &apiv2beta1.RecurringRun{
+			RecurringRunId: createdRecurringRun.RecurringRunId,
+			DisplayName:    "recurring_run_2",
+			MaxConcurrency: 5,
+			Trigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+					Cron: "0 0 * * *",
+				}},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"max_concurrency", "trigger.cron_schedule.cron"}},
+	})
+	assert.Nil(t, err)
+
+	expectedRecurringRun := &apiv2beta1.RecurringRun{
+		RecurringRunId: "123e4567-e89b-12d3-a456-426655440000",
+		DisplayName:    "recurring_run_1",
+		ServiceAccount: "pipeline-runner",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		Namespace:      "ns1",
+		MaxConcurrency: 5,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				Cron: "0 0 * * *",
+			}},
+		},
+		CreatedAt:      timestamppb.New(time.Unix(2, 0)),
+		UpdatedAt:      timestamppb.New(time.Unix(3, 0)),
+		Status:         apiv2beta1.RecurringRun_ENABLED,
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			PipelineRoot: "model-pipeline-root",
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}
+	recurringRun.RuntimeConfig.Parameters = map[string]*structpb.Value{
+		"param1": structpb.NewStringValue("world"),
+	}
+	assert.Equal(t, expectedRecurringRun, recurringRun)
+}
+
+func TestUpdateRecurringRun_InvalidUpdateMask(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createJobServer(manager)
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	apiRecurringRun := &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				Cron: "1 * * * *",
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: apiRecurringRun})
+	require.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		paths  []string
+		update *apiv2beta1.RecurringRun
+		errMsg string
+	}{
+		{
+			"empty update mask",
+			nil,
+			&apiv2beta1.RecurringRun{},
+			"empty update mask",
+		},
+		{
+			"field cannot be updated",
+			[]string{"namespace"},
+			&apiv2beta1.RecurringRun{Namespace: "ns2"},
+			"field namespace cannot be updated",
+		},
+		{
+			"invalid max concurrency",
+			[]string{"max_concurrency"},
+			&apiv2beta1.RecurringRun{MaxConcurrency: 11},
+			"Max concurrency of a recurring run must be at least 1 and at most 10",
+		},
+		{
+			"missing pipeline source",
+			[]string{"pipeline_version_reference"},
+			&apiv2beta1.RecurringRun{},
+			"neither pipeline_version_reference nor pipeline_spec is set",
+		},
+		{
+			"invalid notification",
+			[]string{"notifications"},
+			&apiv2beta1.RecurringRun{Notifications: []*apiv2beta1.NotificationSubscription{{WebhookUrl: "hooks.example.com"}}},
+			"must be an absolute http or https URL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.update.RecurringRunId = createdRecurringRun.RecurringRunId
+			_, err := server.UpdateRecurringRun(nil, &apiv2beta1.UpdateRecurringRunRequest{
+				RecurringRun: tt.update,
+				UpdateMask:   &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestUpdateRecurringRun_Notifications(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createJobServer(manager)
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				Cron: "1 * * * *",
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId:  "123e4567-e89b-12d3-a456-426655440000",
+		Notifications: []*apiv2beta1.NotificationSubscription{{WebhookUrl: "https://hooks.example.com/all"}},
+	}})
+	require.Nil(t, err)
+	assert.Equal(t, "https://hooks.example.com/all", createdRecurringRun.GetNotifications()[0].GetWebhookUrl())
+
+	notifications := []*apiv2beta1.NotificationSubscription{{
+		WebhookUrl: "https://hooks.example.com/failures",
+		States:     []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_FAILED},
+		SecretName: "hook-secret",
+	}}
+	recurringRun, err := server.UpdateRecurringRun(nil, &apiv2beta1.UpdateRecurringRunRequest{
+		RecurringRun: &apiv2beta1.RecurringRun{
+			RecurringRunId: createdRecurringRun.RecurringRunId,
+			Notifications:  notifications,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"notifications"}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, notifications, recurringRun.GetNotifications())
+	assert.Equal(t, "recurring_run_1", recurringRun.GetDisplayName())
+
+	recurringRun, err = server.GetRecurringRun(nil, &apiv2beta1.GetRecurringRunRequest{RecurringRunId: createdRecurringRun.RecurringRunId})
+	require.Nil(t, err)
+	assert.Equal(t, notifications, recurringRun.GetNotifications())
+}
+
 func TestEnableRecurringRun(t *testing.T) {
 	clients, manager, _ := initWithExperiment(t)
 	defer clients.Close()
@@ -1242,3 +1447,87 @@ func TestDisableRecurringRun(t *testing.T) {
 	_, err = server.DisableRecurringRun(nil, &apiv2beta1.DisableRecurringRunRequest{RecurringRunId: createdRecurringRun.RecurringRunId})
 	assert.Nil(t, err)
 }
+
+func TestBackfillRecurringRun(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createJobServer(manager)
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+
+	apiRecurringRun := &apiv2beta1.RecurringRun{
+		DisplayName:    "recurring_run_1",
+		Mode:           apiv2beta1.RecurringRun_ENABLE,
+		MaxConcurrency: 1,
+		Trigger: &apiv2beta1.Trigger{
+			Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				StartTime: timestamppb.New(time.Unix(1, 0)),
+				Cron:      "1 * * * *",
+			}},
+		},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			PipelineRoot: "model-pipeline-root",
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+		ExperimentId: "123e4567-e89b-12d3-a456-426655440000",
+	}
+
+	createdRecurringRun, err := server.CreateRecurringRun(nil, &apiv2beta1.CreateRecurringRunRequest{RecurringRun: apiRecurringRun})
+	require.Nil(t, err)
+
+	_, err = server.BackfillRecurringRun(nil, &apiv2beta1.BackfillRecurringRunRequest{
+		RecurringRunId: createdRecurringRun.RecurringRunId,
+		StartTime:      timestamppb.New(time.Unix(0, 0)),
+		EndTime:        timestamppb.New(time.Unix(1, 0)),
+	})
+	assert.Nil(t, err)
+}
+
+func TestBackfillRecurringRun_InvalidInput(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createJobServer(manager)
+
+	tests := []struct {
+		name    string
+		request *apiv2beta1.BackfillRecurringRunRequest
+		errMsg  string
+	}{
+		{
+			"empty id",
+			&apiv2beta1.BackfillRecurringRunRequest{
+				StartTime: timestamppb.New(time.Unix(0, 0)),
+				EndTime:   timestamppb.New(time.Unix(1, 0)),
+			},
+			"empty recurring run id",
+		},
+		{
+			"missing end time",
+			&apiv2beta1.BackfillRecurringRunRequest{
+				RecurringRunId: "123",
+				StartTime:      timestamppb.New(time.Unix(0, 0)),
+			},
+			"must be specified",
+		},
+		{
+			"start time after end time",
+			&apiv2beta1.BackfillRecurringRunRequest{
+				RecurringRunId: "123",
+				StartTime:      timestamppb.New(time.Unix(2, 0)),
+				EndTime:        timestamppb.New(time.Unix(1, 0)),
+			},
+			"must not be after its end time",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.BackfillRecurringRun(nil, tt.request)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,740 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const (
+	// Name of the manifest file of a bundle.
+	bundleManifestFile = "bundle.json"
+	// Version of the manifest format. Bundles of other versions are rejected on import.
+	bundleFormatVersion = 1
+	// Page size used to list the pipeline versions and the recurring runs to be exported.
+	bundleListPageSize = 100
+)
+
+// Describes the resources of a bundle. A bundle is a tar.gz archive that holds the
+// manifest and one file per pipeline spec, which the manifest references by name.
+type bundleManifest struct {
+	FormatVersion int               `json:"formatVersion"`
+	Pipeline      *bundlePipeline   `json:"pipeline,omitempty"`
+	Experiment    *bundleExperiment `json:"experiment,omitempty"`
+}
+
+type bundlePipeline struct {
+	Name        string            `json:"name"`
+	DisplayName string            `json:"displayName,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	// Name of the default, i.e. the latest, version of the pipeline.
+	DefaultVersion string `json:"defaultVersion,omitempty"`
+	// Versions in creation order.
+	Versions []*bundlePipelineVersion `json:"versions"`
+}
+
+type bundlePipelineVersion struct {
+	Name          string `json:"name"`
+	DisplayName   string `json:"displayName,omitempty"`
+	Description   string `json:"description,omitempty"`
+	CodeSourceUrl string `json:"codeSourceUrl,omitempty"`
+	SpecFile      string `json:"specFile"`
+}
+
+type bundleExperiment struct {
+	Name          string                `json:"name"`
+	Description   string                `json:"description,omitempty"`
+	Labels        map[string]string     `json:"labels,omitempty"`
+	RecurringRuns []*bundleRecurringRun `json:"recurringRuns,omitempty"`
+}
+
+type bundleRecurringRun struct {
+	// API recurring run without its ids, namespace, timestamps and pipeline source.
+	RecurringRun json.RawMessage `json:"recurringRun"`
+	// The pipeline spec the recurring run is pinned to.
+	SpecFile string `json:"specFile"`
+
+	// Set when the bundle is read.
+	job *model.Job
+}
+
+// Returns the versions of the pipeline with the default version last, so that it
+// becomes the latest version of the imported pipeline.
+func (p *bundlePipeline) orderedVersions() []*bundlePipelineVersion {
+	versions := make([]*bundlePipelineVersion, 0, len(p.Versions))
+	var defaultVersion *bundlePipelineVersion
+	for _, version := range p.Versions {
+		if defaultVersion == nil && version.Name == p.DefaultVersion {
+			defaultVersion = version
+			continue
+		}
+		versions = append(versions, version)
+	}
+	if defaultVersion != nil {
+		versions = append(versions, defaultVersion)
+	}
+	return versions
+}
+
+// Adds the manifest to the files of a bundle and archives them.
+func writeBundle(manifest *bundleManifest, files map[string]string) ([]byte, error) {
+	manifestJson, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files[bundleManifestFile] = string(manifestJson)
+	bundle, err := util.ArchiveTgz(files)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(bundle), nil
+}
+
+// Extracts a bundle, and validates its manifest and its pipeline specs.
+func readBundle(bundle []byte) (*bundleManifest, map[string]string, error) {
+	files, err := util.ExtractTgz(string(bundle))
+	if err != nil {
+		return nil, nil, util.NewInvalidInputError("Bundle is not a valid tar.gz archive: %v", err)
+	}
+	manifestJson, ok := files[bundleManifestFile]
+	if !ok {
+		return nil, nil, util.NewInvalidInputError("Bundle has no %v file", bundleManifestFile)
+	}
+	manifest := &bundleManifest{}
+	if err := json.Unmarshal([]byte(manifestJson), manifest); err != nil {
+		return nil, nil, util.NewInvalidInputError("Bundle has an invalid %v file: %v", bundleManifestFile, err)
+	}
+	if manifest.FormatVersion != bundleFormatVersion {
+		return nil, nil, util.NewInvalidInputError("Bundle format version %v is not supported. Supported version is %v", manifest.FormatVersion, bundleFormatVersion)
+	}
+	if manifest.Pipeline == nil && manifest.Experiment == nil {
+		return nil, nil, util.NewInvalidInputError("Bundle has neither a pipeline nor an experiment")
+	}
+	if pipeline := manifest.Pipeline; pipeline != nil {
+		if pipeline.Name == "" {
+			return nil, nil, util.NewInvalidInputError("Bundle has a pipeline with an empty name")
+		}
+		for _, version := range pipeline.Versions {
+			if version.Name == "" {
+				return nil, nil, util.NewInvalidInputError("Bundle has a version of pipeline %v with an empty name", pipeline.Name)
+			}
+			if _, err := parseBundleSpec(files, version.SpecFile); err != nil {
+				return nil, nil, util.Wrapf(err, "Bundle has an invalid version %v of pipeline %v", version.Name, pipeline.Name)
+			}
+		}
+	}
+	if experiment := manifest.Experiment; experiment != nil {
+		if experiment.Name == "" {
+			return nil, nil, util.NewInvalidInputError("Bundle has an experiment with an empty name")
+		}
+		for i, recurringRun := range experiment.RecurringRuns {
+			apiRecurringRun := &apiv2beta1.RecurringRun{}
+			if err := protojson.Unmarshal(recurringRun.RecurringRun, apiRecurringRun); err != nil {
+				return nil, nil, util.NewInvalidInputError("Bundle has an invalid recurring run at index %v: %v", i, err)
+			}
+			job, err := toModelJob(apiRecurringRun)
+			if err != nil {
+				return nil, nil, util.Wrapf(err, "Bundle has an invalid recurring run at index %v", i)
+			}
+			tmpl, err := parseBundleSpec(files, recurringRun.SpecFile)
+			if err != nil {
+				return nil, nil, util.Wrapf(err, "Bundle has an invalid recurring run %v", job.DisplayName)
+			}
+			if tmpl.GetTemplateType() == template.V1 {
+				job.WorkflowSpecManifest = files[recurringRun.SpecFile]
+			} else {
+				job.PipelineSpecManifest = files[recurringRun.SpecFile]
+			}
+			recurringRun.job = job
+		}
+	}
+	return manifest, files, nil
+}
+
+// Parses a pipeline spec file of a bundle.
+func parseBundleSpec(files map[string]string, specFile string) (template.Template, error) {
+	spec := files[specFile]
+	if spec == "" {
+		return nil, util.NewInvalidInputError("Pipeline spec file %q is missing or empty", specFile)
+	}
+	tmpl, err := template.New([]byte(spec), false, nil)
+	if err != nil {
+		return nil, util.Wrapf(err, "Pipeline spec file %q is invalid", specFile)
+	}
+	return tmpl, nil
+}
+
+// Adds a pipeline and all its versions to the files of a bundle.
+func (s *PipelineServer) exportPipeline(ctx context.Context, pipelineId string, files map[string]string) (*bundlePipeline, error) {
+	pipeline, err := s.getPipeline(ctx, pipelineId)
+	if err != nil {
+		return nil, err
+	}
+	pipelineVersions, err := s.listAllPipelineVersions(pipelineId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list the versions of pipeline %v", pipelineId)
+	}
+	sort.SliceStable(pipelineVersions, func(i, j int) bool {
+		return pipelineVersions[i].CreatedAtInSec < pipelineVersions[j].CreatedAtInSec
+	})
+	exported := &bundlePipeline{
+		Name:        pipeline.Name,
+		DisplayName: pipeline.DisplayName,
+		Description: pipeline.Description,
+		Labels:      pipeline.Labels,
+		Versions:    make([]*bundlePipelineVersion, 0, len(pipelineVersions)),
+	}
+	if len(pipelineVersions) > 0 {
+		latestVersion, err := s.resourceManager.GetLatestPipelineVersion(pipelineId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to get the default version of pipeline %v", pipelineId)
+		}
+		exported.DefaultVersion = latestVersion.Name
+	}
+	for i, pipelineVersion := range pipelineVersions {
+		spec, err := s.resourceManager.GetPipelineVersionTemplate(pipelineVersion.UUID)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to export version %v of pipeline %v", pipelineVersion.UUID, pipelineId)
+		}
+		specFile := fmt.Sprintf("pipeline-version-%d.yaml", i)
+		files[specFile] = string(spec)
+		exported.Versions = append(exported.Versions, &bundlePipelineVersion{
+			Name:          pipelineVersion.Name,
+			DisplayName:   pipelineVersion.DisplayName,
+			Description:   pipelineVersion.Description,
+			CodeSourceUrl: pipelineVersion.CodeSourceUrl,
+			SpecFile:      specFile,
+		})
+	}
+	return exported, nil
+}
+
+// Adds an experiment and its recurring runs to the files of a bundle.
+func (s *PipelineServer) exportExperiment(ctx context.Context, experimentId string, files map[string]string) (*bundleExperiment, error) {
+	experiment, err := s.resourceManager.GetExperiment(experimentId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to export experiment %v", experimentId)
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: experiment.Namespace,
+		Name:      experiment.Name,
+		Verb:      common.RbacResourceVerbGet,
+	}
+	if err := s.canAccessNamespacedResource(ctx, common.RbacResourceTypeExperiments, resourceAttributes); err != nil {
+		return nil, util.Wrapf(err, "Failed to export experiment %v due to authorization error. Check if you have read permission to namespace %v", experimentId, experiment.Namespace)
+	}
+	resourceAttributes = &authorizationv1.ResourceAttributes{
+		Namespace: experiment.Namespace,
+		Verb:      common.RbacResourceVerbList,
+	}
+	if err := s.canAccessNamespacedResource(ctx, common.RbacResourceTypeJobs, resourceAttributes); err != nil {
+		return nil, util.Wrapf(err, "Failed to export experiment %v due to authorization error. Check if you have permission to list recurring runs in namespace %v", experimentId, experiment.Namespace)
+	}
+	jobs, err := s.listAllExperimentJobs(experimentId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list the recurring runs of experiment %v", experimentId)
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAtInSec < jobs[j].CreatedAtInSec
+	})
+	exported := &bundleExperiment{
+		Name:        experiment.Name,
+		Description: experiment.Description,
+		Labels:      experiment.Labels,
+	}
+	for i, job := range jobs {
+		spec, err := s.recurringRunSpec(job)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to export recurring run %v", job.UUID)
+		}
+		apiRecurringRun := toApiRecurringRun(job)
+		if apiRecurringRun.GetError() != nil {
+			return nil, util.NewInternalServerError(util.ToError(apiRecurringRun.GetError()), "Failed to export recurring run %v", job.UUID)
+		}
+		apiRecurringRun.RecurringRunId = ""
+		apiRecurringRun.Namespace = ""
+		apiRecurringRun.ExperimentId = ""
+		apiRecurringRun.CreatedAt = nil
+		apiRecurringRun.UpdatedAt = nil
+		apiRecurringRun.Status = apiv2beta1.RecurringRun_STATUS_UNSPECIFIED
+		apiRecurringRun.PipelineSource = nil
+		recurringRunJson, err := protojson.Marshal(apiRecurringRun)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to export recurring run %v", job.UUID)
+		}
+		specFile := fmt.Sprintf("recurring-run-%d.yaml", i)
+		files[specFile] = spec
+		exported.RecurringRuns = append(exported.RecurringRuns, &bundleRecurringRun{
+			RecurringRun: recurringRunJson,
+			SpecFile:     specFile,
+		})
+	}
+	return exported, nil
+}
+
+// Returns the pipeline spec a recurring run runs. Recurring runs that follow the latest
+// version of a pipeline are pinned to the version that is the latest at export time.
+func (s *PipelineServer) recurringRunSpec(job *model.Job) (string, error) {
+	switch {
+	case job.PipelineSpecManifest != "":
+		return job.PipelineSpecManifest, nil
+	case job.WorkflowSpecManifest != "":
+		return job.WorkflowSpecManifest, nil
+	case job.PipelineVersionId != "":
+		spec, err := s.resourceManager.GetPipelineVersionTemplate(job.PipelineVersionId)
+		return string(spec), err
+	case job.PipelineId != "":
+		spec, err := s.resourceManager.GetPipelineLatestTemplate(job.PipelineId)
+		return string(spec), err
+	default:
+		return "", util.NewInternalServerError(util.NewInvalidInputError("Recurring run has no pipeline spec"), "Failed to fetch the pipeline spec of recurring run %v", job.UUID)
+	}
+}
+
+// Fetches all versions of a pipeline.
+func (s *PipelineServer) listAllPipelineVersions(pipelineId string) ([]*model.PipelineVersion, error) {
+	opts, err := list.NewOptions(&model.PipelineVersion{}, bundleListPageSize, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var pipelineVersions []*model.PipelineVersion
+	for {
+		page, _, nextPageToken, err := s.resourceManager.ListPipelineVersions(pipelineId, opts)
+		if err != nil {
+			return nil, err
+		}
+		pipelineVersions = append(pipelineVersions, page...)
+		if nextPageToken == "" {
+			return pipelineVersions, nil
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, bundleListPageSize); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Fetches all recurring runs of an experiment.
+func (s *PipelineServer) listAllExperimentJobs(experimentId string) ([]*model.Job, error) {
+	filterContext := &model.FilterContext{ReferenceKey: &model.ReferenceKey{Type: model.ExperimentResourceType, ID: experimentId}}
+	opts, err := list.NewOptions(&model.Job{}, bundleListPageSize, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []*model.Job
+	for {
+		page, _, nextPageToken, err := s.resourceManager.ListJobs(filterContext, opts)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, page...)
+		if nextPageToken == "" {
+			return jobs, nil
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, bundleListPageSize); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Checks if a user can access the experiments or the recurring runs of a namespace.
+func (s *PipelineServer) canAccessNamespacedResource(ctx context.Context, resourceType string, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	if !common.IsMultiUserMode() {
+		// Skip authorization if not multi-user mode.
+		return nil
+	}
+	if s.resourceManager.IsEmptyNamespace(resourceAttributes.Namespace) {
+		return util.NewInvalidInputError("Namespace cannot be empty in multi-user mode")
+	}
+	resourceAttributes.Group = common.RbacPipelinesGroup
+	resourceAttributes.Version = common.RbacPipelinesVersion
+	resourceAttributes.Resource = resourceType
+	if err := s.resourceManager.IsAuthorized(ctx, resourceAttributes); err != nil {
+		return util.Wrap(err, "Failed to authorize with API")
+	}
+	return nil
+}
+
+// Imports the resources of a bundle into a namespace, and records the outcome for each of them.
+type bundleImporter struct {
+	server    *PipelineServer
+	namespace string
+	policy    apiv2beta1.ImportBundleRequest_ConflictPolicy
+	dryRun    bool
+	files     map[string]string
+	resources []*apiv2beta1.ImportedResource
+	// Deletes the resources created so far, in creation order.
+	undo []func(ctx context.Context) error
+}
+
+func newBundleImporter(server *PipelineServer, request *apiv2beta1.ImportBundleRequest, dryRun bool, files map[string]string) *bundleImporter {
+	return &bundleImporter{
+		server:    server,
+		namespace: server.resourceManager.ReplaceNamespace(request.GetNamespace()),
+		policy:    request.GetConflictPolicy(),
+		dryRun:    dryRun,
+		files:     files,
+	}
+}
+
+// Imports the pipeline and the experiment of a bundle.
+func (i *bundleImporter) importManifest(ctx context.Context, manifest *bundleManifest) error {
+	if manifest.Pipeline != nil {
+		if err := i.importPipeline(ctx, manifest.Pipeline); err != nil {
+			return err
+		}
+	}
+	if manifest.Experiment != nil {
+		if err := i.importExperiment(ctx, manifest.Experiment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deletes the resources created by a failed import, most recent first.
+func (i *bundleImporter) rollback(ctx context.Context) {
+	for j := len(i.undo) - 1; j >= 0; j-- {
+		if err := i.undo[j](ctx); err != nil {
+			glog.Errorf("Failed to roll back the import of a bundle into namespace %v: %+v", i.namespace, err)
+		}
+	}
+}
+
+func (i *bundleImporter) record(resourceType apiv2beta1.ImportedResource_Type, bundleName string, name string, action apiv2beta1.ImportedResource_Action) *apiv2beta1.ImportedResource {
+	resource := &apiv2beta1.ImportedResource{
+		Type:       resourceType,
+		BundleName: bundleName,
+		Name:       name,
+		Action:     action,
+	}
+	i.resources = append(i.resources, resource)
+	return resource
+}
+
+// Imports a pipeline and its versions. The versions are created through
+// CreatePipelineAndPipelineVersion and CreatePipelineVersion, default version last.
+func (i *bundleImporter) importPipeline(ctx context.Context, bundled *bundlePipeline) error {
+	existing, err := i.findPipeline(bundled.Name)
+	if err != nil {
+		return err
+	}
+	name, action := bundled.Name, apiv2beta1.ImportedResource_CREATED
+	if existing != nil {
+		switch i.policy {
+		case apiv2beta1.ImportBundleRequest_SKIP:
+			i.record(apiv2beta1.ImportedResource_PIPELINE, bundled.Name, bundled.Name, apiv2beta1.ImportedResource_SKIPPED)
+			for _, version := range bundled.orderedVersions() {
+				i.record(apiv2beta1.ImportedResource_PIPELINE_VERSION, version.Name, version.Name, apiv2beta1.ImportedResource_SKIPPED)
+			}
+			return nil
+		case apiv2beta1.ImportBundleRequest_RENAME:
+			name, err = nextAvailableName(bundled.Name, func(candidate string) (bool, error) {
+				pipeline, err := i.findPipeline(candidate)
+				return pipeline != nil, err
+			})
+			if err != nil {
+				return err
+			}
+			action = apiv2beta1.ImportedResource_RENAMED
+		case apiv2beta1.ImportBundleRequest_NEW_VERSION:
+			return i.importPipelineVersions(ctx, bundled, existing)
+		default:
+			return util.NewAlreadyExistError("Pipeline %v already exists in namespace %v. Set a conflict policy to import it", bundled.Name, i.namespace)
+		}
+	}
+
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: i.namespace,
+		Name:      name,
+		Verb:      common.RbacResourceVerbCreate,
+	}
+	if err := i.server.canAccessPipeline(ctx, "", resourceAttributes); err != nil {
+		return util.Wrapf(err, "Failed to import pipeline %v due to authorization error. Check if you have write permission to namespace %v", bundled.Name, i.namespace)
+	}
+	pipeline := &model.Pipeline{
+		Name:        name,
+		DisplayName: renamedDisplayName(bundled.DisplayName, bundled.Name, name),
+		Description: bundled.Description,
+		Namespace:   i.namespace,
+		Status:      model.PipelineCreating,
+		Labels:      bundled.Labels,
+	}
+	pipelineResource := i.record(apiv2beta1.ImportedResource_PIPELINE, bundled.Name, name, action)
+	versions := bundled.orderedVersions()
+	if len(versions) == 0 && !i.dryRun {
+		created, err := i.server.resourceManager.CreatePipeline(pipeline)
+		if err != nil {
+			return util.Wrapf(err, "Failed to import pipeline %v", bundled.Name)
+		}
+		pipelineResource.Id = created.UUID
+		i.undoPipeline(created.UUID)
+	}
+	for j, version := range versions {
+		versionResource := i.record(apiv2beta1.ImportedResource_PIPELINE_VERSION, version.Name, version.Name, apiv2beta1.ImportedResource_CREATED)
+		if i.dryRun {
+			continue
+		}
+		pipelineVersion := i.newPipelineVersion(version, version.Name)
+		if j == 0 {
+			createdPipeline, createdVersion, err := i.server.resourceManager.CreatePipelineAndPipelineVersion(pipeline, pipelineVersion)
+			if err != nil {
+				return util.Wrapf(err, "Failed to import pipeline %v", bundled.Name)
+			}
+			pipelineResource.Id = createdPipeline.UUID
+			versionResource.Id = createdVersion.UUID
+			i.undoPipeline(createdPipeline.UUID)
+			continue
+		}
+		pipelineVersion.PipelineId = pipelineResource.Id
+		createdVersion, err := i.server.resourceManager.CreatePipelineVersion(pipelineVersion)
+		if err != nil {
+			return util.Wrapf(err, "Failed to import version %v of pipeline %v", version.Name, bundled.Name)
+		}
+		versionResource.Id = createdVersion.UUID
+	}
+	return nil
+}
+
+// Adds the versions of a bundled pipeline to an existing pipeline. Versions whose names are
+// taken are renamed.
+func (i *bundleImporter) importPipelineVersions(ctx context.Context, bundled *bundlePipeline, existing *model.Pipeline) error {
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb: common.RbacResourceVerbCreate,
+	}
+	if err := i.server.canAccessPipeline(ctx, existing.UUID, resourceAttributes); err != nil {
+		return util.Wrapf(err, "Failed to import the versions of pipeline %v due to authorization error. Check if you have write permission to namespace %v", bundled.Name, i.namespace)
+	}
+	existingVersions, err := i.server.listAllPipelineVersions(existing.UUID)
+	if err != nil {
+		return util.Wrapf(err, "Failed to list the versions of pipeline %v", existing.UUID)
+	}
+	taken := make(map[string]bool, len(existingVersions))
+	for _, version := range existingVersions {
+		taken[version.Name] = true
+	}
+	isTaken := func(candidate string) (bool, error) {
+		return taken[candidate], nil
+	}
+
+	i.record(apiv2beta1.ImportedResource_PIPELINE, bundled.Name, existing.Name, apiv2beta1.ImportedResource_REUSED).Id = existing.UUID
+	for _, version := range bundled.orderedVersions() {
+		name, action := version.Name, apiv2beta1.ImportedResource_CREATED
+		if taken[name] {
+			if name, err = nextAvailableName(version.Name, isTaken); err != nil {
+				return err
+			}
+			action = apiv2beta1.ImportedResource_RENAMED
+		}
+		taken[name] = true
+		versionResource := i.record(apiv2beta1.ImportedResource_PIPELINE_VERSION, version.Name, name, action)
+		if i.dryRun {
+			continue
+		}
+		pipelineVersion := i.newPipelineVersion(version, name)
+		pipelineVersion.PipelineId = existing.UUID
+		createdVersion, err := i.server.resourceManager.CreatePipelineVersion(pipelineVersion)
+		if err != nil {
+			return util.Wrapf(err, "Failed to import version %v of pipeline %v", version.Name, bundled.Name)
+		}
+		versionResource.Id = createdVersion.UUID
+		i.undo = append(i.undo, func(ctx context.Context) error {
+			return i.server.resourceManager.DeletePipelineVersion(createdVersion.UUID)
+		})
+	}
+	return nil
+}
+
+// Deletes a created pipeline with its versions on rollback.
+func (i *bundleImporter) undoPipeline(pipelineId string) {
+	i.undo = append(i.undo, func(ctx context.Context) error {
+		return i.server.resourceManager.DeletePipeline(pipelineId, true)
+	})
+}
+
+func (i *bundleImporter) newPipelineVersion(version *bundlePipelineVersion, name string) *model.PipelineVersion {
+	return &model.PipelineVersion{
+		Name:          name,
+		DisplayName:   renamedDisplayName(version.DisplayName, version.Name, name),
+		Description:   version.Description,
+		CodeSourceUrl: version.CodeSourceUrl,
+		PipelineSpec:  i.files[version.SpecFile],
+		Status:        model.PipelineVersionCreating,
+	}
+}
+
+// Imports an experiment and its recurring runs. The recurring runs are created disabled
+// through CreateJob, pinned to the pipeline specs of the bundle.
+func (i *bundleImporter) importExperiment(ctx context.Context, bundled *bundleExperiment) error {
+	existing, err := i.findExperiment(bundled.Name)
+	if err != nil {
+		return err
+	}
+	name, action := bundled.Name, apiv2beta1.ImportedResource_CREATED
+	if existing != nil {
+		switch i.policy {
+		case apiv2beta1.ImportBundleRequest_SKIP:
+			i.record(apiv2beta1.ImportedResource_EXPERIMENT, bundled.Name, bundled.Name, apiv2beta1.ImportedResource_SKIPPED)
+			for _, recurringRun := range bundled.RecurringRuns {
+				i.record(apiv2beta1.ImportedResource_RECURRING_RUN, recurringRun.job.DisplayName, recurringRun.job.DisplayName, apiv2beta1.ImportedResource_SKIPPED)
+			}
+			return nil
+		case apiv2beta1.ImportBundleRequest_RENAME:
+			name, err = nextAvailableName(bundled.Name, func(candidate string) (bool, error) {
+				experiment, err := i.findExperiment(candidate)
+				return experiment != nil, err
+			})
+			if err != nil {
+				return err
+			}
+			action = apiv2beta1.ImportedResource_RENAMED
+		case apiv2beta1.ImportBundleRequest_NEW_VERSION:
+			action = apiv2beta1.ImportedResource_REUSED
+		default:
+			return util.NewAlreadyExistError("Experiment %v already exists in namespace %v. Set a conflict policy to import it", bundled.Name, i.namespace)
+		}
+	}
+
+	experimentResource := i.record(apiv2beta1.ImportedResource_EXPERIMENT, bundled.Name, name, action)
+	if action == apiv2beta1.ImportedResource_REUSED {
+		experimentResource.Name = existing.Name
+		experimentResource.Id = existing.UUID
+	} else {
+		resourceAttributes := &authorizationv1.ResourceAttributes{
+			Namespace: i.namespace,
+			Name:      name,
+			Verb:      common.RbacResourceVerbCreate,
+		}
+		if err := i.server.canAccessNamespacedResource(ctx, common.RbacResourceTypeExperiments, resourceAttributes); err != nil {
+			return util.Wrapf(err, "Failed to import experiment %v due to authorization error. Check if you have write permission to namespace %v", bundled.Name, i.namespace)
+		}
+		if !i.dryRun {
+			created, err := i.server.resourceManager.CreateExperiment(&model.Experiment{
+				Name:         name,
+				Description:  bundled.Description,
+				Namespace:    i.namespace,
+				StorageState: model.StorageStateAvailable,
+				Labels:       bundled.Labels,
+			})
+			if err != nil {
+				return util.Wrapf(err, "Failed to import experiment %v", bundled.Name)
+			}
+			experimentResource.Id = created.UUID
+			i.undo = append(i.undo, func(ctx context.Context) error {
+				return i.server.resourceManager.DeleteExperiment(created.UUID)
+			})
+		}
+	}
+
+	// Recurring runs that exist in a reused experiment are not imported again.
+	existingJobs := make(map[string]*model.Job)
+	if action == apiv2beta1.ImportedResource_REUSED {
+		jobs, err := i.server.listAllExperimentJobs(existing.UUID)
+		if err != nil {
+			return util.Wrapf(err, "Failed to list the recurring runs of experiment %v", existing.UUID)
+		}
+		for _, job := range jobs {
+			existingJobs[job.DisplayName] = job
+		}
+	}
+
+	for _, recurringRun := range bundled.RecurringRuns {
+		job := recurringRun.job
+		if existingJob, ok := existingJobs[job.DisplayName]; ok {
+			i.record(apiv2beta1.ImportedResource_RECURRING_RUN, job.DisplayName, existingJob.DisplayName, apiv2beta1.ImportedResource_REUSED).Id = existingJob.UUID
+			continue
+		}
+		resourceAttributes := &authorizationv1.ResourceAttributes{
+			Namespace: i.namespace,
+			Name:      job.DisplayName,
+			Verb:      common.RbacResourceVerbCreate,
+		}
+		if err := i.server.canAccessNamespacedResource(ctx, common.RbacResourceTypeJobs, resourceAttributes); err != nil {
+			return util.Wrapf(err, "Failed to import recurring run %v due to authorization error. Check if you have write permission to namespace %v", job.DisplayName, i.namespace)
+		}
+		recurringRunResource := i.record(apiv2beta1.ImportedResource_RECURRING_RUN, job.DisplayName, job.DisplayName, apiv2beta1.ImportedResource_CREATED)
+		if i.dryRun {
+			continue
+		}
+		job.ExperimentId = experimentResource.Id
+		job.Namespace = i.namespace
+		// Imported recurring runs do not start running until they are enabled.
+		job.Enabled = false
+		created, err := i.server.resourceManager.CreateJob(ctx, job)
+		if err != nil {
+			return util.Wrapf(err, "Failed to import recurring run %v", job.DisplayName)
+		}
+		recurringRunResource.Id = created.UUID
+		i.undo = append(i.undo, func(ctx context.Context) error {
+			return i.server.resourceManager.DeleteJob(ctx, created.UUID)
+		})
+	}
+	return nil
+}
+
+// Returns the pipeline with the given name in the target namespace, or nil if there is none.
+func (i *bundleImporter) findPipeline(name string) (*model.Pipeline, error) {
+	pipeline, err := i.server.resourceManager.GetPipelineByNameAndNamespace(name, i.namespace)
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return nil, nil
+	}
+	return pipeline, err
+}
+
+// Returns the experiment with the given name in the target namespace, or nil if there is none.
+func (i *bundleImporter) findExperiment(name string) (*model.Experiment, error) {
+	experiment, err := i.server.resourceManager.GetExperimentByNameAndNamespace(name, i.namespace)
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return nil, nil
+	}
+	return experiment, err
+}
+
+// Returns the first of "name-1", "name-2", ... that is not taken.
+func nextAvailableName(name string, isTaken func(string) (bool, error)) (string, error) {
+	for suffix := 1; ; suffix++ {
+		candidate := fmt.Sprintf("%s-%d", name, suffix)
+		taken, err := isTaken(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+}
+
+// Returns the display name of a renamed resource. Display names that default to the
+// name follow the new name.
+func renamedDisplayName(displayName string, bundleName string, name string) string {
+	if displayName == "" || displayName == bundleName {
+		return name
+	}
+	return displayName
+}
@@ -67,6 +67,21 @@ var (
 		Help: "The total number of GetPipelineVersion requests",
 	})
 
+	diffPipelineVersionsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_server_diff_versions_requests",
+		Help: "The total number of DiffPipelineVersions requests",
+	})
+
+	exportBundleRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_server_export_bundle_requests",
+		Help: "The total number of ExportBundle requests",
+	})
+
+	importBundleRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_server_import_bundle_requests",
+		Help: "The total number of ImportBundle requests",
+	})
+
 	listPipelineVersionRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "pipeline_server_list_version_requests",
 		Help: "The total number of ListPipelineVersions requests",
@@ -92,6 +107,11 @@ var (
 		Name: "pipeline_server_update_default_version_requests",
 		Help: "The total number of UpdatePipelineDefaultVersion requests",
 	})
+
+	updatePipelineRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_server_update_requests",
+		Help: "The total number of UpdatePipeline requests",
+	})
 )
 
 type PipelineServerOptions struct {
@@ -324,6 +344,53 @@ func (s *PipelineServer) GetPipeline(ctx context.Context, request *apiv2beta1.Ge
 	return toApiPipeline(pipeline), nil
 }
 
+// Updates the description and the labels of a pipeline.
+// Applies common logic on v1beta1 and v2beta1 API.
+func (s *BasePipelineServer) updatePipeline(ctx context.Context, pipelineId string, update *apiv2beta1.Pipeline, paths []string) (*model.Pipeline, error) {
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb: common.RbacResourceVerbUpdate,
+	}
+	if err := s.canAccessPipeline(ctx, pipelineId, resourceAttributes); err != nil {
+		return nil, util.Wrapf(err, "Failed to update a pipeline due authorization error for pipeline id %v", pipelineId)
+	}
+	fields, err := metadataUpdateFields("pipeline", pipelineId, paths)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := toModelLabels(update.GetLabels())
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update pipeline %v", pipelineId)
+	}
+	pipeline, err := s.resourceManager.GetPipeline(pipelineId)
+	if err != nil {
+		return nil, err
+	}
+	if fields["description"] {
+		pipeline.Description = update.GetDescription()
+	}
+	if fields["labels"] {
+		pipeline.Labels = labels
+	}
+	return s.resourceManager.UpdatePipeline(pipeline)
+}
+
+// Updates the description and the labels of a pipeline.
+// Supports v2beta behavior.
+func (s *PipelineServer) UpdatePipeline(ctx context.Context, request *apiv2beta1.UpdatePipelineRequest) (*apiv2beta1.Pipeline, error) {
+	if s.options.CollectMetrics {
+		updatePipelineRequests.Inc()
+	}
+	pipelineId := request.GetPipeline().GetPipelineId()
+	if pipelineId == "" {
+		return nil, util.NewInvalidInputError("Failed to update a pipeline. Pipeline id cannot be empty")
+	}
+	pipeline, err := s.updatePipeline(ctx, pipelineId, request.GetPipeline(), request.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update a pipeline %s. Check error stack", pipelineId)
+	}
+	return toApiPipeline(pipeline), nil
+}
+
 // Fetches pipeline and (optionally) pipeline version for a given name and namespace.
 // Applies common logic on v1beta1 and v2beta1 API.
 func (s *BasePipelineServer) getPipelineByName(ctx context.Context, name string, namespace string, apiRequestVersion string) (*model.Pipeline, *model.PipelineVersion, error) {
@@ -846,6 +913,85 @@ func (s *PipelineServer) GetPipelineVersion(ctx context.Context, request *apiv2b
 	return toApiPipelineVersion(pipelineVersion), nil
 }
 
+// Compares the pipeline specs of two pipeline versions of a pipeline.
+// Supports v2beta1 behavior.
+func (s *PipelineServer) DiffPipelineVersions(ctx context.Context, request *apiv2beta1.DiffPipelineVersionsRequest) (*apiv2beta1.DiffPipelineVersionsResponse, error) {
+	if s.options.CollectMetrics {
+		diffPipelineVersionsRequests.Inc()
+	}
+	if request.GetPipelineId() == "" || request.GetBasePipelineVersionId() == "" || request.GetTargetPipelineVersionId() == "" {
+		return nil, util.NewInvalidInputError("Failed to diff pipeline versions. Pipeline id, base pipeline version id and target pipeline version id cannot be empty")
+	}
+	for _, pipelineVersionId := range []string{request.GetBasePipelineVersionId(), request.GetTargetPipelineVersionId()} {
+		pipelineVersion, err := s.getPipelineVersion(ctx, pipelineVersionId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to diff pipeline versions due to an error getting pipeline version %v", pipelineVersionId)
+		}
+		if pipelineVersion.PipelineId != request.GetPipelineId() {
+			return nil, util.NewInvalidInputError("Failed to diff pipeline versions. Pipeline version %v does not belong to pipeline %v", pipelineVersionId, request.GetPipelineId())
+		}
+	}
+	changes, err := s.resourceManager.DiffPipelineVersions(request.GetBasePipelineVersionId(), request.GetTargetPipelineVersionId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to diff pipeline versions")
+	}
+	return &apiv2beta1.DiffPipelineVersionsResponse{Changes: toApiPipelineVersionChanges(changes)}, nil
+}
+
+// Exports a pipeline with all its versions, or an experiment with its recurring runs, as a bundle.
+func (s *PipelineServer) ExportBundle(ctx context.Context, request *apiv2beta1.ExportBundleRequest) (*apiv2beta1.ExportBundleResponse, error) {
+	if s.options.CollectMetrics {
+		exportBundleRequests.Inc()
+	}
+	files := make(map[string]string)
+	manifest := &bundleManifest{FormatVersion: bundleFormatVersion}
+	var err error
+	switch {
+	case request.GetPipelineId() != "":
+		manifest.Pipeline, err = s.exportPipeline(ctx, request.GetPipelineId(), files)
+	case request.GetExperimentId() != "":
+		manifest.Experiment, err = s.exportExperiment(ctx, request.GetExperimentId(), files)
+	default:
+		return nil, util.NewInvalidInputError("Failed to export a bundle. Pipeline id or experiment id must be set")
+	}
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to export a bundle")
+	}
+	bundle, err := writeBundle(manifest, files)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to export a bundle due to an error writing the archive")
+	}
+	return &apiv2beta1.ExportBundleResponse{Bundle: bundle}, nil
+}
+
+// Imports the resources of a bundle created by ExportBundle into a namespace.
+func (s *PipelineServer) ImportBundle(ctx context.Context, request *apiv2beta1.ImportBundleRequest) (*apiv2beta1.ImportBundleResponse, error) {
+	if s.options.CollectMetrics {
+		importBundleRequests.Inc()
+	}
+	if len(request.GetBundle()) == 0 {
+		return nil, util.NewInvalidInputError("Failed to import a bundle. Bundle cannot be empty")
+	}
+	manifest, files, err := readBundle(request.GetBundle())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to import a bundle")
+	}
+	// The bundle is imported in a dry run first, so that conflicts and authorization
+	// errors are reported before anything is created.
+	importer := newBundleImporter(s, request, true, files)
+	if err := importer.importManifest(ctx, manifest); err != nil {
+		return nil, util.Wrap(err, "Failed to import a bundle")
+	}
+	if !request.GetDryRun() {
+		importer = newBundleImporter(s, request, false, files)
+		if err := importer.importManifest(ctx, manifest); err != nil {
+			importer.rollback(ctx)
+			return nil, util.Wrap(err, "Failed to import a bundle")
+		}
+	}
+	return &apiv2beta1.ImportBundleResponse{Resources: importer.resources}, nil
+}
+
 // Fetches an array of pipeline versions for given search query parameters.
 // Applies common logic on v1beta1 and v2beta1 API.
 func (s *BasePipelineServer) listPipelineVersions(ctx context.Context, pipelineId string, pageToken string, pageSize int32, sortBy string, opts *list.Options) ([]*model.PipelineVersion, int, string, error) {
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
@@ -31,7 +32,9 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -740,6 +743,42 @@ func TestPipelineServer_CreatePipeline(t *testing.T) {
 	}
 }
 
+func TestPipelineServer_UpdatePipeline(t *testing.T) {
+	httpServer := getMockServer(t)
+	defer httpServer.Close()
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	pipelineServer := createPipelineServer(resourceManager, httpServer.Client())
+
+	created, err := pipelineServer.CreatePipeline(context.Background(), &apiv2.CreatePipelineRequest{Pipeline: &apiv2.Pipeline{
+		Name:        "pipeline1",
+		Description: "first pipeline",
+		Labels:      map[string]string{"team": "vision"},
+	}})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, created.GetLabels())
+
+	updated, err := pipelineServer.UpdatePipeline(context.Background(), &apiv2.UpdatePipelineRequest{
+		Pipeline: &apiv2.Pipeline{
+			PipelineId:  created.GetPipelineId(),
+			Name:        "pipeline2",
+			Description: "updated pipeline",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "labels"}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "pipeline1", updated.GetName())
+	assert.Equal(t, "updated pipeline", updated.GetDescription())
+	assert.Empty(t, updated.GetLabels())
+
+	_, err = pipelineServer.UpdatePipeline(context.Background(), &apiv2.UpdatePipelineRequest{
+		Pipeline:   &apiv2.Pipeline{PipelineId: created.GetPipelineId(), Name: "pipeline2"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "field name cannot be updated")
+}
+
 func TestPipelineServer_CreatePipelineAndVersion_v2(t *testing.T) {
 	httpServer := getMockServer(t)
 	defer httpServer.Close()
@@ -901,3 +940,298 @@ func TestPipelineServer_CreatePipelineAndVersion_v2(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineServer_DiffPipelineVersions(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	pipelineServer := createPipelineServer(resourceManager, nil)
+
+	p, err := resourceManager.CreatePipeline(&model.Pipeline{Name: "p1"})
+	require.Nil(t, err)
+	baseVersion, err := resourceManager.CreatePipelineVersion(&model.PipelineVersion{Name: "v1", PipelineId: p.UUID, PipelineSpec: v2SpecHelloWorld})
+	require.Nil(t, err)
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(NonDefaultFakeUUID, nil))
+	resourceManager = resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	pipelineServer = createPipelineServer(resourceManager, nil)
+	targetVersion, err := resourceManager.CreatePipelineVersion(&model.PipelineVersion{
+		Name:         "v2",
+		PipelineId:   p.UUID,
+		PipelineSpec: strings.Replace(v2SpecHelloWorld, "image: python:3.9", "image: python:3.11", 1),
+	})
+	require.Nil(t, err)
+
+	response, err := pipelineServer.DiffPipelineVersions(context.Background(), &apiv2.DiffPipelineVersionsRequest{
+		PipelineId:              p.UUID,
+		BasePipelineVersionId:   baseVersion.UUID,
+		TargetPipelineVersionId: targetVersion.UUID,
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetChanges(), 1)
+	change := response.GetChanges()[0]
+	assert.Equal(t, apiv2.PipelineVersionChange_IMAGE_CHANGED, change.GetType())
+	assert.Equal(t, "hello-world", change.GetTaskName())
+	assert.Equal(t, "python:3.9", change.GetBaseValue().GetStringValue())
+	assert.Equal(t, "python:3.11", change.GetTargetValue().GetStringValue())
+
+	// Comparing a version with itself returns no changes.
+	response, err = pipelineServer.DiffPipelineVersions(context.Background(), &apiv2.DiffPipelineVersionsRequest{
+		PipelineId:              p.UUID,
+		BasePipelineVersionId:   baseVersion.UUID,
+		TargetPipelineVersionId: baseVersion.UUID,
+	})
+	require.Nil(t, err)
+	assert.Empty(t, response.GetChanges())
+}
+
+func TestPipelineServer_DiffPipelineVersions_InvalidInput(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	pipelineServer := createPipelineServer(resourceManager, nil)
+
+	p, err := resourceManager.CreatePipeline(&model.Pipeline{Name: "p1"})
+	require.Nil(t, err)
+	pv, err := resourceManager.CreatePipelineVersion(&model.PipelineVersion{Name: "v1", PipelineId: p.UUID, PipelineSpec: v2SpecHelloWorld})
+	require.Nil(t, err)
+
+	_, err = pipelineServer.DiffPipelineVersions(context.Background(), &apiv2.DiffPipelineVersionsRequest{
+		PipelineId:              p.UUID,
+		TargetPipelineVersionId: pv.UUID,
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "cannot be empty")
+
+	_, err = pipelineServer.DiffPipelineVersions(context.Background(), &apiv2.DiffPipelineVersionsRequest{
+		PipelineId:              "other-pipeline",
+		BasePipelineVersionId:   pv.UUID,
+		TargetPipelineVersionId: pv.UUID,
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "does not belong to pipeline other-pipeline")
+}
+
+func initWithPipelineBundleSource(t *testing.T) (*resource.ResourceManager, *model.Pipeline) {
+	initEnvVars()
+	clientManager := resource.NewFakeClientManagerOrFatalV2()
+	resourceManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	p, v1, err := resourceManager.CreatePipelineAndPipelineVersion(
+		&model.Pipeline{Name: "p1", Description: "first pipeline", Labels: map[string]string{"team": "ml"}},
+		&model.PipelineVersion{Name: "v1", PipelineSpec: v2SpecHelloWorld},
+	)
+	require.Nil(t, err)
+	require.Equal(t, "v1", v1.Name)
+	_, err = resourceManager.CreatePipelineVersion(&model.PipelineVersion{
+		Name:         "v2",
+		PipelineId:   p.UUID,
+		PipelineSpec: strings.Replace(v2SpecHelloWorld, "image: python:3.9", "image: python:3.11", 1),
+	})
+	require.Nil(t, err)
+	return resourceManager, p
+}
+
+func TestPipelineServer_ExportImportBundle_Pipeline(t *testing.T) {
+	sourceManager, p := initWithPipelineBundleSource(t)
+	exported, err := createPipelineServer(sourceManager, nil).ExportBundle(context.Background(), &apiv2.ExportBundleRequest{
+		Source: &apiv2.ExportBundleRequest_PipelineId{PipelineId: p.UUID},
+	})
+	require.Nil(t, err)
+
+	targetManager := resource.NewResourceManager(resource.NewFakeClientManagerOrFatalV2(), &resource.ResourceManagerOptions{CollectMetrics: false})
+	response, err := createPipelineServer(targetManager, nil).ImportBundle(context.Background(), &apiv2.ImportBundleRequest{
+		Bundle: exported.GetBundle(),
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetResources(), 3)
+	assert.Equal(t, apiv2.ImportedResource_PIPELINE, response.GetResources()[0].GetType())
+	assert.Equal(t, apiv2.ImportedResource_CREATED, response.GetResources()[0].GetAction())
+	assert.Equal(t, []string{"v1", "v2"}, []string{response.GetResources()[1].GetName(), response.GetResources()[2].GetName()})
+
+	imported, err := targetManager.GetPipelineByNameAndNamespace("p1", "")
+	require.Nil(t, err)
+	assert.Equal(t, response.GetResources()[0].GetId(), imported.UUID)
+	assert.Equal(t, "first pipeline", imported.Description)
+	assert.Equal(t, map[string]string{"team": "ml"}, imported.Labels)
+	latest, err := targetManager.GetLatestPipelineVersion(imported.UUID)
+	require.Nil(t, err)
+	assert.Equal(t, "v2", latest.Name)
+	assert.Contains(t, latest.PipelineSpec, "image: python:3.11")
+}
+
+func TestPipelineServer_ImportBundle_ConflictPolicies(t *testing.T) {
+	resourceManager, p := initWithPipelineBundleSource(t)
+	pipelineServer := createPipelineServer(resourceManager, nil)
+	exported, err := pipelineServer.ExportBundle(context.Background(), &apiv2.ExportBundleRequest{
+		Source: &apiv2.ExportBundleRequest_PipelineId{PipelineId: p.UUID},
+	})
+	require.Nil(t, err)
+
+	_, err = pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{Bundle: exported.GetBundle()})
+	require.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.AlreadyExists))
+
+	response, err := pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{
+		Bundle:         exported.GetBundle(),
+		ConflictPolicy: apiv2.ImportBundleRequest_SKIP,
+	})
+	require.Nil(t, err)
+	for _, resource := range response.GetResources() {
+		assert.Equal(t, apiv2.ImportedResource_SKIPPED, resource.GetAction())
+		assert.Empty(t, resource.GetId())
+	}
+
+	response, err = pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{
+		Bundle:         exported.GetBundle(),
+		ConflictPolicy: apiv2.ImportBundleRequest_RENAME,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, apiv2.ImportedResource_RENAMED, response.GetResources()[0].GetAction())
+	assert.Equal(t, "p1-1", response.GetResources()[0].GetName())
+	renamed, err := resourceManager.GetPipelineByNameAndNamespace("p1-1", "")
+	require.Nil(t, err)
+	assert.Equal(t, response.GetResources()[0].GetId(), renamed.UUID)
+
+	response, err = pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{
+		Bundle:         exported.GetBundle(),
+		ConflictPolicy: apiv2.ImportBundleRequest_NEW_VERSION,
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetResources(), 3)
+	assert.Equal(t, apiv2.ImportedResource_REUSED, response.GetResources()[0].GetAction())
+	assert.Equal(t, p.UUID, response.GetResources()[0].GetId())
+	assert.Equal(t, "v1-1", response.GetResources()[1].GetName())
+	assert.Equal(t, "v2-1", response.GetResources()[2].GetName())
+	latest, err := resourceManager.GetLatestPipelineVersion(p.UUID)
+	require.Nil(t, err)
+	assert.Equal(t, "v2-1", latest.Name)
+}
+
+func TestPipelineServer_ImportBundle_DryRun(t *testing.T) {
+	resourceManager, p := initWithPipelineBundleSource(t)
+	pipelineServer := createPipelineServer(resourceManager, nil)
+	exported, err := pipelineServer.ExportBundle(context.Background(), &apiv2.ExportBundleRequest{
+		Source: &apiv2.ExportBundleRequest_PipelineId{PipelineId: p.UUID},
+	})
+	require.Nil(t, err)
+
+	response, err := pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{
+		Bundle:         exported.GetBundle(),
+		ConflictPolicy: apiv2.ImportBundleRequest_RENAME,
+		DryRun:         true,
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetResources(), 3)
+	assert.Equal(t, "p1-1", response.GetResources()[0].GetName())
+	assert.Empty(t, response.GetResources()[0].GetId())
+	_, err = resourceManager.GetPipelineByNameAndNamespace("p1-1", "")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
+
+// Returns a bundle of an experiment with one recurring run.
+func exportExperimentBundle(t *testing.T) []byte {
+	initEnvVars()
+	sourceManager := resource.NewResourceManager(resource.NewFakeClientManagerOrFatalV2(), &resource.ResourceManagerOptions{CollectMetrics: false})
+	experiment, err := sourceManager.CreateExperiment(&model.Experiment{Name: "exp1", Description: "nightly", StorageState: model.StorageStateAvailable})
+	require.Nil(t, err)
+	cron := "1 * * * *"
+	_, err = sourceManager.CreateJob(context.Background(), &model.Job{
+		DisplayName:    "nightly-training",
+		MaxConcurrency: 1,
+		Enabled:        true,
+		ExperimentId:   experiment.UUID,
+		Trigger:        model.Trigger{CronSchedule: model.CronSchedule{Cron: &cron}},
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorld,
+			RuntimeConfig:        model.RuntimeConfig{Parameters: `{"param1":"world"}`},
+		},
+	})
+	require.Nil(t, err)
+	exported, err := createPipelineServer(sourceManager, nil).ExportBundle(context.Background(), &apiv2.ExportBundleRequest{
+		Source: &apiv2.ExportBundleRequest_ExperimentId{ExperimentId: experiment.UUID},
+	})
+	require.Nil(t, err)
+	return exported.GetBundle()
+}
+
+func TestPipelineServer_ExportImportBundle_Experiment(t *testing.T) {
+	bundle := exportExperimentBundle(t)
+
+	targetManager := resource.NewResourceManager(resource.NewFakeClientManagerOrFatalV2(), &resource.ResourceManagerOptions{CollectMetrics: false})
+	response, err := createPipelineServer(targetManager, nil).ImportBundle(context.Background(), &apiv2.ImportBundleRequest{
+		Bundle: bundle,
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetResources(), 2)
+	assert.Equal(t, apiv2.ImportedResource_EXPERIMENT, response.GetResources()[0].GetType())
+	assert.Equal(t, apiv2.ImportedResource_RECURRING_RUN, response.GetResources()[1].GetType())
+
+	job, err := targetManager.GetJob(response.GetResources()[1].GetId())
+	require.Nil(t, err)
+	assert.Equal(t, "nightly-training", job.DisplayName)
+	assert.Equal(t, response.GetResources()[0].GetId(), job.ExperimentId)
+	assert.Equal(t, "1 * * * *", *job.CronSchedule.Cron)
+	assert.False(t, job.Enabled)
+	assert.Equal(t, `{"param1":"world"}`, job.RuntimeConfig.Parameters)
+	assert.NotEmpty(t, job.PipelineSpecManifest)
+}
+
+func TestPipelineServer_ImportBundle_NewVersionReusesRecurringRuns(t *testing.T) {
+	bundle := exportExperimentBundle(t)
+	targetManager := resource.NewResourceManager(resource.NewFakeClientManagerOrFatalV2(), &resource.ResourceManagerOptions{CollectMetrics: false})
+	pipelineServer := createPipelineServer(targetManager, nil)
+	first, err := pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{Bundle: bundle})
+	require.Nil(t, err)
+
+	response, err := pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{
+		Bundle:         bundle,
+		ConflictPolicy: apiv2.ImportBundleRequest_NEW_VERSION,
+	})
+	require.Nil(t, err)
+	require.Len(t, response.GetResources(), 2)
+	assert.Equal(t, apiv2.ImportedResource_REUSED, response.GetResources()[0].GetAction())
+	assert.Equal(t, apiv2.ImportedResource_REUSED, response.GetResources()[1].GetAction())
+	assert.Equal(t, first.GetResources()[1].GetId(), response.GetResources()[1].GetId())
+
+	jobs, err := pipelineServer.listAllExperimentJobs(first.GetResources()[0].GetId())
+	require.Nil(t, err)
+	assert.Len(t, jobs, 1)
+}
+
+func TestPipelineServer_ImportBundle_RollsBack(t *testing.T) {
+	bundle := exportExperimentBundle(t)
+	clientManager := resource.NewFakeClientManagerOrFatalV2()
+	targetManager := resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	// The experiment is created, but its recurring run cannot be stored.
+	_, err := clientManager.DB().Exec("DROP TABLE jobs")
+	require.Nil(t, err)
+
+	_, err = createPipelineServer(targetManager, nil).ImportBundle(context.Background(), &apiv2.ImportBundleRequest{Bundle: bundle})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to import recurring run nightly-training")
+	_, err = targetManager.GetExperimentByNameAndNamespace("exp1", "")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
+
+func TestPipelineServer_Bundle_InvalidInput(t *testing.T) {
+	resourceManager, _ := initWithPipelineBundleSource(t)
+	pipelineServer := createPipelineServer(resourceManager, nil)
+
+	_, err := pipelineServer.ExportBundle(context.Background(), &apiv2.ExportBundleRequest{})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Pipeline id or experiment id must be set")
+
+	_, err = pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{Bundle: []byte("not an archive")})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not a valid tar.gz archive")
+
+	bundle, err := util.ArchiveTgz(map[string]string{"bundle.json": `{"formatVersion": 1, "pipeline": {"name": "p1", "versions": [{"name": "v1", "specFile": "missing.yaml"}]}}`})
+	require.Nil(t, err)
+	_, err = pipelineServer.ImportBundle(context.Background(), &apiv2.ImportBundleRequest{Bundle: []byte(bundle)})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "missing or empty")
+}
+
+func TestNextAvailableName(t *testing.T) {
+	taken := map[string]bool{"p1-1": true, "p1-2": true}
+	name, err := nextAvailableName("p1", func(candidate string) (bool, error) { return taken[candidate], nil })
+	require.Nil(t, err)
+	assert.Equal(t, "p1-3", name)
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	apiv1beta1 "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	RecurringRunKey = "recurring_run_id"
+	// WebhookSignatureHeader holds the HMAC-SHA256 of the timestamp and the
+	// payload of a webhook request joined by a dot, formatted as
+	// sha256=<hex digest>.
+	WebhookSignatureHeader = "X-Kfp-Signature-256"
+	// WebhookTimestampHeader holds the time a webhook request was signed, in
+	// seconds since the epoch.
+	WebhookTimestampHeader = "X-Kfp-Signature-Timestamp"
+	// The maximum size of the payload of a webhook request.
+	maxWebhookPayloadBytes = 1 << 20
+)
+
+type RecurringRunWebhookServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// Webhook endpoint of recurring runs with a webhook trigger.
+// This endpoint is not exposed through grpc endpoint, since the signature is
+// computed over the raw request body.
+func (s *RecurringRunWebhookServer) TriggerRecurringRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeErrorToResponse(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	recurringRunId, ok := mux.Vars(r)[RecurringRunKey]
+	if !ok {
+		s.writeErrorToResponse(w, http.StatusBadRequest, fmt.Errorf("missing path parameter: '%s'", RecurringRunKey))
+		return
+	}
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadBytes))
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read the payload"))
+		return
+	}
+
+	run, err := s.resourceManager.TriggerJob(r.Context(), recurringRunId, payload, r.Header.Get(WebhookTimestampHeader), r.Header.Get(WebhookSignatureHeader))
+	if err != nil {
+		code := runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err)))
+		s.writeErrorToResponse(w, code, util.Wrapf(err, "Failed to trigger recurring run %v", recurringRunId))
+		return
+	}
+
+	marshaler := &protojson.MarshalOptions{
+		UseProtoNames: true,
+	}
+	data, err := marshaler.Marshal(toApiRun(run))
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Failed to trigger recurring run. Marshaling error"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func (s *RecurringRunWebhookServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
+	glog.Errorf("Failed to trigger recurring run. Error: %+v", err)
+	w.WriteHeader(code)
+	errorResponse := &apiv1beta1.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)}
+	errBytes, err := json.Marshal(errorResponse)
+	if err != nil {
+		w.Write([]byte("Error triggering recurring run"))
+	}
+	w.Write(errBytes)
+}
+
+func NewRecurringRunWebhookServer(resourceManager *resource.ResourceManager) *RecurringRunWebhookServer {
+	return &RecurringRunWebhookServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gorilla/mux"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func triggerRecurringRun(server *RecurringRunWebhookServer, recurringRunId string, payload []byte, timestamp string, signature string) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v2beta1/recurringruns/{recurring_run_id:[^/:]+}:trigger", server.TriggerRecurringRun)
+	req, _ := http.NewRequest("POST", "/apis/v2beta1/recurringruns/"+recurringRunId+":trigger", bytes.NewReader(payload))
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, signature)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestTriggerRecurringRun(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	recurringRun, err := createJobServer(manager).CreateRecurringRun(context.Background(), &apiv2beta1.CreateRecurringRunRequest{
+		RecurringRun: &apiv2beta1.RecurringRun{
+			DisplayName:    "recurring_run_1",
+			Mode:           apiv2beta1.RecurringRun_ENABLE,
+			MaxConcurrency: 10,
+			Trigger: &apiv2beta1.Trigger{
+				Trigger: &apiv2beta1.Trigger_WebhookTrigger{WebhookTrigger: &apiv2beta1.WebhookTrigger{
+					SecretName:        "webhook-secret",
+					PayloadParameters: map[string]string{"param1": "text"},
+				}},
+			},
+			PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpecStruct},
+			RuntimeConfig: &apiv2beta1.RuntimeConfig{
+				Parameters: map[string]*structpb.Value{"param1": structpb.NewStringValue("world")},
+			},
+			ExperimentId: experiment.UUID,
+		},
+	})
+	require.Nil(t, err)
+	_, err = clients.KubernetesCoreClient().SecretClient("ns1").Create(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook-secret"},
+		Data:       map[string][]byte{"hmac-key": []byte("key")},
+	}, metav1.CreateOptions{})
+	require.Nil(t, err)
+
+	server := NewRecurringRunWebhookServer(manager)
+	payload := []byte(`{"text": "hello"}`)
+	timestamp := strconv.FormatInt(clients.Time().Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	rr := triggerRecurringRun(server, recurringRun.RecurringRunId, payload, timestamp, signature)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	run := &apiv2beta1.Run{}
+	require.Nil(t, protojson.Unmarshal(rr.Body.Bytes(), run))
+	assert.Equal(t, recurringRun.RecurringRunId, run.RecurringRunId)
+	assert.Equal(t, "hello", run.GetRuntimeConfig().GetParameters()["param1"].GetStringValue())
+
+	rr = triggerRecurringRun(server, recurringRun.RecurringRunId, payload, timestamp, "sha256=00")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// The same request is only accepted once.
+	rr = triggerRecurringRun(server, recurringRun.RecurringRunId, payload, timestamp, signature)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	rr = triggerRecurringRun(server, "unknown", payload, timestamp, signature)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -77,17 +77,26 @@ func (s *BaseReportServer) reportWorkflow(ctx context.Context, workflow string)
 		return nil, err
 	}
 
-	newExecSpec, err := s.resourceManager.ReportWorkflowResource(ctx, *execSpec)
+	if err := s.ReportExecution(ctx, *execSpec); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ReportExecution reports an execution and its tasks without authorization,
+// for the executions the API server runs itself.
+func (s *BaseReportServer) ReportExecution(ctx context.Context, execSpec util.ExecutionSpec) error {
+	newExecSpec, err := s.resourceManager.ReportWorkflowResource(ctx, execSpec)
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to report workflow")
+		return util.Wrap(err, "Failed to report workflow")
 	}
 
 	runId := newExecSpec.ExecutionObjectMeta().Labels[util.LabelKeyWorkflowRunId]
 	_, err = s.reportTasksFromExecution(newExecSpec, runId)
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to report task details")
+		return util.Wrap(err, "Failed to report task details")
 	}
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *ReportServerV1) ReportWorkflowV1(ctx context.Context,
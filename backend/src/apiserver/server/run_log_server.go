@@ -15,14 +15,17 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 )
 
@@ -82,3 +85,39 @@ func (s *RunLogServer) writeErrorToResponse(w http.ResponseWriter, code int, err
 func NewRunLogServer(resourceManager *resource.ResourceManager) *RunLogServer {
 	return &RunLogServer{resourceManager: resourceManager, httpClient: http.DefaultClient}
 }
+
+// runLogStreamWriter sends the log written to it as ReadRunLogResponse
+// messages. Each message holds complete lines; a trailing partial line is
+// buffered until it is completed or the writer is flushed.
+type runLogStreamWriter struct {
+	stream  apiv2beta1.RunService_ReadRunLogServer
+	partial []byte
+}
+
+func (w *runLogStreamWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+	i := bytes.LastIndexByte(w.partial, '\n')
+	if i < 0 {
+		return len(p), nil
+	}
+	if err := w.send(w.partial[:i+1]); err != nil {
+		return 0, err
+	}
+	w.partial = append(w.partial[:0], w.partial[i+1:]...)
+	return len(p), nil
+}
+
+// Flush sends the buffered partial line, if any.
+func (w *runLogStreamWriter) Flush() error {
+	if len(w.partial) == 0 {
+		return nil
+	}
+	err := w.send(w.partial)
+	w.partial = w.partial[:0]
+	return err
+}
+
+func (w *runLogStreamWriter) send(content []byte) error {
+	// Proto strings must be valid UTF-8, which logs are not guaranteed to be.
+	return w.stream.Send(&apiv2beta1.ReadRunLogResponse{Content: strings.ToValidUTF8(string(content), "\uFFFD")})
+}
@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -30,10 +31,21 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
 // Metric variables. Please prefix the metric names with run_server_.
+const (
+	// The maximum number of runs that a bulk operation can process.
+	maxBulkRuns = 10000
+	// The page size used to list the runs that match the filter of a bulk operation.
+	bulkRunsPageSize = maxPageSize
+)
+
+// How often a watch of runs sends a bookmark while there are no changes.
+var watchRunsBookmarkInterval = 30 * time.Second
+
 var (
 	// Used to calculate the request rate.
 	createRunRequests = promauto.NewCounter(prometheus.CounterOpts{
@@ -86,6 +98,66 @@ var (
 		Help: "The total number of RetryRun requests",
 	})
 
+	pauseRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_pause_requests",
+		Help: "The total number of PauseRun requests",
+	})
+
+	resumeRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_resume_requests",
+		Help: "The total number of ResumeRun requests",
+	})
+
+	readRunLogRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_read_log_requests",
+		Help: "The total number of ReadRunLog requests",
+	})
+
+	watchRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_watch_requests",
+		Help: "The total number of WatchRuns requests",
+	})
+
+	listRunMetricsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_list_metrics_requests",
+		Help: "The total number of ListRunMetrics requests",
+	})
+
+	compareRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_compare_requests",
+		Help: "The total number of CompareRuns requests",
+	})
+
+	bulkArchiveRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_bulk_archive_requests",
+		Help: "The total number of BulkArchiveRuns requests",
+	})
+
+	bulkUnarchiveRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_bulk_unarchive_requests",
+		Help: "The total number of BulkUnarchiveRuns requests",
+	})
+
+	bulkDeleteRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_bulk_delete_requests",
+		Help: "The total number of BulkDeleteRuns requests",
+	})
+
+	bulkTerminateRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_bulk_terminate_requests",
+		Help: "The total number of BulkTerminateRuns requests",
+	})
+
+	bulkRetryRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_bulk_retry_requests",
+		Help: "The total number of BulkRetryRuns requests",
+	})
+
+	updateRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_update_requests",
+		Help: "The total number of UpdateRun requests",
+	})
+
 	runCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "run_server_run_count",
 		Help: "The current number of runs in Kubeflow Pipelines instance",
@@ -196,6 +268,32 @@ func (s *BaseRunServer) getRun(ctx context.Context, runId string) (*model.Run, e
 	return run, nil
 }
 
+func (s *BaseRunServer) updateRun(ctx context.Context, runId string, update *apiv2beta1.Run, paths []string) (*model.Run, error) {
+	err := s.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	fields, err := metadataUpdateFields("run", runId, paths)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := toModelLabels(update.GetLabels())
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to update run %v", runId)
+	}
+	run, err := s.resourceManager.GetRun(runId)
+	if err != nil {
+		return nil, err
+	}
+	if fields["description"] {
+		run.Description = update.GetDescription()
+	}
+	if fields["labels"] {
+		run.Labels = labels
+	}
+	return s.resourceManager.UpdateRun(run)
+}
+
 // Fetches a run.
 // Supports v1beta1 behavior.
 func (s *RunServerV1) GetRunV1(ctx context.Context, request *apiv1beta1.GetRunRequest) (*apiv1beta1.RunDetail, error) {
@@ -485,6 +583,24 @@ func (s *BaseRunServer) retryRun(ctx context.Context, runId string) error {
 	return s.resourceManager.RetryRun(ctx, runId)
 }
 
+// Pauses a run.
+func (s *BaseRunServer) pauseRun(ctx context.Context, runId string) error {
+	err := s.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbPause})
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize the request")
+	}
+	return s.resourceManager.PauseRun(ctx, runId)
+}
+
+// Resumes a run.
+func (s *BaseRunServer) resumeRun(ctx context.Context, runId string) error {
+	err := s.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbResume})
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize the request")
+	}
+	return s.resourceManager.ResumeRun(ctx, runId)
+}
+
 // Terminates a run.
 // Supports v1beta1 behavior.
 func (s *RunServerV1) TerminateRunV1(ctx context.Context, request *apiv1beta1.TerminateRunRequest) (*emptypb.Empty, error) {
@@ -513,6 +629,32 @@ func (s *RunServerV1) RetryRunV1(ctx context.Context, request *apiv1beta1.RetryR
 	return &emptypb.Empty{}, nil
 }
 
+// Pauses a run.
+// Supports v2beta1 behavior.
+func (s *RunServer) PauseRun(ctx context.Context, request *apiv2beta1.PauseRunRequest) (*emptypb.Empty, error) {
+	if s.options.CollectMetrics {
+		pauseRunRequests.Inc()
+	}
+	err := s.pauseRun(ctx, request.GetRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to pause a run")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Resumes a run.
+// Supports v2beta1 behavior.
+func (s *RunServer) ResumeRun(ctx context.Context, request *apiv2beta1.ResumeRunRequest) (*emptypb.Empty, error) {
+	if s.options.CollectMetrics {
+		resumeRunRequests.Inc()
+	}
+	err := s.resumeRun(ctx, request.GetRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to resume a run")
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // Creates a run.
 // Supports v2beta1 behavior.
 func (s *RunServer) CreateRun(ctx context.Context, request *apiv2beta1.CreateRunRequest) (*apiv2beta1.Run, error) {
@@ -551,6 +693,23 @@ func (s *RunServer) GetRun(ctx context.Context, request *apiv2beta1.GetRunReques
 	return toApiRun(run), nil
 }
 
+// Updates the description and the labels of a run.
+// Supports v2beta1 behavior.
+func (s *RunServer) UpdateRun(ctx context.Context, request *apiv2beta1.UpdateRunRequest) (*apiv2beta1.Run, error) {
+	if s.options.CollectMetrics {
+		updateRunRequests.Inc()
+	}
+	runId := request.GetRun().GetRunId()
+	if runId == "" {
+		return nil, util.NewInvalidInputError("Failed to update a run due to an empty run id")
+	}
+	run, err := s.updateRun(ctx, runId, request.GetRun(), request.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to update a run")
+	}
+	return toApiRun(run), nil
+}
+
 // Fetches runs given query parameters.
 // Supports v2beta1 behavior.
 func (s *RunServer) ListRuns(ctx context.Context, r *apiv2beta1.ListRunsRequest) (*apiv2beta1.ListRunsResponse, error) {
@@ -561,11 +720,17 @@ func (s *RunServer) ListRuns(ctx context.Context, r *apiv2beta1.ListRunsRequest)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create list options")
 	}
+	// The resource version is taken before listing, so that watching from it
+	// does not miss the changes made while listing.
+	resourceVersion, err := s.resourceManager.RunResourceVersion()
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list runs")
+	}
 	runs, runsCount, nextPageToken, err := s.listRuns(ctx, r.GetPageToken(), int(r.GetPageSize()), r.GetSortBy(), opts, r.GetNamespace(), r.GetExperimentId())
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to list runs")
 	}
-	return &apiv2beta1.ListRunsResponse{Runs: toApiRuns(runs), TotalSize: int32(runsCount), NextPageToken: nextPageToken}, nil
+	return &apiv2beta1.ListRunsResponse{Runs: toApiRuns(runs), TotalSize: int32(runsCount), NextPageToken: nextPageToken, ResourceVersion: resourceVersion}, nil
 }
 
 // Archives a run.
@@ -631,6 +796,375 @@ func (s *RunServer) ReadArtifact(ctx context.Context, request *apiv2beta1.ReadAr
 	}, nil
 }
 
+// Fetches the metrics of a run.
+// Supports v2beta1 behavior.
+func (s *RunServer) ListRunMetrics(ctx context.Context, request *apiv2beta1.ListRunMetricsRequest) (*apiv2beta1.ListRunMetricsResponse, error) {
+	if s.options.CollectMetrics {
+		listRunMetricsRequests.Inc()
+	}
+	if request.GetRunId() == "" {
+		return nil, util.NewInvalidInputError("Failed to list run metrics: run id is required")
+	}
+	err := s.canAccessRun(ctx, request.GetRunId(), &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	metrics, err := s.resourceManager.ListRunMetrics(request.GetRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list run metrics")
+	}
+	return &apiv2beta1.ListRunMetricsResponse{Metrics: toApiRunMetrics(metrics)}, nil
+}
+
+// Archives runs in bulk.
+// Supports v2beta1 behavior.
+func (s *RunServer) BulkArchiveRuns(ctx context.Context, request *apiv2beta1.BulkRunsRequest) (*apiv2beta1.BulkRunsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkArchiveRunsRequests.Inc()
+	}
+	response, err := s.bulkRunOperation(ctx, request, common.RbacResourceVerbArchive, func(runIds []string) []error {
+		return s.resourceManager.ArchiveRuns(runIds)
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to archive runs")
+	}
+	return response, nil
+}
+
+// Un-archives runs in bulk.
+// Supports v2beta1 behavior.
+func (s *RunServer) BulkUnarchiveRuns(ctx context.Context, request *apiv2beta1.BulkRunsRequest) (*apiv2beta1.BulkRunsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkUnarchiveRunsRequests.Inc()
+	}
+	response, err := s.bulkRunOperation(ctx, request, common.RbacResourceVerbUnarchive, func(runIds []string) []error {
+		return s.resourceManager.UnarchiveRuns(runIds)
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to unarchive runs")
+	}
+	return response, nil
+}
+
+// Deletes runs in bulk.
+// Supports v2beta1 behavior.
+func (s *RunServer) BulkDeleteRuns(ctx context.Context, request *apiv2beta1.BulkRunsRequest) (*apiv2beta1.BulkRunsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkDeleteRunsRequests.Inc()
+	}
+	response, err := s.bulkRunOperation(ctx, request, common.RbacResourceVerbDelete, func(runIds []string) []error {
+		errs := s.resourceManager.DeleteRuns(ctx, runIds)
+		if s.options.CollectMetrics {
+			for _, err := range errs {
+				if err == nil && util.GetMetricValue(runCount) > 0 {
+					runCount.Dec()
+				}
+			}
+		}
+		return errs
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to delete runs")
+	}
+	return response, nil
+}
+
+// Terminates runs in bulk.
+// Supports v2beta1 behavior.
+func (s *RunServer) BulkTerminateRuns(ctx context.Context, request *apiv2beta1.BulkRunsRequest) (*apiv2beta1.BulkRunsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkTerminateRunsRequests.Inc()
+	}
+	response, err := s.bulkRunOperation(ctx, request, common.RbacResourceVerbTerminate, func(runIds []string) []error {
+		return s.resourceManager.TerminateRuns(ctx, runIds)
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to terminate runs")
+	}
+	return response, nil
+}
+
+// Retries runs in bulk.
+// Supports v2beta1 behavior.
+func (s *RunServer) BulkRetryRuns(ctx context.Context, request *apiv2beta1.BulkRunsRequest) (*apiv2beta1.BulkRunsResponse, error) {
+	if s.options.CollectMetrics {
+		bulkRetryRunsRequests.Inc()
+	}
+	response, err := s.bulkRunOperation(ctx, request, common.RbacResourceVerbRetry, func(runIds []string) []error {
+		return s.resourceManager.RetryRuns(ctx, runIds)
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to retry runs")
+	}
+	return response, nil
+}
+
+// Selects the runs of a bulk request, authorizes the operation once per
+// namespace of the runs, and performs it on the authorized runs.
+func (s *RunServer) bulkRunOperation(ctx context.Context, request *apiv2beta1.BulkRunsRequest, verb string, operation func(runIds []string) []error) (*apiv2beta1.BulkRunsResponse, error) {
+	runIds, err := s.selectBulkRunIds(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	errs := s.authorizeRuns(ctx, runIds, verb)
+	var authorized []int
+	for i, err := range errs {
+		if err == nil {
+			authorized = append(authorized, i)
+		}
+	}
+	authorizedIds := make([]string, 0, len(authorized))
+	for _, i := range authorized {
+		authorizedIds = append(authorizedIds, runIds[i])
+	}
+	for i, err := range operation(authorizedIds) {
+		errs[authorized[i]] = err
+	}
+	return toApiBulkRunsResponse(runIds, errs), nil
+}
+
+// Returns the distinct run IDs of a bulk request, or the IDs of the runs that
+// match its filter.
+func (s *RunServer) selectBulkRunIds(ctx context.Context, request *apiv2beta1.BulkRunsRequest) ([]string, error) {
+	if len(request.GetRunIds()) > 0 {
+		if request.GetFilter() != "" || request.GetNamespace() != "" || request.GetExperimentId() != "" {
+			return nil, util.NewInvalidInputError("Run ids cannot be combined with a filter, a namespace or an experiment id")
+		}
+		if len(request.GetRunIds()) > maxBulkRuns {
+			return nil, util.NewInvalidInputError("At most %v runs can be processed at once, got %v", maxBulkRuns, len(request.GetRunIds()))
+		}
+		runIds := make([]string, 0, len(request.GetRunIds()))
+		seen := make(map[string]bool, len(request.GetRunIds()))
+		for _, runId := range request.GetRunIds() {
+			if runId == "" {
+				return nil, util.NewInvalidInputError("Run ids cannot be empty")
+			}
+			if !seen[runId] {
+				seen[runId] = true
+				runIds = append(runIds, runId)
+			}
+		}
+		return runIds, nil
+	}
+	if request.GetFilter() == "" && request.GetExperimentId() == "" {
+		return nil, util.NewInvalidInputError("Either run ids, or a filter or an experiment id, must be set")
+	}
+	opts, err := validatedListOptions(&model.Run{}, "", bulkRunsPageSize, "", request.GetFilter(), "v2beta1")
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	var runIds []string
+	for {
+		runs, totalSize, nextPageToken, err := s.listRuns(ctx, "", bulkRunsPageSize, "", opts, request.GetNamespace(), request.GetExperimentId())
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to list the runs matching the filter")
+		}
+		if totalSize > maxBulkRuns {
+			return nil, util.NewInvalidInputError("At most %v runs can be processed at once, but %v runs match the filter", maxBulkRuns, totalSize)
+		}
+		for _, run := range runs {
+			runIds = append(runIds, run.UUID)
+		}
+		if nextPageToken == "" {
+			return runIds, nil
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, bulkRunsPageSize); err != nil {
+			return nil, util.Wrap(err, "Failed to create list options from the page token")
+		}
+	}
+}
+
+// Authorizes an operation on runs with a single access review per namespace
+// of the runs. Returns the errors of the runs, in the order of the run IDs.
+func (s *BaseRunServer) authorizeRuns(ctx context.Context, runIds []string, verb string) []error {
+	errs := make([]error, len(runIds))
+	if !common.IsMultiUserMode() {
+		// Skip authz if not multi-user mode.
+		return errs
+	}
+	indicesByNamespace := make(map[string][]int)
+	for i, runId := range runIds {
+		run, err := s.resourceManager.GetRun(runId)
+		if err != nil {
+			errs[i] = util.Wrapf(err, "Failed to authorize with the run ID %v", runId)
+			continue
+		}
+		namespace := run.Namespace
+		if s.resourceManager.IsEmptyNamespace(namespace) {
+			experiment, err := s.resourceManager.GetExperiment(run.ExperimentId)
+			if err != nil {
+				errs[i] = util.NewInvalidInputError("run %v has an empty namespace and the parent experiment %v could not be fetched: %s", runId, run.ExperimentId, err.Error())
+				continue
+			}
+			namespace = experiment.Namespace
+		}
+		if s.resourceManager.IsEmptyNamespace(namespace) {
+			errs[i] = util.NewInvalidInputError("A run cannot have an empty namespace in multi-user mode")
+			continue
+		}
+		indicesByNamespace[namespace] = append(indicesByNamespace[namespace], i)
+	}
+	for namespace, indices := range indicesByNamespace {
+		err := s.resourceManager.IsAuthorized(ctx, &authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Group:     common.RbacPipelinesGroup,
+			Version:   common.RbacPipelinesVersion,
+			Resource:  common.RbacResourceTypeRuns,
+		})
+		if err != nil {
+			for _, i := range indices {
+				errs[i] = util.Wrapf(err, "Failed to access run %s. Check if you have access to namespace %s", runIds[i], namespace)
+			}
+		}
+	}
+	return errs
+}
+
+// Compares runs side by side.
+// Supports v2beta1 behavior.
+func (s *RunServer) CompareRuns(ctx context.Context, request *apiv2beta1.CompareRunsRequest) (*apiv2beta1.CompareRunsResponse, error) {
+	if s.options.CollectMetrics {
+		compareRunsRequests.Inc()
+	}
+	for _, runId := range request.GetRunIds() {
+		if runId == "" {
+			return nil, util.NewInvalidInputError("Failed to compare runs: run ids cannot be empty")
+		}
+		err := s.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to authorize the request for run %v", runId)
+		}
+	}
+	comparison, err := s.resourceManager.CompareRuns(ctx, request.GetRunIds())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to compare runs")
+	}
+	return toApiRunComparison(comparison, request.GetOnlyDifferences()), nil
+}
+
+// Streams the log of a run task.
+// Supports v2beta1 behavior.
+func (s *RunServer) ReadRunLog(request *apiv2beta1.ReadRunLogRequest, stream apiv2beta1.RunService_ReadRunLogServer) error {
+	if s.options.CollectMetrics {
+		readRunLogRequests.Inc()
+	}
+	if request.GetRunId() == "" {
+		return util.NewInvalidInputError("Failed to read run log: run id is required")
+	}
+	if request.GetTaskId() != "" && request.GetTaskName() != "" {
+		return util.NewInvalidInputError("Failed to read run log: only one of task id and task name can be specified")
+	}
+	if request.GetTailLines() < 0 {
+		return util.NewInvalidInputError("Failed to read run log: tail lines cannot be negative")
+	}
+	ctx := stream.Context()
+	err := s.canAccessRun(ctx, request.GetRunId(), &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbReadLog})
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize the request")
+	}
+
+	opts := resource.TaskLogOptions{
+		Follow:    request.GetFollow(),
+		TailLines: request.GetTailLines(),
+	}
+	switch request.GetContainer() {
+	case apiv2beta1.ReadRunLogRequest_DRIVER:
+		opts.Container = resource.TaskLogContainerDriver
+	case apiv2beta1.ReadRunLogRequest_LAUNCHER:
+		opts.Container = resource.TaskLogContainerLauncher
+	default:
+		opts.Container = resource.TaskLogContainerUser
+	}
+	if request.GetSinceTime() != nil {
+		sinceTime := request.GetSinceTime().AsTime()
+		opts.SinceTime = &sinceTime
+	}
+
+	w := &runLogStreamWriter{stream: stream}
+	err = s.resourceManager.ReadTaskLog(ctx, request.GetRunId(), request.GetTaskId(), request.GetTaskName(), opts, w)
+	if err != nil {
+		return util.Wrapf(err, "Failed to read log of run %v", request.GetRunId())
+	}
+	return w.Flush()
+}
+
+// Streams the changes of runs and tasks.
+// Supports v2beta1 behavior.
+func (s *RunServer) WatchRuns(request *apiv2beta1.WatchRunsRequest, stream apiv2beta1.RunService_WatchRunsServer) error {
+	if s.options.CollectMetrics {
+		watchRunsRequests.Inc()
+	}
+	ctx := stream.Context()
+	namespace := s.resourceManager.ReplaceNamespace(request.GetNamespace())
+	experimentId := request.GetExperimentId()
+	if experimentId != "" {
+		ns, err := s.resourceManager.GetNamespaceFromExperimentId(experimentId)
+		if err != nil {
+			return util.Wrapf(err, "Failed to watch runs due to error fetching namespace for experiment %s. Try filtering based on namespace", experimentId)
+		}
+		namespace = ns
+	}
+	err := s.canAccessRun(ctx, "", &authorizationv1.ResourceAttributes{Namespace: namespace, Verb: common.RbacResourceVerbWatch})
+	if err != nil {
+		return util.Wrapf(err, "Failed to watch runs due to authorization error. Check if you have permission to access namespace %s", namespace)
+	}
+	filter := &resource.RunWatchFilter{Namespace: namespace, ExperimentId: experimentId}
+	for _, apiState := range request.GetStates() {
+		if apiState == apiv2beta1.RuntimeState_RUNTIME_STATE_UNSPECIFIED {
+			return util.NewInvalidInputError("Failed to watch runs: states cannot be unspecified")
+		}
+		state, err := toModelRuntimeState(apiState)
+		if err != nil {
+			return util.Wrap(err, "Failed to watch runs due to error converting the states")
+		}
+		filter.States = append(filter.States, state)
+	}
+
+	resourceVersion := request.GetResourceVersion()
+	if resourceVersion == "" {
+		// Browsers reconnecting a server-sent event stream send the ID of the
+		// last event they received.
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(common.LastEventIDHeader)) > 0 {
+			resourceVersion = md.Get(common.LastEventIDHeader)[0]
+		}
+	}
+	watcher, err := s.resourceManager.WatchRuns(filter, resourceVersion)
+	if err != nil {
+		return util.Wrap(err, "Failed to watch runs")
+	}
+	defer watcher.Stop()
+
+	sendBookmark := func() error {
+		if resourceVersion, ok := watcher.Bookmark(); ok {
+			return stream.Send(&apiv2beta1.WatchRunsResponse{Type: apiv2beta1.WatchRunsResponse_BOOKMARK, ResourceVersion: resourceVersion})
+		}
+		return nil
+	}
+	if err := sendBookmark(); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(watchRunsBookmarkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events():
+			if !ok {
+				return watcher.Err()
+			}
+			if err := stream.Send(toApiWatchRunsResponse(event)); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := sendBookmark(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Terminates a run.
 // Supports v2beta1 behavior.
 func (s *RunServer) TerminateRun(ctx context.Context, request *apiv2beta1.TerminateRunRequest) (*emptypb.Empty, error) {
@@ -29,14 +29,18 @@ import (
 	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -872,6 +876,59 @@ func TestGetRun(t *testing.T) {
 	assert.EqualValues(t, expectedRun, newRun)
 }
 
+func TestUpdateRun(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	run := &apiv2beta1.Run{
+		DisplayName:  "run1",
+		Description:  "first run",
+		ExperimentId: experiment.UUID,
+		Labels:       map[string]string{"team": "vision"},
+		PipelineSource: &apiv2beta1.Run_PipelineSpec{
+			PipelineSpec: pipelineSpecStruct,
+		},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+	}
+	createdRun, err := server.CreateRun(nil, &apiv2beta1.CreateRunRequest{Run: run})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, createdRun.GetLabels())
+
+	// The labels are not in the update mask, so they are not updated.
+	updatedRun, err := server.UpdateRun(nil, &apiv2beta1.UpdateRunRequest{
+		Run: &apiv2beta1.Run{
+			RunId:       createdRun.GetRunId(),
+			Description: "updated run",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "run1", updatedRun.GetDisplayName())
+	assert.Equal(t, "updated run", updatedRun.GetDescription())
+	assert.Equal(t, map[string]string{"team": "vision"}, updatedRun.GetLabels())
+
+	_, err = server.UpdateRun(nil, &apiv2beta1.UpdateRunRequest{
+		Run:        &apiv2beta1.Run{RunId: createdRun.GetRunId(), Labels: map[string]string{"-team": "a"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Invalid label key")
+
+	_, err = server.UpdateRun(nil, &apiv2beta1.UpdateRunRequest{
+		Run:        &apiv2beta1.Run{RunId: createdRun.GetRunId(), DisplayName: "run2"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "field display_name cannot be updated")
+}
+
 func TestListRunsV1(t *testing.T) {
 	clients, manager, experiment := initWithExperiment(t)
 	defer clients.Close()
@@ -1693,3 +1750,434 @@ func TestRetryRun(t *testing.T) {
 
 	_, err = server.RetryRun(nil, &apiv2beta1.RetryRunRequest{RunId: run.RunId})
 }
+
+func TestPauseAndResumeRun(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	run := &apiv2beta1.Run{
+		DisplayName:  "run1",
+		ExperimentId: experiment.UUID,
+		PipelineSource: &apiv2beta1.Run_PipelineSpec{
+			PipelineSpec: pipelineSpecStruct,
+		},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+	}
+	run, err := server.CreateRun(nil, &apiv2beta1.CreateRunRequest{Run: run})
+	assert.Nil(t, err)
+
+	_, err = server.PauseRun(nil, &apiv2beta1.PauseRunRequest{RunId: run.RunId})
+	assert.Nil(t, err)
+	pausedRun, err := server.GetRun(nil, &apiv2beta1.GetRunRequest{RunId: run.RunId})
+	assert.Nil(t, err)
+	assert.Equal(t, apiv2beta1.RuntimeState_PAUSED, pausedRun.GetState())
+
+	_, err = server.ResumeRun(nil, &apiv2beta1.ResumeRunRequest{RunId: run.RunId})
+	assert.Nil(t, err)
+	resumedRun, err := server.GetRun(nil, &apiv2beta1.GetRunRequest{RunId: run.RunId})
+	assert.Nil(t, err)
+	assert.Equal(t, apiv2beta1.RuntimeState_RUNNING, resumedRun.GetState())
+
+	_, err = server.ResumeRun(nil, &apiv2beta1.ResumeRunRequest{RunId: run.RunId})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not paused")
+}
+
+type fakeReadRunLogServer struct {
+	grpc.ServerStream
+	responses []*apiv2beta1.ReadRunLogResponse
+}
+
+func (s *fakeReadRunLogServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeReadRunLogServer) Send(response *apiv2beta1.ReadRunLogResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestListRunMetrics(t *testing.T) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServerV1 := createRunServerV1(resourceManager)
+	runServer := createRunServer(resourceManager)
+
+	response, err := runServer.ListRunMetrics(context.Background(), &apiv2beta1.ListRunMetricsRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	assert.Empty(t, response.GetMetrics())
+
+	otherMetric := &apiv1beta1.RunMetric{
+		Name:   "accuracy",
+		NodeId: "node-2",
+		Value:  &apiv1beta1.RunMetric_NumberValue{NumberValue: 0.5},
+	}
+	_, err = runServerV1.ReportRunMetricsV1(context.Background(), &apiv1beta1.ReportRunMetricsRequest{
+		RunId:   runDetail.UUID,
+		Metrics: []*apiv1beta1.RunMetric{metricV1, otherMetric},
+	})
+	assert.Nil(t, err)
+
+	response, err = runServer.ListRunMetrics(context.Background(), &apiv2beta1.ListRunMetricsRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	expectedMetrics := []*apiv2beta1.RunMetric{
+		{Name: "accuracy", NodeId: "node-2", NumberValue: 0.5},
+		{Name: metricV1.Name, NodeId: metricV1.NodeId, NumberValue: 0.88},
+	}
+	assert.Equal(t, expectedMetrics, response.GetMetrics())
+
+	run, err := runServer.GetRun(context.Background(), &apiv2beta1.GetRunRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, expectedMetrics, run.GetMetrics())
+}
+
+func TestListRunMetrics_RunNotFound(t *testing.T) {
+	clientManager, resourceManager, _ := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := createRunServer(resourceManager)
+
+	_, err := runServer.ListRunMetrics(context.Background(), &apiv2beta1.ListRunMetricsRequest{RunId: "unknown"})
+	AssertUserError(t, err, codes.NotFound)
+
+	_, err = runServer.ListRunMetrics(context.Background(), &apiv2beta1.ListRunMetricsRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
+func TestCompareRuns(t *testing.T) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	resourceManager = resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	otherRun, err := resourceManager.CreateRun(context.Background(), &model.Run{
+		DisplayName:  "run2",
+		ExperimentId: runDetail.ExperimentId,
+		PipelineSpec: model.PipelineSpec{
+			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			Parameters:           `[{"name":"param1","value":"kfp"}]`,
+		},
+	})
+	assert.Nil(t, err)
+	runServerV1 := createRunServerV1(resourceManager)
+	runServer := createRunServer(resourceManager)
+	for _, runId := range []string{runDetail.UUID, otherRun.UUID} {
+		_, err = runServerV1.ReportRunMetricsV1(context.Background(), &apiv1beta1.ReportRunMetricsRequest{
+			RunId:   runId,
+			Metrics: []*apiv1beta1.RunMetric{metricV1},
+		})
+		assert.Nil(t, err)
+	}
+
+	response, err := runServer.CompareRuns(context.Background(), &apiv2beta1.CompareRunsRequest{
+		RunIds: []string{runDetail.UUID, otherRun.UUID},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{runDetail.UUID, otherRun.UUID}, response.GetRunIds())
+	expectedRows := []*apiv2beta1.RunComparisonRow{
+		{
+			Kind:    apiv2beta1.RunComparisonRow_PARAMETER,
+			Key:     "param1",
+			Values:  []*structpb.Value{structpb.NewStringValue("world"), structpb.NewStringValue("kfp")},
+			Differs: true,
+		},
+		{
+			Kind:     apiv2beta1.RunComparisonRow_METRIC,
+			TaskName: metricV1.NodeId,
+			Key:      metricV1.Name,
+			Values:   []*structpb.Value{structpb.NewNumberValue(0.88), structpb.NewNumberValue(0.88)},
+		},
+	}
+	assert.True(t, cmp.Equal(expectedRows, response.GetRows(), protocmp.Transform()), cmp.Diff(expectedRows, response.GetRows(), protocmp.Transform()))
+
+	response, err = runServer.CompareRuns(context.Background(), &apiv2beta1.CompareRunsRequest{
+		RunIds:          []string{runDetail.UUID, otherRun.UUID},
+		OnlyDifferences: true,
+	})
+	assert.Nil(t, err)
+	assert.True(t, cmp.Equal(expectedRows[:1], response.GetRows(), protocmp.Transform()), cmp.Diff(expectedRows[:1], response.GetRows(), protocmp.Transform()))
+}
+
+func TestCompareRuns_InvalidRequest(t *testing.T) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := createRunServer(resourceManager)
+
+	_, err := runServer.CompareRuns(context.Background(), &apiv2beta1.CompareRunsRequest{RunIds: []string{runDetail.UUID}})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = runServer.CompareRuns(context.Background(), &apiv2beta1.CompareRunsRequest{RunIds: []string{runDetail.UUID, ""}})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = runServer.CompareRuns(context.Background(), &apiv2beta1.CompareRunsRequest{RunIds: []string{runDetail.UUID, "unknown"}})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestReadRunLog_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+
+	err := server.ReadRunLog(&apiv2beta1.ReadRunLogRequest{TaskId: "task1"}, &fakeReadRunLogServer{})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	err = server.ReadRunLog(&apiv2beta1.ReadRunLogRequest{RunId: "run1", TaskId: "task1", TaskName: "hello-world"}, &fakeReadRunLogServer{})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	err = server.ReadRunLog(&apiv2beta1.ReadRunLogRequest{RunId: "run1", TaskId: "task1", TailLines: -1}, &fakeReadRunLogServer{})
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+}
+
+func TestReadRunLog_TaskNotFound(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	run, err := server.CreateRun(nil, &apiv2beta1.CreateRunRequest{Run: &apiv2beta1.Run{
+		DisplayName:  "run1",
+		ExperimentId: experiment.UUID,
+		PipelineSource: &apiv2beta1.Run_PipelineSpec{
+			PipelineSpec: pipelineSpecStruct,
+		},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+	}})
+	assert.Nil(t, err)
+
+	err = server.ReadRunLog(&apiv2beta1.ReadRunLogRequest{RunId: run.RunId, TaskName: "hello-world"}, &fakeReadRunLogServer{})
+	assert.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
+
+func TestRunLogStreamWriter(t *testing.T) {
+	stream := &fakeReadRunLogServer{}
+	w := &runLogStreamWriter{stream: stream}
+
+	_, err := w.Write([]byte("line 1\nline"))
+	assert.Nil(t, err)
+	_, err = w.Write([]byte(" 2\nline 3\n"))
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("partial \xff"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+
+	var contents []string
+	for _, response := range stream.responses {
+		contents = append(contents, response.GetContent())
+	}
+	assert.Equal(t, []string{"line 1\n", "line 2\nline 3\n", "partial �"}, contents)
+}
+
+type fakeWatchRunsServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *apiv2beta1.WatchRunsResponse
+}
+
+func newFakeWatchRunsServer(ctx context.Context) *fakeWatchRunsServer {
+	return &fakeWatchRunsServer{ctx: ctx, responses: make(chan *apiv2beta1.WatchRunsResponse, 10)}
+}
+
+func (s *fakeWatchRunsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchRunsServer) Send(response *apiv2beta1.WatchRunsResponse) error {
+	s.responses <- response
+	return nil
+}
+
+// Starts watching runs in the background. Returns the channel the result of
+// the watch is sent to once the context is canceled.
+func startWatchRuns(server *RunServer, request *apiv2beta1.WatchRunsRequest, stream *fakeWatchRunsServer) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- server.WatchRuns(request, stream)
+	}()
+	return result
+}
+
+func receiveWatchRunsResponse(t *testing.T, stream *fakeWatchRunsServer) *apiv2beta1.WatchRunsResponse {
+	select {
+	case response := <-stream.responses:
+		return response
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "Timed out waiting for a run change")
+		return nil
+	}
+}
+
+func TestWatchRuns(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+
+	listResponse, err := server.ListRuns(context.Background(), &apiv2beta1.ListRunsRequest{})
+	require.Nil(t, err)
+	require.NotEmpty(t, listResponse.GetResourceVersion())
+
+	pipelineSpecStruct := &structpb.Struct{}
+	yaml.Unmarshal([]byte(v2SpecHelloWorld), pipelineSpecStruct)
+	run, err := server.CreateRun(nil, &apiv2beta1.CreateRunRequest{Run: &apiv2beta1.Run{
+		DisplayName:  "run1",
+		ExperimentId: experiment.UUID,
+		PipelineSource: &apiv2beta1.Run_PipelineSpec{
+			PipelineSpec: pipelineSpecStruct,
+		},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"param1": structpb.NewStringValue("world"),
+			},
+		},
+	}})
+	require.Nil(t, err)
+
+	// Watching from the listing returns the run created since.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newFakeWatchRunsServer(ctx)
+	result := startWatchRuns(server, &apiv2beta1.WatchRunsRequest{
+		ExperimentId:    experiment.UUID,
+		States:          []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_PENDING},
+		ResourceVersion: listResponse.GetResourceVersion(),
+	}, stream)
+	response := receiveWatchRunsResponse(t, stream)
+	assert.Equal(t, apiv2beta1.WatchRunsResponse_CREATED, response.GetType())
+	assert.Equal(t, run.GetRunId(), response.GetRun().GetRunId())
+	assert.Equal(t, apiv2beta1.RuntimeState_PENDING, response.GetRun().GetState())
+	assert.NotEqual(t, listResponse.GetResourceVersion(), response.GetResourceVersion())
+
+	_, err = server.DeleteRun(context.Background(), &apiv2beta1.DeleteRunRequest{RunId: run.GetRunId()})
+	require.Nil(t, err)
+	response = receiveWatchRunsResponse(t, stream)
+	assert.Equal(t, apiv2beta1.WatchRunsResponse_DELETED, response.GetType())
+	assert.Equal(t, run.GetRunId(), response.GetRun().GetRunId())
+
+	cancel()
+	assert.Nil(t, <-result)
+}
+
+func TestWatchRuns_Bookmark(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newFakeWatchRunsServer(ctx)
+	startWatchRuns(server, &apiv2beta1.WatchRunsRequest{}, stream)
+	response := receiveWatchRunsResponse(t, stream)
+	assert.Equal(t, apiv2beta1.WatchRunsResponse_BOOKMARK, response.GetType())
+	resourceVersion, err := manager.RunResourceVersion()
+	require.Nil(t, err)
+	assert.Equal(t, resourceVersion, response.GetResourceVersion())
+	assert.Nil(t, response.GetObject())
+}
+
+func TestWatchRuns_LastEventID(t *testing.T) {
+	clients, manager, runDetail := initWithOneTimeRun(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+	resourceVersion, err := manager.RunResourceVersion()
+	require.Nil(t, err)
+	require.Nil(t, manager.DeleteRun(context.Background(), runDetail.UUID))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("last-event-id", resourceVersion))
+	stream := newFakeWatchRunsServer(ctx)
+	startWatchRuns(server, &apiv2beta1.WatchRunsRequest{}, stream)
+	response := receiveWatchRunsResponse(t, stream)
+	assert.Equal(t, apiv2beta1.WatchRunsResponse_DELETED, response.GetType())
+	assert.Equal(t, runDetail.UUID, response.GetRun().GetRunId())
+}
+
+func TestWatchRuns_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := createRunServer(manager)
+	stream := newFakeWatchRunsServer(context.Background())
+
+	err := server.WatchRuns(&apiv2beta1.WatchRunsRequest{States: []apiv2beta1.RuntimeState{apiv2beta1.RuntimeState_RUNTIME_STATE_UNSPECIFIED}}, stream)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	err = server.WatchRuns(&apiv2beta1.WatchRunsRequest{ResourceVersion: "invalid"}, stream)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+
+	// Resource versions after the recorded changes cannot be resumed from.
+	err = server.WatchRuns(&apiv2beta1.WatchRunsRequest{ResourceVersion: "100"}, stream)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.OutOfRange))
+}
+
+func TestBulkArchiveAndUnarchiveRuns(t *testing.T) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	resourceManager = resource.NewResourceManager(clientManager, &resource.ResourceManagerOptions{CollectMetrics: false})
+	otherRun, err := resourceManager.CreateRun(context.Background(), &model.Run{
+		DisplayName:  "run2",
+		ExperimentId: runDetail.ExperimentId,
+		PipelineSpec: model.PipelineSpec{
+			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			Parameters:           `[{"name":"param1","value":"kfp"}]`,
+		},
+	})
+	assert.Nil(t, err)
+	runServer := createRunServer(resourceManager)
+
+	response, err := runServer.BulkArchiveRuns(context.Background(), &apiv2beta1.BulkRunsRequest{
+		RunIds: []string{runDetail.UUID, otherRun.UUID, runDetail.UUID, "unknown"},
+	})
+	assert.Nil(t, err)
+	// Duplicate run IDs are processed once.
+	assert.Len(t, response.GetResults(), 3)
+	assert.Nil(t, response.GetResults()[0].GetError())
+	assert.Nil(t, response.GetResults()[1].GetError())
+	assert.Equal(t, "unknown", response.GetResults()[2].GetRunId())
+	assert.Equal(t, int32(codes.NotFound), response.GetResults()[2].GetError().GetCode())
+	for _, runId := range []string{runDetail.UUID, otherRun.UUID} {
+		run, err := runServer.GetRun(context.Background(), &apiv2beta1.GetRunRequest{RunId: runId})
+		assert.Nil(t, err)
+		assert.Equal(t, apiv2beta1.Run_ARCHIVED, run.GetStorageState())
+	}
+
+	// Selects all runs of the experiment.
+	response, err = runServer.BulkUnarchiveRuns(context.Background(), &apiv2beta1.BulkRunsRequest{
+		ExperimentId: runDetail.ExperimentId,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, response.GetResults(), 2)
+	for _, result := range response.GetResults() {
+		assert.Nil(t, result.GetError())
+		run, err := runServer.GetRun(context.Background(), &apiv2beta1.GetRunRequest{RunId: result.GetRunId()})
+		assert.Nil(t, err)
+		assert.Equal(t, apiv2beta1.Run_AVAILABLE, run.GetStorageState())
+	}
+}
+
+func TestBulkDeleteRuns_InvalidRequest(t *testing.T) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := createRunServer(resourceManager)
+
+	_, err := runServer.BulkDeleteRuns(context.Background(), &apiv2beta1.BulkRunsRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = runServer.BulkDeleteRuns(context.Background(), &apiv2beta1.BulkRunsRequest{
+		RunIds:       []string{runDetail.UUID},
+		ExperimentId: runDetail.ExperimentId,
+	})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = runServer.BulkDeleteRuns(context.Background(), &apiv2beta1.BulkRunsRequest{RunIds: []string{runDetail.UUID, ""}})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
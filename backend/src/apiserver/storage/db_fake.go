@@ -40,6 +40,10 @@ func NewFakeDB() (*DB, error) {
 		&model.Task{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{},
+		&model.Label{},
+		&model.WebhookDelivery{},
+		&model.RunNotification{},
+		&model.RunChange{},
 	)
 	return NewDB(db.DB(), NewSQLiteDialect()), nil
 }
@@ -30,9 +30,16 @@ type ExperimentStoreInterface interface {
 	GetExperiment(uuid string) (*model.Experiment, error)
 	GetExperimentByNameNamespace(name string, namespace string) (*model.Experiment, error)
 	ListExperiments(filterContext *model.FilterContext, opts *list.Options) ([]*model.Experiment, int, string, error)
+	UpdateExperiment(experiment *model.Experiment) error
 	ArchiveExperiment(expId string) error
 	UnarchiveExperiment(expId string) error
 	DeleteExperiment(uuid string) error
+	// Archives experiments in a single transaction.
+	ArchiveExperiments(expIds []string) error
+	// Un-archives experiments in a single transaction.
+	UnarchiveExperiments(expIds []string) error
+	// Deletes experiments in a single transaction.
+	DeleteExperiments(uuids []string) error
 	SetLastRunTimestamp(run *model.Run) error
 }
 
@@ -42,6 +49,7 @@ type ExperimentStore struct {
 	uuid                   util.UUIDGeneratorInterface
 	resourceReferenceStore *ResourceReferenceStore
 	defaultExperimentStore *DefaultExperimentStore
+	labelStore             *LabelStore
 }
 
 var experimentColumns = []string{
@@ -52,6 +60,7 @@ var experimentColumns = []string{
 	"LastRunCreatedAtInSec",
 	"Namespace",
 	"StorageState",
+	"Notifications",
 }
 
 // Runs two SQL queries in a transaction to return a list of matching experiments, as well as their
@@ -128,6 +137,9 @@ func (s *ExperimentStore) ListExperiments(filterContext *model.FilterContext, op
 		glog.Errorf("Failed to commit transaction to list experiments")
 		return errorF(err)
 	}
+	if err := s.addLabels(exps); err != nil {
+		return errorF(err)
+	}
 
 	if len(exps) <= opts.PageSize {
 		return exps, totalSize, "", nil
@@ -160,6 +172,9 @@ func (s *ExperimentStore) GetExperiment(uuid string) (*model.Experiment, error)
 	if len(experiments) == 0 {
 		return nil, util.NewResourceNotFoundError("Experiment", fmt.Sprint(uuid))
 	}
+	if err := s.addLabels(experiments); err != nil {
+		return nil, err
+	}
 	return experiments[0], nil
 }
 
@@ -189,6 +204,9 @@ func (s *ExperimentStore) GetExperimentByNameNamespace(name string, namespace st
 	if len(experiments) == 0 {
 		return nil, util.NewResourceNotFoundError("Experiment", fmt.Sprint(name))
 	}
+	if err := s.addLabels(experiments); err != nil {
+		return nil, err
+	}
 	return experiments[0], nil
 }
 
@@ -198,7 +216,8 @@ func (s *ExperimentStore) scanRows(rows *sql.Rows) ([]*model.Experiment, error)
 		var uuid, name, description, namespace, storageState string
 		var createdAtInSec sql.NullInt64
 		var lastRunCreatedAtInSec sql.NullInt64
-		err := rows.Scan(&uuid, &name, &description, &createdAtInSec, &lastRunCreatedAtInSec, &namespace, &storageState)
+		var notifications sql.NullString
+		err := rows.Scan(&uuid, &name, &description, &createdAtInSec, &lastRunCreatedAtInSec, &namespace, &storageState, &notifications)
 		if err != nil {
 			return experiments, err
 		}
@@ -210,6 +229,7 @@ func (s *ExperimentStore) scanRows(rows *sql.Rows) ([]*model.Experiment, error)
 			LastRunCreatedAtInSec: lastRunCreatedAtInSec.Int64,
 			Namespace:             namespace,
 			StorageState:          model.StorageState(storageState).ToV2(),
+			Notifications:         notifications.String,
 		}
 		// Since storage state is a field added after initial KFP release, it is possible that existing experiments don't have this field and we use AVAILABLE in that case.
 		if experiment.StorageState == "" || experiment.StorageState == model.StorageStateUnspecified {
@@ -220,6 +240,22 @@ func (s *ExperimentStore) scanRows(rows *sql.Rows) ([]*model.Experiment, error)
 	return experiments, nil
 }
 
+// addLabels sets the labels of the experiments.
+func (s *ExperimentStore) addLabels(experiments []*model.Experiment) error {
+	ids := make([]string, 0, len(experiments))
+	for _, experiment := range experiments {
+		ids = append(ids, experiment.UUID)
+	}
+	labels, err := s.labelStore.GetLabels(model.ExperimentResourceType, ids)
+	if err != nil {
+		return err
+	}
+	for _, experiment := range experiments {
+		experiment.Labels = labels[experiment.UUID]
+	}
+	return nil
+}
+
 func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model.Experiment, error) {
 	newExperiment := *experiment
 	now := s.time.Now().Unix()
@@ -250,14 +286,21 @@ func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model
 			"Description":           newExperiment.Description,
 			"Namespace":             newExperiment.Namespace,
 			"StorageState":          newExperiment.StorageState.ToV2().ToString(),
+			"Notifications":         newExperiment.Notifications,
 		}).
 		ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create query to insert experiment to experiment table: %v",
 			err.Error())
 	}
-	_, err = s.db.Exec(sql, args...)
+	// Use a transaction to make sure both experiment and its labels are stored.
+	tx, err := s.db.Begin()
 	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add experiment to experiment table due to an error creating a new transaction")
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
 		if s.db.IsDuplicateError(err) {
 			return nil, util.NewAlreadyExistError(
 				"Failed to create a new experiment. The name %v already exists. Please specify a new name", experiment.Name)
@@ -265,43 +308,160 @@ func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model
 		return nil, util.NewInternalServerError(err, "Failed to add experiment to experiment table: %v",
 			err.Error())
 	}
+	err = s.labelStore.CreateLabels(tx, newExperiment.UUID, model.ExperimentResourceType, newExperiment.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add experiment %v and its labels to table", newExperiment.UUID)
+	}
 	return &newExperiment, nil
 }
 
 func (s *ExperimentStore) DeleteExperiment(id string) error {
-	experimentSql, experimentArgs, err := sq.Delete("experiments").Where(sq.Eq{"UUID": id}).ToSql()
-	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to create query to delete experiment: %s", id)
-	}
 	// Use a transaction to make sure both experiment and its resource references are deleted.
 	tx, err := s.db.Begin()
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to create a new transaction to delete experiment")
 	}
+	if err := s.deleteExperiment(tx, id); err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete experiment %v and its resource references from table", id)
+	}
+	return nil
+}
+
+func (s *ExperimentStore) DeleteExperiments(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to delete experiments")
+	}
+	for _, id := range ids {
+		if err := s.deleteExperiment(tx, id); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete %v experiments and their resource references from table", len(ids))
+	}
+	return nil
+}
+
+// Deletes an experiment, its labels and its resource references within a
+// transaction.
+func (s *ExperimentStore) deleteExperiment(tx *sql.Tx, id string) error {
+	experimentSql, experimentArgs, err := sq.Delete("experiments").Where(sq.Eq{"UUID": id}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to delete experiment: %s", id)
+	}
 	_, err = tx.Exec(experimentSql, experimentArgs...)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete experiment %s from table", id)
 	}
 	err = s.defaultExperimentStore.UnsetDefaultExperimentIdIfIdMatches(tx, id)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to clear default experiment ID for experiment %v ", id)
 	}
 	err = s.resourceReferenceStore.DeleteResourceReferences(tx, id, model.RunResourceType)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for experiment %v ", id)
 	}
+	return s.labelStore.DeleteLabels(tx, id, model.ExperimentResourceType)
+}
+
+// UpdateExperiment updates the description, the notifications and the labels
+// of an experiment.
+func (s *ExperimentStore) UpdateExperiment(experiment *model.Experiment) error {
+	sql, args, err := sq.
+		Update("experiments").
+		SetMap(sq.Eq{
+			"Description":   experiment.Description,
+			"Notifications": experiment.Notifications,
+		}).
+		Where(sq.Eq{"UUID": experiment.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to update experiment %s. error: '%v'", experiment.UUID, err.Error())
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to update experiment")
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err,
+			"Failed to update experiment %s. error: '%v'", experiment.UUID, err.Error())
+	}
+	err = s.labelStore.ReplaceLabels(tx, experiment.UUID, model.ExperimentResourceType, experiment.Labels)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 	err = tx.Commit()
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to delete experiment %v and its resource references from table", id)
+		return util.NewInternalServerError(err, "Failed to update experiment %s and its labels", experiment.UUID)
 	}
 	return nil
 }
 
 func (s *ExperimentStore) ArchiveExperiment(expId string) error {
+	// In a single transaction, we update experiments, run_details and jobs tables.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to archive an experiment")
+	}
+	if err := s.archiveExperiment(tx, expId); err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to archive an experiment %s and its runs", expId)
+	}
+
+	return nil
+}
+
+func (s *ExperimentStore) ArchiveExperiments(expIds []string) error {
+	if len(expIds) == 0 {
+		return nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to archive experiments")
+	}
+	for _, expId := range expIds {
+		if err := s.archiveExperiment(tx, expId); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to archive %v experiments and their runs", len(expIds))
+	}
+	return nil
+}
+
+// Archives an experiment, archives its runs and disables its jobs within a
+// transaction.
+func (s *ExperimentStore) archiveExperiment(tx *sql.Tx, expId string) error {
 	// ArchiveExperiment results in
 	// 1. The experiment getting archived
 	// 2. All the runs in the experiment getting archived no matter what previous storage state they are in
@@ -351,46 +511,29 @@ func (s *ExperimentStore) ArchiveExperiment(expId string) error {
 		"jobs.UUID = resource_references.ResourceUUID",
 		"resource_references.ResourceType = ? AND resource_references.ReferenceUUID = ? AND resource_references.ReferenceType = ?")
 
-	// In a single transaction, we update experiments, run_details and jobs tables.
-	tx, err := s.db.Begin()
-	if err != nil {
-		return util.NewInternalServerError(err, "Failed to create a new transaction to archive an experiment")
-	}
-
 	_, err = tx.Exec(sql, args...)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err,
 			"Failed to archive experiment %s. error: '%v'", expId, err.Error())
 	}
 
 	_, err = tx.Exec(updateRunsSQL, updateRunsArgs...)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err,
 			"Failed to archive runs with experiment reference being %s. error: '%v'", expId, err.Error())
 	}
 
 	_, err = tx.Exec(updateRunsWithExperimentUUIDSql, updateRunsWithExperimentUUIDArgs...)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err,
 			"Failed to archive runs with ExperimentUUID being %s. error: '%v'", expId, err.Error())
 	}
 
 	_, err = tx.Exec(updateJobsSQL, updateJobsArgs...)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err,
 			"Failed to disable all jobs in an experiment %s. error: '%v'", expId, err.Error())
 	}
-
-	err = tx.Commit()
-	if err != nil {
-		tx.Rollback()
-		return util.NewInternalServerError(err, "Failed to archive an experiment %s and its runs", expId)
-	}
-
 	return nil
 }
 
@@ -419,6 +562,29 @@ func (s *ExperimentStore) UnarchiveExperiment(expId string) error {
 	return nil
 }
 
+func (s *ExperimentStore) UnarchiveExperiments(expIds []string) error {
+	if len(expIds) == 0 {
+		return nil
+	}
+	sql, args, err := sq.
+		Update("experiments").
+		SetMap(sq.Eq{
+			"StorageState": model.StorageStateAvailable.ToString(),
+		}).
+		Where(sq.Eq{"UUID": expIds}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to unarchive %v experiments", len(expIds))
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to unarchive %v experiments", len(expIds))
+	}
+	return nil
+}
+
 func (s *ExperimentStore) SetLastRunTimestamp(run *model.Run) error {
 	expId := run.ExperimentId
 	// SetLastRunTimestamp results in the experiment getting last_run_created_at updated
@@ -449,5 +615,6 @@ func NewExperimentStore(db *DB, time util.TimeInterface, uuid util.UUIDGenerator
 		uuid:                   uuid,
 		resourceReferenceStore: NewResourceReferenceStore(db, nil),
 		defaultExperimentStore: NewDefaultExperimentStore(db),
+		labelStore:             NewLabelStore(db),
 	}
 }
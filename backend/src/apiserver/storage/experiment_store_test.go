@@ -372,6 +372,51 @@ func TestDeleteExperiment_InternalError(t *testing.T) {
 		"Expected delete experiment to return internal error")
 }
 
+func TestArchiveUnarchiveAndDeleteExperiments(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment1"))
+	experimentStore = NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment2"))
+
+	err := experimentStore.ArchiveExperiments([]string{fakeID, fakeIDTwo})
+	assert.Nil(t, err)
+	for _, id := range []string{fakeID, fakeIDTwo} {
+		experiment, err := experimentStore.GetExperiment(id)
+		assert.Nil(t, err)
+		assert.Equal(t, model.StorageStateArchived, experiment.StorageState)
+	}
+
+	err = experimentStore.UnarchiveExperiments([]string{fakeIDTwo})
+	assert.Nil(t, err)
+	experiment, err := experimentStore.GetExperiment(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateArchived, experiment.StorageState)
+	experiment, err = experimentStore.GetExperiment(fakeIDTwo)
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateAvailable, experiment.StorageState)
+
+	err = experimentStore.DeleteExperiments([]string{fakeID, fakeIDTwo})
+	assert.Nil(t, err)
+	for _, id := range []string{fakeID, fakeIDTwo} {
+		_, err = experimentStore.GetExperiment(id)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	}
+}
+
+func TestDeleteExperiments_InternalError(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment1"))
+	db.Close()
+
+	err := experimentStore.DeleteExperiments([]string{fakeID})
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode(),
+		"Expected delete experiments to return internal error")
+}
+
 func TestListExperiments_Filtering(t *testing.T) {
 	db := NewFakeDBOrFatal()
 	defer db.Close()
@@ -448,6 +493,74 @@ func TestListExperiments_Filtering(t *testing.T) {
 	assert.Equal(t, 3, total_size)
 }
 
+func TestListExperiments_FilteringByLabel(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experiment1 := createExperiment("experiment1")
+	experiment1.Labels = map[string]string{"team": "a"}
+	experimentStore.CreateExperiment(experiment1)
+	experimentStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil)
+	experiment2 := createExperiment("experiment2")
+	experiment2.Labels = map[string]string{"team": "b", "env": "dev"}
+	experimentStore.CreateExperiment(experiment2)
+	experimentStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDThree, nil)
+	experimentStore.CreateExperiment(createExperiment("experiment3"))
+
+	filterProto := &apiv2beta1.Filter{
+		Predicates: []*apiv2beta1.Predicate{
+			{
+				Key:       "labels.team",
+				Operation: apiv2beta1.Predicate_EQUALS,
+				Value:     &apiv2beta1.Predicate_StringValue{StringValue: "b"},
+			},
+		},
+	}
+	newFilter, err := filter.New(filterProto)
+	assert.Nil(t, err)
+	opts, err := list.NewOptions(&model.Experiment{}, 10, "id", newFilter)
+	assert.Nil(t, err)
+	experiments, totalSize, nextPageToken, err := experimentStore.ListExperiments(&model.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "", nextPageToken)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, []*model.Experiment{
+		{
+			UUID:           fakeIDTwo,
+			CreatedAtInSec: 2,
+			Name:           "experiment2",
+			Description:    "My name is experiment2",
+			StorageState:   "AVAILABLE",
+			Labels:         map[string]string{"team": "b", "env": "dev"},
+		},
+	}, experiments)
+}
+
+func TestUpdateExperiment(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experiment := createExperiment("experiment1")
+	experiment.Labels = map[string]string{"team": "a", "env": "dev"}
+	experiment, err := experimentStore.CreateExperiment(experiment)
+	assert.Nil(t, err)
+
+	experiment.Description = "new description"
+	experiment.Labels = map[string]string{"team": "b"}
+	assert.Nil(t, experimentStore.UpdateExperiment(experiment))
+
+	updatedExperiment, err := experimentStore.GetExperiment(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, "new description", updatedExperiment.Description)
+	assert.Equal(t, map[string]string{"team": "b"}, updatedExperiment.Labels)
+
+	// Deleting an experiment deletes its labels.
+	assert.Nil(t, experimentStore.DeleteExperiment(fakeID))
+	labels, err := experimentStore.labelStore.GetLabels(model.ExperimentResourceType, []string{fakeID})
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+}
+
 func TestArchiveExperiment_InternalError(t *testing.T) {
 	db := NewFakeDBOrFatal()
 	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
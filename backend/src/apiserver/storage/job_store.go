@@ -41,15 +41,18 @@ var jobColumns = []string{
 	"CronScheduleStartTimeInSec",
 	"CronScheduleEndTimeInSec",
 	"Schedule",
+	"CronScheduleTimeZone",
 	"PeriodicScheduleStartTimeInSec",
 	"PeriodicScheduleEndTimeInSec",
 	"IntervalSecond",
+	"EventTrigger",
 	"PipelineId",
 	"PipelineName",
 	"PipelineSpecManifest",
 	"WorkflowSpecManifest",
 	"Parameters",
 	"Conditions",
+	"Notifications",
 	"RuntimeParameters",
 	"PipelineRoot",
 	"ExperimentUUID",
@@ -72,13 +75,21 @@ type JobStoreInterface interface {
 	// Update a recurring run entry in the database.
 	UpdateJob(swf *util.ScheduledWorkflow) error
 
+	// Updates the user-specified settings of a recurring run entry in the database.
+	UpdateJobSpec(job *model.Job) error
+
 	// Removes a recurring run entry from the database.
 	DeleteJob(id string) error
+
+	// Records a request accepted by the webhook trigger of a recurring run, and removes the
+	// requests delivered before expiredBeforeInSec. Fails if the request was already recorded.
+	CreateWebhookDelivery(delivery *model.WebhookDelivery, expiredBeforeInSec int64) error
 }
 
 type JobStore struct {
 	db                     *DB
 	resourceReferenceStore *ResourceReferenceStore
+	labelStore             *LabelStore
 	time                   util.TimeInterface
 }
 
@@ -144,6 +155,9 @@ func (s *JobStore) ListJobs(
 		glog.Errorf("Failed to commit transaction to list jobs")
 		return errorF(err)
 	}
+	if err := s.addLabels(jobs); err != nil {
+		return errorF(err)
+	}
 
 	if len(jobs) <= opts.PageSize {
 		return jobs, totalSize, "", nil
@@ -212,9 +226,28 @@ func (s *JobStore) GetJob(id string) (*model.Job, error) {
 	if len(jobs) == 0 {
 		return nil, util.NewResourceNotFoundError("Job", fmt.Sprint(id))
 	}
+	if err := s.addLabels(jobs); err != nil {
+		return nil, err
+	}
 	return jobs[0], nil
 }
 
+// addLabels sets the labels of the jobs.
+func (s *JobStore) addLabels(jobs []*model.Job) error {
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.UUID)
+	}
+	labels, err := s.labelStore.GetLabels(model.JobResourceType, ids)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		job.Labels = labels[job.UUID]
+	}
+	return nil
+}
+
 func (s *JobStore) addResourceReferences(filteredSelectBuilder sq.SelectBuilder) sq.SelectBuilder {
 	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("r.Payload", ","), `"]"`}, "")
 	return sq.
@@ -232,17 +265,17 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 			description, parameters, pipelineSpecManifest, workflowSpecManifest string
 		var cronScheduleStartTimeInSec, cronScheduleEndTimeInSec, createdAtInSec,
 			periodicScheduleStartTimeInSec, periodicScheduleEndTimeInSec, intervalSecond, updatedAtInSec sql.NullInt64
-		var cron, resourceReferencesInString, runtimeParameters, pipelineRoot sql.NullString
-		var experimentId, pipelineVersionId sql.NullString
+		var cron, cronTimeZone, eventTrigger, resourceReferencesInString, runtimeParameters, pipelineRoot sql.NullString
+		var experimentId, pipelineVersionId, notifications sql.NullString
 		var enabled, noCatchup bool
 		var maxConcurrency int64
 		err := r.Scan(
 			&uuid, &displayName, &name, &namespace, &serviceAccount, &description,
 			&maxConcurrency, &noCatchup, &createdAtInSec, &updatedAtInSec, &enabled,
-			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron,
-			&periodicScheduleStartTimeInSec, &periodicScheduleEndTimeInSec, &intervalSecond,
+			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron, &cronTimeZone,
+			&periodicScheduleStartTimeInSec, &periodicScheduleEndTimeInSec, &intervalSecond, &eventTrigger,
 			&pipelineId, &pipelineName, &pipelineSpecManifest, &workflowSpecManifest, &parameters,
-			&conditions, &runtimeParameters, &pipelineRoot, &experimentId,
+			&conditions, &notifications, &runtimeParameters, &pipelineRoot, &experimentId,
 			&pipelineVersionId, &resourceReferencesInString)
 		if err != nil {
 			return nil, err
@@ -274,6 +307,7 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 			Description:    description,
 			Enabled:        enabled,
 			Conditions:     conditions,
+			Notifications:  notifications.String,
 			ExperimentId:   expId,
 			MaxConcurrency: maxConcurrency,
 			NoCatchup:      noCatchup,
@@ -283,12 +317,14 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 					CronScheduleStartTimeInSec: NullInt64ToPointer(cronScheduleStartTimeInSec),
 					CronScheduleEndTimeInSec:   NullInt64ToPointer(cronScheduleEndTimeInSec),
 					Cron:                       NullStringToPointer(cron),
+					CronScheduleTimeZone:       NullStringToPointer(cronTimeZone),
 				},
 				PeriodicSchedule: model.PeriodicSchedule{
 					PeriodicScheduleStartTimeInSec: NullInt64ToPointer(periodicScheduleStartTimeInSec),
 					PeriodicScheduleEndTimeInSec:   NullInt64ToPointer(periodicScheduleEndTimeInSec),
 					IntervalSecond:                 NullInt64ToPointer(intervalSecond),
 				},
+				Event: eventTrigger.String,
 			},
 			PipelineSpec: model.PipelineSpec{
 				PipelineId:           pipelineId,
@@ -329,6 +365,11 @@ func (s *JobStore) DeleteJob(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for job %v ", id)
 	}
+	err = s.labelStore.DeleteLabels(tx, id, model.JobResourceType)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -360,9 +401,12 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleStartTimeInSec),
 			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleEndTimeInSec),
 			"Schedule":                       PointerToNullString(j.Trigger.CronSchedule.Cron),
+			"CronScheduleTimeZone":           PointerToNullString(j.Trigger.CronSchedule.CronScheduleTimeZone),
 			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleStartTimeInSec),
 			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleEndTimeInSec),
 			"IntervalSecond":                 PointerToNullInt64(j.Trigger.PeriodicSchedule.IntervalSecond),
+			"EventTrigger":                   j.Trigger.Event,
+			"Notifications":                  j.Notifications,
 			"CreatedAtInSec":                 j.CreatedAtInSec,
 			"UpdatedAtInSec":                 j.UpdatedAtInSec,
 			"PipelineId":                     j.PipelineSpec.PipelineId,
@@ -399,6 +443,12 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 		return nil, util.NewInternalServerError(err, "Failed to store resource references to table for job %v ", j.DisplayName)
 	}
 
+	err = s.labelStore.CreateLabels(tx, j.UUID, model.JobResourceType, j.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -448,6 +498,7 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 			"CronScheduleStartTimeInSec":     PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
 			"CronScheduleEndTimeInSec":       PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
 			"Schedule":                       swf.CronOrEmpty(),
+			"CronScheduleTimeZone":           PointerToNullString(swf.CronTimeZoneOrNull()),
 			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
 			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
 			"IntervalSecond":                 swf.IntervalSecondOr0(),
@@ -489,12 +540,141 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 	return nil
 }
 
+func (s *JobStore) UpdateJobSpec(j *model.Job) error {
+	// Populate the resource references to keep them in sync with the pipeline spec.
+	j = j.ToV1().ToV2()
+	now := s.time.Now().Unix()
+	jobSql, jobArgs, err := sq.
+		Update("jobs").
+		SetMap(sq.Eq{
+			"DisplayName":                    j.DisplayName,
+			"ServiceAccount":                 j.ServiceAccount,
+			"Description":                    j.Description,
+			"MaxConcurrency":                 j.MaxConcurrency,
+			"NoCatchup":                      j.NoCatchup,
+			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleStartTimeInSec),
+			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.Trigger.CronSchedule.CronScheduleEndTimeInSec),
+			"Schedule":                       PointerToNullString(j.Trigger.CronSchedule.Cron),
+			"CronScheduleTimeZone":           PointerToNullString(j.Trigger.CronSchedule.CronScheduleTimeZone),
+			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleStartTimeInSec),
+			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.Trigger.PeriodicSchedule.PeriodicScheduleEndTimeInSec),
+			"IntervalSecond":                 PointerToNullInt64(j.Trigger.PeriodicSchedule.IntervalSecond),
+			"EventTrigger":                   j.Trigger.Event,
+			"Notifications":                  j.Notifications,
+			"UpdatedAtInSec":                 now,
+			"PipelineId":                     j.PipelineSpec.PipelineId,
+			"PipelineName":                   j.PipelineSpec.PipelineName,
+			"PipelineSpecManifest":           j.PipelineSpec.PipelineSpecManifest,
+			"WorkflowSpecManifest":           j.PipelineSpec.WorkflowSpecManifest,
+			"Parameters":                     j.PipelineSpec.Parameters,
+			"RuntimeParameters":              j.PipelineSpec.RuntimeConfig.Parameters,
+			"PipelineRoot":                   j.PipelineSpec.RuntimeConfig.PipelineRoot,
+			"PipelineVersionId":              j.PipelineSpec.PipelineVersionId,
+		}).
+		Where(sq.Eq{"UUID": j.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to update job %v", j.UUID)
+	}
+
+	// Use a transaction to make sure the job and its resource references stay consistent.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to update job %v", j.UUID)
+	}
+	r, err := tx.Exec(jobSql, jobArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to update job %v", j.UUID)
+	}
+	rowsAffected, err := r.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to get affected rows while updating job %v", j.UUID)
+	}
+	if rowsAffected <= 0 {
+		tx.Rollback()
+		return util.NewResourceNotFoundError("Job", j.UUID)
+	}
+
+	// The pipeline and pipeline version references are replaced as the pinned pipeline may have changed.
+	pipelineRefTypes := []model.ResourceType{model.PipelineResourceType, model.PipelineVersionResourceType}
+	refSql, refArgs, err := sq.
+		Delete("resource_references").
+		Where(sq.Eq{"ResourceUUID": j.UUID, "ResourceType": model.JobResourceType, "ReferenceType": pipelineRefTypes}).
+		ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to create query to delete pipeline references of job %v", j.UUID)
+	}
+	if _, err = tx.Exec(refSql, refArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete pipeline references of job %v", j.UUID)
+	}
+	var pipelineRefs []*model.ResourceReference
+	for _, ref := range j.ResourceReferences {
+		if ref.ReferenceType == model.PipelineResourceType || ref.ReferenceType == model.PipelineVersionResourceType {
+			pipelineRefs = append(pipelineRefs, ref)
+		}
+	}
+	err = s.resourceReferenceStore.CreateResourceReferences(tx, pipelineRefs)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to store pipeline references of job %v", j.UUID)
+	}
+
+	err = s.labelStore.ReplaceLabels(tx, j.UUID, model.JobResourceType, j.Labels)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to update job %v and its resource references", j.UUID)
+	}
+	return nil
+}
+
+func (s *JobStore) CreateWebhookDelivery(delivery *model.WebhookDelivery, expiredBeforeInSec int64) error {
+	deleteSql, deleteArgs, err := sq.
+		Delete("webhook_deliveries").
+		Where(sq.Lt{"DeliveredAtInSec": expiredBeforeInSec}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the expired webhook deliveries")
+	}
+	if _, err := s.db.Exec(deleteSql, deleteArgs...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete the expired webhook deliveries")
+	}
+	insertSql, insertArgs, err := sq.
+		Insert("webhook_deliveries").
+		SetMap(sq.Eq{
+			"JobUUID":          delivery.JobUUID,
+			"Signature":        delivery.Signature,
+			"DeliveredAtInSec": delivery.DeliveredAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store a webhook delivery of job %v", delivery.JobUUID)
+	}
+	if _, err := s.db.Exec(insertSql, insertArgs...); err != nil {
+		if s.db.IsDuplicateError(err) {
+			return util.NewAlreadyExistError("Webhook delivery of job %v was already accepted", delivery.JobUUID)
+		}
+		return util.NewInternalServerError(err, "Failed to store a webhook delivery of job %v", delivery.JobUUID)
+	}
+	return nil
+}
+
 // If pipelineStore is provided, it will be used instead of direct database queries for getting pipelines
 // and pipeline versions.
 func NewJobStore(db *DB, time util.TimeInterface, pipelineStore PipelineStoreInterface) *JobStore {
 	return &JobStore{
 		db:                     db,
 		resourceReferenceStore: NewResourceReferenceStore(db, pipelineStore),
+		labelStore:             NewLabelStore(db),
 		time:                   time,
 	}
 }
@@ -933,6 +933,70 @@ func TestUpdateJob_InternalError(t *testing.T) {
 	assert.Equal(t, err.(*util.UserError).ExternalStatusCode(), codes.Internal)
 }
 
+func TestUpdateJobSpec(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	job, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	job.DisplayName = "pp 1 updated"
+	job.Description = "updated"
+	job.MaxConcurrency = 3
+	job.Trigger = model.Trigger{
+		CronSchedule: model.CronSchedule{
+			Cron: util.StringPointer("0 0 * * *"),
+		},
+	}
+	// Pin the recurring run to a pipeline spec instead of the pipeline.
+	job.PipelineSpec = model.PipelineSpec{
+		PipelineSpecManifest: "spec",
+		RuntimeConfig: model.RuntimeConfig{
+			Parameters:   `{"param1":"world"}`,
+			PipelineRoot: "gs://my-bucket/path/to/root",
+		},
+	}
+	err = jobStore.UpdateJobSpec(job)
+	assert.Nil(t, err)
+
+	jobExpected := &model.Job{
+		UUID:           "1",
+		DisplayName:    "pp 1 updated",
+		Description:    "updated",
+		K8SName:        "pp1",
+		Namespace:      "n1",
+		MaxConcurrency: 3,
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: "spec",
+			RuntimeConfig: model.RuntimeConfig{
+				Parameters:   `{"param1":"world"}`,
+				PipelineRoot: "gs://my-bucket/path/to/root",
+			},
+		},
+		Conditions: "ready",
+		Trigger: model.Trigger{
+			CronSchedule: model.CronSchedule{
+				Cron: util.StringPointer("0 0 * * *"),
+			},
+		},
+		Enabled:        true,
+		CreatedAtInSec: 1,
+		UpdatedAtInSec: 3,
+		ExperimentId:   defaultFakeExpId,
+	}
+	job, err = jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.Equal(t, jobExpected.ToV1(), job.ToV1())
+}
+
+func TestUpdateJobSpec_RecordNotFound(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	err := jobStore.UpdateJobSpec(&model.Job{UUID: "UNKNOWN_UID", DisplayName: "pp 3"})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestDeleteJob(t *testing.T) {
 	db, jobStore := initializeDbAndStore()
 	defer db.Close()
@@ -966,6 +1030,26 @@ func TestDeleteJob_InternalError(t *testing.T) {
 		"Expected delete job to return internal error")
 }
 
+func TestCreateWebhookDelivery(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	delivery := &model.WebhookDelivery{JobUUID: "1", Signature: "sha256=a", DeliveredAtInSec: 100}
+	assert.Nil(t, jobStore.CreateWebhookDelivery(delivery, 0))
+
+	// The same delivery is only accepted once.
+	err := jobStore.CreateWebhookDelivery(delivery, 0)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+	assert.Nil(t, jobStore.CreateWebhookDelivery(&model.WebhookDelivery{JobUUID: "2", Signature: "sha256=a", DeliveredAtInSec: 100}, 0))
+
+	// Expired deliveries are deleted.
+	assert.Nil(t, jobStore.CreateWebhookDelivery(&model.WebhookDelivery{JobUUID: "1", Signature: "sha256=b", DeliveredAtInSec: 200}, 101))
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT COUNT(*) FROM webhook_deliveries").Scan(&count))
+	assert.Equal(t, 1, count)
+}
+
 func TestJobAPIFieldMap(t *testing.T) {
 	for _, modelField := range (&model.Job{}).APIToModelFieldMap() {
 		assert.Contains(t, jobColumns, modelField)
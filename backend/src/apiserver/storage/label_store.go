@@ -0,0 +1,120 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// LabelStore stores the user-defined labels of pipelines, experiments, runs and
+// jobs in the labels table.
+type LabelStore struct {
+	db *DB
+}
+
+// CreateLabels stores the labels of a resource.
+// This is always in company with creating or updating the resource so a transaction is needed as input.
+func (s *LabelStore) CreateLabels(tx *sql.Tx, resourceUUID string, resourceType model.ResourceType, labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sqlBuilder := sq.Insert("labels").Columns("ResourceUUID", "ResourceType", "Name", "Value")
+	for _, name := range names {
+		sqlBuilder = sqlBuilder.Values(resourceUUID, resourceType, name, labels[name])
+	}
+	labelSql, labelArgs, err := sqlBuilder.ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store labels for %s %s", resourceType, resourceUUID)
+	}
+	if _, err := tx.Exec(labelSql, labelArgs...); err != nil {
+		return util.NewInternalServerError(err, "Failed to store labels for %s %s", resourceType, resourceUUID)
+	}
+	return nil
+}
+
+// DeleteLabels deletes all labels of a resource.
+// This is always in company with deleting or updating the resource so a transaction is needed as input.
+func (s *LabelStore) DeleteLabels(tx *sql.Tx, resourceUUID string, resourceType model.ResourceType) error {
+	labelSql, labelArgs, err := sq.
+		Delete("labels").
+		Where(sq.Eq{"ResourceUUID": resourceUUID, "ResourceType": resourceType}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete labels for %s %s", resourceType, resourceUUID)
+	}
+	if _, err := tx.Exec(labelSql, labelArgs...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete labels for %s %s", resourceType, resourceUUID)
+	}
+	return nil
+}
+
+// ReplaceLabels replaces all labels of a resource with the given ones.
+func (s *LabelStore) ReplaceLabels(tx *sql.Tx, resourceUUID string, resourceType model.ResourceType, labels map[string]string) error {
+	if err := s.DeleteLabels(tx, resourceUUID, resourceType); err != nil {
+		return err
+	}
+	return s.CreateLabels(tx, resourceUUID, resourceType, labels)
+}
+
+// GetLabels returns the labels of the given resources, keyed by resource UUID.
+// Resources without labels are not in the returned map.
+func (s *LabelStore) GetLabels(resourceType model.ResourceType, resourceUUIDs []string) (map[string]map[string]string, error) {
+	labels := make(map[string]map[string]string)
+	if len(resourceUUIDs) == 0 {
+		return labels, nil
+	}
+	labelSql, labelArgs, err := sq.
+		Select("ResourceUUID", "Name", "Value").
+		From("labels").
+		Where(sq.Eq{"ResourceType": resourceType, "ResourceUUID": resourceUUIDs}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get labels of %s resources", resourceType)
+	}
+	rows, err := s.db.Query(labelSql, labelArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get labels of %s resources", resourceType)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var resourceUUID, name, value string
+		if err := rows.Scan(&resourceUUID, &name, &value); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse labels of %s resources", resourceType)
+		}
+		if labels[resourceUUID] == nil {
+			labels[resourceUUID] = make(map[string]string)
+		}
+		labels[resourceUUID][name] = value
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get labels of %s resources", resourceType)
+	}
+	return labels, nil
+}
+
+// NewLabelStore creates a new LabelStore.
+func NewLabelStore(db *DB) *LabelStore {
+	return &LabelStore{db: db}
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelStore(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	labelStore := NewLabelStore(db)
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	assert.Nil(t, labelStore.CreateLabels(tx, fakeID, model.RunResourceType, map[string]string{"team": "a", "env": "dev"}))
+	assert.Nil(t, labelStore.CreateLabels(tx, fakeIDTwo, model.RunResourceType, map[string]string{"team": "b"}))
+	// Labels of other resource types with the same id are kept apart.
+	assert.Nil(t, labelStore.CreateLabels(tx, fakeID, model.JobResourceType, map[string]string{"team": "c"}))
+	assert.Nil(t, labelStore.CreateLabels(tx, fakeIDThree, model.RunResourceType, nil))
+	assert.Nil(t, tx.Commit())
+
+	labels, err := labelStore.GetLabels(model.RunResourceType, []string{fakeID, fakeIDTwo, fakeIDThree})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		fakeID:    {"team": "a", "env": "dev"},
+		fakeIDTwo: {"team": "b"},
+	}, labels)
+
+	tx, err = db.Begin()
+	assert.Nil(t, err)
+	assert.Nil(t, labelStore.ReplaceLabels(tx, fakeID, model.RunResourceType, map[string]string{"team": "d"}))
+	assert.Nil(t, labelStore.DeleteLabels(tx, fakeIDTwo, model.RunResourceType))
+	assert.Nil(t, tx.Commit())
+
+	labels, err = labelStore.GetLabels(model.RunResourceType, []string{fakeID, fakeIDTwo})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]map[string]string{fakeID: {"team": "d"}}, labels)
+	labels, err = labelStore.GetLabels(model.JobResourceType, []string{fakeID})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]map[string]string{fakeID: {"team": "c"}}, labels)
+}
+
+func TestLabelStore_GetLabelsError(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	labelStore := NewLabelStore(db)
+	db.Close()
+
+	_, err := labelStore.GetLabels(model.RunResourceType, []string{fakeID})
+	assert.NotNil(t, err)
+}
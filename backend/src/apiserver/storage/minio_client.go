@@ -26,6 +26,7 @@ type MinioClientInterface interface {
 	PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (n int64, err error)
 	GetObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error)
 	DeleteObject(ctx context.Context, bucketName, objectName string) error
+	ListObjects(ctx context.Context, bucketName, prefix string) ([]string, error)
 }
 
 type MinioClient struct {
@@ -47,3 +48,14 @@ func (c *MinioClient) GetObject(ctx context.Context, bucketName, objectName stri
 func (c *MinioClient) DeleteObject(ctx context.Context, bucketName, objectName string) error {
 	return c.Client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
 }
+
+func (c *MinioClient) ListObjects(ctx context.Context, bucketName, prefix string) ([]string, error) {
+	var objectNames []string
+	for object := range c.Client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		objectNames = append(objectNames, object.Key)
+	}
+	return objectNames, nil
+}
@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/pkg/errors"
@@ -59,6 +61,17 @@ func (c *FakeMinioClient) DeleteObject(ctx context.Context, bucketName, objectNa
 	return nil
 }
 
+func (c *FakeMinioClient) ListObjects(ctx context.Context, bucketName, prefix string) ([]string, error) {
+	var objectNames []string
+	for objectName := range c.minioClient {
+		if strings.HasPrefix(objectName, prefix) {
+			objectNames = append(objectNames, objectName)
+		}
+	}
+	sort.Strings(objectNames)
+	return objectNames, nil
+}
+
 func (c *FakeMinioClient) GetObjectCount() int {
 	return len(c.minioClient)
 }
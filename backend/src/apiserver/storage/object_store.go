@@ -19,6 +19,7 @@ import (
 	"context"
 	"path"
 	"regexp"
+	"strings"
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go/v7"
@@ -33,10 +34,12 @@ const (
 type ObjectStoreInterface interface {
 	AddFile(ctx context.Context, template []byte, filePath string) error
 	DeleteFile(ctx context.Context, filePath string) error
+	DeleteFolder(ctx context.Context, folderPath string) error
 	GetFile(ctx context.Context, filePath string) ([]byte, error)
 	AddAsYamlFile(ctx context.Context, o interface{}, filePath string) error
 	GetFromYamlFile(ctx context.Context, o interface{}, filePath string) error
 	GetPipelineKey(pipelineId string) string
+	GetBucketName() string
 }
 
 // Managing pipeline using Minio.
@@ -52,6 +55,11 @@ func (m *MinioObjectStore) GetPipelineKey(pipelineID string) string {
 	return path.Join(m.baseFolder, pipelineID)
 }
 
+// GetBucketName returns the name of the bucket that stores the files.
+func (m *MinioObjectStore) GetBucketName() string {
+	return m.bucketName
+}
+
 func (m *MinioObjectStore) AddFile(ctx context.Context, file []byte, filePath string) error {
 	var parts int64
 
@@ -79,6 +87,23 @@ func (m *MinioObjectStore) DeleteFile(ctx context.Context, filePath string) erro
 	return nil
 }
 
+// DeleteFolder deletes all files whose path starts with the folder path.
+func (m *MinioObjectStore) DeleteFolder(ctx context.Context, folderPath string) error {
+	if !strings.HasSuffix(folderPath, "/") {
+		folderPath = folderPath + "/"
+	}
+	filePaths, err := m.minioClient.ListObjects(ctx, m.bucketName, folderPath)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to list files in folder %v", folderPath)
+	}
+	for _, filePath := range filePaths {
+		if err := m.DeleteFile(ctx, filePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *MinioObjectStore) GetFile(ctx context.Context, filePath string) ([]byte, error) {
 	reader, err := m.minioClient.GetObject(ctx, m.bucketName, filePath, minio.GetObjectOptions{})
 	if err != nil {
@@ -49,6 +49,10 @@ func (c *FakeBadMinioClient) DeleteObject(ctx context.Context, bucketName, objec
 	return errors.New("some error")
 }
 
+func (c *FakeBadMinioClient) ListObjects(ctx context.Context, bucketName, prefix string) ([]string, error) {
+	return nil, errors.New("some error")
+}
+
 func TestAddFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -93,6 +97,24 @@ func TestDeleteFileError(t *testing.T) {
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 }
 
+func TestDeleteFolder(t *testing.T) {
+	minioClient := NewFakeMinioClient()
+	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
+	manager.AddFile(context.TODO(), []byte("abc"), "artifacts/run1/task1/output")
+	manager.AddFile(context.TODO(), []byte("abc"), "artifacts/run1/task2/output")
+	manager.AddFile(context.TODO(), []byte("abc"), "artifacts/run10/task1/output")
+	error := manager.DeleteFolder(context.TODO(), "artifacts/run1")
+	assert.Nil(t, error)
+	assert.Equal(t, 1, minioClient.GetObjectCount())
+	assert.True(t, minioClient.ExistObject("artifacts/run10/task1/output"))
+}
+
+func TestDeleteFolderError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
+	error := manager.DeleteFolder(context.TODO(), "artifacts/run1")
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
 func TestAddAsYamlFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -97,6 +97,7 @@ type PipelineStoreInterface interface {
 	GetPipelineByNameAndNamespace(name string, namespace string) (*model.Pipeline, error)
 	ListPipelines(filterContext *model.FilterContext, opts *list.Options) ([]*model.Pipeline, int, string, error)
 	UpdatePipelineStatus(pipelineId string, status model.PipelineStatus) error
+	UpdatePipeline(pipeline *model.Pipeline) error
 	DeletePipeline(pipelineId string) error
 	UpdatePipelineDefaultVersion(pipelineId string, versionId string) error
 
@@ -112,9 +113,10 @@ type PipelineStoreInterface interface {
 }
 
 type PipelineStore struct {
-	db   *DB
-	time util.TimeInterface
-	uuid util.UUIDGeneratorInterface
+	db         *DB
+	time       util.TimeInterface
+	uuid       util.UUIDGeneratorInterface
+	labelStore *LabelStore
 }
 
 // TODO(gkcalat): consider removing after KFP v2 GA if users are not affected.
@@ -191,6 +193,9 @@ func (s *PipelineStore) GetPipelineByNameAndNamespace(name string, namespace str
 	if len(pipelines) == 0 {
 		return nil, util.NewResourceNotFoundError("Namespace/Pipeline", fmt.Sprintf("%v/%v", namespace, name))
 	}
+	if err := s.addLabels(pipelines); err != nil {
+		return nil, err
+	}
 	return pipelines[0], nil
 }
 
@@ -372,6 +377,9 @@ func (s *PipelineStore) ListPipelines(filterContext *model.FilterContext, opts *
 		glog.Errorf("Failed to commit transaction to list pipelines")
 		return nil, 0, "", util.NewInternalServerError(err, "Failed to commit listing pipelines")
 	}
+	if err := s.addLabels(pipelines); err != nil {
+		return nil, 0, "", err
+	}
 
 	// Split results on multiple pages if needed
 	if len(pipelines) <= opts.PageSize {
@@ -515,9 +523,28 @@ func (s *PipelineStore) GetPipelineWithStatus(id string, status model.PipelineSt
 	if len(pipelines) == 0 {
 		return nil, util.NewResourceNotFoundError("Pipeline", id)
 	}
+	if err := s.addLabels(pipelines); err != nil {
+		return nil, err
+	}
 	return pipelines[0], nil
 }
 
+// addLabels sets the labels of the pipelines.
+func (s *PipelineStore) addLabels(pipelines []*model.Pipeline) error {
+	ids := make([]string, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		ids = append(ids, pipeline.UUID)
+	}
+	labels, err := s.labelStore.GetLabels(model.PipelineResourceType, ids)
+	if err != nil {
+		return err
+	}
+	for _, pipeline := range pipelines {
+		pipeline.Labels = labels[pipeline.UUID]
+	}
+	return nil
+}
+
 // Removes a pipeline from the DB.
 // DB should take care of the corresponding records in pipeline_versions.
 func (s *PipelineStore) DeletePipeline(id string) error {
@@ -526,7 +553,24 @@ func (s *PipelineStore) DeletePipeline(id string) error {
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to create query to delete a pipeline with id %v", id)
 	}
-	return s.ExecuteSQL(sql, args, "delete", "pipeline")
+
+	// Delete the pipeline and its labels in a single transaction
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start a transaction to delete a pipeline with id %v", id)
+	}
+	if _, err := tx.Exec(sql, args...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete a pipeline with id %v", id)
+	}
+	if err := s.labelStore.DeleteLabels(tx, id, model.PipelineResourceType); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to commit deletion of a pipeline with id %v", id)
+	}
+	return nil
 }
 
 // Creates a pipeline and a pipeline version in a single transaction.
@@ -621,6 +665,12 @@ func (s *PipelineStore) CreatePipelineAndPipelineVersion(p *model.Pipeline, pv *
 		return nil, nil, util.NewInternalServerError(err, "Failed to insert a new pipeline version")
 	}
 
+	err = s.labelStore.CreateLabels(tx, newPipeline.UUID, model.PipelineResourceType, newPipeline.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, nil, util.NewInternalServerError(err, "Failed to update pipelines and pipeline_versions in a transaction")
 	}
@@ -679,6 +729,11 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to add pipeline to pipeline table")
 	}
+	err = s.labelStore.CreateLabels(tx, newPipeline.UUID, model.PipelineResourceType, newPipeline.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	if err := tx.Commit(); err != nil {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to commit pipeline creation in a SQL transaction")
@@ -700,6 +755,34 @@ func (s *PipelineStore) UpdatePipelineStatus(id string, status model.PipelineSta
 	return s.ExecuteSQL(sql, args, "update", "pipeline status")
 }
 
+// Updates the description and the labels of a pipeline.
+func (s *PipelineStore) UpdatePipeline(p *model.Pipeline) error {
+	sql, args, err := sq.
+		Update("pipelines").
+		SetMap(sq.Eq{"Description": p.Description}).
+		Where(sq.Eq{"UUID": p.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to update pipeline %v", p.UUID)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start a transaction to update pipeline %v", p.UUID)
+	}
+	if _, err := tx.Exec(sql, args...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to update pipeline %v", p.UUID)
+	}
+	if err := s.labelStore.ReplaceLabels(tx, p.UUID, model.PipelineResourceType, p.Labels); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to commit update of pipeline %v", p.UUID)
+	}
+	return nil
+}
+
 // Updates status of a pipeline version.
 func (s *PipelineStore) UpdatePipelineVersionStatus(id string, status model.PipelineVersionStatus) error {
 	sql, args, err := sq.
@@ -715,7 +798,7 @@ func (s *PipelineStore) UpdatePipelineVersionStatus(id string, status model.Pipe
 
 // Factory function for pipeline store.
 func NewPipelineStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *PipelineStore {
-	return &PipelineStore{db: db, time: time, uuid: uuid}
+	return &PipelineStore{db: db, time: time, uuid: uuid, labelStore: NewLabelStore(db)}
 }
 
 // Creates a PipelineVersion.
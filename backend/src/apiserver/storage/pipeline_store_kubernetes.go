@@ -3,8 +3,6 @@ package storage
 import (
 	"context"
 	"fmt"
-	"slices"
-	"sort"
 	"strings"
 
 	"github.com/golang/glog"
@@ -67,6 +65,13 @@ func (k *PipelineStoreKubernetes) ListPipelines(filterContext *model.FilterConte
 		listOptions = append(listOptions, ctrlclient.InNamespace(filterContext.ReferenceKey.ID))
 	}
 
+	// Let the Kubernetes API preselect the pipelines by their labels.
+	if opts.Filter != nil {
+		if selector := opts.Filter.K8sLabelSelector(model.LabelNameFromKey); !selector.Empty() {
+			listOptions = append(listOptions, ctrlclient.MatchingLabelsSelector{Selector: selector})
+		}
+	}
+
 	// Be careful, the deep copy is disabled here to reduce memory allocations
 	err := k.client.List(context.TODO(), &k8sPipelines, listOptions...)
 	if err != nil {
@@ -91,52 +96,8 @@ func (k *PipelineStoreKubernetes) ListPipelines(filterContext *model.FilterConte
 		}
 	}
 
-	// Because controller-client does not have sorting, use this function to sort by fields.
-	sort.SliceStable(pipelines, func(i, j int) bool {
-		if opts.SortByFieldName != "" {
-			elementA := pipelines[i].GetFieldValue(opts.SortByFieldName)
-			elementB := pipelines[j].GetFieldValue(opts.SortByFieldName)
-
-			if elementA != nil && elementB != nil {
-				switch elementA.(type) {
-				case int64:
-					return elementA.(int64) > elementB.(int64)
-				case float64:
-					return elementA.(float64) > elementB.(float64)
-				case string:
-					return elementA.(string) > elementB.(string)
-				default:
-					glog.Warningf("Field type %T in %s not recognized. Sorting will not work.", elementA, opts.SortByFieldName)
-					return false
-				}
-			}
-		}
-		return false
-	})
-	if !opts.IsDesc {
-		slices.Reverse(pipelines)
-	}
-
-	// If there's pagination, find the index of the first element of the next page
-	nextPage := 0
-	for p, pipeline := range pipelines {
-		if opts.KeyFieldValue != "" {
-			if strings.Compare(fmt.Sprint(opts.KeyFieldValue), pipeline.UUID) == 0 {
-				nextPage = p
-				break
-			}
-		}
-	}
-
-	// Split results on multiple pages if needed
-	upperBound := nextPage + opts.PageSize
-	npt := ""
-	if len(pipelines) > upperBound {
-		npt, err = opts.NextPageToken(pipelines[upperBound])
-	} else {
-		upperBound = len(pipelines)
-	}
-	return pipelines[nextPage:upperBound], len(pipelines), npt, err
+	// Because controller-client does not have sorting, sort and paginate in memory.
+	return list.SortAndPaginate(opts, pipelines)
 }
 
 func (k *PipelineStoreKubernetes) GetPipeline(pipelineId string) (*model.Pipeline, error) {
@@ -235,6 +196,27 @@ func (k *PipelineStoreKubernetes) UpdatePipelineStatus(pipelineId string, status
 	return nil
 }
 
+func (k *PipelineStoreKubernetes) UpdatePipeline(pipeline *model.Pipeline) error {
+	k8sPipeline, err := k.getK8sPipeline(pipeline.UUID)
+	if err != nil {
+		return err
+	}
+
+	// The pipeline may be shared with the cache, so update a copy of it
+	k8sPipeline = k8sPipeline.DeepCopy()
+	k8sPipeline.Spec.Description = pipeline.Description
+	k8sPipeline.Labels = pipeline.Labels
+
+	err = k.client.Update(context.TODO(), k8sPipeline)
+	if err != nil && k8serrors.IsConflict(err) {
+		return k.UpdatePipeline(pipeline)
+	} else if err != nil {
+		return util.NewInternalServerError(err, "Failed to update the pipeline")
+	}
+
+	return nil
+}
+
 func (k *PipelineStoreKubernetes) UpdatePipelineVersionStatus(pipelineVersionId string, status model.PipelineVersionStatus) error {
 	k8sPipelineVersion, err := k.getK8sPipelineVersion(context.TODO(), pipelineVersionId)
 	if err != nil {
@@ -384,52 +366,8 @@ func (k *PipelineStoreKubernetes) ListPipelineVersions(pipelineId string, opts *
 		}
 	}
 
-	// Because controller-client does not have sorting, use this function to sort by fields.
-	sort.SliceStable(pipelineVersions, func(i, j int) bool {
-		if opts.SortByFieldName != "" {
-			elementA := pipelineVersions[i].GetFieldValue(opts.SortByFieldName)
-			elementB := pipelineVersions[j].GetFieldValue(opts.SortByFieldName)
-
-			if elementA != nil && elementB != nil {
-				switch elementA.(type) {
-				case int64:
-					return elementA.(int64) > elementB.(int64)
-				case float64:
-					return elementA.(float64) > elementB.(float64)
-				case string:
-					return elementA.(string) > elementB.(string)
-				default:
-					glog.Warningf("Field type %T in %s not recognized. Sorting will not work.", elementA, opts.SortByFieldName)
-					return false
-				}
-			}
-		}
-		return false
-	})
-	if !opts.IsDesc {
-		slices.Reverse(pipelineVersions)
-	}
-
-	// If there's pagination, find the index of the first element of the next page
-	nextPage := 0
-	if opts.KeyFieldValue != "" {
-		for p, pipelineVersion := range pipelineVersions {
-			if strings.Compare(fmt.Sprint(opts.KeyFieldValue), pipelineVersion.UUID) == 0 {
-				nextPage = p
-				break
-			}
-		}
-	}
-
-	// Split results on multiple pages if needed
-	upperBound := nextPage + opts.PageSize
-	npt := ""
-	if len(pipelineVersions) > upperBound {
-		npt, err = opts.NextPageToken(pipelineVersions[upperBound])
-	} else {
-		upperBound = len(pipelineVersions)
-	}
-	return pipelineVersions[nextPage:upperBound], len(pipelineVersions), npt, err
+	// Because controller-client does not have sorting, sort and paginate in memory.
+	return list.SortAndPaginate(opts, pipelineVersions)
 }
 
 func (k *PipelineStoreKubernetes) DeletePipelineVersion(pipelineVersionId string) error {
@@ -1,10 +1,14 @@
 package storage
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
@@ -13,10 +17,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/kubeflow/pipelines/backend/src/crd/kubernetes/v2beta1"
 )
@@ -82,6 +89,96 @@ func TestListK8sPipelines_WithFilter(t *testing.T) {
 	require.Equalf(t, len(pipelines), 2, "List size should return 2")
 }
 
+func TestListK8sPipelines_WithPredicates(t *testing.T) {
+	podNamespace := viper.Get("POD_NAMESPACE")
+	viper.Set("POD_NAMESPACE", "Test")
+	defer viper.Set("POD_NAMESPACE", podNamespace)
+
+	store := NewPipelineStoreKubernetes(getClient())
+
+	pipeline1 := &model.Pipeline{
+		Name:      "Test Pipeline 1",
+		Namespace: "Test",
+		Labels:    map[string]string{"team": "a"},
+	}
+	pipeline2 := &model.Pipeline{
+		Name:      "Test Pipeline 2",
+		Namespace: "Test",
+		Labels:    map[string]string{"team": "b"},
+	}
+	_, err := store.CreatePipeline(pipeline1)
+	require.Nil(t, err, "Failed to create Pipeline: %v", err)
+	_, err = store.CreatePipeline(pipeline2)
+	require.Nil(t, err, "Failed to create Pipeline: %v", err)
+
+	tests := []struct {
+		name       string
+		predicates []*apiv2beta1.Predicate
+		want       []string
+	}{
+		{
+			name: "equals",
+			predicates: []*apiv2beta1.Predicate{
+				{Key: "name", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "Test Pipeline 2"}},
+			},
+			want: []string{"Test Pipeline 2"},
+		},
+		{
+			name: "not equals",
+			predicates: []*apiv2beta1.Predicate{
+				{Key: "name", Operation: apiv2beta1.Predicate_NOT_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "Test Pipeline 2"}},
+			},
+			want: []string{"Test Pipeline 3", "Test Pipeline 1"},
+		},
+		{
+			name: "in",
+			predicates: []*apiv2beta1.Predicate{
+				{Key: "name", Operation: apiv2beta1.Predicate_IN, Value: &apiv2beta1.Predicate_StringValues_{StringValues: &apiv2beta1.Predicate_StringValues{Values: []string{"Test Pipeline 1", "Test Pipeline 3"}}}},
+			},
+			want: []string{"Test Pipeline 3", "Test Pipeline 1"},
+		},
+		{
+			name: "created after",
+			predicates: []*apiv2beta1.Predicate{
+				{Key: "created_at", Operation: apiv2beta1.Predicate_GREATER_THAN_EQUALS, Value: &apiv2beta1.Predicate_TimestampValue{TimestampValue: timestamppb.New(time.Unix(2, 0))}},
+			},
+			want: []string{"Test Pipeline 2"},
+		},
+		{
+			name: "label",
+			predicates: []*apiv2beta1.Predicate{
+				{Key: "labels.team", Operation: apiv2beta1.Predicate_EQUALS, Value: &apiv2beta1.Predicate_StringValue{StringValue: "a"}},
+			},
+			want: []string{"Test Pipeline 1"},
+		},
+		{
+			name: "label and name",
+			predicates: []*apiv2beta1.Predicate{
+				{Key: "labels.team", Operation: apiv2beta1.Predicate_IN, Value: &apiv2beta1.Predicate_StringValues_{StringValues: &apiv2beta1.Predicate_StringValues{Values: []string{"a", "b"}}}},
+				{Key: "name", Operation: apiv2beta1.Predicate_IS_SUBSTRING, Value: &apiv2beta1.Predicate_StringValue{StringValue: "2"}},
+			},
+			want: []string{"Test Pipeline 2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFilter, err := filter.New(&apiv2beta1.Filter{Predicates: tt.predicates})
+			require.Nil(t, err)
+			options, err := list.NewOptions(&model.Pipeline{}, 10, "", newFilter)
+			require.Nil(t, err)
+
+			pipelines, totalSize, _, err := store.ListPipelines(&model.FilterContext{}, options)
+			require.Nil(t, err)
+			assert.Equal(t, len(tt.want), totalSize)
+			var names []string
+			for _, pipeline := range pipelines {
+				names = append(names, pipeline.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
 func TestListK8sPipelines_Pagination(t *testing.T) {
 	podNamespace := viper.Get("POD_NAMESPACE")
 	viper.Set("POD_NAMESPACE", "Test")
@@ -117,7 +214,7 @@ func TestListK8sPipelines_Pagination(t *testing.T) {
 	require.Nil(t, err1, "Failed to create list options: %v")
 	pipelines, _, _, err3 := store.ListPipelines(&model.FilterContext{}, options)
 	require.Nil(t, err3, "Failed to list pipelines: %v")
-	require.Equalf(t, pipelines[0].Name, "Test Pipeline 3", "Pagination failed")
+	require.Equalf(t, pipelines[0].Name, "Test Pipeline 1", "Pagination failed")
 }
 
 func TestListK8sPipelines_Pagination_Descend(t *testing.T) {
@@ -154,7 +251,7 @@ func TestListK8sPipelines_Pagination_Descend(t *testing.T) {
 	options, err1 = list.NewOptionsFromToken(npt, 1)
 	pipelines, _, _, err3 := store.ListPipelines(&model.FilterContext{}, options)
 	require.Nil(t, err3, "Failed to list pipelines: %v")
-	require.Equalf(t, pipelines[0].Name, "Test Pipeline 3", "Pagination failed")
+	require.Equalf(t, pipelines[0].Name, "Test Pipeline 2", "Pagination failed")
 }
 
 func TestListK8sPipelinesV1_Pagination_NameAsc(t *testing.T) {
@@ -191,7 +288,7 @@ func TestListK8sPipelinesV1_Pagination_NameAsc(t *testing.T) {
 	options, err1 = list.NewOptionsFromToken(npt, 1)
 	pipelines, _, _, err3 := store.ListPipelines(&model.FilterContext{}, options)
 	require.Nil(t, err3, "Failed to list pipelines: %v")
-	require.Equalf(t, pipelines[0].Name, "Test Pipeline 1", "Pagination failed")
+	require.Equalf(t, pipelines[0].Name, "Test Pipeline 2", "Pagination failed")
 }
 
 func TestListK8sPipelines_Pagination_LessThanPageSize(t *testing.T) {
@@ -256,6 +353,26 @@ func TestCreateK8sPipeline(t *testing.T) {
 	require.Equalf(t, pipeline.Name, "Test Pipeline", "Pipeline name is not the same")
 }
 
+func TestUpdateK8sPipeline(t *testing.T) {
+	podNamespace := viper.Get("POD_NAMESPACE")
+	viper.Set("POD_NAMESPACE", "Test")
+	defer viper.Set("POD_NAMESPACE", podNamespace)
+
+	store := NewPipelineStoreKubernetes(getClient())
+
+	p, err := store.GetPipeline(DefaultFakePipelineIdTwo)
+	require.Nil(t, err, "Failed to get Pipeline: %v", err)
+	p.Description = "Updated Pipeline Description"
+	p.Labels = map[string]string{"team": "vision"}
+	err = store.UpdatePipeline(p)
+	require.Nil(t, err, "Failed to update Pipeline: %v", err)
+
+	p, err = store.GetPipeline(DefaultFakePipelineIdTwo)
+	require.Nil(t, err, "Failed to get Pipeline: %v", err)
+	require.Equal(t, "Updated Pipeline Description", p.Description)
+	require.Equal(t, map[string]string{"team": "vision"}, p.Labels)
+}
+
 func TestDeleteK8sPipeline(t *testing.T) {
 	podNamespace := viper.Get("POD_NAMESPACE")
 	viper.Set("POD_NAMESPACE", "Test")
@@ -385,7 +502,7 @@ func TestListK8sPipelineVersions_Pagination(t *testing.T) {
 	pipelineVersions, _, _, err = store.ListPipelineVersions(DefaultFakePipelineIdTwo, options)
 	require.Nil(t, err, "Failed to list pipeline versions: %v", err)
 	require.Equalf(t, len(pipelineVersions), 1, "List size should not be zero")
-	require.Equalf(t, pipelineVersions[0].Name, "Test Pipeline Version 3", "Pagination did not work as expected")
+	require.Equalf(t, pipelineVersions[0].Name, "Test Pipeline Version 1", "Pagination did not work as expected")
 }
 
 func TestListK8sPipelineVersions_Pagination_Descend(t *testing.T) {
@@ -599,7 +716,22 @@ func getClient() (client.Client, client.Client) {
 		WithScheme(scheme).
 		WithStatusSubresource(pipelineVersion, pipelineVersion1, pipelineVersion2, pipelineVersion3).
 		WithObjects(pipeline3, pipelineVersion3).
+		WithInterceptorFuncs(interceptor.Funcs{Create: createWithMetadata()}).
 		Build()
 
 	return k8sClient, k8sClient
 }
+
+// Returns a create function that sets the UID and an increasing creation
+// timestamp, like the Kubernetes API server does and the fake client does not.
+func createWithMetadata() func(context.Context, client.WithWatch, client.Object, ...client.CreateOption) error {
+	created := 0
+	return func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+		created++
+		if obj.GetUID() == "" {
+			obj.SetUID(types.UID(fmt.Sprintf("00000000-0000-0000-0000-%012d", created)))
+		}
+		obj.SetCreationTimestamp(metav1.NewTime(time.Unix(int64(created), 0)))
+		return c.Create(ctx, obj, opts...)
+	}
+}
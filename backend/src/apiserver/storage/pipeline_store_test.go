@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	apiv2 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
@@ -237,6 +238,85 @@ func TestListPipelines_WithFilter(t *testing.T) {
 	assert.Equal(t, pipelinesExpected, pipelines)
 }
 
+func TestListPipelines_WithFilterGroups(t *testing.T) {
+	db := NewFakeDBOrFatal()
+	defer db.Close()
+	pipelineStore := NewPipelineStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(DefaultFakePipelineId, nil))
+	pipelineStore.CreatePipeline(createPipelineV1("pipeline_foo"))
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(DefaultFakePipelineIdTwo, nil)
+	pipelineStore.CreatePipeline(createPipelineV1("pipeline_bar"))
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(DefaultFakePipelineIdThree, nil)
+	pipelineStore.CreatePipeline(createPipelineV1("pipeline_tmp"))
+
+	expectedPipeline1 := &model.Pipeline{
+		UUID:           DefaultFakePipelineId,
+		CreatedAtInSec: 1,
+		Name:           "pipeline_foo",
+		Status:         model.PipelineReady,
+	}
+	expectedPipeline2 := &model.Pipeline{
+		UUID:           DefaultFakePipelineIdTwo,
+		CreatedAtInSec: 2,
+		Name:           "pipeline_bar",
+		Status:         model.PipelineReady,
+	}
+
+	// (name = pipeline_foo OR name = pipeline_bar OR name = pipeline_tmp) AND NOT (name contains tmp)
+	filterProto := &apiv2.Filter{
+		Groups: []*apiv2.FilterGroup{
+			{
+				Operator: apiv2.FilterGroup_OR,
+				Predicates: []*apiv2.Predicate{
+					{
+						Key:       "name",
+						Operation: apiv2.Predicate_EQUALS,
+						Value:     &apiv2.Predicate_StringValue{StringValue: "pipeline_foo"},
+					},
+					{
+						Key:       "name",
+						Operation: apiv2.Predicate_EQUALS,
+						Value:     &apiv2.Predicate_StringValue{StringValue: "pipeline_bar"},
+					},
+					{
+						Key:       "name",
+						Operation: apiv2.Predicate_EQUALS,
+						Value:     &apiv2.Predicate_StringValue{StringValue: "pipeline_tmp"},
+					},
+				},
+			},
+			{
+				Operator: apiv2.FilterGroup_NOT,
+				Predicates: []*apiv2.Predicate{
+					{
+						Key:       "name",
+						Operation: apiv2.Predicate_IS_SUBSTRING,
+						Value:     &apiv2.Predicate_StringValue{StringValue: "tmp"},
+					},
+				},
+			},
+		},
+	}
+	newFilter, err := filter.New(filterProto)
+	assert.Nil(t, err)
+	opts, err := list.NewOptions(&model.Pipeline{}, 1, "id", newFilter)
+	assert.Nil(t, err)
+
+	pipelines, _, totalSize, nextPageToken, err := pipelineStore.ListPipelinesV1(&model.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, nextPageToken)
+	assert.Equal(t, 2, totalSize)
+	assert.Equal(t, []*model.Pipeline{expectedPipeline1}, pipelines)
+
+	// The filter groups are carried over to the next page through the page token.
+	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	assert.Nil(t, err)
+	pipelines, _, totalSize, nextPageToken, err = pipelineStore.ListPipelinesV1(&model.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "", nextPageToken)
+	assert.Equal(t, 2, totalSize)
+	assert.Equal(t, []*model.Pipeline{expectedPipeline2}, pipelines)
+}
+
 func TestListPipelines_Pagination(t *testing.T) {
 	db := NewFakeDBOrFatal()
 	defer db.Close()
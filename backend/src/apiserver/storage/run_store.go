@@ -81,6 +81,9 @@ type RunStoreInterface interface {
 	// Note: only state, runtime manifest can be updated. Does not update dependent tasks.
 	UpdateRun(run *model.Run) (err error)
 
+	// Updates the description and the labels of a run.
+	UpdateRunMetadata(run *model.Run) error
+
 	// Archives a run.
 	ArchiveRun(runId string) error
 
@@ -93,13 +96,74 @@ type RunStoreInterface interface {
 	// Creates a new metric entry.
 	CreateMetric(metric *model.RunMetric) (err error)
 
+	// Fetches the metrics of a run.
+	ListMetrics(runId string) ([]*model.RunMetric, error)
+
 	// Terminates a run.
 	TerminateRun(runId string) error
+
+	// Pauses an active run.
+	PauseRun(runId string) error
+
+	// Resumes a paused run.
+	ResumeRun(runId string) error
+
+	// Archives runs in a single transaction.
+	ArchiveRuns(runIds []string) error
+
+	// Un-archives runs in a single transaction.
+	UnarchiveRuns(runIds []string) error
+
+	// Deletes runs in a single transaction.
+	DeleteRuns(runIds []string) error
+
+	// Updates the state and the workflow manifest of runs in a single
+	// transaction.
+	UpdateRuns(runs []*model.Run) error
+
+	// Terminates the active runs among the given ones in a single transaction.
+	// Returns the IDs of the terminated runs.
+	TerminateRuns(runIds []string) ([]string, error)
+
+	// Records that a webhook is notified about a state of a run. Fails if it
+	// was already recorded.
+	CreateRunNotification(notification *model.RunNotification) error
+
+	// Fetches the notifications whose delivery is pending and started before
+	// the given time.
+	ListUndeliveredRunNotifications(notifiedBefore int64) ([]*model.RunNotification, error)
+
+	// Starts the delivery of a pending notification again at the given time.
+	// Fails if the delivery was started again or finished since the
+	// notification was fetched.
+	ClaimRunNotification(notification *model.RunNotification, notifiedAt int64) error
+
+	// Records that the delivery of a notification finished.
+	MarkRunNotificationDelivered(notification *model.RunNotification, deliveredAt int64) error
+
+	// Removes the records of the notifications about a state of the runs that
+	// are no longer in that state.
+	DeleteStaleRunNotifications(state model.RuntimeState) error
+
+	// Records a change of a run or of one of its tasks. The sequence of the
+	// change is assigned by the database.
+	CreateRunChange(change *model.RunChange) error
+
+	// Fetches the changes after a sequence, ordered by their sequence.
+	ListRunChanges(after int64, limit int) ([]*model.RunChange, error)
+
+	// Fetches the sequences of the oldest and of the latest recorded change.
+	// Both are 0 if no change is recorded.
+	GetRunChangeSequences() (int64, int64, error)
+
+	// Deletes the changes up to a sequence.
+	DeleteRunChanges(upTo int64) error
 }
 
 type RunStore struct {
 	db                     *DB
 	resourceReferenceStore *ResourceReferenceStore
+	labelStore             *LabelStore
 	time                   util.TimeInterface
 }
 
@@ -165,6 +229,9 @@ func (s *RunStore) ListRuns(
 		glog.Error("Failed to commit transaction to list runs")
 		return errorF(err)
 	}
+	if err := s.addLabels(runs); err != nil {
+		return errorF(err)
+	}
 
 	if len(runs) <= opts.PageSize {
 		return runs, total_size, "", nil
@@ -242,9 +309,28 @@ func (s *RunStore) GetRun(runId string) (*model.Run, error) {
 		// This can only happen when workflow reporting is failed.
 		return nil, util.NewResourceNotFoundError("Failed to get run: %s", runId)
 	}
+	if err := s.addLabels(runs); err != nil {
+		return nil, err
+	}
 	return runs[0], nil
 }
 
+// addLabels sets the labels of the runs.
+func (s *RunStore) addLabels(runs []*model.Run) error {
+	ids := make([]string, 0, len(runs))
+	for _, run := range runs {
+		ids = append(ids, run.UUID)
+	}
+	labels, err := s.labelStore.GetLabels(model.RunResourceType, ids)
+	if err != nil {
+		return err
+	}
+	for _, run := range runs {
+		run.Labels = labels[run.UUID]
+	}
+	return nil
+}
+
 // Applies a func f to every string in a given string slice.
 func apply(f func(string) string, vs []string) []string {
 	vsm := make([]string, len(vs))
@@ -542,6 +628,11 @@ func (s *RunStore) CreateRun(r *model.Run) (*model.Run, error) {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to store resource references to table for run %v ", r.DisplayName)
 	}
+	err = s.labelStore.CreateLabels(tx, r.UUID, model.RunResourceType, r.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -555,6 +646,38 @@ func (s *RunStore) UpdateRun(run *model.Run) error {
 	if err != nil {
 		return util.NewInternalServerError(err, "transaction creation failed")
 	}
+	if err := s.updateRun(tx, run); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "failed to commit transaction for run %s", run.UUID)
+	}
+	return nil
+}
+
+func (s *RunStore) UpdateRuns(runs []*model.Run) error {
+	if len(runs) == 0 {
+		return nil
+	}
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "transaction creation failed")
+	}
+	for _, run := range runs {
+		if err := s.updateRun(tx, run); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "failed to commit transaction for %v runs", len(runs))
+	}
+	return nil
+}
+
+// Updates the state and the workflow manifest of a run within a transaction.
+func (s *RunStore) updateRun(tx *sql.Tx, run *model.Run) error {
 	if len(run.RunDetails.StateHistory) == 0 || run.RunDetails.StateHistory[len(run.RunDetails.StateHistory)-1].State != run.RunDetails.State {
 		run.RunDetails.StateHistory = append(run.RunDetails.StateHistory, &model.RuntimeStatus{
 			UpdateTimeInSec: s.time.Now().Unix(),
@@ -577,32 +700,56 @@ func (s *RunStore) UpdateRun(run *model.Run) error {
 		Where(sq.Eq{"UUID": run.UUID}).
 		ToSql()
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err,
 			"Failed to create query to update run %s", run.UUID)
 	}
 	result, err := tx.Exec(sql, args...)
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err,
 			"Failed to update run %s", run.UUID)
 	}
 	r, err := result.RowsAffected()
 	if err != nil {
-		tx.Rollback()
 		return util.NewInternalServerError(err,
 			"Failed to update run %s", run.UUID)
 	}
 	if r > 1 {
-		tx.Rollback()
 		return util.NewInternalServerError(errors.New("Failed to update run"), "Failed to update run %s. More than 1 rows affected", run.UUID)
 	} else if r == 0 {
-		tx.Rollback()
 		return util.Wrap(util.NewResourceNotFoundError("Run", run.UUID), "Failed to update run")
 	}
+	return nil
+}
 
+func (s *RunStore) UpdateRunMetadata(run *model.Run) error {
+	sql, args, err := sq.
+		Update("run_details").
+		SetMap(sq.Eq{
+			"Description": run.Description,
+		}).
+		Where(sq.Eq{"UUID": run.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to update run %s", run.UUID)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to update run")
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err,
+			"Failed to update run %s", run.UUID)
+	}
+	err = s.labelStore.ReplaceLabels(tx, run.UUID, model.RunResourceType, run.Labels)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 	if err := tx.Commit(); err != nil {
-		return util.NewInternalServerError(err, "failed to commit transaction for run %s", run.UUID)
+		return util.NewInternalServerError(err, "Failed to update run %v and its labels", run.UUID)
 	}
 	return nil
 }
@@ -672,6 +819,11 @@ func (s *RunStore) DeleteRun(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for run %v ", id)
 	}
+	err = s.labelStore.DeleteLabels(tx, id, model.RunResourceType)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -712,11 +864,31 @@ func (s *RunStore) CreateMetric(metric *model.RunMetric) error {
 	return nil
 }
 
+// ListMetrics returns the metrics of a run, ordered by name and node ID.
+func (s *RunStore) ListMetrics(runId string) ([]*model.RunMetric, error) {
+	sql, args, err := sq.
+		Select(runMetricsColumns...).
+		From("run_metrics").
+		Where(sq.Eq{"RunUUID": runId}).
+		OrderBy("Name", "NodeID").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list the metrics of run %v", runId)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the metrics of run %v", runId)
+	}
+	defer rows.Close()
+	return s.scanRowsToRunMetrics(rows)
+}
+
 // Returns a new RunStore.
 func NewRunStore(db *DB, time util.TimeInterface) *RunStore {
 	return &RunStore{
 		db:                     db,
 		resourceReferenceStore: NewResourceReferenceStore(db, nil),
+		labelStore:             NewLabelStore(db),
 		time:                   time,
 	}
 }
@@ -746,6 +918,356 @@ func (s *RunStore) TerminateRun(runId string) error {
 	return nil
 }
 
+func (s *RunStore) PauseRun(runId string) error {
+	result, err := s.db.Exec(`
+		UPDATE run_details
+		SET Conditions = ?, State = ?
+		WHERE UUID = ? AND (State = ? OR State = ? OR State = ?)`,
+		string(model.RuntimeStatePaused.ToV1()),
+		model.RuntimeStatePaused.ToString(),
+		runId,
+		model.RuntimeStatePending.ToString(),
+		model.RuntimeStateRunning.ToString(),
+		model.RuntimeStateUnspecified.ToString(),
+	)
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to pause a run %s. Error: '%v'", runId, err.Error())
+	}
+
+	if r, _ := result.RowsAffected(); r != 1 {
+		return util.NewInvalidInputError("Failed to pause a run %s. Row not found or the run is not active", runId)
+	}
+	return nil
+}
+
+func (s *RunStore) ResumeRun(runId string) error {
+	result, err := s.db.Exec(`
+		UPDATE run_details
+		SET Conditions = ?, State = ?
+		WHERE UUID = ? AND State = ?`,
+		string(model.RuntimeStateRunning.ToV1()),
+		model.RuntimeStateRunning.ToString(),
+		runId,
+		model.RuntimeStatePaused.ToString(),
+	)
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to resume a run %s. Error: '%v'", runId, err.Error())
+	}
+
+	if r, _ := result.RowsAffected(); r != 1 {
+		return util.NewInvalidInputError("Failed to resume a run %s. Row not found or the run is not paused", runId)
+	}
+	return nil
+}
+
+func (s *RunStore) ArchiveRuns(runIds []string) error {
+	return s.updateStorageStateOfRuns(runIds, model.StorageStateArchived)
+}
+
+func (s *RunStore) UnarchiveRuns(runIds []string) error {
+	return s.updateStorageStateOfRuns(runIds, model.StorageStateAvailable)
+}
+
+func (s *RunStore) updateStorageStateOfRuns(runIds []string, state model.StorageState) error {
+	if len(runIds) == 0 {
+		return nil
+	}
+	sql, args, err := sq.
+		Update("run_details").
+		SetMap(sq.Eq{
+			"StorageState": state.ToString(),
+		}).
+		Where(sq.Eq{"UUID": runIds}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to set the storage state of %v runs to %v", len(runIds), state)
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to set the storage state of %v runs to %v", len(runIds), state)
+	}
+	return nil
+}
+
+func (s *RunStore) DeleteRuns(runIds []string) error {
+	if len(runIds) == 0 {
+		return nil
+	}
+	runSql, runArgs, err := sq.Delete("run_details").Where(sq.Eq{"UUID": runIds}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to delete %v runs", len(runIds))
+	}
+	// Use a transaction to make sure the runs are deleted along with their resource references.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to delete runs")
+	}
+	_, err = tx.Exec(runSql, runArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete %v runs from table", len(runIds))
+	}
+	for _, runId := range runIds {
+		err = s.resourceReferenceStore.DeleteResourceReferences(tx, runId, model.RunResourceType)
+		if err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to delete resource references from table for run %v", runId)
+		}
+		err = s.labelStore.DeleteLabels(tx, runId, model.RunResourceType)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete %v runs and their resource references from table", len(runIds))
+	}
+	return nil
+}
+
+func (s *RunStore) TerminateRuns(runIds []string) ([]string, error) {
+	if len(runIds) == 0 {
+		return nil, nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to terminate runs")
+	}
+	var terminated []string
+	for _, runId := range runIds {
+		result, err := tx.Exec(`
+		UPDATE run_details
+		SET Conditions = ?, State = ?
+		WHERE UUID = ? AND (State = ? OR State = ? OR State = ? OR State = ?)`,
+			string(model.RuntimeStateCancelling.ToV1()),
+			model.RuntimeStateCancelling.ToString(),
+			runId,
+			model.RuntimeStatePaused.ToString(),
+			model.RuntimeStatePending.ToString(),
+			model.RuntimeStateRunning.ToString(),
+			model.RuntimeStateUnspecified.ToString(),
+		)
+		if err != nil {
+			tx.Rollback()
+			return nil, util.NewInternalServerError(err, "Failed to terminate run %s", runId)
+		}
+		if r, _ := result.RowsAffected(); r == 1 {
+			terminated = append(terminated, runId)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to terminate %v runs", len(runIds))
+	}
+	return terminated, nil
+}
+
+func (s *RunStore) CreateRunNotification(notification *model.RunNotification) error {
+	sql, args, err := sq.
+		Insert("run_notifications").
+		SetMap(sq.Eq{
+			"RunUUID":          notification.RunUUID,
+			"WebhookHash":      notification.WebhookHash,
+			"State":            notification.State.ToString(),
+			"NotifiedAtInSec":  notification.NotifiedAtInSec,
+			"DeliveredAtInSec": notification.DeliveredAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store a notification of run %v", notification.RunUUID)
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		if s.db.IsDuplicateError(err) {
+			return util.NewAlreadyExistError("Notification about state %v of run %v was already sent", notification.State, notification.RunUUID)
+		}
+		return util.NewInternalServerError(err, "Failed to store a notification of run %v", notification.RunUUID)
+	}
+	return nil
+}
+
+func (s *RunStore) ListUndeliveredRunNotifications(notifiedBefore int64) ([]*model.RunNotification, error) {
+	sql, args, err := sq.
+		Select("RunUUID", "WebhookHash", "State", "NotifiedAtInSec", "DeliveredAtInSec").
+		From("run_notifications").
+		Where(sq.Eq{"DeliveredAtInSec": 0}).
+		Where(sq.Lt{"NotifiedAtInSec": notifiedBefore}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list the undelivered run notifications")
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the undelivered run notifications")
+	}
+	defer rows.Close()
+	var notifications []*model.RunNotification
+	for rows.Next() {
+		var notification model.RunNotification
+		var state string
+		if err := rows.Scan(&notification.RunUUID, &notification.WebhookHash, &state, &notification.NotifiedAtInSec, &notification.DeliveredAtInSec); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse the undelivered run notifications")
+		}
+		notification.State = model.RuntimeState(state)
+		notifications = append(notifications, &notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the undelivered run notifications")
+	}
+	return notifications, nil
+}
+
+func (s *RunStore) ClaimRunNotification(notification *model.RunNotification, notifiedAt int64) error {
+	sql, args, err := sq.
+		Update("run_notifications").
+		SetMap(sq.Eq{"NotifiedAtInSec": notifiedAt}).
+		Where(sq.Eq{
+			"RunUUID":          notification.RunUUID,
+			"WebhookHash":      notification.WebhookHash,
+			"State":            notification.State.ToString(),
+			"NotifiedAtInSec":  notification.NotifiedAtInSec,
+			"DeliveredAtInSec": 0,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to claim a notification of run %v", notification.RunUUID)
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to claim a notification of run %v", notification.RunUUID)
+	}
+	if r, _ := result.RowsAffected(); r != 1 {
+		return util.NewAlreadyExistError("Notification about state %v of run %v is already being sent", notification.State, notification.RunUUID)
+	}
+	notification.NotifiedAtInSec = notifiedAt
+	return nil
+}
+
+func (s *RunStore) MarkRunNotificationDelivered(notification *model.RunNotification, deliveredAt int64) error {
+	sql, args, err := sq.
+		Update("run_notifications").
+		SetMap(sq.Eq{"DeliveredAtInSec": deliveredAt}).
+		Where(sq.Eq{
+			"RunUUID":     notification.RunUUID,
+			"WebhookHash": notification.WebhookHash,
+			"State":       notification.State.ToString(),
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to mark a notification of run %v as delivered", notification.RunUUID)
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to mark a notification of run %v as delivered", notification.RunUUID)
+	}
+	return nil
+}
+
+func (s *RunStore) DeleteStaleRunNotifications(state model.RuntimeState) error {
+	// The runs of the v1 API may store the state in its v1 form.
+	sql, args, err := sq.
+		Delete("run_notifications").
+		Where(sq.Eq{"State": state.ToString()}).
+		Where(sq.Expr("RunUUID NOT IN (SELECT UUID FROM run_details WHERE State IN (?, ?))", state.ToString(), state.ToV1().ToString())).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the stale notifications about state %v", state)
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete the stale notifications about state %v", state)
+	}
+	return nil
+}
+
+func (s *RunStore) CreateRunChange(change *model.RunChange) error {
+	sql, args, err := sq.
+		Insert("run_changes").
+		SetMap(sq.Eq{
+			"Type":           change.Type,
+			"RunUUID":        change.RunUUID,
+			"TaskUUID":       change.TaskUUID,
+			"Namespace":      change.Namespace,
+			"ExperimentUUID": change.ExperimentUUID,
+			"State":          change.State.ToString(),
+			"PreviousState":  change.PreviousState.ToString(),
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store a change of run %v", change.RunUUID)
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to store a change of run %v", change.RunUUID)
+	}
+	return nil
+}
+
+func (s *RunStore) ListRunChanges(after int64, limit int) ([]*model.RunChange, error) {
+	sql, args, err := sq.
+		Select("Sequence", "Type", "RunUUID", "TaskUUID", "Namespace", "ExperimentUUID", "State", "PreviousState").
+		From("run_changes").
+		Where(sq.Gt{"Sequence": after}).
+		OrderBy("Sequence").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list the run changes after %v", after)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the run changes after %v", after)
+	}
+	defer rows.Close()
+	var changes []*model.RunChange
+	for rows.Next() {
+		var change model.RunChange
+		var state, previousState string
+		if err := rows.Scan(&change.Sequence, &change.Type, &change.RunUUID, &change.TaskUUID, &change.Namespace, &change.ExperimentUUID, &state, &previousState); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse the run changes after %v", after)
+		}
+		change.State = model.RuntimeState(state)
+		change.PreviousState = model.RuntimeState(previousState)
+		changes = append(changes, &change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the run changes after %v", after)
+	}
+	return changes, nil
+}
+
+func (s *RunStore) GetRunChangeSequences() (int64, int64, error) {
+	sql, args, err := sq.
+		Select("COALESCE(MIN(Sequence), 0)", "COALESCE(MAX(Sequence), 0)").
+		From("run_changes").
+		ToSql()
+	if err != nil {
+		return 0, 0, util.NewInternalServerError(err, "Failed to create query to get the sequences of the run changes")
+	}
+	var oldest, latest int64
+	if err := s.db.QueryRow(sql, args...).Scan(&oldest, &latest); err != nil {
+		return 0, 0, util.NewInternalServerError(err, "Failed to get the sequences of the run changes")
+	}
+	return oldest, latest, nil
+}
+
+func (s *RunStore) DeleteRunChanges(upTo int64) error {
+	sql, args, err := sq.
+		Delete("run_changes").
+		Where(sq.LtOrEq{"Sequence": upTo}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the run changes up to %v", upTo)
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete the run changes up to %v", upTo)
+	}
+	return nil
+}
+
 // Add a metric as a new field to the select clause by join the passed-in SQL query with run_metrics table.
 // With the metric as a field in the select clause enable sorting on this metric afterwards.
 // TODO(jingzhang36): example of resulting SQL query and explanation for it.
@@ -755,10 +1277,12 @@ func (s *RunStore) addSortByRunMetricToSelect(sqlBuilder sq.SelectBuilder, opts
 		return sqlBuilder
 	}
 	// TODO(jingzhang36): address the case where runs doesn't have the specified metric.
+	// Several tasks of a run can report the same metric, e.g. inside a
+	// ParallelFor, so the largest value is used to keep one row per run.
 	return sq.
-		Select("selected_runs.*, run_metrics.numbervalue as "+opts.SortByFieldName).
+		Select("selected_runs.*, run_metrics.NumberValue AS "+opts.SortByFieldName).
 		FromSelect(sqlBuilder, "selected_runs").
-		LeftJoin("run_metrics ON selected_runs.uuid=run_metrics.runuuid AND run_metrics.name='" + opts.SortByFieldName + "'")
+		LeftJoin("(SELECT RunUUID, MAX(NumberValue) AS NumberValue FROM run_metrics WHERE Name = ? GROUP BY RunUUID) AS run_metrics ON selected_runs.UUID = run_metrics.RunUUID", opts.SortByFieldName)
 }
 
 func (s *RunStore) scanRowsToRunMetrics(rows *sql.Rows) ([]*model.RunMetric, error) {
@@ -1004,6 +1004,43 @@ func TestTerminateRun_RunHasAlreadyFinished(t *testing.T) {
 	assert.Contains(t, err.Error(), "Row not found")
 }
 
+func TestPauseAndResumeRun(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.PauseRun("1")
+	assert.Nil(t, err)
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStatePaused, runDetail.State)
+
+	// A paused run cannot be paused again.
+	err = runStore.PauseRun("1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Row not found")
+
+	err = runStore.ResumeRun("1")
+	assert.Nil(t, err)
+	runDetail, err = runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStateRunning, runDetail.State)
+	assert.Equal(t, "Running", runDetail.Conditions)
+
+	// A running run cannot be resumed.
+	err = runStore.ResumeRun("1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Row not found")
+}
+
+func TestPauseRun_RunHasAlreadyFinished(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.PauseRun("2")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Row not found")
+}
+
 func TestCreateMetric_Success(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
@@ -1202,6 +1239,130 @@ func TestListRuns_WithMetrics(t *testing.T) {
 	assert.Equal(t, expectedRuns, runs, "Unexpected Run listed")
 }
 
+func TestListMetrics(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	metric := &model.RunMetric{
+		RunUUID:     "1",
+		NodeID:      "node0",
+		Name:        "accuracy",
+		NumberValue: 0.77,
+		Format:      "RAW",
+	}
+	assert.Nil(t, runStore.CreateMetric(metric))
+
+	metrics, err := runStore.ListMetrics("1")
+	assert.Nil(t, err)
+	assert.Len(t, metrics, 2)
+	assert.Equal(t, "accuracy", metrics[0].Name)
+	assert.Equal(t, "node0", metrics[0].NodeID)
+	assert.Equal(t, 0.77, metrics[0].NumberValue)
+	assert.Equal(t, "dummymetric", metrics[1].Name)
+
+	metrics, err = runStore.ListMetrics("3")
+	assert.Nil(t, err)
+	assert.Empty(t, metrics)
+}
+
+func TestListRuns_FilterOnMetric(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "metric:dummymetric",
+				Op:    api.Predicate_GREATER_THAN,
+				Value: &api.Predicate_LongValue{LongValue: 1},
+			},
+		},
+	}
+	newFilter, err := filter.New(filterProto)
+	assert.Nil(t, err)
+	opts, err := list.NewOptions(&model.Run{}, 10, "", newFilter)
+	assert.Nil(t, err)
+	runs, totalSize, _, err := runStore.ListRuns(&model.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	if assert.Len(t, runs, 1) {
+		assert.Equal(t, "2", runs[0].UUID)
+	}
+}
+
+func TestUpdateRunMetadata_FilterOnLabel(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	run, err := runStore.GetRun("2")
+	assert.Nil(t, err)
+	run.Description = "second run"
+	run.Labels = map[string]string{"team": "a"}
+	assert.Nil(t, runStore.UpdateRunMetadata(run))
+	run, err = runStore.GetRun("3")
+	assert.Nil(t, err)
+	run.Labels = map[string]string{"team": "b", "env": "dev"}
+	assert.Nil(t, runStore.UpdateRunMetadata(run))
+
+	run, err = runStore.GetRun("2")
+	assert.Nil(t, err)
+	assert.Equal(t, "second run", run.Description)
+	assert.Equal(t, map[string]string{"team": "a"}, run.Labels)
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "labels.team",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "b"},
+			},
+		},
+	}
+	newFilter, err := filter.New(filterProto)
+	assert.Nil(t, err)
+	opts, err := list.NewOptions(&model.Run{}, 10, "", newFilter)
+	assert.Nil(t, err)
+	runs, totalSize, _, err := runStore.ListRuns(&model.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	if assert.Len(t, runs, 1) {
+		assert.Equal(t, "3", runs[0].UUID)
+		assert.Equal(t, map[string]string{"team": "b", "env": "dev"}, runs[0].Labels)
+	}
+
+	// Deleting a run deletes its labels.
+	assert.Nil(t, runStore.DeleteRun("3"))
+	labels, err := runStore.labelStore.GetLabels(model.RunResourceType, []string{"3"})
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+}
+
+func TestListRuns_SortingOnMetricReportedByManyTasks(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	// A second task of run 1 reports the same metric.
+	assert.Nil(t, runStore.CreateMetric(&model.RunMetric{
+		RunUUID:     "1",
+		NodeID:      "node2",
+		Name:        "dummymetric",
+		NumberValue: 3.0,
+		Format:      "RAW",
+	}))
+
+	opts, err := list.NewOptions(&model.Run{}, 2, "metric:dummymetric desc", nil)
+	assert.Nil(t, err)
+	runs, totalSize, nextPageToken, err := runStore.ListRuns(
+		&model.FilterContext{ReferenceKey: &model.ReferenceKey{Type: model.ExperimentResourceType, ID: defaultFakeExpId}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Empty(t, nextPageToken)
+	if assert.Len(t, runs, 2) {
+		assert.Equal(t, "1", runs[0].UUID)
+		assert.Equal(t, "2", runs[1].UUID)
+	}
+}
+
 func TestArchiveRun(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
@@ -1365,6 +1526,148 @@ func TestDeleteRun_InternalError(t *testing.T) {
 		"Expected delete run to return internal error")
 }
 
+func TestArchiveAndUnarchiveRuns(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.ArchiveRuns([]string{"1", "2"})
+	assert.Nil(t, err)
+	for _, runId := range []string{"1", "2"} {
+		run, err := runStore.GetRun(runId)
+		assert.Nil(t, err)
+		assert.Equal(t, model.StorageStateArchived, run.StorageState)
+	}
+
+	err = runStore.UnarchiveRuns([]string{"2"})
+	assert.Nil(t, err)
+	run, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateArchived, run.StorageState)
+	run, err = runStore.GetRun("2")
+	assert.Nil(t, err)
+	assert.Equal(t, model.StorageStateAvailable, run.StorageState)
+}
+
+func TestDeleteRuns(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	resourceReferenceStore := NewResourceReferenceStore(db, nil)
+
+	err := runStore.DeleteRuns([]string{"1", "2"})
+	assert.Nil(t, err)
+	for _, runId := range []string{"1", "2"} {
+		_, err = runStore.GetRun(runId)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not found")
+		_, err = resourceReferenceStore.GetResourceReference(runId, model.RunResourceType, model.ExperimentResourceType)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	}
+}
+
+func TestDeleteRuns_InternalError(t *testing.T) {
+	db, runStore := initializeRunStore()
+	db.Close()
+
+	err := runStore.DeleteRuns([]string{"1", "2"})
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode(),
+		"Expected delete runs to return internal error")
+}
+
+func TestTerminateRuns(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	// Run 2 has already finished and does-not-exist is not found, so only run 1
+	// is terminated.
+	terminated, err := runStore.TerminateRuns([]string{"1", "2", "does-not-exist"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1"}, terminated)
+
+	run, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStateCancelling, run.State)
+	assert.Equal(t, "Terminating", run.Conditions)
+}
+
+func TestUpdateRuns(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.UpdateRuns([]*model.Run{
+		{UUID: "1", RunDetails: model.RunDetails{Conditions: "Running", State: model.RuntimeStateRunning, WorkflowRuntimeManifest: "workflow1"}},
+		{UUID: "2", RunDetails: model.RunDetails{Conditions: "Running", State: model.RuntimeStateRunning, WorkflowRuntimeManifest: "workflow2"}},
+	})
+	assert.Nil(t, err)
+	for _, runId := range []string{"1", "2"} {
+		run, err := runStore.GetRun(runId)
+		assert.Nil(t, err)
+		assert.Equal(t, model.RuntimeStateRunning, run.State)
+		assert.Equal(t, "workflow"+runId, run.WorkflowRuntimeManifest)
+	}
+
+	// The runs are updated in a single transaction, so none is updated if one
+	// is not found.
+	err = runStore.UpdateRuns([]*model.Run{
+		{UUID: "1", RunDetails: model.RunDetails{Conditions: "Succeeded", State: model.RuntimeStateSucceeded}},
+		{UUID: "does-not-exist", RunDetails: model.RunDetails{Conditions: "Succeeded", State: model.RuntimeStateSucceeded}},
+	})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	run, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, model.RuntimeStateRunning, run.State)
+}
+
+func TestCreateAndDeleteStaleRunNotifications(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	err := runStore.UpdateRuns([]*model.Run{
+		{UUID: "1", RunDetails: model.RunDetails{Conditions: "Pending", State: model.RuntimeStatePending}},
+		{UUID: "2", RunDetails: model.RunDetails{Conditions: "Running", State: model.RuntimeStateRunning}},
+	})
+	assert.Nil(t, err)
+
+	for _, runId := range []string{"1", "2"} {
+		err = runStore.CreateRunNotification(&model.RunNotification{RunUUID: runId, WebhookHash: "hash", State: model.RuntimeStatePending, NotifiedAtInSec: 1})
+		assert.Nil(t, err)
+	}
+	err = runStore.CreateRunNotification(&model.RunNotification{RunUUID: "1", WebhookHash: "hash", State: model.RuntimeStatePending, NotifiedAtInSec: 2})
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+
+	// Only the notification of the run that is no longer pending is deleted.
+	assert.Nil(t, runStore.DeleteStaleRunNotifications(model.RuntimeStatePending))
+	err = runStore.CreateRunNotification(&model.RunNotification{RunUUID: "1", WebhookHash: "hash", State: model.RuntimeStatePending, NotifiedAtInSec: 2})
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+	err = runStore.CreateRunNotification(&model.RunNotification{RunUUID: "2", WebhookHash: "hash", State: model.RuntimeStatePending, NotifiedAtInSec: 2})
+	assert.Nil(t, err)
+}
+
+func TestRunNotificationDelivery(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	err := runStore.CreateRunNotification(&model.RunNotification{RunUUID: "1", WebhookHash: "hash", State: model.RuntimeStateFailed, NotifiedAtInSec: 1})
+	assert.Nil(t, err)
+
+	notifications, err := runStore.ListUndeliveredRunNotifications(1)
+	assert.Nil(t, err)
+	assert.Empty(t, notifications)
+	notifications, err = runStore.ListUndeliveredRunNotifications(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunNotification{{RunUUID: "1", WebhookHash: "hash", State: model.RuntimeStateFailed, NotifiedAtInSec: 1}}, notifications)
+
+	// A notification is claimed once.
+	stale := *notifications[0]
+	assert.Nil(t, runStore.ClaimRunNotification(notifications[0], 5))
+	assert.Equal(t, int64(5), notifications[0].NotifiedAtInSec)
+	err = runStore.ClaimRunNotification(&stale, 6)
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+
+	assert.Nil(t, runStore.MarkRunNotificationDelivered(notifications[0], 7))
+	notifications, err = runStore.ListUndeliveredRunNotifications(10)
+	assert.Nil(t, err)
+	assert.Empty(t, notifications)
+}
+
 func TestParseMetrics(t *testing.T) {
 	expectedModelRunMetrics := []*model.RunMetric{
 		{
@@ -1427,3 +1730,34 @@ func TestRunAPIFieldMap(t *testing.T) {
 		assert.Contains(t, runColumns, modelField)
 	}
 }
+
+func TestRunChanges(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	oldest, latest, err := runStore.GetRunChangeSequences()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), oldest)
+	assert.Equal(t, int64(0), latest)
+
+	for _, runId := range []string{"1", "2", "3"} {
+		err = runStore.CreateRunChange(&model.RunChange{Type: "UPDATED", RunUUID: runId, Namespace: "ns1", State: model.RuntimeStateRunning, PreviousState: model.RuntimeStatePending})
+		assert.Nil(t, err)
+	}
+	changes, err := runStore.ListRunChanges(1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunChange{
+		{Sequence: 2, Type: "UPDATED", RunUUID: "2", Namespace: "ns1", State: model.RuntimeStateRunning, PreviousState: model.RuntimeStatePending},
+		{Sequence: 3, Type: "UPDATED", RunUUID: "3", Namespace: "ns1", State: model.RuntimeStateRunning, PreviousState: model.RuntimeStatePending},
+	}, changes)
+	changes, err = runStore.ListRunChanges(0, 1)
+	assert.Nil(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, int64(1), changes[0].Sequence)
+
+	assert.Nil(t, runStore.DeleteRunChanges(2))
+	oldest, latest, err = runStore.GetRunChangeSequences()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), oldest)
+	assert.Equal(t, int64(3), latest)
+}
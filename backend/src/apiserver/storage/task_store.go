@@ -62,6 +62,14 @@ type TaskStoreInterface interface {
 
 	// Creates new tasks or updates the existing ones.
 	CreateOrUpdateTasks(tasks []*model.Task) ([]*model.Task, error)
+
+	// Creates new tasks or updates the existing ones. Also returns the tasks
+	// as they were before the update, keyed by pod name.
+	UpsertTasks(tasks []*model.Task) ([]*model.Task, map[string]*model.Task, error)
+
+	// Clears the fingerprint of the cached tasks matching all non-empty selectors,
+	// so that their results are no longer reused. Returns the number of tasks invalidated.
+	InvalidateCacheEntries(namespace string, fingerprint string, runId string, pipelineName string) (int64, error)
 }
 
 type TaskStore struct {
@@ -323,8 +331,9 @@ func (s *TaskStore) GetTask(id string) (*model.Task, error) {
 	return tasks[0], nil
 }
 
-// Updates missing fields with existing data entries.
-func (s *TaskStore) patchWithExistingTasks(tasks []*model.Task) error {
+// Updates missing fields with existing data entries. Returns the existing
+// tasks, keyed by pod name.
+func (s *TaskStore) patchWithExistingTasks(tasks []*model.Task) (map[string]*model.Task, error) {
 	var podNames []string
 	for _, task := range tasks {
 		podNames = append(podNames, task.PodName)
@@ -335,16 +344,16 @@ func (s *TaskStore) patchWithExistingTasks(tasks []*model.Task) error {
 		Where(sq.Eq{"PodName": podNames}).
 		ToSql()
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to create query to check existing tasks")
+		return nil, util.NewInternalServerError(err, "Failed to create query to check existing tasks")
 	}
 	r, err := s.db.Query(sql, args...)
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to check existing tasks")
+		return nil, util.NewInternalServerError(err, "Failed to check existing tasks")
 	}
 	defer r.Close()
 	existingTasks, err := s.scanRows(r)
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to parse existing tasks")
+		return nil, util.NewInternalServerError(err, "Failed to parse existing tasks")
 	}
 	mapTasks := make(map[string]*model.Task, 0)
 	for _, task := range existingTasks {
@@ -355,11 +364,16 @@ func (s *TaskStore) patchWithExistingTasks(tasks []*model.Task) error {
 			patchTask(task, existingTask)
 		}
 	}
-	return nil
+	return mapTasks, nil
 }
 
 // Creates new entries or updates existing ones.
 func (s *TaskStore) CreateOrUpdateTasks(tasks []*model.Task) ([]*model.Task, error) {
+	tasks, _, err := s.UpsertTasks(tasks)
+	return tasks, err
+}
+
+func (s *TaskStore) UpsertTasks(tasks []*model.Task) ([]*model.Task, map[string]*model.Task, error) {
 	buildQuery := func(ts []*model.Task) (string, []interface{}, error) {
 		sqlInsert := sq.Insert("tasks").Columns(taskColumnsWithPayload...)
 		for _, t := range ts {
@@ -405,15 +419,16 @@ func (s *TaskStore) CreateOrUpdateTasks(tasks []*model.Task) ([]*model.Task, err
 
 	// Check for existing tasks and fill empty field with existing data.
 	// Assumes that PodName column is a unique key.
-	if err := s.patchWithExistingTasks(tasks); err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to check for existing tasks")
+	existingTasks, err := s.patchWithExistingTasks(tasks)
+	if err != nil {
+		return nil, nil, util.NewInternalServerError(err, "Failed to check for existing tasks")
 	}
 	for _, task := range tasks {
 		task.State = task.State.ToV2()
 		if task.UUID == "" {
 			id, err := s.uuid.NewRandom()
 			if err != nil {
-				return nil, util.NewInternalServerError(err, "Failed to create an task id")
+				return nil, nil, util.NewInternalServerError(err, "Failed to create an task id")
 			}
 			task.UUID = id.String()
 		}
@@ -430,14 +445,43 @@ func (s *TaskStore) CreateOrUpdateTasks(tasks []*model.Task) ([]*model.Task, err
 	// Execute the query
 	sql, arg, err := buildQuery(tasks)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to build query to update or insert tasks")
+		return nil, nil, util.NewInternalServerError(err, "Failed to build query to update or insert tasks")
 	}
 	sql = s.db.Upsert(sql, "UUID", true, taskColumnsWithPayload...)
 	_, err = s.db.Exec(sql, arg...)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to update or insert tasks. Query: %v. Args: %v", sql, arg)
+		return nil, nil, util.NewInternalServerError(err, "Failed to update or insert tasks. Query: %v. Args: %v", sql, arg)
 	}
-	return tasks, nil
+	return tasks, existingTasks, nil
+}
+
+func (s *TaskStore) InvalidateCacheEntries(namespace string, fingerprint string, runId string, pipelineName string) (int64, error) {
+	sqlBuilder := sq.Update("tasks").Set("Fingerprint", "").Where(sq.NotEq{"Fingerprint": ""})
+	if namespace != "" {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": namespace})
+	}
+	if fingerprint != "" {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{"Fingerprint": fingerprint})
+	}
+	if runId != "" {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{"RunUUID": runId})
+	}
+	if pipelineName != "" {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{"PipelineName": pipelineName})
+	}
+	sql, args, err := sqlBuilder.ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to invalidate cache entries: %v", err.Error())
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to invalidate cache entries: %v", err.Error())
+	}
+	r, err := result.RowsAffected()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to count the invalidated cache entries: %v", err.Error())
+	}
+	return r, nil
 }
 
 // Fills empty fields in a new task with the data from an existing task.
@@ -289,6 +289,37 @@ func TestTaskStore_GetTask(t *testing.T) {
 	}
 }
 
+func TestTaskStore_InvalidateCacheEntries(t *testing.T) {
+	db, taskStore := initializeTaskStore()
+	defer db.Close()
+
+	// Fingerprint "1" is shared by tasks in both namespaces; only ns1 is invalidated.
+	count, err := taskStore.InvalidateCacheEntries("ns1", "1", "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+
+	task, err := taskStore.GetTask(defaultFakeTaskId)
+	assert.Nil(t, err)
+	assert.Empty(t, task.Fingerprint)
+	assert.Equal(t, "pod1", task.PodName)
+	task, err = taskStore.GetTask(defaultFakeTaskIdFour)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", task.Fingerprint)
+
+	// Already invalidated entries are not counted again.
+	count, err = taskStore.InvalidateCacheEntries("ns1", "", defaultFakeRunId, "")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = taskStore.InvalidateCacheEntries("", "", "", "namespace/ns2/pipeline/pipeline2")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = taskStore.InvalidateCacheEntries("", "1", "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
 func TestTaskStore_patchWithExistingTasks(t *testing.T) {
 	db, taskStore := initializeTaskStore()
 	defer db.Close()
@@ -504,7 +535,7 @@ func TestTaskStore_patchWithExistingTasks(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := taskStore.patchWithExistingTasks(tt.tasks)
+			_, err := taskStore.patchWithExistingTasks(tt.tasks)
 			if tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Contains(t, err.Error(), tt.errMsg)
@@ -623,3 +654,20 @@ func TestTaskAPIFieldMap(t *testing.T) {
 		assert.Contains(t, taskColumns, modelField)
 	}
 }
+
+func TestUpsertTasks_ExistingTasks(t *testing.T) {
+	db, taskStore := initializeTaskStore()
+	defer db.Close()
+
+	tasks, existingTasks, err := taskStore.UpsertTasks([]*model.Task{
+		{Namespace: "ns2", PipelineName: "namespace/ns2/pipeline/pipeline2", PodName: "pod4", RunId: defaultFakeRunIdTwo, State: model.RuntimeStateSucceeded},
+		{Namespace: "ns2", PipelineName: "namespace/ns2/pipeline/pipeline2", PodName: "pod99", RunId: defaultFakeRunIdTwo, State: model.RuntimeStateRunning},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, tasks, 2)
+	// Only the task that existed is returned, as it was before the update.
+	assert.Len(t, existingTasks, 1)
+	assert.Equal(t, defaultFakeTaskIdFour, existingTasks["pod4"].UUID)
+	assert.Equal(t, defaultFakeTaskIdFour, tasks[0].UUID)
+	assert.NotEqual(t, model.RuntimeStateSucceeded, existingTasks["pod4"].State)
+}
@@ -66,6 +66,7 @@ func (t *Argo) RunWorkflow(modelRun *model.Run, options RunWorkflowOptions) (uti
 		return nil, err
 	}
 
+	setUserLabels(workflow, modelRun.Labels)
 	// Add label to the workflow so it can be persisted by persistent agent later.
 	workflow.SetLabels(util.LabelKeyWorkflowRunId, options.RunId)
 	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
@@ -116,6 +117,7 @@ func (t *Argo) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Schedu
 	// Append provided parameter
 	workflow.OverrideParameters(parameters)
 	setDefaultServiceAccount(workflow, modelJob.ServiceAccount)
+	setUserLabels(workflow, modelJob.Labels)
 	// Disable istio sidecar injection if not specified
 	workflow.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
 
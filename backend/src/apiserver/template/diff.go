@@ -0,0 +1,332 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The platform whose executor configs are compared for v2 templates.
+const kubernetesPlatform = "kubernetes"
+
+// Argo template fields that configure the pod of a step rather than what it runs.
+var argoPlatformFields = map[string]bool{
+	"affinity":                     true,
+	"automountServiceAccountToken": true,
+	"metadata":                     true,
+	"nodeSelector":                 true,
+	"podSpecPatch":                 true,
+	"priorityClassName":            true,
+	"schedulerName":                true,
+	"securityContext":              true,
+	"serviceAccountName":           true,
+	"tolerations":                  true,
+	"volumes":                      true,
+}
+
+var changeTypeOrder = map[model.PipelineVersionChangeType]int{
+	model.PipelineVersionChangeTypeParameterAdded:   0,
+	model.PipelineVersionChangeTypeParameterRemoved: 1,
+	model.PipelineVersionChangeTypeParameterChanged: 2,
+	model.PipelineVersionChangeTypeTaskAdded:        3,
+	model.PipelineVersionChangeTypeTaskRemoved:      4,
+	model.PipelineVersionChangeTypeTaskChanged:      5,
+	model.PipelineVersionChangeTypeImageChanged:     6,
+	model.PipelineVersionChangeTypePlatformChanged:  7,
+}
+
+// templateSummary holds the parts of a template that are compared by Diff.
+type templateSummary struct {
+	// Input parameters of the pipeline, keyed by name.
+	parameters map[string]*structpb.Value
+	tasks      map[string]*taskSummary
+}
+
+type taskSummary struct {
+	image string
+	// Fields of the task, its component and its executor, keyed by the field
+	// name prefixed with where it is defined, e.g. "component.inputDefinitions".
+	fields map[string]*structpb.Value
+	// Kubernetes settings of the task, keyed by field name.
+	platform map[string]*structpb.Value
+}
+
+// Diff compares the templates of two pipeline versions. Both templates must be
+// of the same type. Tasks are matched by name. Tasks of nested DAGs are named by
+// their path from the root DAG, e.g. "outer/inner". The changes are ordered by
+// type, task name and key.
+func Diff(base Template, target Template) ([]*model.PipelineVersionChange, error) {
+	if base.GetTemplateType() != target.GetTemplateType() {
+		return nil, util.NewInvalidInputError("Cannot diff a %v template against a %v template", base.GetTemplateType(), target.GetTemplateType())
+	}
+	baseSummary, err := summarize(base)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to summarize the base template")
+	}
+	targetSummary, err := summarize(target)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to summarize the target template")
+	}
+	return diffSummaries(baseSummary, targetSummary), nil
+}
+
+func summarize(t Template) (*templateSummary, error) {
+	switch t := t.(type) {
+	case *V2Spec:
+		return summarizeV2Spec(t)
+	case *Argo:
+		return summarizeArgo(t)
+	default:
+		return nil, util.NewInvalidInputError("Cannot diff a template of type %v", t.GetTemplateType())
+	}
+}
+
+func summarizeV2Spec(t *V2Spec) (*templateSummary, error) {
+	spec := t.PipelineSpec()
+	summary := &templateSummary{
+		parameters: make(map[string]*structpb.Value),
+		tasks:      make(map[string]*taskSummary),
+	}
+	for name, parameter := range spec.GetRoot().GetInputDefinitions().GetParameters() {
+		value, err := protoToValue(parameter)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to convert input parameter %v", name)
+		}
+		summary.parameters[name] = value
+	}
+
+	executors := spec.GetDeploymentSpec().GetFields()["executors"].GetStructValue().GetFields()
+	var platformExecutors map[string]*structpb.Struct
+	if platformSpec := t.PlatformSpec(); platformSpec != nil {
+		platformExecutors = platformSpec.GetPlatforms()[kubernetesPlatform].GetDeploymentSpec().GetExecutors()
+	}
+
+	var summarizeDag func(prefix string, dag *pipelinespec.DagSpec) error
+	summarizeDag = func(prefix string, dag *pipelinespec.DagSpec) error {
+		for name, task := range dag.GetTasks() {
+			path := prefix + name
+			taskSummary := &taskSummary{
+				fields:   make(map[string]*structpb.Value),
+				platform: make(map[string]*structpb.Value),
+			}
+			summary.tasks[path] = taskSummary
+			if err := addProtoFields(taskSummary.fields, "task.", task); err != nil {
+				return util.Wrapf(err, "Failed to convert task %v", path)
+			}
+			component := spec.GetComponents()[task.GetComponentRef().GetName()]
+			if component == nil {
+				continue
+			}
+			// Nested DAGs are compared task by task, and executors are compared below.
+			if err := addProtoFields(taskSummary.fields, "component.", component, "dag", "executorLabel"); err != nil {
+				return util.Wrapf(err, "Failed to convert the component of task %v", path)
+			}
+			if component.GetDag() != nil {
+				if err := summarizeDag(path+"/", component.GetDag()); err != nil {
+					return err
+				}
+				continue
+			}
+			executorLabel := component.GetExecutorLabel()
+			// An executor has a single field naming its kind, e.g. "container" or "importer".
+			for kind, executor := range executors[executorLabel].GetStructValue().GetFields() {
+				for field, value := range executor.GetStructValue().GetFields() {
+					if kind == "container" && field == "image" {
+						taskSummary.image = value.GetStringValue()
+						continue
+					}
+					taskSummary.fields[kind+"."+field] = value
+				}
+			}
+			for field, value := range platformExecutors[executorLabel].GetFields() {
+				taskSummary.platform[field] = value
+			}
+		}
+		return nil
+	}
+	if err := summarizeDag("", spec.GetRoot().GetDag()); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func summarizeArgo(t *Argo) (*templateSummary, error) {
+	summary := &templateSummary{
+		parameters: make(map[string]*structpb.Value),
+		tasks:      make(map[string]*taskSummary),
+	}
+	for _, parameter := range t.wf.Spec.Arguments.Parameters {
+		value, err := jsonToValue(parameter)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to convert parameter %v", parameter.Name)
+		}
+		summary.parameters[parameter.Name] = value
+	}
+	// Each Argo template is compared as a task.
+	for _, argoTemplate := range t.wf.Spec.Templates {
+		value, err := jsonToValue(argoTemplate)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to convert template %v", argoTemplate.Name)
+		}
+		taskSummary := &taskSummary{
+			fields:   make(map[string]*structpb.Value),
+			platform: make(map[string]*structpb.Value),
+		}
+		for field, fieldValue := range value.GetStructValue().GetFields() {
+			switch {
+			case field == "name":
+			case field == "container" || field == "script":
+				for containerField, containerValue := range fieldValue.GetStructValue().GetFields() {
+					if containerField == "image" {
+						taskSummary.image = containerValue.GetStringValue()
+						continue
+					}
+					taskSummary.fields[field+"."+containerField] = containerValue
+				}
+			case argoPlatformFields[field]:
+				taskSummary.platform[field] = fieldValue
+			default:
+				taskSummary.fields["template."+field] = fieldValue
+			}
+		}
+		summary.tasks[argoTemplate.Name] = taskSummary
+	}
+	return summary, nil
+}
+
+func diffSummaries(base *templateSummary, target *templateSummary) []*model.PipelineVersionChange {
+	var changes []*model.PipelineVersionChange
+	addChange := func(changeType model.PipelineVersionChangeType, taskName string, key string, baseValue *structpb.Value, targetValue *structpb.Value) {
+		changes = append(changes, &model.PipelineVersionChange{
+			Type:        changeType,
+			TaskName:    taskName,
+			Key:         key,
+			BaseValue:   baseValue,
+			TargetValue: targetValue,
+		})
+	}
+	diffValues := func(changeType model.PipelineVersionChangeType, taskName string, baseValues map[string]*structpb.Value, targetValues map[string]*structpb.Value) {
+		for _, key := range sortedUnionKeys(baseValues, targetValues) {
+			baseValue, targetValue := baseValues[key], targetValues[key]
+			if !proto.Equal(baseValue, targetValue) {
+				addChange(changeType, taskName, key, baseValue, targetValue)
+			}
+		}
+	}
+
+	for _, name := range sortedUnionKeys(base.parameters, target.parameters) {
+		baseValue, inBase := base.parameters[name]
+		targetValue, inTarget := target.parameters[name]
+		switch {
+		case !inBase:
+			addChange(model.PipelineVersionChangeTypeParameterAdded, "", name, nil, targetValue)
+		case !inTarget:
+			addChange(model.PipelineVersionChangeTypeParameterRemoved, "", name, baseValue, nil)
+		case !proto.Equal(baseValue, targetValue):
+			addChange(model.PipelineVersionChangeTypeParameterChanged, "", name, baseValue, targetValue)
+		}
+	}
+	for _, name := range sortedUnionKeys(base.tasks, target.tasks) {
+		baseTask, targetTask := base.tasks[name], target.tasks[name]
+		switch {
+		case baseTask == nil:
+			addChange(model.PipelineVersionChangeTypeTaskAdded, name, "", nil, nil)
+		case targetTask == nil:
+			addChange(model.PipelineVersionChangeTypeTaskRemoved, name, "", nil, nil)
+		default:
+			diffValues(model.PipelineVersionChangeTypeTaskChanged, name, baseTask.fields, targetTask.fields)
+			if baseTask.image != targetTask.image {
+				addChange(model.PipelineVersionChangeTypeImageChanged, name, "", structpb.NewStringValue(baseTask.image), structpb.NewStringValue(targetTask.image))
+			}
+			diffValues(model.PipelineVersionChangeTypePlatformChanged, name, baseTask.platform, targetTask.platform)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Type != changes[j].Type {
+			return changeTypeOrder[changes[i].Type] < changeTypeOrder[changes[j].Type]
+		}
+		if changes[i].TaskName != changes[j].TaskName {
+			return changes[i].TaskName < changes[j].TaskName
+		}
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+// Adds the top-level fields of a proto message to values, prefixing their names
+// and skipping the excluded fields.
+func addProtoFields(values map[string]*structpb.Value, prefix string, message proto.Message, excludedFields ...string) error {
+	value, err := protoToValue(message)
+	if err != nil {
+		return err
+	}
+	excluded := make(map[string]bool, len(excludedFields))
+	for _, field := range excludedFields {
+		excluded[field] = true
+	}
+	for field, fieldValue := range value.GetStructValue().GetFields() {
+		if !excluded[field] {
+			values[prefix+field] = fieldValue
+		}
+	}
+	return nil
+}
+
+func protoToValue(message proto.Message) (*structpb.Value, error) {
+	bytes, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	value := &structpb.Value{}
+	if err := protojson.Unmarshal(bytes, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func jsonToValue(v interface{}) (*structpb.Value, error) {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	value := &structpb.Value{}
+	if err := protojson.Unmarshal(bytes, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func sortedUnionKeys[V any](a map[string]V, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
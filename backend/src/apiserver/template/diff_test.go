@@ -0,0 +1,137 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var argoDiffTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: hello-world-
+spec:
+  entrypoint: hello
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: hello
+    nodeSelector:
+      pool: default
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+      args: ["{{workflow.parameters.message}}"]
+`
+
+type changeSummary struct {
+	changeType model.PipelineVersionChangeType
+	taskName   string
+	key        string
+}
+
+func summarizeChanges(changes []*model.PipelineVersionChange) []changeSummary {
+	summaries := make([]changeSummary, 0, len(changes))
+	for _, change := range changes {
+		summaries = append(summaries, changeSummary{changeType: change.Type, taskName: change.TaskName, key: change.Key})
+	}
+	return summaries
+}
+
+func diffTemplates(t *testing.T, base string, target string) []*model.PipelineVersionChange {
+	baseTemplate, err := New([]byte(base), false, nil)
+	require.Nil(t, err)
+	targetTemplate, err := New([]byte(target), false, nil)
+	require.Nil(t, err)
+	changes, err := Diff(baseTemplate, targetTemplate)
+	require.Nil(t, err)
+	return changes
+}
+
+func TestDiff_V2(t *testing.T) {
+	base := loadYaml(t, "testdata/hello_world.yaml")
+
+	changes := diffTemplates(t, base, base)
+	assert.Empty(t, changes)
+
+	target := strings.Replace(base, "image: python:3.9", "image: python:3.11", 1)
+	target = strings.Replace(target, "enableCache: true", "enableCache: false", 1)
+	target = strings.Replace(target, "      y:\n        parameterType: STRING", "      y:\n        defaultValue: hi\n        parameterType: STRING", 1)
+	changes = diffTemplates(t, base, target)
+	assert.Equal(t, []changeSummary{
+		{changeType: model.PipelineVersionChangeTypeParameterChanged, key: "y"},
+		{changeType: model.PipelineVersionChangeTypeTaskChanged, taskName: "hello-world", key: "task.cachingOptions"},
+		{changeType: model.PipelineVersionChangeTypeImageChanged, taskName: "hello-world"},
+	}, summarizeChanges(changes))
+	assert.Equal(t, "hi", changes[0].TargetValue.GetStructValue().GetFields()["defaultValue"].GetStringValue())
+	assert.Nil(t, changes[0].BaseValue.GetStructValue().GetFields()["defaultValue"])
+	assert.Equal(t, "python:3.9", changes[2].BaseValue.GetStringValue())
+	assert.Equal(t, "python:3.11", changes[2].TargetValue.GetStringValue())
+
+	// Renaming a task removes the old task and adds a new one.
+	target = strings.Replace(base, "      hello-world:\n", "      hello:\n", 1)
+	changes = diffTemplates(t, base, target)
+	assert.Equal(t, []changeSummary{
+		{changeType: model.PipelineVersionChangeTypeTaskAdded, taskName: "hello"},
+		{changeType: model.PipelineVersionChangeTypeTaskRemoved, taskName: "hello-world"},
+	}, summarizeChanges(changes))
+}
+
+func TestDiff_V2_PlatformSpec(t *testing.T) {
+	base := loadYaml(t, "testdata/pipeline_with_volume.yaml")
+	target := strings.Replace(base, "mountPath: /reused_data", "mountPath: /data", 1)
+
+	changes := diffTemplates(t, base, target)
+	assert.Equal(t, []changeSummary{
+		{changeType: model.PipelineVersionChangeTypePlatformChanged, taskName: "comp-2", key: "pvcMount"},
+	}, summarizeChanges(changes))
+}
+
+func TestDiff_Argo(t *testing.T) {
+	target := strings.Replace(argoDiffTemplate, "value: hello", "value: bye", 1)
+	target = strings.Replace(target, "image: docker/whalesay", "image: docker/whalesay:latest", 1)
+	target = strings.Replace(target, "pool: default", "pool: gpu", 1)
+	target = strings.Replace(target, "command: [cowsay]", "command: [cowsay, -f, dragon]", 1)
+
+	changes := diffTemplates(t, argoDiffTemplate, target)
+	assert.Equal(t, []changeSummary{
+		{changeType: model.PipelineVersionChangeTypeParameterChanged, key: "message"},
+		{changeType: model.PipelineVersionChangeTypeTaskChanged, taskName: "hello", key: "container.command"},
+		{changeType: model.PipelineVersionChangeTypeImageChanged, taskName: "hello"},
+		{changeType: model.PipelineVersionChangeTypePlatformChanged, taskName: "hello", key: "nodeSelector"},
+	}, summarizeChanges(changes))
+	assert.Equal(t, map[string]interface{}{"name": "message", "value": "bye"}, changes[0].TargetValue.GetStructValue().AsMap())
+	assert.Equal(t, structpb.NewStringValue("docker/whalesay:latest").AsInterface(), changes[2].TargetValue.AsInterface())
+}
+
+func TestDiff_DifferentTemplateTypes(t *testing.T) {
+	v1Template, err := New([]byte(argoDiffTemplate), false, nil)
+	require.Nil(t, err)
+	v2Template, err := New([]byte(loadYaml(t, "testdata/hello_world.yaml")), false, nil)
+	require.Nil(t, err)
+
+	_, err = Diff(v1Template, v2Template)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Cannot diff a v1Argo template against a v2 template")
+}
@@ -243,6 +243,9 @@ func modelToCRDTrigger(modelTrigger model.Trigger) (scheduledworkflow.Trigger, e
 		if modelTrigger.Cron != nil {
 			crdCronSchedule.Cron = *modelTrigger.Cron
 		}
+		if modelTrigger.CronScheduleTimeZone != nil {
+			crdCronSchedule.TimeZone = *modelTrigger.CronScheduleTimeZone
+		}
 		if modelTrigger.CronScheduleStartTimeInSec != nil {
 			startTime := metav1.NewTime(time.Unix(*modelTrigger.CronScheduleStartTimeInSec, 0))
 			crdCronSchedule.StartTime = &startTime
@@ -268,6 +271,26 @@ func modelToCRDTrigger(modelTrigger model.Trigger) (scheduledworkflow.Trigger, e
 		}
 		crdTrigger.PeriodicSchedule = &crdPeriodicSchedule
 	}
+	eventTrigger, err := modelTrigger.GetEventTrigger()
+	if err != nil {
+		return crdTrigger, util.NewInternalServerError(err, "Failed to parse the event trigger")
+	}
+	if eventTrigger != nil && eventTrigger.ObjectStore != nil {
+		crdTrigger.ObjectStoreTrigger = &scheduledworkflow.ObjectStoreTrigger{
+			BucketURL:          eventTrigger.ObjectStore.BucketURL,
+			Provider:           eventTrigger.ObjectStore.Provider,
+			ProviderParams:     eventTrigger.ObjectStore.ProviderParams,
+			PollIntervalSecond: eventTrigger.ObjectStore.PollIntervalSecond,
+			PayloadParameters:  eventTrigger.ObjectStore.PayloadParameters,
+		}
+	}
+	if eventTrigger != nil && eventTrigger.Webhook != nil {
+		crdTrigger.WebhookTrigger = &scheduledworkflow.WebhookTrigger{
+			SecretName:        eventTrigger.Webhook.SecretName,
+			SecretKey:         eventTrigger.Webhook.SecretKey,
+			PayloadParameters: eventTrigger.Webhook.PayloadParameters,
+		}
+	}
 	return crdTrigger, nil
 }
 
@@ -310,6 +333,14 @@ func setDefaultServiceAccount(workflow util.ExecutionSpec, serviceAccount string
 	}
 }
 
+// setUserLabels copies the user-defined labels of a run or a recurring run to
+// the execution spec. System labels set afterwards take precedence.
+func setUserLabels(workflow util.ExecutionSpec, labels map[string]string) {
+	for key, value := range labels {
+		workflow.SetLabels(key, value)
+	}
+}
+
 // Process the job name to remove special char, prepend with "job-" prefix if empty, and
 // truncate size to <=25.
 func toSWFCRDResourceGeneratedName(displayName string) (string, error) {
@@ -245,17 +245,75 @@ func TestScheduledWorkflow(t *testing.T) {
 	assert.Equal(t, &expectedScheduledWorkflow, actualScheduledWorkflow)
 }
 
+func TestScheduledWorkflow_Labels(t *testing.T) {
+	proxy.InitializeConfigWithEmptyForTests()
+
+	v2SpecHelloWorldYAML := loadYaml(t, "testdata/hello_world.yaml")
+	v2Template, _ := New([]byte(v2SpecHelloWorldYAML), true, defaultPVC)
+
+	modelJob := &model.Job{
+		K8SName:        "name1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Labels:         map[string]string{"team": "vision"},
+		Trigger: model.Trigger{
+			PeriodicSchedule: model.PeriodicSchedule{IntervalSecond: util.Int64Pointer(60)},
+		},
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorldYAML,
+			RuntimeConfig: model.RuntimeConfig{
+				Parameters: "{\"y\":\"world\"}",
+			},
+		},
+	}
+
+	scheduledWorkflow, err := v2Template.ScheduledWorkflow(modelJob)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, scheduledWorkflow.Labels)
+	// The labels are set on the workflows created by the scheduled workflow as well.
+	workflow, err := util.NewExecutionSpecJSON(util.ArgoWorkflow, []byte(scheduledWorkflow.Spec.Workflow.Spec.(string)))
+	assert.Nil(t, err)
+	assert.Equal(t, "vision", workflow.ExecutionObjectMeta().Labels["team"])
+}
+
+func TestRunWorkflow_Labels(t *testing.T) {
+	proxy.InitializeConfigWithEmptyForTests()
+
+	v2SpecHelloWorldYAML := loadYaml(t, "testdata/hello_world.yaml")
+	v2Template, _ := New([]byte(v2SpecHelloWorldYAML), true, defaultPVC)
+
+	modelRun := &model.Run{
+		DisplayName: "run1",
+		Labels:      map[string]string{"team": "vision", util.LabelKeyWorkflowRunId: "overridden"},
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: v2SpecHelloWorldYAML,
+			RuntimeConfig: model.RuntimeConfig{
+				Parameters: "{\"y\":\"world\"}",
+			},
+		},
+	}
+
+	workflow, err := v2Template.RunWorkflow(modelRun, RunWorkflowOptions{RunId: "123", RunAt: 1})
+	assert.Nil(t, err)
+	labels := workflow.ExecutionObjectMeta().Labels
+	assert.Equal(t, "vision", labels["team"])
+	// System labels take precedence over the labels of the run.
+	assert.Equal(t, "123", labels[util.LabelKeyWorkflowRunId])
+}
+
 func TestModelToCRDTrigger_Cron(t *testing.T) {
 	inputModelTrigger := model.Trigger{
 		CronSchedule: model.CronSchedule{
 			CronScheduleStartTimeInSec: util.Int64Pointer(1),
 			CronScheduleEndTimeInSec:   util.Int64Pointer(10),
 			Cron:                       util.StringPointer("1 * * * *"),
+			CronScheduleTimeZone:       util.StringPointer("Europe/Berlin"),
 		},
 	}
 	expectedCRDTrigger := scheduledworkflow.Trigger{
 		CronSchedule: &scheduledworkflow.CronSchedule{
 			Cron:      "1 * * * *",
+			TimeZone:  "Europe/Berlin",
 			StartTime: &metav1.Time{Time: time.Unix(1, 0)},
 			EndTime:   &metav1.Time{Time: time.Unix(10, 0)},
 		},
@@ -266,6 +324,39 @@ func TestModelToCRDTrigger_Cron(t *testing.T) {
 	assert.Equal(t, expectedCRDTrigger, actualCRDTrigger)
 }
 
+func TestModelToCRDTrigger_EventTriggers(t *testing.T) {
+	inputModelTrigger := model.Trigger{}
+	err := inputModelTrigger.SetEventTrigger(&model.EventTrigger{
+		ObjectStore: &model.ObjectStoreTrigger{
+			BucketURL:          "s3://bucket/incoming",
+			PollIntervalSecond: 30,
+			PayloadParameters:  map[string]string{"path": "uri"},
+		},
+	})
+	assert.Nil(t, err)
+	expectedCRDTrigger := scheduledworkflow.Trigger{
+		ObjectStoreTrigger: &scheduledworkflow.ObjectStoreTrigger{
+			BucketURL:          "s3://bucket/incoming",
+			PollIntervalSecond: 30,
+			PayloadParameters:  map[string]string{"path": "uri"},
+		},
+	}
+	actualCRDTrigger, err := modelToCRDTrigger(inputModelTrigger)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedCRDTrigger, actualCRDTrigger)
+
+	err = inputModelTrigger.SetEventTrigger(&model.EventTrigger{
+		Webhook: &model.WebhookTrigger{SecretName: "webhook-secret"},
+	})
+	assert.Nil(t, err)
+	expectedCRDTrigger = scheduledworkflow.Trigger{
+		WebhookTrigger: &scheduledworkflow.WebhookTrigger{SecretName: "webhook-secret"},
+	}
+	actualCRDTrigger, err = modelToCRDTrigger(inputModelTrigger)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedCRDTrigger, actualCRDTrigger)
+}
+
 func loadYaml(t *testing.T, path string) string {
 	res, err := os.ReadFile(path)
 	if err != nil {
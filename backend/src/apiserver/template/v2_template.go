@@ -30,6 +30,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/native"
 	"google.golang.org/protobuf/encoding/protojson"
 	goyaml "gopkg.in/yaml.v3"
 	corev1 "k8s.io/api/core/v1"
@@ -64,7 +65,7 @@ func NewGenericScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Schedu
 			APIVersion: "kubeflow.org/v2beta1",
 			Kind:       "ScheduledWorkflow",
 		},
-		ObjectMeta: metav1.ObjectMeta{GenerateName: swfGeneratedName},
+		ObjectMeta: metav1.ObjectMeta{GenerateName: swfGeneratedName, Labels: modelJob.Labels},
 		Spec: scheduledworkflow.ScheduledWorkflowSpec{
 			Enabled:           modelJob.Enabled,
 			MaxConcurrency:    &modelJob.MaxConcurrency,
@@ -126,13 +127,13 @@ func (t *V2Spec) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Sche
 			DefaultWorkspace: t.defaultWorkspace,
 		}
 		obj, err = argocompiler.Compile(job, kubernetesSpec, opts)
+	} else if util.CurrentExecutionType() == util.NativeExecution {
+		// The scheduled workflow controller only creates Argo Workflows.
+		return nil, util.NewInvalidInputError("recurring runs are not supported by the native execution backend")
 	}
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to compile job")
 	}
-	// currently, there is only Argo implementation, so it's using `ArgoWorkflow` for now
-	// later on, if a new runtime support will be added, we need a way to switch/specify
-	// runtime. i.e using ENV var
 	executionSpec, err := util.NewExecutionSpecFromInterface(util.CurrentExecutionType(), obj)
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "error creating execution spec")
@@ -144,6 +145,7 @@ func (t *V2Spec) ScheduledWorkflow(modelJob *model.Job) (*scheduledworkflow.Sche
 	if executionSpec.ServiceAccount() == "" {
 		setDefaultServiceAccount(executionSpec, modelJob.ServiceAccount)
 	}
+	setUserLabels(executionSpec, modelJob.Labels)
 	// Disable istio sidecar injection if not specified
 	executionSpec.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
 	parameters, err := StringMapToCRDParameters(modelJob.RuntimeConfig.Parameters)
@@ -335,6 +337,8 @@ func (t *V2Spec) RunWorkflow(modelRun *model.Run, options RunWorkflowOptions) (u
 			DefaultWorkspace: t.defaultWorkspace,
 		}
 		obj, err = argocompiler.Compile(job, kubernetesSpec, opts)
+	} else if util.CurrentExecutionType() == util.NativeExecution {
+		obj, err = native.Compile(job, kubernetesSpec, &native.Options{CacheDisabled: options.CacheDisabled})
 	}
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to compile job")
@@ -350,6 +354,7 @@ func (t *V2Spec) RunWorkflow(modelRun *model.Run, options RunWorkflowOptions) (u
 	setDefaultServiceAccount(executionSpec, modelRun.ServiceAccount)
 	// Disable istio sidecar injection if not specified
 	executionSpec.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
+	setUserLabels(executionSpec, modelRun.Labels)
 	// Add label to the workflow so it can be persisted by persistent agent later.
 	executionSpec.SetLabels(util.LabelKeyWorkflowRunId, options.RunId)
 	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
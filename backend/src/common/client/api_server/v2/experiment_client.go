@@ -31,6 +31,7 @@ import (
 type ExperimentInterface interface {
 	Create(params *params.ExperimentServiceCreateExperimentParams) (*model.V2beta1Experiment, error)
 	Get(params *params.ExperimentServiceGetExperimentParams) (*model.V2beta1Experiment, error)
+	Update(params *params.ExperimentServiceUpdateExperimentParams) (*model.V2beta1Experiment, error)
 	List(params *params.ExperimentServiceListExperimentsParams) ([]*model.V2beta1Experiment, int, string, error)
 	ListAll(params *params.ExperimentServiceListExperimentsParams, maxResultSize int) ([]*model.V2beta1Experiment, error)
 	Archive(params *params.ExperimentServiceArchiveExperimentParams) error
@@ -106,6 +107,24 @@ func (c *ExperimentClient) Get(parameters *params.ExperimentServiceGetExperiment
 	return response.Payload, nil
 }
 
+func (c *ExperimentClient) Update(parameters *params.ExperimentServiceUpdateExperimentParams) (*model.V2beta1Experiment,
+	error) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.ExperimentService.ExperimentServiceUpdateExperiment(parameters)
+	if err != nil {
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to update experiment. Params: '%+v'. Body: '%+v'", parameters, parameters.Experiment),
+			fmt.Sprintf("Failed to update experiment '%v'", parameters.ExperimentExperimentID))
+	}
+
+	return response.Payload, nil
+}
+
 func (c *ExperimentClient) List(parameters *params.ExperimentServiceListExperimentsParams) (
 	[]*model.V2beta1Experiment, int, string, error) {
 	// Create context with timeout
@@ -45,6 +45,11 @@ func (c *ExperimentClientFake) Get(parameters *params.ExperimentServiceGetExperi
 	return getDefaultExperiment(parameters.ExperimentID, "EXPERIMENT_NAME"), nil
 }
 
+func (c *ExperimentClientFake) Update(parameters *params.ExperimentServiceUpdateExperimentParams) (
+	*model.V2beta1Experiment, error) {
+	return getDefaultExperiment(parameters.ExperimentExperimentID, "EXPERIMENT_NAME"), nil
+}
+
 func (c *ExperimentClientFake) List(params *params.ExperimentServiceListExperimentsParams) (
 	[]*model.V2beta1Experiment, int, string, error) {
 	return []*model.V2beta1Experiment{
@@ -33,6 +33,7 @@ type PipelineInterface interface {
 	Create(params *params.PipelineServiceCreatePipelineParams) (*model.V2beta1Pipeline, error)
 	CreatePipelineAndVersion(params *params.PipelineServiceCreatePipelineAndVersionParams) (*model.V2beta1Pipeline, error)
 	Get(params *params.PipelineServiceGetPipelineParams) (*model.V2beta1Pipeline, error)
+	Update(params *params.PipelineServiceUpdatePipelineParams) (*model.V2beta1Pipeline, error)
 	Delete(params *params.PipelineServiceDeletePipelineParams) error
 	//GetTemplate(params *params.GetTemplateParams) (template.Template, error)
 	List(params *params.PipelineServiceListPipelinesParams) ([]*model.V2beta1Pipeline, int, string, error)
@@ -146,6 +147,30 @@ func (c *PipelineClient) Get(parameters *params.PipelineServiceGetPipelineParams
 	return response.Payload, nil
 }
 
+func (c *PipelineClient) Update(parameters *params.PipelineServiceUpdatePipelineParams) (*model.V2beta1Pipeline,
+	error) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.PipelineServiceUpdatePipeline(parameters, c.authInfoWriter)
+	if err != nil {
+		if defaultError, ok := err.(*params.PipelineServiceUpdatePipelineDefault); ok {
+			err = api_server.CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = api_server.CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to update pipeline. Params: '%v'. Body: '%+v'", parameters, parameters.Pipeline),
+			fmt.Sprintf("Failed to update pipeline '%v'", parameters.PipelinePipelineID))
+	}
+
+	return response.Payload, nil
+}
+
 func (c *PipelineClient) Delete(parameters *params.PipelineServiceDeletePipelineParams) error {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
@@ -76,6 +76,11 @@ func (c *PipelineClientFake) Get(params *params.PipelineServiceGetPipelineParams
 
 }
 
+func (c *PipelineClientFake) Update(params *params.PipelineServiceUpdatePipelineParams) (
+	*model.V2beta1Pipeline, error) {
+	return getDefaultPipeline(params.PipelinePipelineID), nil
+}
+
 func (c *PipelineClientFake) Delete(params *params.PipelineServiceDeletePipelineParams) error {
 	return nil
 }
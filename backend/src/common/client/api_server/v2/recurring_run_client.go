@@ -32,9 +32,11 @@ import (
 type RecurringRunInterface interface {
 	Create(params *params.RecurringRunServiceCreateRecurringRunParams) (*model.V2beta1RecurringRun, error)
 	Get(params *params.RecurringRunServiceGetRecurringRunParams) (*model.V2beta1RecurringRun, error)
+	Update(params *params.RecurringRunServiceUpdateRecurringRunParams) (*model.V2beta1RecurringRun, error)
 	Delete(params *params.RecurringRunServiceDeleteRecurringRunParams) error
 	Enable(params *params.RecurringRunServiceEnableRecurringRunParams) error
 	Disable(params *params.RecurringRunServiceDisableRecurringRunParams) error
+	Backfill(params *params.RecurringRunServiceBackfillRecurringRunParams) error
 	List(params *params.RecurringRunServiceListRecurringRunsParams) ([]*model.V2beta1RecurringRun, int, string, error)
 	ListAll(params *params.RecurringRunServiceListRecurringRunsParams, maxResultSize int) ([]*model.V2beta1RecurringRun, error)
 }
@@ -110,6 +112,24 @@ func (c *RecurringRunClient) Get(parameters *params.RecurringRunServiceGetRecurr
 	return response.Payload, nil
 }
 
+func (c *RecurringRunClient) Update(parameters *params.RecurringRunServiceUpdateRecurringRunParams) (*model.V2beta1RecurringRun,
+	error) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RecurringRunService.RecurringRunServiceUpdateRecurringRun(parameters)
+	if err != nil {
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to update job. Params: '%+v'. Body: '%+v'", parameters, parameters.RecurringRun),
+			fmt.Sprintf("Failed to update job '%v'", parameters.RecurringRunRecurringRunID))
+	}
+
+	return response.Payload, nil
+}
+
 func (c *RecurringRunClient) Delete(parameters *params.RecurringRunServiceDeleteRecurringRunParams) error {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
@@ -161,6 +181,23 @@ func (c *RecurringRunClient) Disable(parameters *params.RecurringRunServiceDisab
 	return nil
 }
 
+func (c *RecurringRunClient) Backfill(parameters *params.RecurringRunServiceBackfillRecurringRunParams) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RecurringRunService.RecurringRunServiceBackfillRecurringRun(parameters)
+	if err != nil {
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to backfill job. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to backfill job '%v'", parameters.RecurringRunID))
+	}
+
+	return nil
+}
+
 func (c *RecurringRunClient) List(parameters *params.RecurringRunServiceListRecurringRunsParams) (
 	[]*model.V2beta1RecurringRun, int, string, error) {
 	// Create context with timeout
@@ -45,6 +45,11 @@ func (c *RecurringRunClientFake) Get(params *params.RecurringRunServiceGetRecurr
 	return getDefaultJob(params.RecurringRunID, "RECURRING_RUN_NAME"), nil
 }
 
+func (c *RecurringRunClientFake) Update(params *params.RecurringRunServiceUpdateRecurringRunParams) (
+	*model.V2beta1RecurringRun, error) {
+	return getDefaultJob(params.RecurringRunRecurringRunID, params.RecurringRun.DisplayName), nil
+}
+
 func (c *RecurringRunClientFake) Delete(params *params.RecurringRunServiceDeleteRecurringRunParams) error {
 	return nil
 }
@@ -57,6 +62,10 @@ func (c *RecurringRunClientFake) Disable(params *params.RecurringRunServiceDisab
 	return nil
 }
 
+func (c *RecurringRunClientFake) Backfill(params *params.RecurringRunServiceBackfillRecurringRunParams) error {
+	return nil
+}
+
 func (c *RecurringRunClientFake) List(params *params.RecurringRunServiceListRecurringRunsParams) (
 	[]*model.V2beta1RecurringRun, int, string, error) {
 	return []*model.V2beta1RecurringRun{
@@ -33,10 +33,13 @@ type RunInterface interface {
 	Archive(params *params.RunServiceArchiveRunParams) error
 	Create(params *params.RunServiceCreateRunParams) (*model.V2beta1Run, error)
 	Get(params *params.RunServiceGetRunParams) (*model.V2beta1Run, error)
+	Update(params *params.RunServiceUpdateRunParams) (*model.V2beta1Run, error)
 	List(params *params.RunServiceListRunsParams) ([]*model.V2beta1Run, int, string, error)
 	ListAll(params *params.RunServiceListRunsParams, maxResultSize int) ([]*model.V2beta1Run, error)
 	Unarchive(params *params.RunServiceUnarchiveRunParams) error
 	Terminate(params *params.RunServiceTerminateRunParams) error
+	Pause(params *params.RunServicePauseRunParams) error
+	Resume(params *params.RunServiceResumeRunParams) error
 }
 
 type RunClient struct {
@@ -120,6 +123,29 @@ func (c *RunClient) Get(parameters *params.RunServiceGetRunParams) (*model.V2bet
 	return response.Payload, nil
 }
 
+func (c *RunClient) Update(parameters *params.RunServiceUpdateRunParams) (*model.V2beta1Run, error) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RunService.RunServiceUpdateRun(parameters, c.authInfoWriter)
+	if err != nil {
+		if defaultError, ok := err.(*params.RunServiceUpdateRunDefault); ok {
+			err = api_server.CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = api_server.CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to update run. Params: '%+v'. Body: '%+v'", parameters, parameters.Run),
+			fmt.Sprintf("Failed to update run '%v'", parameters.RunRunID))
+	}
+
+	return response.Payload, nil
+}
+
 func (c *RunClient) Archive(parameters *params.RunServiceArchiveRunParams) error {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
@@ -261,3 +287,33 @@ func (c *RunClient) Terminate(parameters *params.RunServiceTerminateRunParams) e
 	}
 	return nil
 }
+
+func (c *RunClient) Pause(parameters *params.RunServicePauseRunParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RunService.RunServicePauseRun(parameters, c.authInfoWriter)
+	if err != nil {
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to pause run. Params: %+v", parameters),
+			fmt.Sprintf("Failed to pause run %v", parameters.RunID))
+	}
+	return nil
+}
+
+func (c *RunClient) Resume(parameters *params.RunServiceResumeRunParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), api_server.APIServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RunService.RunServiceResumeRun(parameters, c.authInfoWriter)
+	if err != nil {
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to resume run. Params: %+v", parameters),
+			fmt.Sprintf("Failed to resume run %v", parameters.RunID))
+	}
+	return nil
+}
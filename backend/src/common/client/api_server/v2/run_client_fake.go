@@ -44,6 +44,10 @@ func (c *RunClientFake) Get(params *params.RunServiceGetRunParams) (*model.V2bet
 	return getDefaultRun(params.RunID, "RUN_NAME"), nil
 }
 
+func (c *RunClientFake) Update(params *params.RunServiceUpdateRunParams) (*model.V2beta1Run, error) {
+	return getDefaultRun(params.RunRunID, "RUN_NAME"), nil
+}
+
 func (c *RunClientFake) List(params *params.RunServiceListRunsParams) (
 	[]*model.V2beta1Run, int, string, error) {
 	return []*model.V2beta1Run{
@@ -69,3 +73,11 @@ func (c *RunClientFake) Terminate(params *params.RunServiceTerminateRunParams) e
 	return fmt.Errorf(InvalidFakeRequest, params.RunID)
 
 }
+
+func (c *RunClientFake) Pause(params *params.RunServicePauseRunParams) error {
+	return fmt.Errorf(InvalidFakeRequest, params.RunID)
+}
+
+func (c *RunClientFake) Resume(params *params.RunServiceResumeRunParams) error {
+	return fmt.Errorf(InvalidFakeRequest, params.RunID)
+}
@@ -55,4 +55,8 @@ const (
 	// It captures whether this step will be selected by cache service.
 	// To disable/enable cache for a single run, this label needs to be added in every step under a run.
 	LabelKeyCacheEnabled = "pipelines.kubeflow.org/cache_enabled"
+
+	// CachePipelineNamePrefix prefixes the pipeline name of the tasks stored
+	// for caching by the v2 driver and launcher.
+	CachePipelineNamePrefix = "pipeline/"
 )
@@ -222,6 +222,11 @@ func NewFailedPreconditionError(err error, externalFormat string, a ...interface
 		codes.FailedPrecondition)
 }
 
+func NewOutOfRangeError(messageFormat string, a ...interface{}) *UserError {
+	message := fmt.Sprintf(messageFormat, a...)
+	return newUserError(errors.Errorf("Out of range error: %v", message), message, codes.OutOfRange)
+}
+
 func NewUnauthenticatedError(err error, externalFormat string, a ...interface{}) *UserError {
 	externalMessage := fmt.Sprintf(externalFormat, a...)
 	return newUserError(
@@ -0,0 +1,49 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PayloadParameters returns the values of the parameters mapped to fields of
+// the payload of an event. The mapping is keyed by parameter name, and nested
+// fields are separated by dots.
+func PayloadParameters(payload map[string]interface{}, mapping map[string]string) (map[string]interface{}, error) {
+	parameters := make(map[string]interface{}, len(mapping))
+	for name, field := range mapping {
+		value, ok := payloadField(payload, field)
+		if !ok {
+			return nil, fmt.Errorf("field %q of parameter %q is not in the event payload", field, name)
+		}
+		parameters[name] = value
+	}
+	return parameters, nil
+}
+
+func payloadField(payload map[string]interface{}, field string) (interface{}, bool) {
+	var value interface{} = payload
+	for _, name := range strings.Split(field, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[name]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
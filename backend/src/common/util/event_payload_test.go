@@ -0,0 +1,50 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadParameters(t *testing.T) {
+	payload := map[string]interface{}{
+		"key":  "data/file.csv",
+		"size": float64(42),
+		"data": map[string]interface{}{
+			"path": "gs://bucket/data",
+		},
+	}
+	parameters, err := PayloadParameters(payload, map[string]string{
+		"file":   "key",
+		"bytes":  "size",
+		"source": "data.path",
+	})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"file":   "data/file.csv",
+		"bytes":  float64(42),
+		"source": "gs://bucket/data",
+	}, parameters)
+
+	_, err = PayloadParameters(payload, map[string]string{"source": "data.missing"})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `field "data.missing" of parameter "source"`)
+
+	_, err = PayloadParameters(payload, map[string]string{"file": "key.nested"})
+	require.NotNil(t, err)
+}
@@ -27,8 +27,9 @@ import (
 type ExecutionType string
 
 const (
-	ArgoWorkflow ExecutionType = "Workflow"
-	Unknown      ExecutionType = "Unknown"
+	ArgoWorkflow    ExecutionType = "Workflow"
+	NativeExecution ExecutionType = "NativeRun"
+	Unknown         ExecutionType = "Unknown"
 )
 
 var (
@@ -146,6 +147,9 @@ type ExecutionSpec interface {
 	// If the ExecutionSpec was terminated and not finished yet
 	IsTerminating() bool
 
+	// If the ExecutionSpec was suspended and not finished yet
+	IsPaused() bool
+
 	// Get schedule time from label in second
 	ScheduledAtInSecOr0() int64
 
@@ -186,6 +190,8 @@ func NewExecutionSpec(bytes []byte) (ExecutionSpec, error) {
 	switch meta.Kind {
 	case string(ArgoWorkflow):
 		return NewWorkflowFromBytes(bytes)
+	case string(NativeExecution):
+		return NewNativeRunFromBytes(bytes)
 	default:
 		return nil, NewInvalidInputError("Unknown execution spec")
 	}
@@ -201,6 +207,8 @@ func NewExecutionSpecJSON(execType ExecutionType, bytes []byte) (ExecutionSpec,
 	switch execType {
 	case ArgoWorkflow:
 		return NewWorkflowFromBytesJSON(bytes)
+	case NativeExecution:
+		return NewNativeRunFromBytesJSON(bytes)
 	default:
 		return nil, NewInvalidInputError("Unknown execution spec")
 	}
@@ -214,6 +222,8 @@ func NewExecutionSpecFromInterface(execType ExecutionType, obj interface{}) (Exe
 	switch execType {
 	case ArgoWorkflow:
 		return NewWorkflowFromInterface(obj)
+	case NativeExecution:
+		return NewNativeRunFromInterface(obj)
 	default:
 		return nil, NewInternalServerError(
 			errors.New("ExecutionType is not supported"), "type:%s", execType)
@@ -226,6 +236,8 @@ func UnmarshalParameters(execType ExecutionType, paramsString string) (SpecParam
 	switch execType {
 	case ArgoWorkflow:
 		return UnmarshParametersWorkflow(paramsString)
+	case NativeExecution:
+		return UnmarshalParametersNativeRun(paramsString)
 	default:
 		return nil, NewInternalServerError(
 			errors.New("ExecutionType is not supported"), "type:%s", execType)
@@ -238,6 +250,8 @@ func MarshalParameters(execType ExecutionType, params SpecParameters) (string, e
 	switch execType {
 	case ArgoWorkflow:
 		return MarshalParametersWorkflow(params)
+	case NativeExecution:
+		return MarshalParametersNativeRun(params)
 	default:
 		return "", NewInternalServerError(
 			errors.New("ExecutionType is not supported"), "type:%s", execType)
@@ -272,6 +286,17 @@ func ScheduleSpecToExecutionSpec(
 		workflow.APIVersion = "argoproj.io/v1alpha1"
 		workflow.Kind = "Workflow"
 		return NewWorkflow(workflow), nil
+	case NativeExecution:
+		executionSpecStr, ok := wfr.Spec.(string)
+		if !ok {
+			raw, err := json.Marshal(wfr.Spec)
+			if err != nil {
+				return nil, NewInternalServerError(
+					errors.New("can't marshal WorkflowResource.Spec"), "err:%v", err)
+			}
+			executionSpecStr = string(raw)
+		}
+		return NewNativeRunFromBytesJSON([]byte(executionSpecStr))
 	default:
 		return nil, NewInternalServerError(
 			errors.New("ExecutionType is not supported"), "type:%s", execType)
@@ -286,6 +311,47 @@ func GetTerminatePatch(execType ExecutionType) interface{} {
 				"activeDeadlineSeconds": 0,
 			},
 		}
+	case NativeExecution:
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"terminate": true,
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+// GetPausePatch returns the patch that suspends an execution.
+func GetPausePatch(execType ExecutionType) interface{} {
+	switch execType {
+	case ArgoWorkflow:
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"suspend": true,
+			},
+		}
+	case NativeExecution:
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"suspend": true,
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+// GetResumePatch returns the patch that resumes a suspended execution.
+func GetResumePatch(execType ExecutionType) interface{} {
+	switch execType {
+	case ArgoWorkflow, NativeExecution:
+		// A null value removes the field in a JSON merge patch.
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"suspend": nil,
+			},
+		}
 	default:
 		return nil
 	}
@@ -0,0 +1,601 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/golang/glog"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	exec "github.com/kubeflow/pipelines/backend/src/common"
+	swfregister "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/pkg/errors"
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	NativeRunAPIVersion = "pipelines.kubeflow.org/v2beta1"
+	NativeRunKind       = string(NativeExecution)
+)
+
+// NativeRunGroupVersion is the group version of the NativeRun custom resource.
+var NativeRunGroupVersion = schema.GroupVersion{Group: "pipelines.kubeflow.org", Version: "v2beta1"}
+
+// AddNativeRunToScheme registers the NativeRun custom resource, so that
+// Kubernetes clients built with the scheme can store native runs.
+func AddNativeRunToScheme(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypeWithName(NativeRunGroupVersion.WithKind(NativeRunKind), &NativeRunManifest{})
+	scheme.AddKnownTypeWithName(NativeRunGroupVersion.WithKind(NativeRunKind+"List"), &NativeRunManifestList{})
+	metav1.AddToGroupVersion(scheme, NativeRunGroupVersion)
+	return nil
+}
+
+// NativeNodePhase is the phase of a task of a NativeRun. The values match
+// the Argo node phases, so that both execution types report tasks alike.
+type NativeNodePhase string
+
+const (
+	NativeNodePending   NativeNodePhase = "Pending"
+	NativeNodeRunning   NativeNodePhase = "Running"
+	NativeNodeSucceeded NativeNodePhase = "Succeeded"
+	NativeNodeSkipped   NativeNodePhase = "Skipped"
+	NativeNodeFailed    NativeNodePhase = "Failed"
+	NativeNodeError     NativeNodePhase = "Error"
+	NativeNodeOmitted   NativeNodePhase = "Omitted"
+)
+
+// NativeRunManifest is the NativeRun custom resource of the native execution
+// backend, which runs a v2 PipelineJob without Argo.
+type NativeRunManifest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              NativeRunSpec   `json:"spec"`
+	Status            NativeRunStatus `json:"status,omitempty"`
+}
+
+// NativeRunManifestList is a list of NativeRun custom resources.
+type NativeRunManifestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NativeRunManifest `json:"items"`
+}
+
+type NativeRunSpec struct {
+	// PipelineJob is the PipelineJob to run, in its protojson representation.
+	PipelineJob json.RawMessage `json:"pipelineJob,omitempty"`
+	// KubernetesSpec is the Kubernetes platform spec of the pipeline, in its
+	// protojson representation.
+	KubernetesSpec json.RawMessage `json:"kubernetesSpec,omitempty"`
+	// Parameters override the parameter values of the PipelineJob runtime config.
+	Parameters         []NativeRunParameter `json:"parameters,omitempty"`
+	ServiceAccountName string               `json:"serviceAccountName,omitempty"`
+	// PodMetadata is added to the pods of all tasks.
+	PodMetadata     NativeRunPodMetadata        `json:"podMetadata,omitempty"`
+	SecurityContext *k8score.PodSecurityContext `json:"securityContext,omitempty"`
+	CacheDisabled   bool                        `json:"cacheDisabled,omitempty"`
+	// Suspend stops starting new tasks until it is unset.
+	Suspend bool `json:"suspend,omitempty"`
+	// Terminate stops the running tasks and fails the run.
+	Terminate bool `json:"terminate,omitempty"`
+}
+
+type NativeRunParameter struct {
+	Name    string  `json:"name"`
+	Default *string `json:"default,omitempty"`
+	Value   *string `json:"value,omitempty"`
+}
+
+type NativeRunPodMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type NativeRunStatus struct {
+	Phase      exec.ExecutionPhase `json:"phase,omitempty"`
+	StartedAt  metav1.Time         `json:"startedAt,omitempty"`
+	FinishedAt metav1.Time         `json:"finishedAt,omitempty"`
+	Message    string              `json:"message,omitempty"`
+	// Nodes are the DAGs and tasks of the run, keyed by their IDs.
+	Nodes map[string]NativeRunNodeStatus `json:"nodes,omitempty"`
+}
+
+type NativeRunNodeStatus struct {
+	// ID is derived from the run name and the path of the node in the
+	// pipeline, so that it stays the same when the run is resumed.
+	ID         string          `json:"id"`
+	TaskName   string          `json:"taskName"`
+	Phase      NativeNodePhase `json:"phase,omitempty"`
+	StartedAt  metav1.Time     `json:"startedAt,omitempty"`
+	FinishedAt metav1.Time     `json:"finishedAt,omitempty"`
+	Message    string          `json:"message,omitempty"`
+	// Children are the IDs of the tasks of a DAG, the iterations of an
+	// iterator and the tasks that depend on a task.
+	Children []string `json:"children,omitempty"`
+	// ExecutionID is the ML Metadata execution the driver created for the node.
+	ExecutionID int64 `json:"executionID,omitempty"`
+	// IterationCount is the number of iterations of an iterator.
+	IterationCount *int `json:"iterationCount,omitempty"`
+	// PodName is the latest pod of a task that runs in a pod.
+	PodName string `json:"podName,omitempty"`
+	// Retries counts the pods that were replaced after they failed.
+	Retries int32 `json:"retries,omitempty"`
+}
+
+// Fulfilled reports whether the node has finished.
+func (n NativeRunNodeStatus) Fulfilled() bool {
+	switch n.Phase {
+	case NativeNodeSucceeded, NativeNodeSkipped, NativeNodeFailed, NativeNodeError, NativeNodeOmitted:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeepCopy returns a deep copy of the manifest.
+func (m *NativeRunManifest) DeepCopy() *NativeRunManifest {
+	if m == nil {
+		return nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		glog.Errorf("Could not marshal the native run: %v", err)
+		return nil
+	}
+	var copied NativeRunManifest
+	if err := json.Unmarshal(b, &copied); err != nil {
+		glog.Errorf("Could not unmarshal the native run: %v", err)
+		return nil
+	}
+	return &copied
+}
+
+// DeepCopyObject implements runtime.Object.
+func (m *NativeRunManifest) DeepCopyObject() runtime.Object {
+	if copied := m.DeepCopy(); copied != nil {
+		return copied
+	}
+	return nil
+}
+
+// DeepCopyObject implements runtime.Object.
+func (l *NativeRunManifestList) DeepCopyObject() runtime.Object {
+	if l == nil {
+		return nil
+	}
+	copied := &NativeRunManifestList{TypeMeta: l.TypeMeta}
+	l.ListMeta.DeepCopyInto(&copied.ListMeta)
+	for i := range l.Items {
+		copied.Items = append(copied.Items, *l.Items[i].DeepCopy())
+	}
+	return copied
+}
+
+// NativeRun is a type to help manipulate NativeRunManifest objects.
+type NativeRun struct {
+	*NativeRunManifest
+}
+
+// NewNativeRun creates a NativeRun.
+func NewNativeRun(manifest *NativeRunManifest) *NativeRun {
+	return &NativeRun{
+		manifest,
+	}
+}
+
+func NewNativeRunFromBytes(bytes []byte) (*NativeRun, error) {
+	var manifest NativeRunManifest
+	err := yaml.Unmarshal(bytes, &manifest)
+	if err != nil {
+		return nil, NewInvalidInputErrorWithDetails(err, "Failed to unmarshal the inputs")
+	}
+	return NewNativeRun(&manifest), nil
+}
+
+func NewNativeRunFromBytesJSON(bytes []byte) (*NativeRun, error) {
+	var manifest NativeRunManifest
+	err := json.Unmarshal(bytes, &manifest)
+	if err != nil {
+		return nil, NewInvalidInputErrorWithDetails(err, "Failed to unmarshal the inputs")
+	}
+	return NewNativeRun(&manifest), nil
+}
+
+func NewNativeRunFromInterface(obj interface{}) (*NativeRun, error) {
+	manifest, ok := obj.(*NativeRunManifest)
+	if ok {
+		return NewNativeRun(manifest), nil
+	}
+	return nil, NewInvalidInputError("not NativeRun struct")
+}
+
+func UnmarshalParametersNativeRun(paramsString string) (SpecParameters, error) {
+	if paramsString == "" {
+		return nil, nil
+	}
+	var params []NativeRunParameter
+	err := json.Unmarshal([]byte(paramsString), &params)
+	if err != nil {
+		return nil, NewInternalServerError(err, "Parameters have wrong format")
+	}
+	rev := make(SpecParameters, 0, len(params))
+	for _, param := range params {
+		rev = append(rev, SpecParameter(param))
+	}
+	return rev, nil
+}
+
+// Marshal parameters to JSON encoded string.
+// This also checks result is not longer than a limit.
+func MarshalParametersNativeRun(params SpecParameters) (string, error) {
+	if params == nil {
+		return "[]", nil
+	}
+	inputParams := make([]NativeRunParameter, 0, len(params))
+	for _, param := range params {
+		inputParams = append(inputParams, NativeRunParameter(param))
+	}
+	paramBytes, err := json.Marshal(inputParams)
+	if err != nil {
+		return "", NewInvalidInputErrorWithDetails(err, "Failed to marshal the parameter.")
+	}
+	if len(paramBytes) > MaxParameterBytes {
+		return "", NewInvalidInputError("The input parameter length exceed maximum size of %v.", MaxParameterBytes)
+	}
+	return string(paramBytes), nil
+}
+
+// Get ExecutionType: NativeExecution
+func (r *NativeRun) ExecutionType() ExecutionType {
+	return NativeExecution
+}
+
+func (r *NativeRun) ExecutionStatus() ExecutionStatus {
+	return r
+}
+
+func (r *NativeRun) SetServiceAccount(serviceAccount string) {
+	r.Spec.ServiceAccountName = serviceAccount
+}
+
+func (r *NativeRun) ServiceAccount() string {
+	return r.Spec.ServiceAccountName
+}
+
+func (r *NativeRun) OverrideParameters(desiredParams map[string]string) {
+	params := make([]NativeRunParameter, 0, len(r.Spec.Parameters))
+	for _, currentParam := range r.Spec.Parameters {
+		value := currentParam.Value
+		if desired, ok := desiredParams[currentParam.Name]; ok {
+			value = &desired
+		}
+		params = append(params, NativeRunParameter{Name: currentParam.Name, Value: value})
+	}
+	r.Spec.Parameters = params
+}
+
+// SetAnnotationsToAllTemplatesIfKeyNotExist sets annotations on the pods of
+// all tasks if the annotation key does not exist.
+func (r *NativeRun) SetAnnotationsToAllTemplatesIfKeyNotExist(key string, value string) {
+	if r.Spec.PodMetadata.Annotations == nil {
+		r.Spec.PodMetadata.Annotations = make(map[string]string)
+	}
+	if _, isSet := r.Spec.PodMetadata.Annotations[key]; !isSet {
+		r.Spec.PodMetadata.Annotations[key] = value
+	}
+}
+
+func (r *NativeRun) SetLabels(key string, value string) {
+	if r.Labels == nil {
+		r.Labels = make(map[string]string)
+	}
+	r.Labels[key] = value
+}
+
+func (r *NativeRun) SetAnnotations(key string, value string) {
+	if r.Annotations == nil {
+		r.Annotations = make(map[string]string)
+	}
+	r.Annotations[key] = value
+}
+
+func (r *NativeRun) ReplaceUID(id string) error {
+	newRunString := strings.Replace(r.ToStringForStore(), "{{workflow.uid}}", id, -1)
+	var manifest *NativeRunManifest
+	if err := json.Unmarshal([]byte(newRunString), &manifest); err != nil {
+		return NewInternalServerError(err,
+			"Failed to unmarshal native run manifest. Native run: %s", r.ToStringForStore())
+	}
+	r.NativeRunManifest = manifest
+	return nil
+}
+
+func (r *NativeRun) SetPodMetadataLabels(key string, value string) {
+	if r.Spec.PodMetadata.Labels == nil {
+		r.Spec.PodMetadata.Labels = make(map[string]string)
+	}
+	r.Spec.PodMetadata.Labels[key] = value
+}
+
+func (r *NativeRun) SpecParameters() SpecParameters {
+	params := make(SpecParameters, 0, len(r.Spec.Parameters))
+	for _, param := range r.Spec.Parameters {
+		params = append(params, SpecParameter(param))
+	}
+	return params
+}
+
+func (r *NativeRun) SetSpecParameters(params SpecParameters) {
+	newParams := make([]NativeRunParameter, 0, len(params))
+	for _, param := range params {
+		newParams = append(newParams, NativeRunParameter(param))
+	}
+	r.Spec.Parameters = newParams
+}
+
+// GenerateRetryExecution resets the failed nodes, so that they run again.
+// The nodes keep their ML Metadata executions, so that the retried tasks are
+// recorded in the same DAGs, and their pods, which the runner replaces like
+// it does for the retries of a task. Hence no pods need to be deleted.
+func (r *NativeRun) GenerateRetryExecution() (ExecutionSpec, []string, error) {
+	switch r.Status.Phase {
+	case exec.ExecutionFailed, exec.ExecutionError:
+		break
+	default:
+		return nil, nil, NewBadRequestError(errors.New("native run cannot be retried"), "Native run must be Failed/Error to retry")
+	}
+
+	newRun := r.NativeRunManifest.DeepCopy()
+	delete(newRun.Labels, LabelKeyWorkflowPersistedFinalState)
+	newRun.Spec.Terminate = false
+	newRun.Status.Phase = exec.ExecutionRunning
+	newRun.Status.Message = ""
+	newRun.Status.FinishedAt = metav1.Time{}
+
+	newRun.Status.Nodes = make(map[string]NativeRunNodeStatus)
+	for id, node := range r.Status.Nodes {
+		switch node.Phase {
+		case NativeNodeSucceeded, NativeNodeSkipped:
+			newRun.Status.Nodes[id] = node
+		case NativeNodeFailed, NativeNodeError:
+			node.Phase = NativeNodePending
+			node.Message = ""
+			node.FinishedAt = metav1.Time{}
+			node.Retries = 0
+			newRun.Status.Nodes[id] = node
+		case NativeNodeOmitted:
+		default:
+			return nil, nil, NewInternalServerError(
+				errors.New("native run cannot be retried"),
+				"Native run cannot be retried with node %s in %s phase", node.ID, node.Phase)
+		}
+	}
+	for id, node := range newRun.Status.Nodes {
+		var children []string
+		for _, child := range node.Children {
+			if _, ok := newRun.Status.Nodes[child]; ok {
+				children = append(children, child)
+			}
+		}
+		node.Children = children
+		newRun.Status.Nodes[id] = node
+	}
+	return NewNativeRun(newRun), nil, nil
+}
+
+func (r *NativeRun) ToStringForStore() string {
+	run, err := json.Marshal(r.NativeRunManifest)
+	if err != nil {
+		glog.Errorf("Could not marshal the native run: %v", r.NativeRunManifest)
+		return ""
+	}
+	return string(run)
+}
+
+func (r *NativeRun) Version() string {
+	return r.ResourceVersion
+}
+
+func (r *NativeRun) SetVersion(version string) {
+	r.ResourceVersion = version
+}
+
+func (r *NativeRun) ExecutionName() string {
+	return r.Name
+}
+
+func (r *NativeRun) SetExecutionName(name string) {
+	r.GenerateName = ""
+	r.Name = name
+}
+
+func (r *NativeRun) ExecutionNamespace() string {
+	return r.Namespace
+}
+
+func (r *NativeRun) SetExecutionNamespace(namespace string) {
+	r.Namespace = namespace
+}
+
+func (r *NativeRun) ExecutionUID() string {
+	return string(r.UID)
+}
+
+func (r *NativeRun) ExecutionObjectMeta() *metav1.ObjectMeta {
+	return &r.ObjectMeta
+}
+
+func (r *NativeRun) ExecutionTypeMeta() *metav1.TypeMeta {
+	return &r.TypeMeta
+}
+
+func (r *NativeRun) ScheduledWorkflowUUIDAsStringOrEmpty() string {
+	for _, reference := range r.OwnerReferences {
+		if isScheduledWorkflow(reference) {
+			return string(reference.UID)
+		}
+	}
+	return ""
+}
+
+func (r *NativeRun) PersistedFinalState() bool {
+	_, ok := r.GetLabels()[LabelKeyWorkflowPersistedFinalState]
+	return ok
+}
+
+func (r *NativeRun) IsTerminating() bool {
+	return r.Spec.Terminate && !r.IsInFinalState()
+}
+
+func (r *NativeRun) IsPaused() bool {
+	return r.Spec.Suspend && !r.IsInFinalState()
+}
+
+func (r *NativeRun) ScheduledAtInSecOr0() int64 {
+	value, ok := r.Labels[LabelKeyWorkflowEpoch]
+	if !ok {
+		return 0
+	}
+	result, err := RetrieveInt64FromLabel(value)
+	if err != nil {
+		glog.Errorf("Could not retrieve scheduled epoch from label key (%v) and label value (%v).", LabelKeyWorkflowEpoch, value)
+		return 0
+	}
+	return result
+}
+
+func (r *NativeRun) GetExecutionSpec() ExecutionSpec {
+	run := r.NativeRunManifest.DeepCopy()
+	run.Status = NativeRunStatus{}
+	run.TypeMeta = metav1.TypeMeta{Kind: r.Kind, APIVersion: r.APIVersion}
+	// To prevent collisions, clear name, set GenerateName to first 200 runes of previous name.
+	nameRunes := []rune(r.Name)
+	length := len(nameRunes)
+	if length > 200 {
+		length = 200
+	}
+	run.ObjectMeta = metav1.ObjectMeta{GenerateName: string(nameRunes[:length])}
+	return NewNativeRun(run)
+}
+
+func (r *NativeRun) Validate(lint, ignoreEntrypoint bool) error {
+	if r.Kind != NativeRunKind {
+		return NewInvalidInputError("Native run kind must be %s, got %q", NativeRunKind, r.Kind)
+	}
+	if len(r.Spec.PipelineJob) == 0 {
+		return NewInvalidInputError("Native run must have a pipeline job")
+	}
+	return nil
+}
+
+func (r *NativeRun) Decompress() error {
+	return nil
+}
+
+func (r *NativeRun) CanRetry() error {
+	return nil
+}
+
+func (r *NativeRun) ToStringForSchedule() string {
+	return r.ToStringForStore()
+}
+
+func (r *NativeRun) SetCannonicalLabels(name string, nextScheduledEpoch int64, index int64) {
+	r.SetLabels(LabelKeyWorkflowScheduledWorkflowName, name)
+	r.SetLabels(LabelKeyWorkflowEpoch, FormatInt64ForLabel(nextScheduledEpoch))
+	r.SetLabels(LabelKeyWorkflowIndex, FormatInt64ForLabel(index))
+	r.SetLabels(LabelKeyWorkflowIsOwnedByScheduledWorkflow, "true")
+}
+
+func (r *NativeRun) SetOwnerReferences(schedule *swfapi.ScheduledWorkflow) {
+	r.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(schedule, schema.GroupVersionKind{
+			Group:   swfapi.SchemeGroupVersion.Group,
+			Version: swfapi.SchemeGroupVersion.Version,
+			Kind:    swfregister.Kind,
+		}),
+	}
+}
+
+// Native runs record their artifacts in ML Metadata only.
+func (r *NativeRun) FindObjectStoreArtifactKeyOrEmpty(nodeID string, artifactName string) string {
+	return ""
+}
+
+func (r *NativeRun) Condition() exec.ExecutionPhase {
+	return r.Status.Phase
+}
+
+func (r *NativeRun) FinishedAt() int64 {
+	if r.Status.FinishedAt.IsZero() {
+		return 0
+	}
+	return r.Status.FinishedAt.Unix()
+}
+
+func (r *NativeRun) FinishedAtTime() metav1.Time {
+	return r.Status.FinishedAt
+}
+
+func (r *NativeRun) StartedAtTime() metav1.Time {
+	return r.Status.StartedAt
+}
+
+func (r *NativeRun) IsInFinalState() bool {
+	switch r.Status.Phase {
+	case exec.ExecutionSucceeded, exec.ExecutionFailed, exec.ExecutionError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *NativeRun) Message() string {
+	return r.Status.Message
+}
+
+// Native runs do not collect v1 run metrics.
+func (r *NativeRun) CollectionMetrics(retrieveArtifact RetrieveArtifact) ([]*api.RunMetric, []error) {
+	return nil, nil
+}
+
+func (r *NativeRun) HasMetrics() bool {
+	return false
+}
+
+func (r *NativeRun) HasNodes() bool {
+	return len(r.Status.Nodes) > 0
+}
+
+func (r *NativeRun) NodeStatuses() map[string]NodeStatus {
+	rev := make(map[string]NodeStatus, len(r.Status.Nodes))
+	for id, node := range r.Status.Nodes {
+		rev[id] = NodeStatus{
+			ID:          node.ID,
+			DisplayName: node.TaskName,
+			State:       string(node.Phase),
+			StartTime:   node.StartedAt.Unix(),
+			CreateTime:  node.StartedAt.Unix(),
+			FinishTime:  node.FinishedAt.Unix(),
+			Children:    node.Children,
+		}
+	}
+	return rev
+}
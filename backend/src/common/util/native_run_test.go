@@ -0,0 +1,98 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	exec "github.com/kubeflow/pipelines/backend/src/common"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNativeRun_NewExecutionSpec(t *testing.T) {
+	execSpec, err := NewExecutionSpec([]byte(`
+apiVersion: pipelines.kubeflow.org/v2beta1
+kind: NativeRun
+metadata:
+  name: hello-world
+spec:
+  parameters:
+  - name: message
+    value: hello`))
+	assert.Nil(t, err)
+	assert.Equal(t, NativeExecution, execSpec.ExecutionType())
+	assert.Equal(t, "hello-world", execSpec.ExecutionName())
+	assert.Equal(t, "hello", *execSpec.SpecParameters()[0].Value)
+
+	execSpec, err = NewExecutionSpecJSON(NativeExecution, []byte(execSpec.ToStringForStore()))
+	assert.Nil(t, err)
+	assert.Equal(t, "hello-world", execSpec.ExecutionName())
+}
+
+func TestNativeRun_GenerateRetryExecution(t *testing.T) {
+	run := NewNativeRun(&NativeRunManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "run",
+			Labels: map[string]string{LabelKeyWorkflowPersistedFinalState: "true"},
+		},
+		Spec: NativeRunSpec{Terminate: true},
+		Status: NativeRunStatus{
+			Phase:   exec.ExecutionFailed,
+			Message: "task b failed",
+			Nodes: map[string]NativeRunNodeStatus{
+				"run-a": {ID: "run-a", TaskName: "a", Phase: NativeNodeSucceeded, Children: []string{"run-b", "run-c"}},
+				"run-b": {ID: "run-b", TaskName: "b", Phase: NativeNodeFailed, Message: "pod run-b-1 failed", ExecutionID: 2, PodName: "run-b-1", Retries: 1},
+				"run-c": {ID: "run-c", TaskName: "c", Phase: NativeNodeOmitted},
+			},
+		},
+	})
+
+	execSpec, podsToDelete, err := run.GenerateRetryExecution()
+	assert.Nil(t, err)
+	assert.Empty(t, podsToDelete)
+	retry := execSpec.(*NativeRun)
+	assert.False(t, retry.PersistedFinalState())
+	assert.False(t, retry.IsTerminating())
+	assert.Equal(t, exec.ExecutionRunning, retry.Condition())
+	assert.Empty(t, retry.Message())
+	// The failed node keeps its execution and pod, which the runner replaces.
+	assert.Equal(t, map[string]NativeRunNodeStatus{
+		"run-a": {ID: "run-a", TaskName: "a", Phase: NativeNodeSucceeded, Children: []string{"run-b"}},
+		"run-b": {ID: "run-b", TaskName: "b", Phase: NativeNodePending, ExecutionID: 2, PodName: "run-b-1"},
+	}, retry.Status.Nodes)
+	// The original run is left unchanged.
+	assert.Len(t, run.Status.Nodes, 3)
+
+	run.Status.Phase = exec.ExecutionSucceeded
+	_, _, err = run.GenerateRetryExecution()
+	assert.NotNil(t, err)
+}
+
+func TestNativeRun_NodeStatuses(t *testing.T) {
+	started := metav1.Unix(10, 0)
+	finished := metav1.Unix(20, 0)
+	run := NewNativeRun(&NativeRunManifest{
+		Status: NativeRunStatus{
+			Nodes: map[string]NativeRunNodeStatus{
+				"run-a": {ID: "run-a", TaskName: "a", Phase: NativeNodeSucceeded, StartedAt: started, FinishedAt: finished},
+			},
+		},
+	})
+	assert.True(t, run.HasNodes())
+	assert.Equal(t, map[string]NodeStatus{
+		"run-a": {ID: "run-a", DisplayName: "a", State: "Succeeded", StartTime: 10, CreateTime: 10, FinishTime: 20},
+	}, run.NodeStatuses())
+}
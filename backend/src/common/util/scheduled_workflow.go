@@ -74,6 +74,13 @@ func (s *ScheduledWorkflow) CronOrEmpty() string {
 	return ""
 }
 
+func (s *ScheduledWorkflow) CronTimeZoneOrNull() *string {
+	if s.Spec.CronSchedule != nil && s.Spec.CronSchedule.TimeZone != "" {
+		return StringPointer(s.Spec.CronSchedule.TimeZone)
+	}
+	return nil
+}
+
 func (s *ScheduledWorkflow) PeriodicScheduleStartTimeInSecOrNull() *int64 {
 	if s.Spec.PeriodicSchedule != nil && s.Spec.PeriodicSchedule.StartTime != nil {
 		return Int64Pointer(s.Spec.PeriodicSchedule.StartTime.Unix())
@@ -312,6 +312,12 @@ func (w *Workflow) IsTerminating() bool {
 		!w.IsInFinalState()
 }
 
+func (w *Workflow) IsPaused() bool {
+	return w.Spec.Suspend != nil &&
+		*w.Spec.Suspend &&
+		!w.IsInFinalState()
+}
+
 // OverrideParameters overrides some of the parameters of a Workflow.
 func (w *Workflow) OverrideParameters(desiredParams map[string]string) {
 	desiredSlice := make([]workflowapi.Parameter, 0)
@@ -215,6 +215,34 @@ func TestCondition(t *testing.T) {
 	assert.Equal(t, "", string(workflow.Condition()))
 }
 
+func TestIsPaused(t *testing.T) {
+	suspend := true
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		Spec: workflowapi.WorkflowSpec{Suspend: &suspend},
+		Status: workflowapi.WorkflowStatus{
+			Phase: workflowapi.WorkflowRunning,
+		},
+	})
+	assert.True(t, workflow.IsPaused())
+
+	// Finished workflows are not paused even if the suspend flag is left behind.
+	workflow = NewWorkflow(&workflowapi.Workflow{
+		Spec: workflowapi.WorkflowSpec{Suspend: &suspend},
+		Status: workflowapi.WorkflowStatus{
+			Phase: workflowapi.WorkflowSucceeded,
+		},
+	})
+	assert.False(t, workflow.IsPaused())
+
+	// Not suspended
+	workflow = NewWorkflow(&workflowapi.Workflow{
+		Status: workflowapi.WorkflowStatus{
+			Phase: workflowapi.WorkflowRunning,
+		},
+	})
+	assert.False(t, workflow.IsPaused())
+}
+
 func TestToStringForStore(t *testing.T) {
 	workflow := NewWorkflow(&workflowapi.Workflow{
 		ObjectMeta: metav1.ObjectMeta{
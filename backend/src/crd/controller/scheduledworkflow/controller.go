@@ -29,10 +29,12 @@ import (
 	swfclientset "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	swfScheme "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/scheme"
 	swfinformers "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/informers/externalversions"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 	wraperror "github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -62,6 +64,7 @@ var (
 // Controller is the controller implementation for ScheduledWorkflow resources
 type Controller struct {
 	kubeClient     *client.KubeClient
+	kubeClientSet  kubernetes.Interface
 	swfClient      *client.ScheduledWorkflowClient
 	workflowClient *client.WorkflowClient
 	runClient      api.RunServiceClient
@@ -112,6 +115,7 @@ func NewController(
 
 	controller := &Controller{
 		kubeClient:     client.NewKubeClient(kubeClientSet, recorder),
+		kubeClientSet:  kubeClientSet,
 		swfClient:      client.NewScheduledWorkflowClient(swfClientSet, swfInformer),
 		runClient:      runClient,
 		workflowClient: client.NewWorkflowClient(workflowClientSet, executionInformer),
@@ -436,13 +440,31 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	err = c.updateStatus(ctx, swf, submitted, active, completed, nextScheduledEpoch, nowEpoch)
+	// Backfill workflows are only submitted when no regular workflow is due, so
+	// that a backfill does not delay the schedule.
+	backfillSubmitted, nextBackfillEpoch := false, int64(0)
+	if !submitted {
+		backfillSubmitted, nextBackfillEpoch, err = c.submitNextBackfillWorkflowIfNeeded(ctx, swf, len(active), nowEpoch)
+		if err != nil {
+			return false, true, swf,
+				wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't submit backfill workflow: %v", name, err)
+		}
+	}
+
+	objectsSubmitted, objectStoreTriggerStatus, err := c.submitObjectWorkflowsIfNeeded(ctx, swf, len(active), nowEpoch)
+	if err != nil {
+		return false, true, swf,
+			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't submit object store workflows: %v", name, err)
+	}
+
+	err = c.updateStatus(ctx, swf, submitted, active, completed, nextScheduledEpoch, nowEpoch,
+		backfillSubmitted, nextBackfillEpoch, objectStoreTriggerStatus)
 	if err != nil {
 		return false, true, swf,
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't update swf status: %v", name, err)
 	}
 
-	if submitted {
+	if submitted || backfillSubmitted || objectsSubmitted > 0 {
 		// Success. Since we created a new workflow, sync again soon since there might be one more
 		// resource to create.
 		log.WithFields(log.Fields{
@@ -495,6 +517,100 @@ func (c *Controller) submitNextWorkflowIfNeeded(ctx context.Context, swf *util.S
 	return submitted, nextScheduledEpoch, nil
 }
 
+// Submits the next workflow of the backfill window if one is due. Returns whether a workflow
+// was submitted, the trigger time of the workflow, and an error (if any).
+func (c *Controller) submitNextBackfillWorkflowIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
+	activeWorkflowCount int, nowEpoch int64) (
+	submitted bool, nextBackfillEpoch int64, err error) {
+	nextBackfillEpoch, shouldRunNow := swf.GetNextBackfillEpoch(
+		int64(activeWorkflowCount), nowEpoch, *c.location)
+
+	if !shouldRunNow {
+		return false, nextBackfillEpoch, nil
+	}
+
+	var workflowName string
+	submitted, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf, nextBackfillEpoch, nowEpoch)
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting backfill workflow for ScheduledWorkflow (%v): transient error while submitting workflow: %v",
+			swf.Name, err)
+		return false, nextBackfillEpoch, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflowName,
+	}).Infof("Submitting backfill workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (scheduled at: %v)",
+		swf.Name, workflowName, commonutil.FormatTimeForLogging(nextBackfillEpoch))
+	return submitted, nextBackfillEpoch, nil
+}
+
+// Submits a workflow for each new object of the bucket prefix watched by the object store
+// trigger if the bucket is due to be polled. Returns the number of submitted workflows and
+// the resulting trigger status, which is nil if the bucket was not polled.
+func (c *Controller) submitObjectWorkflowsIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
+	activeWorkflowCount int, nowEpoch int64) (
+	submittedCount int, triggerStatus *swfapi.TriggerStatus, err error) {
+	if !swf.ShouldPollObjectStore(int64(activeWorkflowCount), nowEpoch) {
+		return 0, nil, nil
+	}
+
+	config, err := swf.ObjectStoreBucketConfig()
+	if err != nil {
+		return 0, nil, err
+	}
+	bucket, err := objectstore.OpenBucket(ctx, c.kubeClientSet, swf.Namespace, config)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer bucket.Close()
+	objects, err := util.ListObjects(ctx, bucket, config, swf.LastObjectKey())
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to list the objects of %q: %w", config.PrefixedBucket(), err)
+	}
+
+	// The pending ScheduledWorkflow tracks the index and the last object of each
+	// submitted workflow, so that the name of the next workflow is unique.
+	pending := util.NewScheduledWorkflow(swf.Get().DeepCopy())
+	next, remaining := pending.NextObjects(objects, int64(activeWorkflowCount))
+	for _, object := range next {
+		parameters, err := commonutil.PayloadParameters(object.Payload(),
+			swf.Spec.Trigger.ObjectStoreTrigger.PayloadParameters)
+		if err != nil {
+			return submittedCount, nil, err
+		}
+		eventSwf, err := pending.WithEventParameters(parameters)
+		if err != nil {
+			return submittedCount, nil, err
+		}
+		_, workflowName, err := c.submitNewWorkflowIfNotAlreadySubmitted(ctx, eventSwf, nowEpoch, nowEpoch)
+		if err != nil {
+			log.WithFields(log.Fields{
+				ScheduledWorkflow: swf.Name,
+			}).Errorf("Submitting workflow for object (%v) of ScheduledWorkflow (%v): transient error while submitting workflow: %v",
+				object.URI, swf.Name, err)
+			break
+		}
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+			Workflow:          workflowName,
+		}).Infof("Submitting workflow for object (%v) of ScheduledWorkflow (%v): workflow (%v) successfully submitted",
+			object.URI, swf.Name, workflowName)
+		pending.MarkObjectSubmitted(object, nowEpoch)
+		submittedCount++
+	}
+
+	if submittedCount == 0 && len(next) > 0 {
+		return 0, nil, fmt.Errorf("failed to submit a workflow for object %q", next[0].URI)
+	}
+	// The bucket is polled again right away while objects are left.
+	if !remaining && submittedCount == len(next) {
+		pending.MarkObjectStorePolled(nowEpoch)
+	}
+	return submittedCount, &pending.Status.Trigger, nil
+}
+
 func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	ctx context.Context,
 	swf *util.ScheduledWorkflow, nextScheduledEpoch int64, nowEpoch int64) (
@@ -577,6 +693,7 @@ func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 				},
 			},
 			ServiceAccount: swf.Spec.ServiceAccount,
+			ScheduledAt:    timestamppb.New(time.Unix(nextScheduledEpoch, 0)),
 		},
 	})
 	if err != nil {
@@ -595,12 +712,17 @@ func (c *Controller) updateStatus(
 	active []swfapi.WorkflowStatus,
 	completed []swfapi.WorkflowStatus,
 	nextScheduledEpoch int64,
-	nowEpoch int64) error {
+	nowEpoch int64,
+	backfillSubmitted bool,
+	nextBackfillEpoch int64,
+	objectStoreTriggerStatus *swfapi.TriggerStatus) error {
 	// NEVER modify objects from the store. It's a read-only, local cache.
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
 	swfCopy := util.NewScheduledWorkflow(swf.Get().DeepCopy())
+	swfCopy.UpdateObjectStoreStatus(objectStoreTriggerStatus)
 	swfCopy.UpdateStatus(nowEpoch, submitted, nextScheduledEpoch, active, completed, c.location)
+	swfCopy.UpdateBackfillStatus(backfillSubmitted, nextBackfillEpoch, c.location)
 
 	// Until #38113 is merged, we must use Update instead of UpdateStatus to
 	// update the Status block of the ScheduledWorkflow. UpdateStatus will not
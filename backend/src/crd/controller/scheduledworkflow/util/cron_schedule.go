@@ -80,6 +80,15 @@ func (s *CronSchedule) getNextScheduledTime(lastJobTime time.Time, location *tim
 		true /* nowEpoch doesn't matter when catchup=true */, time.Unix(0, 0), location)
 }
 
+// GetLocation returns the location the cron string is evaluated in. The time
+// zone of the schedule takes precedence over the default location.
+func (s *CronSchedule) GetLocation(defaultLocation *time.Location) (*time.Location, error) {
+	if s.TimeZone == "" {
+		return defaultLocation, nil
+	}
+	return time.LoadLocation(s.TimeZone)
+}
+
 func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bool, nowTime time.Time, location *time.Location) time.Time {
 	schedule, err := cron.Parse(s.Cron)
 	if err != nil {
@@ -89,6 +98,15 @@ func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bo
 		return maxTime.In(location)
 	}
 
+	scheduleLocation, err := s.GetLocation(location)
+	if err != nil {
+		// This should never happen, validation should have caught this at resource creation.
+		log.Errorf("%+v", wraperror.Errorf(
+			"Found invalid time zone (%v): %v", s.TimeZone, err))
+		return maxTime.In(location)
+	}
+	location = scheduleLocation
+
 	startTime := lastJobTime
 	if s.StartTime != nil && s.StartTime.Time.After(startTime) {
 		startTime = s.StartTime.Time
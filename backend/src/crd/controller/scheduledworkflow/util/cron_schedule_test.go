@@ -149,6 +149,30 @@ func TestCronSchedule_GetNextScheduledTime_LocationsEnvSet(t *testing.T) {
 		schedule.GetNextScheduledTime(nil, defaultStartTime, location))
 }
 
+func TestCronSchedule_GetNextScheduledTime_TimeZone(t *testing.T) {
+	// 02:00 in Berlin is 01:00 UTC in winter and 00:00 UTC in summer.
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 2 * * *",
+		TimeZone: "Europe/Berlin",
+	})
+	location, _ := time.LoadLocation("UTC")
+	winter := v1.NewTime(time.Date(2024, time.January, 10, 1, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2024, time.January, 11, 1, 0, 0, 0, time.UTC),
+		schedule.GetNextScheduledTime(&winter, winter.Time, location).UTC())
+	summer := v1.NewTime(time.Date(2024, time.July, 10, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2024, time.July, 11, 0, 0, 0, 0, time.UTC),
+		schedule.GetNextScheduledTime(&summer, summer.Time, location).UTC())
+
+	// The time zone of the schedule takes precedence over the default location.
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	assert.Equal(t, time.Date(2024, time.January, 11, 1, 0, 0, 0, time.UTC),
+		schedule.GetNextScheduledTime(&winter, winter.Time, shanghai).UTC())
+
+	schedule.TimeZone = "Invalid/Zone"
+	assert.Equal(t, maxTime.UTC(),
+		schedule.GetNextScheduledTime(&winter, winter.Time, location))
+}
+
 func TestCronSchedule_GetNextScheduledTimeNoCatchup(t *testing.T) {
 	// There was a previous job, hasn't been time for next job
 	schedule := NewCronSchedule(&swfapi.CronSchedule{
@@ -0,0 +1,253 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/storage"
+	s3v2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"gocloud.dev/blob"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultPollIntervalSecond = int64(60)
+
+// ObjectEvent is an object of the bucket prefix watched by an object store trigger.
+type ObjectEvent struct {
+	Key          string
+	URI          string
+	Size         int64
+	ModifiedTime time.Time
+}
+
+// Payload returns the fields of the object that can be mapped to workflow parameters.
+func (o ObjectEvent) Payload() map[string]interface{} {
+	return map[string]interface{}{
+		"key":          o.Key,
+		"uri":          o.URI,
+		"size":         o.Size,
+		"modifiedTime": o.ModifiedTime.UTC().Format(time.RFC3339),
+	}
+}
+
+// ListObjects lists the objects of a bucket opened with the prefix of the config
+// whose keys sort after startAfter, in the order of their keys. S3 and GCS
+// buckets start the listing after the key, so that the objects processed by
+// previous polls are not listed again. Modification times are truncated to
+// seconds, the precision of the status of the ScheduledWorkflow.
+func ListObjects(ctx context.Context, bucket *blob.Bucket, config *objectstore.Config, startAfter string) ([]ObjectEvent, error) {
+	options := &blob.ListOptions{}
+	if startAfter != "" {
+		// The bucket is prefixed, but the providers see the full keys.
+		startAfterKey := config.Prefix + startAfter
+		options.BeforeList = func(as func(interface{}) bool) error {
+			var s3Input *s3.ListObjectsV2Input
+			if as(&s3Input) {
+				s3Input.StartAfter = aws.String(startAfterKey)
+			}
+			var s3v2Input *s3v2.ListObjectsV2Input
+			if as(&s3v2Input) {
+				s3v2Input.StartAfter = aws.String(startAfterKey)
+			}
+			var gcsQuery *storage.Query
+			if as(&gcsQuery) {
+				// The offset is inclusive, the key itself is filtered below.
+				gcsQuery.StartOffset = startAfterKey
+			}
+			return nil
+		}
+	}
+	objects := make([]ObjectEvent, 0)
+	iter := bucket.List(options)
+	for {
+		object, err := iter.Next(ctx)
+		if err == io.EOF {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Other providers list every object of the prefix.
+		if object.IsDir || object.Key <= startAfter {
+			continue
+		}
+		objects = append(objects, ObjectEvent{
+			Key:          object.Key,
+			URI:          config.UriFromKey(object.Key),
+			Size:         object.Size,
+			ModifiedTime: object.ModTime.Truncate(time.Second),
+		})
+	}
+}
+
+// LastObjectKey returns the key of the last object that created a workflow,
+// from which the next listing of the bucket prefix starts.
+func (s *ScheduledWorkflow) LastObjectKey() string {
+	if status := s.Status.Trigger.ObjectStore; status != nil {
+		return status.LastObjectKey
+	}
+	return ""
+}
+
+// ObjectStoreBucketConfig returns the configuration of the bucket prefix watched
+// by the object store trigger.
+func (s *ScheduledWorkflow) ObjectStoreBucketConfig() (*objectstore.Config, error) {
+	trigger := s.Spec.Trigger.ObjectStoreTrigger
+	var sessionInfo *objectstore.SessionInfo
+	if trigger.Provider != "" {
+		sessionInfo = &objectstore.SessionInfo{
+			Provider: trigger.Provider,
+			Params:   trigger.ProviderParams,
+		}
+	}
+	return objectstore.ParseBucketConfig(trigger.BucketURL, sessionInfo)
+}
+
+// ShouldPollObjectStore returns whether the bucket prefix of the object store
+// trigger should be listed now.
+func (s *ScheduledWorkflow) ShouldPollObjectStore(activeWorkflowCount int64, nowEpoch int64) bool {
+	trigger := s.Spec.Trigger.ObjectStoreTrigger
+	if trigger == nil || !s.enabled() || activeWorkflowCount >= s.maxConcurrency() {
+		return false
+	}
+	status := s.Status.Trigger.ObjectStore
+	if status == nil || status.LastPollTime == nil {
+		return true
+	}
+	interval := trigger.PollIntervalSecond
+	if interval <= 0 {
+		interval = defaultPollIntervalSecond
+	}
+	return nowEpoch >= status.LastPollTime.Unix()+interval
+}
+
+// NextObjects returns the objects whose keys follow the key of the last object
+// that created a workflow, in the order of their keys, up to the number of
+// workflows that can still run concurrently. Objects that were last modified
+// before the ScheduledWorkflow was created are skipped. It also returns whether
+// objects are left for later.
+func (s *ScheduledWorkflow) NextObjects(objects []ObjectEvent, activeWorkflowCount int64) ([]ObjectEvent, bool) {
+	lastKey := s.LastObjectKey()
+	next := make([]ObjectEvent, 0)
+	for _, object := range objects {
+		if object.Key > lastKey && object.ModifiedTime.After(s.CreationTimestamp.Time) {
+			next = append(next, object)
+		}
+	}
+	sort.Slice(next, func(i, j int) bool {
+		return next[i].Key < next[j].Key
+	})
+
+	available := s.maxConcurrency() - activeWorkflowCount
+	if available < 0 {
+		available = 0
+	}
+	if int64(len(next)) > available {
+		return next[:available], true
+	}
+	return next, false
+}
+
+// MarkObjectSubmitted records that a workflow was created for the object.
+func (s *ScheduledWorkflow) MarkObjectSubmitted(object ObjectEvent, nowEpoch int64) {
+	status := s.objectStoreStatus()
+	status.LastObjectModifiedTime = commonutil.Metav1TimePointer(metav1.NewTime(object.ModifiedTime.UTC()))
+	status.LastObjectKey = object.Key
+	s.updateLastTriggeredTime(nowEpoch)
+	s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
+}
+
+// MarkObjectStorePolled records that every object of the bucket prefix was processed.
+func (s *ScheduledWorkflow) MarkObjectStorePolled(nowEpoch int64) {
+	s.objectStoreStatus().LastPollTime = commonutil.Metav1TimePointer(
+		metav1.NewTime(time.Unix(nowEpoch, 0).UTC()))
+}
+
+func (s *ScheduledWorkflow) objectStoreStatus() *swfapi.ObjectStoreTriggerStatus {
+	if s.Status.Trigger.ObjectStore == nil {
+		s.Status.Trigger.ObjectStore = &swfapi.ObjectStoreTriggerStatus{}
+	}
+	return s.Status.Trigger.ObjectStore
+}
+
+// UpdateObjectStoreStatus applies the trigger status that results from polling
+// the bucket prefix, if any. It clears the status of the object store trigger
+// if the ScheduledWorkflow no longer has one.
+func (s *ScheduledWorkflow) UpdateObjectStoreStatus(polled *swfapi.TriggerStatus) {
+	if s.Spec.Trigger.ObjectStoreTrigger == nil {
+		s.Status.Trigger.ObjectStore = nil
+		return
+	}
+	if polled != nil {
+		s.Status.Trigger = *polled.DeepCopy()
+	}
+}
+
+// WithEventParameters returns a copy of the ScheduledWorkflow whose workflow
+// parameters are overridden by the parameters of an event.
+func (s *ScheduledWorkflow) WithEventParameters(parameters map[string]interface{}) (*ScheduledWorkflow, error) {
+	swf := s.ScheduledWorkflow.DeepCopy()
+	if swf.Spec.Workflow == nil {
+		swf.Spec.Workflow = &swfapi.WorkflowResource{}
+	}
+	// Argo workflows take the raw values of the parameters, while the run API
+	// takes their JSON values.
+	rawValues := swf.Spec.Workflow.Spec != nil
+
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, err := formatEventParameter(parameters[name], rawValues)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for i := range swf.Spec.Workflow.Parameters {
+			if swf.Spec.Workflow.Parameters[i].Name == name {
+				swf.Spec.Workflow.Parameters[i].Value = value
+				found = true
+			}
+		}
+		if !found {
+			swf.Spec.Workflow.Parameters = append(swf.Spec.Workflow.Parameters,
+				swfapi.Parameter{Name: name, Value: value})
+		}
+	}
+	return &ScheduledWorkflow{swf, s.uuid}, nil
+}
+
+func formatEventParameter(value interface{}, raw bool) (string, error) {
+	if str, ok := value.(string); ok && raw {
+		return str, nil
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
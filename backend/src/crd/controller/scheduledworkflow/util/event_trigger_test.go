@@ -0,0 +1,168 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob/memblob"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func objectStoreScheduledWorkflow(creationEpoch int64) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(creationEpoch, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(2),
+			Trigger: swfapi.Trigger{
+				ObjectStoreTrigger: &swfapi.ObjectStoreTrigger{
+					BucketURL:          "mem://bucket/incoming",
+					PollIntervalSecond: 30,
+				},
+			},
+		},
+	})
+}
+
+func TestListObjects(t *testing.T) {
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+	require.Nil(t, bucket.WriteAll(ctx, "a.csv", []byte("abc"), nil))
+	require.Nil(t, bucket.WriteAll(ctx, "dir/b.csv", []byte("de"), nil))
+	config, err := objectstore.ParseBucketConfig("mem://bucket/incoming", nil)
+	require.Nil(t, err)
+
+	objects, err := ListObjects(ctx, bucket, config, "")
+	require.Nil(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "a.csv", objects[0].Key)
+	assert.Equal(t, "mem://bucket/incoming/a.csv", objects[0].URI)
+	assert.Equal(t, int64(3), objects[0].Size)
+	assert.Equal(t, 0, objects[0].ModifiedTime.Nanosecond())
+	assert.Equal(t, "dir/b.csv", objects[1].Key)
+	assert.Equal(t, "mem://bucket/incoming/dir/b.csv", objects[1].URI)
+
+	// The listing starts after the given key.
+	objects, err = ListObjects(ctx, bucket, config, "a.csv")
+	require.Nil(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "dir/b.csv", objects[0].Key)
+}
+
+func TestScheduledWorkflow_GetNextScheduledEpoch_EventTrigger(t *testing.T) {
+	schedule := objectStoreScheduledWorkflow(1000)
+	assert.False(t, schedule.isOneOffRun())
+	nextEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 2000, *time.UTC)
+	assert.Equal(t, int64(math.MaxInt64), nextEpoch)
+	assert.False(t, shouldRunNow)
+}
+
+func TestScheduledWorkflow_ShouldPollObjectStore(t *testing.T) {
+	schedule := objectStoreScheduledWorkflow(1000)
+	assert.True(t, schedule.ShouldPollObjectStore(0, 1000))
+	assert.False(t, schedule.ShouldPollObjectStore(2, 1000))
+
+	schedule.MarkObjectStorePolled(1000)
+	assert.False(t, schedule.ShouldPollObjectStore(0, 1029))
+	assert.True(t, schedule.ShouldPollObjectStore(0, 1030))
+
+	schedule.Spec.Enabled = false
+	assert.False(t, schedule.ShouldPollObjectStore(0, 1030))
+}
+
+func TestScheduledWorkflow_NextObjects(t *testing.T) {
+	schedule := objectStoreScheduledWorkflow(1000)
+	objects := []ObjectEvent{
+		{Key: "c", ModifiedTime: time.Unix(1100, 0)},
+		{Key: "old", ModifiedTime: time.Unix(900, 0)},
+		{Key: "b", ModifiedTime: time.Unix(1200, 0)},
+		{Key: "a", ModifiedTime: time.Unix(1200, 0)},
+	}
+
+	next, remaining := schedule.NextObjects(objects, 0)
+	assert.True(t, remaining)
+	require.Len(t, next, 2)
+	assert.Equal(t, "a", next[0].Key)
+	assert.Equal(t, "b", next[1].Key)
+
+	schedule.MarkObjectSubmitted(next[0], 1300)
+	schedule.MarkObjectSubmitted(next[1], 1300)
+	assert.Equal(t, int64(2), *schedule.Status.Trigger.LastIndex)
+	assert.Equal(t, int64(1300), schedule.Status.Trigger.LastTriggeredTime.Unix())
+	assert.Equal(t, "b", schedule.Status.Trigger.ObjectStore.LastObjectKey)
+	assert.Equal(t, "b", schedule.LastObjectKey())
+
+	next, remaining = schedule.NextObjects(objects, 1)
+	assert.False(t, remaining)
+	require.Len(t, next, 1)
+	assert.Equal(t, "c", next[0].Key)
+}
+
+func TestScheduledWorkflow_UpdateObjectStoreStatus(t *testing.T) {
+	polled := objectStoreScheduledWorkflow(1000)
+	polled.MarkObjectSubmitted(ObjectEvent{Key: "a", ModifiedTime: time.Unix(1100, 0)}, 1200)
+	polled.MarkObjectStorePolled(1200)
+
+	schedule := objectStoreScheduledWorkflow(1000)
+	schedule.UpdateObjectStoreStatus(nil)
+	assert.Nil(t, schedule.Status.Trigger.ObjectStore)
+	schedule.UpdateObjectStoreStatus(&polled.Status.Trigger)
+	assert.Equal(t, polled.Status.Trigger, schedule.Status.Trigger)
+
+	schedule.Spec.Trigger.ObjectStoreTrigger = nil
+	schedule.UpdateObjectStoreStatus(nil)
+	assert.Nil(t, schedule.Status.Trigger.ObjectStore)
+}
+
+func TestScheduledWorkflow_WithEventParameters(t *testing.T) {
+	parameters := map[string]interface{}{"path": "mem://bucket/a.csv", "size": int64(3)}
+
+	// Workflows created with the run API take JSON values.
+	schedule := objectStoreScheduledWorkflow(1000)
+	eventSchedule, err := schedule.WithEventParameters(parameters)
+	require.Nil(t, err)
+	assert.Equal(t, []swfapi.Parameter{
+		{Name: "path", Value: `"mem://bucket/a.csv"`},
+		{Name: "size", Value: "3"},
+	}, eventSchedule.Spec.Workflow.Parameters)
+	assert.Nil(t, schedule.Spec.Workflow)
+
+	// Argo workflows take raw string values.
+	schedule.Spec.Workflow = &swfapi.WorkflowResource{
+		Parameters: []swfapi.Parameter{{Name: "path", Value: "default"}, {Name: "other", Value: "x"}},
+		Spec:       &workflowapi.Workflow{},
+	}
+	eventSchedule, err = schedule.WithEventParameters(parameters)
+	require.Nil(t, err)
+	assert.Equal(t, []swfapi.Parameter{
+		{Name: "path", Value: "mem://bucket/a.csv"},
+		{Name: "other", Value: "x"},
+		{Name: "size", Value: "3"},
+	}, eventSchedule.Spec.Workflow.Parameters)
+	assert.Equal(t, "default", schedule.Spec.Workflow.Parameters[0].Value)
+}
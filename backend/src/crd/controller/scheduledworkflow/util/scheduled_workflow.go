@@ -38,6 +38,10 @@ const (
 	maxMaxHistory         = int64(100)
 )
 
+// MaxBackfillRuns is the maximum number of workflows created for a backfill
+// window. The trigger times beyond it are not enumerated nor run.
+const MaxBackfillRuns = int64(10000)
+
 // ScheduledWorkflow is a type to help manipulate ScheduledWorkflow objects.
 type ScheduledWorkflow struct {
 	*swfapi.ScheduledWorkflow
@@ -124,7 +128,13 @@ func (s *ScheduledWorkflow) MinIndex() int64 {
 
 func (s *ScheduledWorkflow) isOneOffRun() bool {
 	return s.Spec.Trigger.CronSchedule == nil &&
-		s.Spec.Trigger.PeriodicSchedule == nil
+		s.Spec.Trigger.PeriodicSchedule == nil &&
+		!s.isEventTriggered()
+}
+
+func (s *ScheduledWorkflow) isEventTriggered() bool {
+	return s.Spec.Trigger.ObjectStoreTrigger != nil ||
+		s.Spec.Trigger.WebhookTrigger != nil
 }
 
 func (s *ScheduledWorkflow) nextResourceID() string {
@@ -249,6 +259,11 @@ func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64, location time.
 			time.Unix(s.creationEpoch(), 0).In(&location), nowTime, &location).Unix()
 	}
 
+	// Event triggers create workflows when events occur, never on a schedule.
+	if s.isEventTriggered() {
+		return math.MaxInt64
+	}
+
 	return s.getNextScheduledEpochForOneTimeRun()
 }
 
@@ -260,6 +275,138 @@ func (s *ScheduledWorkflow) getNextScheduledEpochForOneTimeRun() int64 {
 	return s.creationEpoch()
 }
 
+// GetNextBackfillEpoch returns the next trigger time of the backfill window
+// for which no workflow was created yet, and whether it should be run now.
+func (s *ScheduledWorkflow) GetNextBackfillEpoch(activeWorkflowCount int64, nowEpoch int64, location time.Location) (
+	nextBackfillEpoch int64, shouldRunNow bool) {
+
+	if s.Spec.Backfill == nil {
+		return math.MaxInt64, false
+	}
+
+	var lastBackfillTime *metav1.Time
+	if status := s.currentBackfillStatus(); status != nil {
+		if status.SubmittedCount >= MaxBackfillRuns {
+			return math.MaxInt64, false
+		}
+		lastBackfillTime = status.LastTriggeredTime
+	}
+	nextBackfillEpoch = s.getNextBackfillEpoch(lastBackfillTime, location)
+
+	if s.enabled() == false {
+		return nextBackfillEpoch, false
+	}
+
+	if activeWorkflowCount >= s.maxConcurrency() {
+		return nextBackfillEpoch, false
+	}
+
+	// The backfill is complete, or its window is not over yet.
+	if nextBackfillEpoch >= maxTime.Unix() || nextBackfillEpoch > nowEpoch {
+		return nextBackfillEpoch, false
+	}
+
+	return nextBackfillEpoch, true
+}
+
+// getNextBackfillEpoch enumerates the trigger times of the backfill window
+// with the schedule of the trigger, bounded by the window. Periodic schedules
+// are anchored at the start of the window. One-off runs have nothing to
+// backfill.
+func (s *ScheduledWorkflow) getNextBackfillEpoch(lastBackfillTime *metav1.Time, location time.Location) int64 {
+	backfill := s.Spec.Backfill
+
+	if s.Spec.Trigger.PeriodicSchedule != nil {
+		periodicSchedule := s.Spec.Trigger.PeriodicSchedule.DeepCopy()
+		periodicSchedule.StartTime = backfill.StartTime.DeepCopy()
+		periodicSchedule.EndTime = backfill.EndTime.DeepCopy()
+		schedule := NewPeriodicSchedule(periodicSchedule)
+		// Start from one interval before the window, so that the start of the
+		// window is the first trigger time.
+		lastBackfillEpoch := backfill.StartTime.Unix() - schedule.getInterval()
+		if lastBackfillTime != nil {
+			lastBackfillEpoch = lastBackfillTime.Unix()
+		}
+		return schedule.GetNextScheduledEpoch(&lastBackfillEpoch, backfill.StartTime.Unix())
+	}
+
+	if s.Spec.Trigger.CronSchedule != nil {
+		cronSchedule := s.Spec.Trigger.CronSchedule.DeepCopy()
+		// The next cron time is strictly after the start time, so start from
+		// one second before the window to include its start.
+		cronSchedule.StartTime = commonutil.Metav1TimePointer(
+			metav1.NewTime(backfill.StartTime.Add(-time.Second)))
+		cronSchedule.EndTime = backfill.EndTime.DeepCopy()
+		schedule := NewCronSchedule(cronSchedule)
+		return schedule.GetNextScheduledTime(lastBackfillTime,
+			cronSchedule.StartTime.Time.In(&location), &location).Unix()
+	}
+
+	return math.MaxInt64
+}
+
+// currentBackfillStatus returns the status of the backfill, or nil if the
+// status does not belong to the backfill window in the spec.
+func (s *ScheduledWorkflow) currentBackfillStatus() *swfapi.BackfillStatus {
+	status := s.Status.Backfill
+	if s.Spec.Backfill == nil || status == nil {
+		return nil
+	}
+	if !status.StartTime.Equal(&s.Spec.Backfill.StartTime) ||
+		!status.EndTime.Equal(&s.Spec.Backfill.EndTime) {
+		return nil
+	}
+	return status
+}
+
+// UpdateBackfillStatus updates the progress of the backfill. It resets the
+// progress when the backfill window changed.
+func (s *ScheduledWorkflow) UpdateBackfillStatus(submitted bool, scheduledEpoch int64, location *time.Location) {
+	if s.Spec.Backfill == nil {
+		s.Status.Backfill = nil
+		return
+	}
+
+	status := s.currentBackfillStatus()
+	if status == nil {
+		status = &swfapi.BackfillStatus{
+			StartTime:  *s.Spec.Backfill.StartTime.DeepCopy(),
+			EndTime:    *s.Spec.Backfill.EndTime.DeepCopy(),
+			TotalCount: s.CountBackfillEpochs(MaxBackfillRuns, location),
+		}
+		s.Status.Backfill = status
+	}
+
+	if submitted {
+		status.LastTriggeredTime = commonutil.Metav1TimePointer(
+			metav1.NewTime(time.Unix(scheduledEpoch, 0).UTC()))
+		status.SubmittedCount++
+		s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
+	}
+
+	status.Completed = status.SubmittedCount >= MaxBackfillRuns ||
+		s.getNextBackfillEpoch(status.LastTriggeredTime, *location) >= maxTime.Unix()
+}
+
+// CountBackfillEpochs returns the number of trigger times of the backfill
+// window, counting at most limit of them.
+func (s *ScheduledWorkflow) CountBackfillEpochs(limit int64, location *time.Location) int64 {
+	if s.Spec.Backfill == nil {
+		return 0
+	}
+	count := int64(0)
+	var lastBackfillTime *metav1.Time
+	for count < limit {
+		epoch := s.getNextBackfillEpoch(lastBackfillTime, *location)
+		if epoch >= maxTime.Unix() {
+			return count
+		}
+		count++
+		lastBackfillTime = commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(epoch, 0).UTC()))
+	}
+	return count
+}
+
 func (s *ScheduledWorkflow) setLabel(key string, value string) {
 	if s.Labels == nil {
 		s.Labels = make(map[string]string)
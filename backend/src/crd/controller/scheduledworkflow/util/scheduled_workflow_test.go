@@ -665,6 +665,173 @@ func TestScheduledWorkflow_GetNextScheduledEpoch_UpdateStatus_WithWorkflow(t *te
 	assert.Equal(t, expected, schedule.Get())
 }
 
+func TestScheduledWorkflow_GetNextBackfillEpoch_CronSchedule(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	creationTimestamp := metav1.NewTime(time.Unix(9*hour, 0).UTC())
+
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: creationTimestamp,
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron: "0 * * * * *", // trigger every minute
+				},
+			},
+			Backfill: &swfapi.Backfill{
+				StartTime: metav1.NewTime(time.Unix(2*hour, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(2*hour+2*minute, 0).UTC()),
+			},
+		},
+	})
+
+	// Must run now, starting with the start of the window
+	nextBackfillEpoch, mustRunNow := schedule.GetNextBackfillEpoch(
+		int64(9) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(2*hour), nextBackfillEpoch)
+
+	// Cannot run because of concurrency
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(10) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(2*hour), nextBackfillEpoch)
+
+	// Cannot run because the schedule is disabled
+	schedule.Spec.Enabled = false
+	_, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+
+	// Nothing to run without backfill
+	schedule.Spec.Enabled = true
+	schedule.Spec.Backfill = nil
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(math.MaxInt64), nextBackfillEpoch)
+}
+
+func TestScheduledWorkflow_GetNextBackfillEpoch_PeriodicSchedule(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	creationTimestamp := metav1.NewTime(time.Unix(9*hour, 0).UTC())
+
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: creationTimestamp,
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(60),
+				},
+			},
+			Backfill: &swfapi.Backfill{
+				StartTime: metav1.NewTime(time.Unix(2*hour, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(2*hour+150, 0).UTC()),
+			},
+		},
+	})
+
+	nextBackfillEpoch, mustRunNow := schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(2*hour), nextBackfillEpoch)
+	assert.Equal(t, int64(3), schedule.CountBackfillEpochs(MaxBackfillRuns, &time.Location{}))
+	// The count stops at the limit.
+	assert.Equal(t, int64(2), schedule.CountBackfillEpochs(2, &time.Location{}))
+
+	// Must run later if the window is not over yet
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, int64(hour), time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(2*hour), nextBackfillEpoch)
+}
+
+func TestScheduledWorkflow_UpdateBackfillStatus(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	creationTimestamp := metav1.NewTime(time.Unix(9*hour, 0).UTC())
+
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: creationTimestamp,
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron: "0 * * * * *", // trigger every minute
+				},
+			},
+			Backfill: &swfapi.Backfill{
+				StartTime: metav1.NewTime(time.Unix(2*hour, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(2*hour+2*minute, 0).UTC()),
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{
+				LastIndex: commonutil.Int64Pointer(5),
+			},
+		},
+	})
+
+	// Nothing submitted yet
+	schedule.UpdateBackfillStatus(false, int64(2*hour), time.UTC)
+	assert.Equal(t, &swfapi.BackfillStatus{
+		StartTime:  metav1.NewTime(time.Unix(2*hour, 0).UTC()),
+		EndTime:    metav1.NewTime(time.Unix(2*hour+2*minute, 0).UTC()),
+		TotalCount: 3,
+	}, schedule.Status.Backfill)
+
+	// Submit every trigger time of the window
+	for i := int64(0); i < 3; i++ {
+		nextBackfillEpoch, mustRunNow := schedule.GetNextBackfillEpoch(
+			int64(0) /* active workflow count */, nowEpoch, time.Location{})
+		assert.Equal(t, true, mustRunNow)
+		assert.Equal(t, int64(2*hour+i*minute), nextBackfillEpoch)
+		schedule.UpdateBackfillStatus(true, nextBackfillEpoch, time.UTC)
+		assert.Equal(t, i+1, schedule.Status.Backfill.SubmittedCount)
+		assert.Equal(t, i == 2, schedule.Status.Backfill.Completed)
+		assert.Equal(t, 6+i, *schedule.Status.Trigger.LastIndex)
+	}
+	assert.Equal(t, commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(2*hour+2*minute, 0).UTC())),
+		schedule.Status.Backfill.LastTriggeredTime)
+
+	_, mustRunNow := schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+
+	// A new window resets the progress
+	schedule.Spec.Backfill.EndTime = metav1.NewTime(time.Unix(2*hour+3*minute, 0).UTC())
+	nextBackfillEpoch, mustRunNow := schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(2*hour), nextBackfillEpoch)
+	schedule.UpdateBackfillStatus(false, nextBackfillEpoch, time.UTC)
+	assert.Equal(t, int64(4), schedule.Status.Backfill.TotalCount)
+	assert.Equal(t, int64(0), schedule.Status.Backfill.SubmittedCount)
+	assert.Nil(t, schedule.Status.Backfill.LastTriggeredTime)
+
+	// Nothing more is run once the maximum number of runs was submitted
+	schedule.Status.Backfill.SubmittedCount = MaxBackfillRuns
+	_, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	schedule.UpdateBackfillStatus(false, nextBackfillEpoch, time.UTC)
+	assert.Equal(t, true, schedule.Status.Backfill.Completed)
+
+	// Removing the backfill clears its status
+	schedule.Spec.Backfill = nil
+	schedule.UpdateBackfillStatus(false, int64(math.MaxInt64), time.UTC)
+	assert.Nil(t, schedule.Status.Backfill)
+}
+
 func TestScheduledWorkflow_NewWorkflow(t *testing.T) {
 
 	tests := []struct {
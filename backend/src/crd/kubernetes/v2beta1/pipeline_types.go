@@ -18,6 +18,7 @@ package v2beta1
 
 import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -53,6 +54,7 @@ func FromPipelineModel(pipeline model.Pipeline) Pipeline {
 			Name:      pipeline.Name,
 			Namespace: pipeline.Namespace,
 			UID:       types.UID(pipeline.UUID),
+			Labels:    pipeline.Labels,
 		},
 		Spec: PipelineSpec{
 			DisplayName: pipeline.DisplayName,
@@ -64,41 +66,54 @@ func FromPipelineModel(pipeline model.Pipeline) Pipeline {
 func (p *Pipeline) ToModel() *model.Pipeline {
 	pipelineStatus := model.PipelineCreating
 
-	displayName := p.Spec.DisplayName
-	if displayName == "" {
-		displayName = p.Name
-	}
-
 	return &model.Pipeline{
 		Name:           p.Name,
-		DisplayName:    displayName,
+		DisplayName:    p.displayName(),
 		Description:    p.Spec.Description,
 		Namespace:      p.Namespace,
 		UUID:           string(p.UID),
 		CreatedAtInSec: p.CreationTimestamp.Unix(),
 		Status:         pipelineStatus,
+		Labels:         p.Labels,
 	}
 }
 
-func (p *Pipeline) GetField(name string) interface{} {
-	switch name {
-	case "pipelines.id":
-		return p.UID
-	case "pipelines.pipeline_id":
-		return p.UID
-	case "pipelines.Name":
+// displayName returns the display name of the pipeline, which defaults to its
+// name.
+func (p *Pipeline) displayName() string {
+	if p.Spec.DisplayName == "" {
 		return p.Name
-	case "pipelines.display_name":
-		return p.Spec.DisplayName
-	case "pipelines.created_at":
-		return p.CreationTimestamp
+	}
+	return p.Spec.DisplayName
+}
+
+// GetField returns the value of the pipeline for a filter key of the API, e.g.
+// "display_name" or "labels.team", or nil if the pipeline does not have the
+// label.
+func (p *Pipeline) GetField(key string) (interface{}, error) {
+	switch key {
+	case "id", "pipeline_id":
+		return string(p.UID), nil
+	case "name":
+		return p.Name, nil
+	case "display_name":
+		return p.displayName(), nil
+	case "created_at":
+		return p.CreationTimestamp.Unix(), nil
 	case "description":
-		return p.Spec.Description
-	case "pipelines.namespace":
-		return p.Namespace
-	default:
-		return ""
+		return p.Spec.Description, nil
+	case "namespace":
+		return p.Namespace, nil
+	}
+	labelName, ok := model.LabelNameFromKey(key)
+	if !ok {
+		return nil, util.NewInvalidInputError("no support for filtering pipelines on field %q", key)
+	}
+	value, ok := p.Labels[labelName]
+	if !ok {
+		return nil, nil
 	}
+	return value, nil
 }
 
 func init() {
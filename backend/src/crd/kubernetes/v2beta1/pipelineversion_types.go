@@ -23,6 +23,7 @@ import (
 
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"google.golang.org/protobuf/encoding/protojson"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -164,29 +165,9 @@ func FromPipelineVersionModel(pipeline model.Pipeline, pipelineVersion model.Pip
 }
 
 func (p *PipelineVersion) ToModel() (*model.PipelineVersion, error) {
-	piplineSpecAndPlatformSpec, err := yaml.Marshal(p.Spec.PipelineSpec.Value)
+	piplineSpecAndPlatformSpec, err := p.pipelineSpecYAML()
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal pipeline spec to YAML: %w", err)
-	}
-
-	if p.Spec.PlatformSpec != nil && p.Spec.PlatformSpec.Value != nil {
-		platformSpecBytes, err := yaml.Marshal(p.Spec.PlatformSpec.Value)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal platform spec to YAML: %w", err)
-		}
-
-		piplineSpecAndPlatformSpec = append(piplineSpecAndPlatformSpec, []byte("\n---\n")...)
-		piplineSpecAndPlatformSpec = append(piplineSpecAndPlatformSpec, platformSpecBytes...)
-	}
-
-	pipelineVersionStatus := model.PipelineVersionCreating
-
-	for _, condition := range p.Status.Conditions {
-		if condition.Type == "PipelineVersionStatus" {
-			pipelineVersionStatus = model.PipelineVersionStatus(condition.Reason)
-
-			break
-		}
+		return nil, err
 	}
 
 	var pipelineID types.UID
@@ -199,26 +180,62 @@ func (p *PipelineVersion) ToModel() (*model.PipelineVersion, error) {
 		}
 	}
 
-	displayName := p.Spec.DisplayName
-	if displayName == "" {
-		displayName = p.Name
-	}
-
 	return &model.PipelineVersion{
 		UUID:            string(p.UID),
 		CreatedAtInSec:  p.CreationTimestamp.Unix(),
 		Name:            p.Name,
-		DisplayName:     displayName,
+		DisplayName:     p.displayName(),
 		Parameters:      "",
 		PipelineId:      string(pipelineID),
-		Status:          pipelineVersionStatus,
+		Status:          p.status(),
 		CodeSourceUrl:   p.Spec.CodeSourceURL,
 		Description:     p.Spec.Description,
-		PipelineSpec:    string(piplineSpecAndPlatformSpec),
+		PipelineSpec:    piplineSpecAndPlatformSpec,
 		PipelineSpecURI: p.Spec.PipelineSpecURI,
 	}, nil
 }
 
+// pipelineSpecYAML returns the pipeline spec of the pipeline version as YAML,
+// followed by its platform spec if it has one.
+func (p *PipelineVersion) pipelineSpecYAML() (string, error) {
+	piplineSpecAndPlatformSpec, err := yaml.Marshal(p.Spec.PipelineSpec.Value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pipeline spec to YAML: %w", err)
+	}
+
+	if p.Spec.PlatformSpec != nil && p.Spec.PlatformSpec.Value != nil {
+		platformSpecBytes, err := yaml.Marshal(p.Spec.PlatformSpec.Value)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal platform spec to YAML: %w", err)
+		}
+
+		piplineSpecAndPlatformSpec = append(piplineSpecAndPlatformSpec, []byte("\n---\n")...)
+		piplineSpecAndPlatformSpec = append(piplineSpecAndPlatformSpec, platformSpecBytes...)
+	}
+
+	return string(piplineSpecAndPlatformSpec), nil
+}
+
+// status returns the status of the pipeline version from its conditions.
+func (p *PipelineVersion) status() model.PipelineVersionStatus {
+	for _, condition := range p.Status.Conditions {
+		if condition.Type == "PipelineVersionStatus" {
+			return model.PipelineVersionStatus(condition.Reason)
+		}
+	}
+
+	return model.PipelineVersionCreating
+}
+
+// displayName returns the display name of the pipeline version, which defaults
+// to its name.
+func (p *PipelineVersion) displayName() string {
+	if p.Spec.DisplayName == "" {
+		return p.Name
+	}
+	return p.Spec.DisplayName
+}
+
 func (p *PipelineVersion) IsOwnedByPipeline(pipelineId string) bool {
 	for _, ownerRef := range p.OwnerReferences {
 		if string(ownerRef.UID) == pipelineId {
@@ -273,22 +290,26 @@ func (in *IRSpec) DeepCopyInto(out *IRSpec) {
 	}
 }
 
-func (p *PipelineVersion) GetField(name string) interface{} {
-	switch name {
-	case "pipeline_versions.UUID":
-		return p.UID
-	case "pipeline_versions.pipeline_version_id":
-		return p.OwnerReferences[0].UID
-	case "pipeline_versions.Name":
-		return p.Name
-	case "pipeline_versions.CreatedAtInSec":
-		return p.CreationTimestamp
-	case "pipeline_versions.DisplayName":
-		return p.Spec.DisplayName
-	case "pipeline_versions.Description":
-		return p.Spec.Description
+// GetField returns the value of the pipeline version for a filter key of the
+// API, e.g. "display_name".
+func (p *PipelineVersion) GetField(key string) (interface{}, error) {
+	switch key {
+	case "id", "pipeline_version_id":
+		return string(p.UID), nil
+	case "name":
+		return p.Name, nil
+	case "display_name":
+		return p.displayName(), nil
+	case "created_at":
+		return p.CreationTimestamp.Unix(), nil
+	case "status":
+		return p.status(), nil
+	case "description":
+		return p.Spec.Description, nil
+	case "pipeline_spec":
+		return p.pipelineSpecYAML()
 	default:
-		return nil
+		return nil, util.NewInvalidInputError("no support for filtering pipeline versions on field %q", key)
 	}
 }
 
@@ -22,8 +22,10 @@ import (
 	"testing"
 
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	k8syaml "sigs.k8s.io/yaml"
@@ -485,6 +487,43 @@ func TestToModel_DisplayNameFallback(t *testing.T) {
 	assert.Equal(t, "test-version", result.DisplayName)
 }
 
+func TestGetField(t *testing.T) {
+	pipelineVersion := &PipelineVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-version",
+			Namespace: "default",
+			UID:       "version-456",
+		},
+		Spec: PipelineVersionSpec{
+			Description: "A test version",
+			PipelineSpec: IRSpec{
+				Value: map[string]interface{}{
+					"schemaVersion": "2.1.0",
+				},
+			},
+		},
+		Status: PipelineVersionStatus{
+			Conditions: []SimplifiedCondition{{Type: "PipelineVersionStatus", Reason: "READY"}},
+		},
+	}
+
+	for key, want := range map[string]interface{}{
+		"pipeline_version_id": "version-456",
+		"name":                "test-version",
+		"display_name":        "test-version",
+		"description":         "A test version",
+		"status":              model.PipelineVersionStatus("READY"),
+		"pipeline_spec":       "schemaVersion: 2.1.0\n",
+	} {
+		value, err := pipelineVersion.GetField(key)
+		require.NoError(t, err)
+		assert.Equal(t, want, value, key)
+	}
+
+	_, err := pipelineVersion.GetField("unknown")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+}
+
 func TestToModel_InvalidPipelineSpec(t *testing.T) {
 	pipelineVersion := &PipelineVersion{
 		ObjectMeta: metav1.ObjectMeta{
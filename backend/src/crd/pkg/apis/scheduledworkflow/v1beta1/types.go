@@ -72,6 +72,12 @@ type ScheduledWorkflowSpec struct {
 	// Trigger describes when to create a new workflow.
 	Trigger `json:"trigger,omitempty"`
 
+	// Backfill requests a workflow for each trigger time of the schedule
+	// within a past time window. Backfill workflows count towards
+	// MaxConcurrency, and are created when no regular workflow is due.
+	// +optional
+	Backfill *Backfill `json:"backfill,omitempty"`
+
 	// Specification of the workflow to schedule.
 	// +optional
 	Workflow *WorkflowResource `json:"workflow,omitempty"`
@@ -137,6 +143,14 @@ type Trigger struct {
 
 	// Create workflows periodically.
 	PeriodicSchedule *PeriodicSchedule `json:"periodicSchedule,omitempty"`
+
+	// Create a workflow for each new object under a bucket prefix.
+	ObjectStoreTrigger *ObjectStoreTrigger `json:"objectStoreTrigger,omitempty"`
+
+	// Create a workflow for each authenticated call to the webhook of the
+	// recurring run. The API server creates these workflows, the controller
+	// never schedules them.
+	WebhookTrigger *WebhookTrigger `json:"webhookTrigger,omitempty"`
 }
 
 type CronSchedule struct {
@@ -154,6 +168,11 @@ type CronSchedule struct {
 	// time interval defined by StartTime and EndTime.
 	// +optional
 	Cron string `json:"cron,omitempty"`
+
+	// IANA time zone in which the cron string is evaluated, e.g. Europe/Berlin.
+	// If no time zone is specified, the default time zone of the controller is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type PeriodicSchedule struct {
@@ -173,6 +192,60 @@ type PeriodicSchedule struct {
 	IntervalSecond int64 `json:"intervalSecond,omitempty"`
 }
 
+type ObjectStoreTrigger struct {
+	// URL of the bucket prefix to watch, e.g. s3://bucket/prefix,
+	// gs://bucket/prefix or minio://bucket/prefix.
+	BucketURL string `json:"bucketUrl"`
+
+	// Provider of the bucket credentials: s3, minio or gs. If no provider is
+	// specified, the credentials are taken from the environment.
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Parameters of the provider, as in the pipeline root configuration of the
+	// kfp-launcher ConfigMap, e.g. fromEnv, secretName, region and endpoint.
+	// +optional
+	ProviderParams map[string]string `json:"providerParams,omitempty"`
+
+	// Minimum interval between two listings of the bucket prefix.
+	// If no interval is specified, the bucket prefix is listed every minute.
+	// +optional
+	PollIntervalSecond int64 `json:"pollIntervalSecond,omitempty"`
+
+	// Workflow parameters set from the payload of an object, by parameter
+	// name. The payload has the fields key, uri, size and modifiedTime.
+	// +optional
+	PayloadParameters map[string]string `json:"payloadParameters,omitempty"`
+}
+
+type WebhookTrigger struct {
+	// Name of the Kubernetes secret holding the HMAC key that signs the calls
+	// to the webhook. The secret is in the namespace of the ScheduledWorkflow.
+	SecretName string `json:"secretName"`
+
+	// Key of the HMAC key in the secret.
+	// If no key is specified, the key is hmac-key.
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
+
+	// Workflow parameters set from the JSON body of a call, by parameter
+	// name. Nested fields are separated by dots.
+	// +optional
+	PayloadParameters map[string]string `json:"payloadParameters,omitempty"`
+}
+
+// Backfill specifies a time window whose trigger times each get a workflow,
+// regardless of NoCatchup and of the last trigger time of the schedule.
+type Backfill struct {
+	// Time at which the backfill window starts. A trigger time equal to the
+	// start time is part of the window.
+	StartTime metav1.Time `json:"startTime"`
+
+	// Time at which the backfill window ends. A trigger time equal to the end
+	// time is part of the window.
+	EndTime metav1.Time `json:"endTime"`
+}
+
 // ScheduledWorkflowStatus is the status for a ScheduledWorkflow resource.
 type ScheduledWorkflowStatus struct {
 
@@ -185,6 +258,10 @@ type ScheduledWorkflowStatus struct {
 
 	// Status of workflow resources.
 	WorkflowHistory *WorkflowHistory `json:"workflowHistory,omitempty"`
+
+	// Progress of the backfill requested in the spec.
+	// +optional
+	Backfill *BackfillStatus `json:"backfill,omitempty"`
 }
 
 type ScheduledWorkflowConditionType string
@@ -226,6 +303,42 @@ type TriggerStatus struct {
 
 	// Index of the last workflow created.
 	LastIndex *int64 `json:"lastWorkflowIndex,omitempty"`
+
+	// Status of the object store trigger.
+	// +optional
+	ObjectStore *ObjectStoreTriggerStatus `json:"objectStore,omitempty"`
+}
+
+type ObjectStoreTriggerStatus struct {
+	// Time of the last complete listing of the bucket prefix.
+	LastPollTime *metav1.Time `json:"lastPollTime,omitempty"`
+
+	// Modification time and key of the last object that created a workflow.
+	// Objects are processed in key order, and listings of the bucket prefix
+	// start after the last key.
+	LastObjectModifiedTime *metav1.Time `json:"lastObjectModifiedTime,omitempty"`
+	LastObjectKey          string       `json:"lastObjectKey,omitempty"`
+}
+
+type BackfillStatus struct {
+	// Time at which the backfill window starts.
+	StartTime metav1.Time `json:"startTime"`
+
+	// Time at which the backfill window ends.
+	EndTime metav1.Time `json:"endTime"`
+
+	// Trigger time of the last workflow created by the backfill.
+	LastTriggeredTime *metav1.Time `json:"lastTriggeredTime,omitempty"`
+
+	// Number of trigger times in the backfill window.
+	TotalCount int64 `json:"totalCount"`
+
+	// Number of workflows created by the backfill.
+	SubmittedCount int64 `json:"submittedCount"`
+
+	// True once a workflow has been created for every trigger time of the
+	// backfill window.
+	Completed bool `json:"completed,omitempty"`
 }
 
 type WorkflowHistory struct {
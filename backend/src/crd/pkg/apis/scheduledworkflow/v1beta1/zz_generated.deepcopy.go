@@ -22,6 +22,46 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backfill) DeepCopyInto(out *Backfill) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backfill.
+func (in *Backfill) DeepCopy() *Backfill {
+	if in == nil {
+		return nil
+	}
+	out := new(Backfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackfillStatus) DeepCopyInto(out *BackfillStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.LastTriggeredTime != nil {
+		in, out := &in.LastTriggeredTime, &out.LastTriggeredTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackfillStatus.
+func (in *BackfillStatus) DeepCopy() *BackfillStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackfillStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronSchedule) DeepCopyInto(out *CronSchedule) {
 	*out = *in
@@ -46,6 +86,60 @@ func (in *CronSchedule) DeepCopy() *CronSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreTrigger) DeepCopyInto(out *ObjectStoreTrigger) {
+	*out = *in
+	if in.ProviderParams != nil {
+		in, out := &in.ProviderParams, &out.ProviderParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PayloadParameters != nil {
+		in, out := &in.PayloadParameters, &out.PayloadParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreTrigger.
+func (in *ObjectStoreTrigger) DeepCopy() *ObjectStoreTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreTriggerStatus) DeepCopyInto(out *ObjectStoreTriggerStatus) {
+	*out = *in
+	if in.LastPollTime != nil {
+		in, out := &in.LastPollTime, &out.LastPollTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastObjectModifiedTime != nil {
+		in, out := &in.LastObjectModifiedTime, &out.LastObjectModifiedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreTriggerStatus.
+func (in *ObjectStoreTriggerStatus) DeepCopy() *ObjectStoreTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
@@ -184,6 +278,11 @@ func (in *ScheduledWorkflowSpec) DeepCopyInto(out *ScheduledWorkflowSpec) {
 		**out = **in
 	}
 	in.Trigger.DeepCopyInto(&out.Trigger)
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(Backfill)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Workflow != nil {
 		in, out := &in.Workflow, &out.Workflow
 		*out = new(WorkflowResource)
@@ -218,6 +317,11 @@ func (in *ScheduledWorkflowStatus) DeepCopyInto(out *ScheduledWorkflowStatus) {
 		*out = new(WorkflowHistory)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(BackfillStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -244,6 +348,16 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(PeriodicSchedule)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ObjectStoreTrigger != nil {
+		in, out := &in.ObjectStoreTrigger, &out.ObjectStoreTrigger
+		*out = new(ObjectStoreTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebhookTrigger != nil {
+		in, out := &in.WebhookTrigger, &out.WebhookTrigger
+		*out = new(WebhookTrigger)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -273,6 +387,11 @@ func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(ObjectStoreTriggerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -286,6 +405,29 @@ func (in *TriggerStatus) DeepCopy() *TriggerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookTrigger) DeepCopyInto(out *WebhookTrigger) {
+	*out = *in
+	if in.PayloadParameters != nil {
+		in, out := &in.PayloadParameters, &out.PayloadParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookTrigger.
+func (in *WebhookTrigger) DeepCopy() *WebhookTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowHistory) DeepCopyInto(out *WorkflowHistory) {
 	*out = *in
@@ -7,16 +7,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/cachekey"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 )
 
 const (
@@ -28,7 +31,7 @@ const (
 )
 
 type Client interface {
-	GetExecutionCache(fingerPrint, pipelineName, namespace string) (string, error)
+	GetExecutionCache(fingerPrint, pipelineName, namespace string, maxCacheStaleness time.Duration) (string, error)
 	CreateExecutionCache(ctx context.Context, task *api.Task) error
 	GenerateCacheKey(
 		inputs *pipelinespec.ExecutorInput_Inputs,
@@ -52,7 +55,7 @@ func (d disabledCacheClient) GenerateFingerPrint(*cachekey.CacheKey) (string, er
 	panic("GenerateFingerPrint is not supposed to be called when cache is disabled")
 }
 
-func (d disabledCacheClient) GetExecutionCache(string, string, string) (string, error) {
+func (d disabledCacheClient) GetExecutionCache(string, string, string, time.Duration) (string, error) {
 	panic("GetExecutionCache is not supposed to be called when cache is disabled")
 }
 
@@ -73,7 +76,7 @@ func NewClient(cacheDisabled bool) (Client, error) {
 		return &disabledCacheClient{}, nil
 	}
 
-	cacheEndPoint := cacheDefaultEndpoint()
+	cacheEndPoint := KfpApiEndpoint()
 	glog.Infof("Connecting to cache endpoint %s", cacheEndPoint)
 	conn, err := grpc.Dial(cacheEndPoint,
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxClientGRPCMessageSize)),
@@ -87,7 +90,8 @@ func NewClient(cacheDisabled bool) (Client, error) {
 	}, nil
 }
 
-func cacheDefaultEndpoint() string {
+// KfpApiEndpoint returns the gRPC endpoint of the KFP API server.
+func KfpApiEndpoint() string {
 	// Discover ml-pipeline in the same namespace by env var.
 	// https://kubernetes.io/docs/concepts/services-networking/service/#environment-variables
 	cacheHost := os.Getenv("ML_PIPELINE_SERVICE_HOST")
@@ -103,25 +107,14 @@ func cacheDefaultEndpoint() string {
 	return defaultKfpApiEndpoint
 }
 
-func (c *client) GetExecutionCache(fingerPrint, pipelineName, namespace string) (string, error) {
-	fingerPrintPredicate := &api.Predicate{
-		Op:    api.Predicate_EQUALS,
-		Key:   "fingerprint",
-		Value: &api.Predicate_StringValue{StringValue: fingerPrint},
-	}
-	pipelineNamePredicate := &api.Predicate{
-		Op:    api.Predicate_EQUALS,
-		Key:   "pipelineName",
-		Value: &api.Predicate_StringValue{StringValue: pipelineName},
-	}
-	namespacePredicate := &api.Predicate{
-		Op:    api.Predicate_EQUALS,
-		Key:   "namespace",
-		Value: &api.Predicate_StringValue{StringValue: namespace},
-	}
-	filter := api.Filter{Predicates: []*api.Predicate{fingerPrintPredicate, pipelineNamePredicate, namespacePredicate}}
+// GetExecutionCache returns the MLMD execution ID of the newest cached task
+// with the given fingerprint in the namespace. An empty pipelineName reuses
+// results across pipelines, and a positive maxCacheStaleness ignores results
+// older than it.
+func (c *client) GetExecutionCache(fingerPrint, pipelineName, namespace string, maxCacheStaleness time.Duration) (string, error) {
+	filter := cacheFilter(fingerPrint, pipelineName, namespace, maxCacheStaleness, time.Now())
 
-	taskFilterJson, err := protojson.Marshal(&filter)
+	taskFilterJson, err := protojson.Marshal(filter)
 	if err != nil {
 		return "", fmt.Errorf("failed to convert filter into JSON: %w", err)
 	}
@@ -138,6 +131,35 @@ func (c *client) GetExecutionCache(fingerPrint, pipelineName, namespace string)
 	}
 }
 
+func cacheFilter(fingerPrint, pipelineName, namespace string, maxCacheStaleness time.Duration, now time.Time) *api.Filter {
+	fingerPrintPredicate := &api.Predicate{
+		Op:    api.Predicate_EQUALS,
+		Key:   "fingerprint",
+		Value: &api.Predicate_StringValue{StringValue: fingerPrint},
+	}
+	namespacePredicate := &api.Predicate{
+		Op:    api.Predicate_EQUALS,
+		Key:   "namespace",
+		Value: &api.Predicate_StringValue{StringValue: namespace},
+	}
+	filter := &api.Filter{Predicates: []*api.Predicate{fingerPrintPredicate, namespacePredicate}}
+	if pipelineName != "" {
+		filter.Predicates = append(filter.Predicates, &api.Predicate{
+			Op:    api.Predicate_EQUALS,
+			Key:   "pipelineName",
+			Value: &api.Predicate_StringValue{StringValue: pipelineName},
+		})
+	}
+	if maxCacheStaleness > 0 {
+		filter.Predicates = append(filter.Predicates, &api.Predicate{
+			Op:    api.Predicate_GREATER_THAN_EQUALS,
+			Key:   "created_at",
+			Value: &api.Predicate_TimestampValue{TimestampValue: timestamppb.New(now.Add(-maxCacheStaleness))},
+		})
+	}
+	return filter
+}
+
 func (c *client) CreateExecutionCache(ctx context.Context, task *api.Task) error {
 	req := &api.CreateTaskRequest{
 		Task: task,
@@ -179,12 +201,19 @@ func (c *client) GenerateCacheKey(
 
 	cacheKey := cachekey.CacheKey{
 		InputArtifactNames:   make(map[string]*cachekey.ArtifactNameList),
+		InputArtifactDigests: make(map[string]*cachekey.ArtifactDigestList),
 		InputParameterValues: make(map[string]*structpb.Value),
 		OutputArtifactsSpec:  make(map[string]*pipelinespec.RuntimeArtifact),
 		OutputParametersSpec: make(map[string]string),
 	}
 
 	for inputArtifactName, inputArtifactList := range inputs.GetArtifacts() {
+		// Key inputs by content when every artifact has a recorded digest, so that
+		// mutable URIs and reused names do not produce stale cache hits.
+		if digests, ok := artifactDigests(inputArtifactList.GetArtifacts()); ok {
+			cacheKey.InputArtifactDigests[inputArtifactName] = &cachekey.ArtifactDigestList{ArtifactDigests: digests}
+			continue
+		}
 		inputArtifactNameList := cachekey.ArtifactNameList{ArtifactNames: make([]string, 0)}
 		for _, artifact := range inputArtifactList.Artifacts {
 			inputArtifactNameList.ArtifactNames = append(inputArtifactNameList.ArtifactNames, artifact.GetName())
@@ -227,3 +256,20 @@ func (c *client) GenerateCacheKey(
 	return &cacheKey, nil
 
 }
+
+// artifactDigests returns the content digests of the given artifacts, or false
+// if any of them has no digest recorded.
+func artifactDigests(artifacts []*pipelinespec.RuntimeArtifact) ([]string, bool) {
+	if len(artifacts) == 0 {
+		return nil, false
+	}
+	digests := make([]string, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		digest := artifact.GetMetadata().GetFields()[metadata.ArtifactDigestKey].GetStringValue()
+		if digest == "" {
+			return nil, false
+		}
+		digests = append(digests, digest)
+	}
+	return digests, true
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/cachekey"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -134,6 +137,52 @@ func TestGenerateCacheKey(t *testing.T) {
 
 			wantErr: false,
 		},
+		{
+			name: "Generate CacheKey with artifact digests",
+			executorInputInputs: &pipelinespec.ExecutorInput_Inputs{
+				Artifacts: map[string]*pipelinespec.ArtifactList{
+					"dataset_one": {
+						Artifacts: []*pipelinespec.RuntimeArtifact{
+							{
+								Name: "1",
+								Uri:  "gs://some-bucket/dataset-one",
+								Metadata: &structpb.Struct{
+									Fields: map[string]*structpb.Value{metadata.ArtifactDigestKey: structpb.NewStringValue("sha256:abc")},
+								},
+							}}},
+					"dataset_two": {
+						Artifacts: []*pipelinespec.RuntimeArtifact{
+							{
+								Name: "2",
+								Uri:  "gs://some-bucket/dataset-two",
+								Metadata: &structpb.Struct{
+									Fields: map[string]*structpb.Value{metadata.ArtifactDigestKey: structpb.NewStringValue("sha256:def")},
+								},
+							},
+							{
+								Name:     "3",
+								Uri:      "gs://some-bucket/dataset-three",
+								Metadata: &structpb.Struct{},
+							}}}},
+			},
+			executorInputOutputs: &pipelinespec.ExecutorInput_Outputs{},
+			cmdArgs:              []string{"sh", "ec", "test"},
+			image:                "python:3.9",
+			want: &cachekey.CacheKey{
+				InputArtifactDigests: map[string]*cachekey.ArtifactDigestList{
+					"dataset_one": {ArtifactDigests: []string{"sha256:abc"}},
+				},
+				// Falls back to names because one artifact has no digest.
+				InputArtifactNames: map[string]*cachekey.ArtifactNameList{
+					"dataset_two": {ArtifactNames: []string{"2", "3"}},
+				},
+				ContainerSpec: &cachekey.ContainerSpec{
+					CmdArgs: []string{"sh", "ec", "test"},
+					Image:   "python:3.9",
+				},
+			},
+			wantErr: false,
+		},
 	}
 	cacheClient, err := NewClient(false)
 	require.NoError(t, err)
@@ -255,6 +304,22 @@ func TestGenerateFingerPrint(t *testing.T) {
 			},
 			wantEqual:   false,
 			fingerPrint: "0a4cc1f15cdfad5170e1358518f7128c5278500a670db1b9a3f3d83b93db396e",
+		}, {
+			name: "Generated Different FingerPrint With Artifact Digests",
+			cacheKey: &cachekey.CacheKey{
+				InputArtifactDigests: map[string]*cachekey.ArtifactDigestList{
+					"dataset": {ArtifactDigests: []string{"sha256:abc"}},
+				},
+				OutputParametersSpec: map[string]string{
+					"output_parameter": "DOUBLE",
+				},
+				ContainerSpec: &cachekey.ContainerSpec{
+					CmdArgs: []string{"sh", "ec", "run"},
+					Image:   "python:3.9",
+				},
+			},
+			wantEqual:   false,
+			fingerPrint: "2e228464bace65ce50305015170beb65ba51d8a7d2661d6a415dcb3ba76a1709",
 		},
 	}
 	cacheClient, err := NewClient(false)
@@ -270,3 +335,21 @@ func TestGenerateFingerPrint(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheFilter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	filter := cacheFilter("fp", "pipeline/p1", "ns1", 0, now)
+	assert.Len(t, filter.GetPredicates(), 3)
+	assert.Equal(t, "pipelineName", filter.GetPredicates()[2].GetKey())
+
+	// Cross-pipeline scope drops the pipeline predicate, and staleness bounds created_at.
+	filter = cacheFilter("fp", "", "ns1", time.Hour, now)
+	require.Len(t, filter.GetPredicates(), 3)
+	assert.Equal(t, "fingerprint", filter.GetPredicates()[0].GetKey())
+	assert.Equal(t, "namespace", filter.GetPredicates()[1].GetKey())
+	stalenessPredicate := filter.GetPredicates()[2]
+	assert.Equal(t, "created_at", stalenessPredicate.GetKey())
+	assert.Equal(t, api.Predicate_GREATER_THAN_EQUALS, stalenessPredicate.GetOp())
+	assert.Equal(t, now.Add(-time.Hour).Unix(), stalenessPredicate.GetTimestampValue().AsTime().Unix())
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/runmetrics"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -13,6 +14,7 @@ type ClientManagerInterface interface {
 	K8sClient() kubernetes.Interface
 	MetadataClient() metadata.ClientInterface
 	CacheClient() cacheutils.Client
+	RunMetricsClient() runmetrics.Client
 }
 
 // Ensure ClientManager implements ClientManagerInterface
@@ -20,9 +22,10 @@ var _ ClientManagerInterface = (*ClientManager)(nil)
 
 // ClientManager is a container for various service clients.
 type ClientManager struct {
-	k8sClient      kubernetes.Interface
-	metadataClient metadata.ClientInterface
-	cacheClient    cacheutils.Client
+	k8sClient        kubernetes.Interface
+	metadataClient   metadata.ClientInterface
+	cacheClient      cacheutils.Client
+	runMetricsClient runmetrics.Client
 }
 
 type Options struct {
@@ -54,6 +57,10 @@ func (cm *ClientManager) CacheClient() cacheutils.Client {
 	return cm.cacheClient
 }
 
+func (cm *ClientManager) RunMetricsClient() runmetrics.Client {
+	return cm.runMetricsClient
+}
+
 func (cm *ClientManager) init(opts *Options) error {
 	k8sClient, err := initK8sClient()
 	if err != nil {
@@ -67,9 +74,14 @@ func (cm *ClientManager) init(opts *Options) error {
 	if err != nil {
 		return err
 	}
+	runMetricsClient, err := initRunMetricsClient()
+	if err != nil {
+		return err
+	}
 	cm.k8sClient = k8sClient
 	cm.metadataClient = metadataClient
 	cm.cacheClient = cacheClient
+	cm.runMetricsClient = runMetricsClient
 	return nil
 }
 
@@ -92,3 +104,7 @@ func initMetadataClient(address string, port string) (metadata.ClientInterface,
 func initCacheClient(cacheDisabled bool) (cacheutils.Client, error) {
 	return cacheutils.NewClient(cacheDisabled)
 }
+
+func initRunMetricsClient() (runmetrics.Client, error) {
+	return runmetrics.NewClient()
+}
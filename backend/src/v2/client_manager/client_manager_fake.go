@@ -3,13 +3,15 @@ package client_manager
 import (
 	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/runmetrics"
 	"k8s.io/client-go/kubernetes"
 )
 
 type FakeClientManager struct {
-	k8sClient      kubernetes.Interface
-	metadataClient metadata.ClientInterface
-	cacheClient    cacheutils.Client
+	k8sClient        kubernetes.Interface
+	metadataClient   metadata.ClientInterface
+	cacheClient      cacheutils.Client
+	runMetricsClient runmetrics.Client
 }
 
 // Ensure FakeClientManager implements ClientManagerInterface
@@ -27,10 +29,15 @@ func (f *FakeClientManager) CacheClient() cacheutils.Client {
 	return f.cacheClient
 }
 
-func NewFakeClientManager(k8sClient kubernetes.Interface, metadataClient metadata.ClientInterface, cacheClient cacheutils.Client) *FakeClientManager {
+func (f *FakeClientManager) RunMetricsClient() runmetrics.Client {
+	return f.runMetricsClient
+}
+
+func NewFakeClientManager(k8sClient kubernetes.Interface, metadataClient metadata.ClientInterface, cacheClient cacheutils.Client, runMetricsClient runmetrics.Client) *FakeClientManager {
 	return &FakeClientManager{
-		k8sClient:      k8sClient,
-		metadataClient: metadataClient,
-		cacheClient:    cacheClient,
+		k8sClient:        k8sClient,
+		metadataClient:   metadataClient,
+		cacheClient:      cacheClient,
+		runMetricsClient: runMetricsClient,
 	}
 }
@@ -47,11 +47,12 @@ const (
 	ROOT_DAG           = "ROOT_DAG"
 	DAG                = "DAG"
 	CONTAINER          = "CONTAINER"
+	RESOLVER           = "RESOLVER"
 )
 
 var (
 	// inputs
-	driverType        = flag.String(driverTypeArg, "", "task driver type, one of ROOT_DAG, DAG, CONTAINER")
+	driverType        = flag.String(driverTypeArg, "", "task driver type, one of ROOT_DAG, DAG, CONTAINER, RESOLVER")
 	pipelineName      = flag.String("pipeline_name", "", "pipeline context name")
 	runID             = flag.String("run_id", "", "pipeline run uid")
 	runName           = flag.String("run_name", "", "pipeline run name (Kubernetes object name)")
@@ -66,6 +67,9 @@ var (
 	containerSpecJson = flag.String("container", "{}", "container spec")
 	k8sExecConfigJson = flag.String("kubernetes_config", "{}", "kubernetes executor config")
 
+	// resolver inputs
+	resolverSpecJson = flag.String("resolver", "", "resolver spec")
+
 	// config
 	mlmdServerAddress = flag.String("mlmd_server_address", "", "MLMD server address")
 	mlmdServerPort    = flag.String("mlmd_server_port", "", "MLMD server port")
@@ -210,6 +214,14 @@ func drive() (err error) {
 		options.Container = containerSpec
 		options.KubernetesExecutorConfig = k8sExecCfg
 		execution, driverErr = driver.Container(ctx, options, client, cacheClient)
+	case RESOLVER:
+		resolverSpec := &pipelinespec.PipelineDeploymentConfig_ResolverSpec{}
+		glog.Infof("input ResolverSpec:%s\n", prettyPrint(*resolverSpecJson))
+		if err := util.UnmarshalString(*resolverSpecJson, resolverSpec); err != nil {
+			return fmt.Errorf("failed to unmarshal resolver spec, error: %w\nresolverSpec: %v", err, prettyPrint(*resolverSpecJson))
+		}
+		options.Resolver = resolverSpec
+		execution, driverErr = driver.Resolver(ctx, options, client)
 	default:
 		err = fmt.Errorf("unknown driverType %s", *driverType)
 	}
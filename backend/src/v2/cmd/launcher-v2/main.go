@@ -45,6 +45,7 @@ var (
 	logLevel          = flag.String("log_level", "1", "The verbosity level to log.")
 	publishLogs       = flag.String("publish_logs", "true", "Whether to publish component logs to the object store")
 	cacheDisabledFlag = flag.Bool("cache_disabled", false, "Disable cache globally.")
+	hashImported      = flag.String("hash_imported_artifacts", "false", "Whether the importer hashes the contents behind artifact URIs")
 )
 
 func main() {
@@ -89,9 +90,10 @@ func run() error {
 	switch *executorType {
 	case "importer":
 		importerLauncherOpts := &component.ImporterLauncherOptions{
-			PipelineName: *pipelineName,
-			RunID:        *runID,
-			ParentDagID:  *parentDagID,
+			PipelineName:         *pipelineName,
+			RunID:                *runID,
+			ParentDagID:          *parentDagID,
+			HashArtifactContents: *hashImported == "true",
 		}
 		importerLauncher, err := component.NewImporterLauncher(ctx, *componentSpecJSON, *importerSpecJSON, *taskSpecJSON, launcherV2Opts, importerLauncherOpts)
 		if err != nil {
@@ -211,10 +211,6 @@ type workflowCompiler struct {
 	defaultWorkspace *k8score.PersistentVolumeClaimSpec
 }
 
-func (c *workflowCompiler) Resolver(name string, component *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) error {
-	return fmt.Errorf("resolver not implemented yet")
-}
-
 var errAlreadyExists = fmt.Errorf("template already exists")
 
 func (c *workflowCompiler) addTemplate(t *wfapi.Template, name string) (string, error) {
@@ -369,6 +365,7 @@ const (
 	paramTask                    = "task"           // task spec
 	paramContainer               = "container"      // container spec
 	paramImporter                = "importer"       // importer spec
+	paramResolver                = "resolver"       // resolver spec
 	paramRuntimeConfig           = "runtime-config" // job runtime config, pipeline level inputs
 	paramParentDagID             = "parent-dag-id"
 	paramExecutionID             = "execution-id"
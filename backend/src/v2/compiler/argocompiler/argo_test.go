@@ -58,11 +58,21 @@ func Test_argo_compiler(t *testing.T) {
 			platformSpecPath: "",
 			argoYAMLPath:     "testdata/importer.yaml",
 		},
+		{
+			jobPath:          "../testdata/resolver.json",
+			platformSpecPath: "",
+			argoYAMLPath:     "testdata/resolver.yaml",
+		},
 		{
 			jobPath:          "../testdata/multiple_parallel_loops.json",
 			platformSpecPath: "",
 			argoYAMLPath:     "testdata/multiple_parallel_loops.yaml",
 		},
+		{
+			jobPath:          "../testdata/pipeline_with_parallelfor_list_artifacts.json",
+			platformSpecPath: "",
+			argoYAMLPath:     "testdata/pipeline_with_parallelfor_list_artifacts.yaml",
+		},
 		{
 			jobPath:          "../testdata/create_mount_delete_dynamic_pvc.json",
 			platformSpecPath: "../testdata/create_mount_delete_dynamic_pvc_platform.json",
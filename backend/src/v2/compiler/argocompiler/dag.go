@@ -61,9 +61,6 @@ func (c *workflowCompiler) DAG(name string, componentSpec *pipelinespec.Componen
 		if kfpTask.GetParameterIterator() != nil && kfpTask.GetArtifactIterator() != nil {
 			return fmt.Errorf("invalid task %q: parameterIterator and artifactIterator cannot be specified at the same time", taskName)
 		}
-		if kfpTask.GetArtifactIterator() != nil {
-			return fmt.Errorf("artifact iterator not implemented yet")
-		}
 
 		if kfpTask.GetTriggerPolicy().GetStrategy().String() != "ALL_UPSTREAM_TASKS_COMPLETED" {
 			// Skip tasks that aren't exit tasks.
@@ -326,7 +323,20 @@ func (c *workflowCompiler) task(name string, task *pipelinespec.PipelineTaskSpec
 			}
 			return []wfapi.DAGTask{*importer}, nil
 		case *pipelinespec.PipelineDeploymentConfig_ExecutorSpec_Resolver:
-			return nil, fmt.Errorf("resolver executors not implemented")
+			if task.GetTriggerPolicy().GetCondition() != "" {
+				// Resolver tasks only run the driver, there is no executor to skip based on driver outputs.
+				return nil, fmt.Errorf("triggerPolicy.condition on resolver task is not supported")
+			}
+			resolver, err := c.resolverTask(name, task, taskSpecJson, inputs.parentDagID, inputs.iterationIndex)
+			if err != nil {
+				return nil, err
+			}
+			// iterations belong to a sub-DAG, no need to add dependent tasks
+			// Also skip adding dependencies when it's an exit hook
+			if inputs.iterationIndex == "" && task.GetTriggerPolicy().GetStrategy().String() != "ALL_UPSTREAM_TASKS_COMPLETED" {
+				resolver.Depends = depends(task.GetDependentTasks())
+			}
+			return []wfapi.DAGTask{*resolver}, nil
 		case *pipelinespec.PipelineDeploymentConfig_ExecutorSpec_CustomJob:
 			return nil, fmt.Errorf("custom job executors is Google Cloud only, it's not supported")
 		default:
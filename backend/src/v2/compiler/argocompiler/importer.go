@@ -24,6 +24,10 @@ import (
 	k8score "k8s.io/api/core/v1"
 )
 
+// HashImportedArtifactsEnvVar opts importers into hashing the files behind
+// their artifact URIs, so that cache keys track imported content.
+const HashImportedArtifactsEnvVar = "HASH_IMPORTED_ARTIFACTS"
+
 func (c *workflowCompiler) Importer(name string, componentSpec *pipelinespec.ComponentSpec, importer *pipelinespec.PipelineDeploymentConfig_ImporterSpec) error {
 	err := c.saveComponentSpec(name, componentSpec)
 	if err != nil {
@@ -91,6 +95,9 @@ func (c *workflowCompiler) addImporterTemplate() string {
 	if value, ok := os.LookupEnv(PublishLogsEnvVar); ok {
 		args = append(args, "--publish_logs", value)
 	}
+	if value, ok := os.LookupEnv(HashImportedArtifactsEnvVar); ok {
+		args = append(args, "--hash_imported_artifacts", value)
+	}
 	importerTemplate := &wfapi.Template{
 		Name: name,
 		Inputs: wfapi.Inputs{
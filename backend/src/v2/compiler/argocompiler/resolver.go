@@ -0,0 +1,112 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argocompiler
+
+import (
+	"os"
+
+	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/config/proxy"
+	k8score "k8s.io/api/core/v1"
+)
+
+func (c *workflowCompiler) Resolver(name string, componentSpec *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) error {
+	err := c.saveComponentSpec(name, componentSpec)
+	if err != nil {
+		return err
+	}
+	return c.saveComponentImpl(name, resolver)
+}
+
+// resolverTask returns a DAG task that runs the driver in RESOLVER mode.
+// Resolvers query MLMD for existing artifacts and publish them as the task
+// outputs, so there is no executor pod to run after the driver.
+func (c *workflowCompiler) resolverTask(name string, task *pipelinespec.PipelineTaskSpec, taskJSON string, parentDagID string, iterationIndex string) (*wfapi.DAGTask, error) {
+	componentPlaceholder, err := c.useComponentSpec(task.GetComponentRef().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resolverPlaceholder, err := c.useComponentImpl(task.GetComponentRef().GetName())
+	if err != nil {
+		return nil, err
+	}
+	dagTask := &wfapi.DAGTask{
+		Name:     name,
+		Template: c.addResolverTemplate(),
+		Arguments: wfapi.Arguments{
+			Parameters: []wfapi.Parameter{
+				{Name: paramComponent, Value: wfapi.AnyStringPtr(componentPlaceholder)},
+				{Name: paramTask, Value: wfapi.AnyStringPtr(taskJSON)},
+				{Name: paramResolver, Value: wfapi.AnyStringPtr(resolverPlaceholder)},
+				{Name: paramParentDagID, Value: wfapi.AnyStringPtr(parentDagID)},
+			},
+		},
+	}
+	if iterationIndex != "" {
+		dagTask.Arguments.Parameters = append(
+			dagTask.Arguments.Parameters,
+			wfapi.Parameter{Name: paramIterationIndex, Value: wfapi.AnyStringPtr(iterationIndex)},
+		)
+	}
+	return dagTask, nil
+}
+
+func (c *workflowCompiler) addResolverTemplate() string {
+	name := "system-resolver-driver"
+	if _, alreadyExists := c.templates[name]; alreadyExists {
+		return name
+	}
+	args := []string{
+		"--type", "RESOLVER",
+		"--pipeline_name", c.spec.GetPipelineInfo().GetName(),
+		"--run_id", runID(),
+		"--run_name", runResourceName(),
+		"--run_display_name", c.job.DisplayName,
+		"--dag_execution_id", inputValue(paramParentDagID),
+		"--component", inputValue(paramComponent),
+		"--task", inputValue(paramTask),
+		"--resolver", inputValue(paramResolver),
+		"--iteration_index", inputValue(paramIterationIndex),
+		"--http_proxy", proxy.GetConfig().GetHttpProxy(),
+		"--https_proxy", proxy.GetConfig().GetHttpsProxy(),
+		"--no_proxy", proxy.GetConfig().GetNoProxy(),
+	}
+	if value, ok := os.LookupEnv(PipelineLogLevelEnvVar); ok {
+		args = append(args, "--log_level", value)
+	}
+	t := &wfapi.Template{
+		Name: name,
+		Inputs: wfapi.Inputs{
+			Parameters: []wfapi.Parameter{
+				{Name: paramComponent},
+				{Name: paramTask},
+				{Name: paramResolver},
+				{Name: paramParentDagID},
+				{Name: paramIterationIndex, Default: wfapi.AnyStringPtr("-1")},
+			},
+		},
+		Container: &k8score.Container{
+			Image:     c.driverImage,
+			Command:   c.driverCommand,
+			Args:      args,
+			Resources: driverResources,
+			Env:       proxy.GetConfig().GetEnvVars(),
+		},
+	}
+	c.templates[name] = t
+	c.wf.Spec.Templates = append(c.wf.Spec.Templates, *t)
+	return name
+}
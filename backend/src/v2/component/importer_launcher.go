@@ -25,6 +25,9 @@ type ImporterLauncherOptions struct {
 	RunID string
 	// required, parent DAG execution ID
 	ParentDagID int64
+	// optional, hash the contents behind the artifact URI and record the
+	// digest, so that cache keys change when the imported files change
+	HashArtifactContents bool
 }
 
 func (o *ImporterLauncherOptions) validate() error {
@@ -277,9 +280,40 @@ func (l *ImportLauncher) ImportSpecToMLMDArtifact(ctx context.Context) (artifact
 	}
 	storeSessionInfoStr := string(storeSessionInfoJSON)
 	artifact.CustomProperties["store_session_info"] = metadata.StringValue(storeSessionInfoStr)
+
+	if l.importerLauncherOptions.HashArtifactContents {
+		digest, err := l.importedArtifactDigest(ctx, artifactUri, &storeSessionInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute digest of imported artifact %q: %w", artifactUri, err)
+		}
+		// The digest also takes part in FindMatchedArtifact, so changed contents
+		// behind the same URI are imported as a new artifact.
+		artifact.CustomProperties[metadata.ArtifactDigestKey] = metadata.StringValue(digest)
+	}
 	return artifact, nil
 }
 
+// importedArtifactDigest reads the files behind artifactUri and returns their
+// content digest.
+func (l *ImportLauncher) importedArtifactDigest(ctx context.Context, artifactUri string, storeSessionInfo *objectstore.SessionInfo) (string, error) {
+	bucketConfig, err := objectstore.ParseBucketConfigForArtifactURI(artifactUri)
+	if err != nil {
+		return "", err
+	}
+	bucketConfig.SessionInfo = storeSessionInfo
+	blobKey, err := bucketConfig.KeyFromURI(artifactUri)
+	if err != nil {
+		return "", err
+	}
+	bucket, err := objectstore.OpenBucket(ctx, l.k8sClient, l.launcherV2Options.Namespace, bucketConfig)
+	if err != nil {
+		return "", err
+	}
+	defer bucket.Close()
+	glog.Infof("Computing digest of imported artifact %q", artifactUri)
+	return objectstore.BlobDigest(ctx, bucket, blobKey)
+}
+
 func (l *ImportLauncher) getOutPutArtifactName() (string, error) {
 	outPutNames := make([]string, 0, len(l.component.GetOutputDefinitions().GetArtifacts()))
 	for name := range l.component.GetOutputDefinitions().GetArtifacts() {
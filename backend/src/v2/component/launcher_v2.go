@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -31,12 +32,14 @@ import (
 
 	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/kubeflow/pipelines/backend/src/v2/client_manager"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"github.com/kubeflow/pipelines/backend/src/v2/runmetrics"
 	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
 	"gocloud.dev/blob"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -230,6 +233,7 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 		return err
 	}
 	status = pb.Execution_COMPLETE
+	l.reportRunMetrics(ctx)
 	// if fingerPrint is not empty, it means this task enables cache but it does not hit cache, we need to create cache entry for this task
 	if fingerPrint != "" {
 		id := execution.GetID()
@@ -238,7 +242,7 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 		}
 		task := &api.Task{
 			//TODO how to differentiate between shared pipeline and namespaced pipeline
-			PipelineName:    "pipeline/" + l.options.PipelineName,
+			PipelineName:    util.CachePipelineNamePrefix + l.options.PipelineName,
 			Namespace:       l.options.Namespace,
 			RunId:           l.options.RunID,
 			MlmdExecutionID: strconv.FormatInt(id, 10),
@@ -252,6 +256,19 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 	return nil
 }
 
+// reportRunMetrics reports the scalar metrics of the output Metrics artifacts
+// as run metrics, so that runs can be sorted and filtered by them. Failures
+// are only logged because the metrics are published to MLMD regardless.
+func (l *LauncherV2) reportRunMetrics(ctx context.Context) {
+	if l.options.RunID == "" {
+		return
+	}
+	metrics := runmetrics.FromArtifacts(l.executorInput.GetOutputs().GetArtifacts(), l.options.PodName)
+	if err := l.clientManager.RunMetricsClient().ReportRunMetrics(ctx, l.options.RunID, metrics); err != nil {
+		glog.Warningf("Failed to report run metrics: %v", err)
+	}
+}
+
 func (l *LauncherV2) Info() string {
 	content, err := protojson.Marshal(l.executorInput)
 	if err != nil {
@@ -350,7 +367,7 @@ func executeV2(
 	}
 
 	// Fill in placeholders with runtime values.
-	compiledCmd, compiledArgs, err := compileCmdAndArgs(executorInputWithDefault, cmd, args)
+	compiledCmd, compiledArgs, err := CompileCmdAndArgs(executorInputWithDefault, cmd, args)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -529,7 +546,9 @@ func uploadOutputArtifacts(ctx context.Context, executorInput *pipelinespec.Exec
 
 			// Merge executor output artifact info with executor input
 			if list, ok := executorOutput.Artifacts[name]; ok && len(list.Artifacts) > 0 {
-				mergeRuntimeArtifacts(list.Artifacts[0], outputArtifact)
+				if err := mergeRuntimeArtifacts(list.Artifacts[0], outputArtifact, opts.bucketConfig); err != nil {
+					return nil, fmt.Errorf("failed to merge the executor output of artifact %q: %w", name, err)
+				}
 			}
 
 			// Upload artifacts from local path to remote storages.
@@ -548,6 +567,14 @@ func uploadOutputArtifacts(ctx context.Context, executorInput *pipelinespec.Exec
 					} else {
 						return nil, fmt.Errorf("failed to upload output artifact %q to remote storage URI %q: %w", name, outputArtifact.Uri, err)
 					}
+				} else {
+					// Record the content digest so downstream cache keys track what was
+					// uploaded rather than the artifact name.
+					digest, err := objectstore.LocalDigest(localDir)
+					if err != nil {
+						return nil, fmt.Errorf("failed to compute digest of output artifact %q: %w", name, err)
+					}
+					setArtifactDigest(outputArtifact, digest)
 				}
 			}
 
@@ -713,7 +740,8 @@ func fetchNonDefaultBuckets(
 
 }
 
-func compileCmdAndArgs(executorInput *pipelinespec.ExecutorInput, cmd string, args []string) (string, []string, error) {
+// CompileCmdAndArgs resolves the executor input placeholders in the command and args of a container.
+func CompileCmdAndArgs(executorInput *pipelinespec.ExecutorInput, cmd string, args []string) (string, []string, error) {
 	placeholders, err := getPlaceholders(executorInput)
 
 	executorInputJSON, err := protojson.Marshal(executorInput)
@@ -836,9 +864,17 @@ func getArtifactSchema(schema *pipelinespec.ArtifactTypeSchema) (string, error)
 	}
 }
 
-func mergeRuntimeArtifacts(src, dst *pipelinespec.RuntimeArtifact) {
-	if len(src.Uri) > 0 {
-		dst.Uri = src.Uri
+// mergeRuntimeArtifacts merges the artifact reported by the executor into the
+// one the launcher generated. The executor may only move the artifact within
+// the pipeline root, so that it cannot point the recorded URI at another
+// scheme, bucket or path, which the API server would then serve.
+func mergeRuntimeArtifacts(src, dst *pipelinespec.RuntimeArtifact, bucketConfig *objectstore.Config) error {
+	if len(src.Uri) > 0 && src.Uri != dst.Uri {
+		if !bucketConfig.ContainsURI(src.Uri) {
+			return fmt.Errorf("URI %q is outside of the pipeline root %q", src.Uri, bucketConfig.PrefixedBucket())
+		}
+		key, _ := bucketConfig.KeyFromURI(src.Uri)
+		dst.Uri = bucketConfig.UriFromKey(path.Clean(key))
 	}
 
 	if src.Metadata != nil {
@@ -850,6 +886,19 @@ func mergeRuntimeArtifacts(src, dst *pipelinespec.RuntimeArtifact) {
 			}
 		}
 	}
+	return nil
+}
+
+// setArtifactDigest records the content digest in the artifact metadata, which
+// is persisted as an MLMD custom property by RecordArtifact.
+func setArtifactDigest(artifact *pipelinespec.RuntimeArtifact, digest string) {
+	if artifact.Metadata == nil {
+		artifact.Metadata = &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+	}
+	if artifact.Metadata.Fields == nil {
+		artifact.Metadata.Fields = make(map[string]*structpb.Value)
+	}
+	artifact.Metadata.Fields[metadata.ArtifactDigestKey] = structpb.NewStringValue(digest)
 }
 
 func getExecutorOutputFile(path string) (*pipelinespec.ExecutorOutput, error) {
@@ -893,6 +942,15 @@ func LocalPathForURI(uri string) (string, error) {
 	if strings.HasPrefix(uri, "s3://") {
 		return "/s3/" + strings.TrimPrefix(uri, "s3://"), nil
 	}
+	if strings.HasPrefix(uri, objectstore.FileScheme) {
+		return "/file/" + strings.TrimPrefix(uri, objectstore.FileScheme), nil
+	}
+	if strings.HasPrefix(uri, objectstore.AzureBlobScheme) {
+		return "/azblob/" + strings.TrimPrefix(uri, objectstore.AzureBlobScheme), nil
+	}
+	if strings.HasPrefix(uri, objectstore.AzureBlobShortScheme) {
+		return "/az/" + strings.TrimPrefix(uri, objectstore.AzureBlobShortScheme), nil
+	}
 	if strings.HasPrefix(uri, "oci://") {
 		return "/oci/" + strings.ReplaceAll(strings.TrimPrefix(uri, "oci://"), "/", "_") + "/models", nil
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
 	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"github.com/kubeflow/pipelines/backend/src/v2/runmetrics"
 	"github.com/stretchr/testify/assert"
 	"gocloud.dev/blob"
 	_ "gocloud.dev/blob/memblob"
@@ -215,7 +216,7 @@ func Test_executorInput_compileCmdAndArgs(t *testing.T) {
 		"--executor_input", "{{$}}",
 		"--function_to_execute", "sayHello",
 	}
-	cmd, args, err = compileCmdAndArgs(executorInput, cmd, args)
+	cmd, args, err = CompileCmdAndArgs(executorInput, cmd, args)
 
 	assert.NoError(t, err)
 
@@ -242,6 +243,61 @@ func Test_executorInput_compileCmdAndArgs(t *testing.T) {
 	assert.Equal(t, "9312", config["sphinx_port"])
 }
 
+func Test_LocalPathForURI(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		{uri: "gs://my-bucket/task/output", want: "/gcs/my-bucket/task/output"},
+		{uri: "minio://my-bucket/task/output", want: "/minio/my-bucket/task/output"},
+		{uri: "s3://my-bucket/task/output", want: "/s3/my-bucket/task/output"},
+		{uri: "file:///mnt/kfp/task/output", want: "/file/mnt/kfp/task/output"},
+		{uri: "azblob://my-container/task/output", want: "/azblob/my-container/task/output"},
+		{uri: "az://my-container/task/output", want: "/az/my-container/task/output"},
+		{uri: "http://example.com/task/output", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := LocalPathForURI(tt.uri)
+		if tt.wantErr {
+			assert.Error(t, err, tt.uri)
+			continue
+		}
+		assert.Nil(t, err, tt.uri)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func Test_mergeRuntimeArtifacts(t *testing.T) {
+	bucketConfig, err := objectstore.ParseBucketPathToConfig("file:///mnt/kfp/root")
+	assert.Nil(t, err)
+	newArtifact := func() *pipelinespec.RuntimeArtifact {
+		return &pipelinespec.RuntimeArtifact{Uri: "file:///mnt/kfp/root/run/task/output"}
+	}
+
+	dst := newArtifact()
+	err = mergeRuntimeArtifacts(&pipelinespec.RuntimeArtifact{
+		Uri:      "file:///mnt/kfp/root/run/task/../task/renamed",
+		Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}},
+	}, dst, bucketConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, "file:///mnt/kfp/root/run/task/renamed", dst.Uri)
+	assert.Equal(t, "value", dst.Metadata.Fields["key"].GetStringValue())
+
+	// The executor cannot move the artifact out of the pipeline root.
+	for _, uri := range []string{
+		"file:///etc/passwd",
+		"file:///mnt/kfp/root/../../../etc/passwd",
+		"file:///mnt/kfp/root-other/output",
+		"gs://mnt/kfp/root/run/task/output",
+	} {
+		dst = newArtifact()
+		err = mergeRuntimeArtifacts(&pipelinespec.RuntimeArtifact{Uri: uri}, dst, bucketConfig)
+		assert.Error(t, err, uri)
+		assert.Equal(t, "file:///mnt/kfp/root/run/task/output", dst.Uri)
+	}
+}
+
 func Test_get_log_Writer(t *testing.T) {
 	old := osCreateFunc
 	defer func() { osCreateFunc = old }()
@@ -322,6 +378,7 @@ func Test_NewLauncherV2(t *testing.T) {
 		fake.NewSimpleClientset(),
 		metadata.NewFakeClient(),
 		disabledCacheClient,
+		runmetrics.NewFakeClient(),
 	)
 
 	var testValidLauncherV2Opts = LauncherV2Options{
@@ -415,3 +472,37 @@ func Test_NewLauncherV2(t *testing.T) {
 		})
 	}
 }
+
+func Test_reportRunMetrics(t *testing.T) {
+	runMetricsClient := runmetrics.NewFakeClient()
+	l := &LauncherV2{
+		executorInput: &pipelinespec.ExecutorInput{
+			Outputs: &pipelinespec.ExecutorInput_Outputs{
+				Artifacts: map[string]*pipelinespec.ArtifactList{
+					"metrics": {Artifacts: []*pipelinespec.RuntimeArtifact{{
+						Type: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "system.Metrics"}},
+						Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+							"accuracy":     structpb.NewNumberValue(0.9),
+							"display_name": structpb.NewStringValue("metrics"),
+						}},
+					}}},
+					"model": {Artifacts: []*pipelinespec.RuntimeArtifact{{
+						Type:     &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "system.Model"}},
+						Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{"size": structpb.NewNumberValue(3)}},
+					}}},
+				},
+			},
+		},
+		options:       LauncherV2Options{PodName: "my-pod", RunID: "run-1"},
+		clientManager: client_manager.NewFakeClientManager(fake.NewSimpleClientset(), metadata.NewFakeClient(), nil, runMetricsClient),
+	}
+
+	l.reportRunMetrics(context.Background())
+
+	metrics := runMetricsClient.Reported["run-1"]
+	if assert.Len(t, metrics, 1) {
+		assert.Equal(t, "accuracy", metrics[0].GetName())
+		assert.Equal(t, "my-pod", metrics[0].GetNodeId())
+		assert.Equal(t, 0.9, metrics[0].GetNumberValue())
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+)
+
+type AzureBlobProviderConfig struct {
+	Default *AzureBlobProviderDefault `json:"default"`
+
+	// optional, ordered, the auth config for the first matching prefix is used
+	Overrides []AzureBlobOverride `json:"Overrides"`
+}
+
+type AzureBlobProviderDefault struct {
+	// required unless the credentials are read from the environment
+	AccountName string `json:"accountName"`
+	// optional, defaults to https://<accountName>.blob.core.windows.net
+	Endpoint string `json:"endpoint"`
+	// required
+	Credentials *AzureBlobCredentials `json:"credentials"`
+}
+
+type AzureBlobOverride struct {
+	// optional
+	AccountName string `json:"accountName"`
+	// optional
+	Endpoint      string `json:"endpoint"`
+	ContainerName string `json:"containerName"`
+	KeyPrefix     string `json:"keyPrefix"`
+	// required
+	Credentials *AzureBlobCredentials `json:"credentials"`
+}
+
+type AzureBlobCredentials struct {
+	// optional
+	FromEnv bool `json:"fromEnv"`
+	// if FromEnv is False then SecretRef is required
+	SecretRef *AzureBlobSecretRef `json:"secretRef"`
+}
+
+type AzureBlobSecretRef struct {
+	SecretName string `json:"secretName"`
+	// The k8s secret "Key" for the storage account key or a SAS token, the
+	// account key is used when both are present in the secret.
+	AccountKeyKey string `json:"accountKeyKey"`
+	SASTokenKey   string `json:"sasTokenKey"`
+}
+
+func (p AzureBlobProviderConfig) ProvideSessionInfo(path string) (objectstore.SessionInfo, error) {
+	bucketConfig, err := objectstore.ParseBucketPathToConfig(path)
+	if err != nil {
+		return objectstore.SessionInfo{}, err
+	}
+	containerName := bucketConfig.BucketName
+	bucketPrefix := bucketConfig.Prefix
+
+	invalidConfigErr := func(err error) error {
+		return fmt.Errorf("invalid provider config: %w", err)
+	}
+
+	params := map[string]string{}
+
+	// If provider config did not have a matching configuration for the provider inferred from pipelineroot
+	// then we use blob.OpenBucket(ctx, config.bucketURL()) by setting "FromEnv = True"
+	if p.Default == nil && p.Overrides == nil {
+		params["fromEnv"] = strconv.FormatBool(true)
+		return objectstore.SessionInfo{
+			Provider: "azblob",
+			Params:   params,
+		}, nil
+	}
+
+	if p.Default == nil || p.Default.Credentials == nil {
+		return objectstore.SessionInfo{}, invalidConfigErr(fmt.Errorf("missing default credentials"))
+	}
+
+	params["accountName"] = p.Default.AccountName
+	params["endpoint"] = p.Default.Endpoint
+	params["fromEnv"] = strconv.FormatBool(p.Default.Credentials.FromEnv)
+	if !p.Default.Credentials.FromEnv {
+		if p.Default.Credentials.SecretRef == nil {
+			return objectstore.SessionInfo{}, invalidConfigErr(fmt.Errorf("missing default secretref"))
+		}
+		params["secretName"] = p.Default.Credentials.SecretRef.SecretName
+		params["accountKeyKey"] = p.Default.Credentials.SecretRef.AccountKeyKey
+		params["sasTokenKey"] = p.Default.Credentials.SecretRef.SASTokenKey
+	}
+
+	// Set defaults
+	sessionInfo := objectstore.SessionInfo{
+		Provider: "azblob",
+		Params:   params,
+	}
+
+	// If there's a matching override, then override defaults with provided configs
+	override := p.getOverrideByPrefix(containerName, bucketPrefix)
+	if override != nil {
+		if override.AccountName != "" {
+			params["accountName"] = override.AccountName
+		}
+		if override.Endpoint != "" {
+			params["endpoint"] = override.Endpoint
+		}
+		if override.Credentials == nil {
+			return objectstore.SessionInfo{}, invalidConfigErr(fmt.Errorf("missing override credentials"))
+		}
+		params["fromEnv"] = strconv.FormatBool(override.Credentials.FromEnv)
+		if !override.Credentials.FromEnv {
+			if override.Credentials.SecretRef == nil {
+				return objectstore.SessionInfo{}, invalidConfigErr(fmt.Errorf("missing override secretref"))
+			}
+			params["secretName"] = override.Credentials.SecretRef.SecretName
+			params["accountKeyKey"] = override.Credentials.SecretRef.AccountKeyKey
+			params["sasTokenKey"] = override.Credentials.SecretRef.SASTokenKey
+		} else {
+			// Don't need a secret if pulling from Env
+			delete(params, "secretName")
+			delete(params, "accountKeyKey")
+			delete(params, "sasTokenKey")
+		}
+	}
+	return sessionInfo, nil
+}
+
+// getOverrideByPrefix returns first matching container name and prefix in overrides
+func (p AzureBlobProviderConfig) getOverrideByPrefix(containerName, prefix string) *AzureBlobOverride {
+	for _, override := range p.Overrides {
+		if override.ContainerName == containerName && strings.HasPrefix(prefix, override.KeyPrefix) {
+			return &override
+		}
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ import (
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 const (
@@ -44,9 +45,10 @@ const (
 )
 
 type BucketProviders struct {
-	Minio *MinioProviderConfig `json:"minio"`
-	S3    *S3ProviderConfig    `json:"s3"`
-	GCS   *GCSProviderConfig   `json:"gs"`
+	Minio     *MinioProviderConfig     `json:"minio"`
+	S3        *S3ProviderConfig        `json:"s3"`
+	GCS       *GCSProviderConfig       `json:"gs"`
+	AzureBlob *AzureBlobProviderConfig `json:"azblob"`
 }
 
 type SessionInfoProvider interface {
@@ -60,7 +62,13 @@ type Config struct {
 
 // FromConfigMap loads config from a kfp-launcher Kubernetes config map.
 func FromConfigMap(ctx context.Context, clientSet kubernetes.Interface, namespace string) (*Config, error) {
-	config, err := clientSet.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, metav1.GetOptions{})
+	return FromConfigMapClient(ctx, clientSet.CoreV1().ConfigMaps(namespace), namespace)
+}
+
+// FromConfigMapClient loads config from the kfp-launcher config map of the
+// namespace the config map client is bound to.
+func FromConfigMapClient(ctx context.Context, configMaps corev1client.ConfigMapInterface, namespace string) (*Config, error) {
+	config, err := configMaps.Get(ctx, configMapName, metav1.GetOptions{})
 	if err != nil {
 		if k8errors.IsNotFound(err) {
 			glog.Infof("cannot find launcher configmap: name=%q namespace=%q, will use default config", configMapName, namespace)
@@ -136,6 +144,16 @@ func (c *Config) GetStoreSessionInfo(path string) (objectstore.SessionInfo, erro
 			sessProvider = bucketProviders.GCS
 		}
 		break
+	case "azblob":
+		if bucketProviders == nil || bucketProviders.AzureBlob == nil {
+			sessProvider = &AzureBlobProviderConfig{}
+		} else {
+			sessProvider = bucketProviders.AzureBlob
+		}
+		break
+	case "file":
+		// Filesystems mounted into the pods need no credentials.
+		return objectstore.SessionInfo{Provider: provider, Params: map[string]string{}}, nil
 	default:
 		return objectstore.SessionInfo{}, fmt.Errorf("Encountered unsupported provider in provider config %s", provider)
 	}
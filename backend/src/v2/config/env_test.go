@@ -89,6 +89,14 @@ func TestGetBucketSessionInfo(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg:          "valid - file pipelineroot needs no provider config",
+			pipelineroot: "file:///mnt/kfp/v2/artifacts",
+			expectedSessionInfo: objectstore.SessionInfo{
+				Provider: "file",
+				Params:   map[string]string{},
+			},
+		},
 		{
 			msg:                 "invalid - unsupported pipeline root format",
 			pipelineroot:        "minio.unsupported.format",
@@ -423,6 +431,69 @@ func TestGetBucketSessionInfo(t *testing.T) {
 			},
 			testDataCase: "case11",
 		},
+		{
+			msg:          "valid - only azblob pipelineroot no provider config",
+			pipelineroot: "az://my-container/v2/artifacts",
+			expectedSessionInfo: objectstore.SessionInfo{
+				Provider: "azblob",
+				Params: map[string]string{
+					"fromEnv": "true",
+				},
+			},
+		},
+		{
+			msg:          "valid - pick default azblob when no matching prefix",
+			pipelineroot: "azblob://azblob-container/some/other/path",
+			expectedSessionInfo: objectstore.SessionInfo{
+				Provider: "azblob",
+				Params: map[string]string{
+					"accountName":   "kfpartifacts",
+					"endpoint":      "",
+					"fromEnv":       "false",
+					"secretName":    "azblob-test-secret-13",
+					"accountKeyKey": "azblob-test-accountKeyKey-13",
+					"sasTokenKey":   "",
+				},
+			},
+			testDataCase: "case13",
+		},
+		{
+			msg:          "valid - first matching azblob override with az scheme",
+			pipelineroot: "az://azblob-container/some/azblob/path/a/b",
+			expectedSessionInfo: objectstore.SessionInfo{
+				Provider: "azblob",
+				Params: map[string]string{
+					"accountName":   "kfpartifactsa",
+					"endpoint":      "http://azurite.kubeflow:10000/kfpartifactsa",
+					"fromEnv":       "false",
+					"secretName":    "azblob-test-secret-13-a",
+					"accountKeyKey": "",
+					"sasTokenKey":   "azblob-test-sasTokenKey-13-a",
+				},
+			},
+			testDataCase: "case13",
+		},
+		{
+			msg:          "valid - azblob secretref not required when matching override is set to env",
+			pipelineroot: "azblob://azblob-container/some/azblob/path/b",
+			expectedSessionInfo: objectstore.SessionInfo{
+				Provider: "azblob",
+				Params: map[string]string{
+					"accountName": "kfpartifacts",
+					"endpoint":    "",
+					"fromEnv":     "true",
+				},
+			},
+			testDataCase: "case13",
+		},
+		{
+			msg:                 "invalid - azblob default credentials are required",
+			pipelineroot:        "azblob://azblob-container/some/azblob/path",
+			expectedSessionInfo: objectstore.SessionInfo{},
+			shouldError:         true,
+			errorMsg:            "missing default credentials",
+			testDataCase:        "case14",
+		},
 	}
 
 	for _, test := range tt {
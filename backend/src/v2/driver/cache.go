@@ -25,8 +25,10 @@ import (
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/peterhellberg/duration"
 )
 
 func collectOutputArtifactMetadataFromCache(ctx context.Context, executorInput *pipelinespec.ExecutorInput, cachedMLMDExecutionID int64, mlmd *metadata.Client) ([]*metadata.OutputArtifact, error) {
@@ -100,7 +102,17 @@ func getFingerPrintsAndID(execution *Execution, opts *Options, cacheClient cache
 		if err != nil {
 			return "", "", fmt.Errorf("failure while getting fingerPrint: %w", err)
 		}
-		cachedMLMDExecutionID, err := cacheClient.GetExecutionCache(fingerPrint, "pipeline/"+opts.PipelineName, opts.Namespace)
+		cachingOptions := opts.Task.GetCachingOptions()
+		maxCacheStaleness, err := parseMaxCacheStaleness(cachingOptions.GetMaxCacheStaleness())
+		if err != nil {
+			return "", "", err
+		}
+		// An empty pipeline name lets the lookup match results of any pipeline.
+		pipelineName := util.CachePipelineNamePrefix + opts.PipelineName
+		if cachingOptions.GetCacheScope() == pipelinespec.PipelineTaskSpec_CachingOptions_NAMESPACE {
+			pipelineName = ""
+		}
+		cachedMLMDExecutionID, err := cacheClient.GetExecutionCache(fingerPrint, pipelineName, opts.Namespace, maxCacheStaleness)
 		if err != nil {
 			return "", "", fmt.Errorf("failure while getting executionCache: %w", err)
 		}
@@ -110,6 +122,22 @@ func getFingerPrintsAndID(execution *Execution, opts *Options, cacheClient cache
 	}
 }
 
+// parseMaxCacheStaleness parses an ISO 8601 duration such as "P30D". An empty
+// staleness means cached results never go stale and is returned as zero.
+func parseMaxCacheStaleness(staleness string) (time.Duration, error) {
+	if staleness == "" {
+		return 0, nil
+	}
+	d, err := duration.Parse(staleness)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max cache staleness %q, expecting an ISO 8601 duration such as \"P30D\": %w", staleness, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid max cache staleness %q: must be positive", staleness)
+	}
+	return d, nil
+}
+
 func createCache(
 	ctx context.Context,
 	execution *metadata.Execution,
@@ -124,7 +152,7 @@ func createCache(
 	}
 	task := &api.Task{
 		//TODO how to differentiate between shared pipeline and namespaced pipeline
-		PipelineName:    "pipeline/" + opts.PipelineName,
+		PipelineName:    util.CachePipelineNamePrefix + opts.PipelineName,
 		Namespace:       opts.Namespace,
 		RunId:           opts.RunID,
 		MlmdExecutionID: strconv.FormatInt(id, 10),
@@ -0,0 +1,117 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/cachekey"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCacheClient records the arguments of the cache lookup.
+type fakeCacheClient struct {
+	cacheutils.Client
+	pipelineName      string
+	namespace         string
+	maxCacheStaleness time.Duration
+}
+
+func (c *fakeCacheClient) GenerateCacheKey(*pipelinespec.ExecutorInput_Inputs, *pipelinespec.ExecutorInput_Outputs, map[string]string, []string, string) (*cachekey.CacheKey, error) {
+	return &cachekey.CacheKey{}, nil
+}
+
+func (c *fakeCacheClient) GenerateFingerPrint(*cachekey.CacheKey) (string, error) {
+	return "fingerprint", nil
+}
+
+func (c *fakeCacheClient) GetExecutionCache(fingerPrint, pipelineName, namespace string, maxCacheStaleness time.Duration) (string, error) {
+	c.pipelineName = pipelineName
+	c.namespace = namespace
+	c.maxCacheStaleness = maxCacheStaleness
+	return "1", nil
+}
+
+func Test_parseMaxCacheStaleness(t *testing.T) {
+	tests := []struct {
+		staleness string
+		want      time.Duration
+		wantErr   bool
+	}{
+		{staleness: "", want: 0},
+		{staleness: "P1D", want: 24 * time.Hour},
+		{staleness: "PT30M", want: 30 * time.Minute},
+		{staleness: "30 days", wantErr: true},
+		{staleness: "P0D", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.staleness, func(t *testing.T) {
+			got, err := parseMaxCacheStaleness(tt.staleness)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_getFingerPrintsAndID_cachingOptions(t *testing.T) {
+	tests := []struct {
+		name             string
+		cachingOptions   *pipelinespec.PipelineTaskSpec_CachingOptions
+		wantPipelineName string
+		wantStaleness    time.Duration
+	}{
+		{
+			name:             "pipeline scope without staleness",
+			cachingOptions:   &pipelinespec.PipelineTaskSpec_CachingOptions{EnableCache: true},
+			wantPipelineName: "pipeline/my-pipeline",
+		},
+		{
+			name: "namespace scope with staleness",
+			cachingOptions: &pipelinespec.PipelineTaskSpec_CachingOptions{
+				EnableCache:       true,
+				MaxCacheStaleness: "PT12H",
+				CacheScope:        pipelinespec.PipelineTaskSpec_CachingOptions_NAMESPACE,
+			},
+			wantPipelineName: "",
+			wantStaleness:    12 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheClient := &fakeCacheClient{}
+			opts := &Options{
+				PipelineName: "my-pipeline",
+				Namespace:    "ns1",
+				Task:         &pipelinespec.PipelineTaskSpec{CachingOptions: tt.cachingOptions},
+				Container:    &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{Image: "python:3.9"},
+			}
+			fingerPrint, cachedID, err := getFingerPrintsAndID(&Execution{}, opts, cacheClient)
+			require.Nil(t, err)
+			assert.Equal(t, "fingerprint", fingerPrint)
+			assert.Equal(t, "1", cachedID)
+			assert.Equal(t, tt.wantPipelineName, cacheClient.pipelineName)
+			assert.Equal(t, "ns1", cacheClient.namespace)
+			assert.Equal(t, tt.wantStaleness, cacheClient.maxCacheStaleness)
+		})
+	}
+}
@@ -119,8 +119,16 @@ func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *E
 	ecfg.TotalDagTasks = &totalDagTasks
 	glog.V(4).Info("totalDagTasks: ", *ecfg.TotalDagTasks)
 
-	if opts.Task.GetArtifactIterator() != nil {
-		return execution, fmt.Errorf("ArtifactIterator is not implemented")
+	isArtifactIterator := opts.Task.GetArtifactIterator() != nil && opts.IterationIndex < 0
+	// Fan out iterations over the upstream artifact list, which was resolved
+	// as an input artifact of the iterator DAG.
+	if execution.WillTrigger() && isArtifactIterator {
+		count, err := getArtifactIterationCount(opts.Task.GetArtifactIterator(), executorInput)
+		if err != nil {
+			return execution, err
+		}
+		ecfg.IterationCount = &count
+		execution.IterationCount = &count
 	}
 	isIterator := opts.Task.GetParameterIterator() != nil && opts.IterationIndex < 0
 	// Fan out iterations
@@ -178,3 +186,13 @@ func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *E
 	execution.ID = createdExecution.GetID()
 	return execution, nil
 }
+
+// getArtifactIterationCount returns the number of iterations of an artifact
+// iterator, which is the length of the artifact list resolved as its items input.
+func getArtifactIterationCount(iterator *pipelinespec.ArtifactIteratorSpec, executorInput *pipelinespec.ExecutorInput) (int, error) {
+	artifacts, ok := executorInput.GetInputs().GetArtifacts()[iterator.GetItems().GetInputArtifact()]
+	if !ok {
+		return 0, fmt.Errorf("iterating on item input %q failed: cannot find input artifact %q", iterator.GetItemInput(), iterator.GetItems().GetInputArtifact())
+	}
+	return len(artifacts.GetArtifacts()), nil
+}
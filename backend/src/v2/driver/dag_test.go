@@ -0,0 +1,63 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getArtifactIterationCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		artifacts map[string]*pipelinespec.ArtifactList
+		count     int
+		err       string
+	}{
+		{
+			name:      "artifact list",
+			artifacts: map[string]*pipelinespec.ArtifactList{"pipelinechannel--datasets": runtimeArtifacts("s3://bucket/a", "s3://bucket/b", "s3://bucket/c")},
+			count:     3,
+		},
+		{
+			name:      "empty artifact list",
+			artifacts: map[string]*pipelinespec.ArtifactList{"pipelinechannel--datasets": runtimeArtifacts()},
+			count:     0,
+		},
+		{
+			name:      "missing input artifact",
+			artifacts: map[string]*pipelinespec.ArtifactList{"pipelinechannel--model": runtimeArtifacts("s3://bucket/model")},
+			err:       `cannot find input artifact "pipelinechannel--datasets"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			executorInput := &pipelinespec.ExecutorInput{
+				Inputs: &pipelinespec.ExecutorInput_Inputs{Artifacts: test.artifacts},
+			}
+			count, err := getArtifactIterationCount(artifactIteratorTask().GetArtifactIterator(), executorInput)
+			if test.err != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.count, count)
+		})
+	}
+}
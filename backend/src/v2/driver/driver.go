@@ -52,6 +52,9 @@ type Options struct {
 	// optional, required only by container driver
 	Container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec
 
+	// optional, required only by resolver driver
+	Resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec
+
 	// optional, allows to specify kubernetes-specific executor config
 	KubernetesExecutorConfig *kubernetesplatform.KubernetesExecutorConfig
 
@@ -43,6 +43,13 @@ var accessModeMap = map[string]k8score.PersistentVolumeAccessMode{
 	"ReadWriteOncePod": k8score.ReadWriteOncePod,
 }
 
+const (
+	// sharedMemoryVolumeName is the volume name used for the shared memory volume
+	// when the executor config does not specify one.
+	sharedMemoryVolumeName = "shm"
+	sharedMemoryMountPath  = "/dev/shm"
+)
+
 var dummyImages = map[string]string{
 	"argostub/createpvc": "create PVC",
 	"argostub/deletepvc": "delete PVC",
@@ -236,6 +243,109 @@ func extendPodSpecPatch(
 		podSpec.Tolerations = k8sTolerations
 	}
 
+	// Get node affinity information
+	if nodeAffinityTerms := kubernetesExecutorConfig.GetNodeAffinity(); len(nodeAffinityTerms) > 0 {
+		var requiredTerms []k8score.NodeSelectorTerm
+		var preferredTerms []k8score.PreferredSchedulingTerm
+		for _, nodeAffinityTerm := range nodeAffinityTerms {
+			// An empty required term would match no node.
+			if len(nodeAffinityTerm.GetMatchExpressions()) == 0 && len(nodeAffinityTerm.GetMatchFields()) == 0 {
+				continue
+			}
+			nodeSelectorTerm := k8score.NodeSelectorTerm{
+				MatchExpressions: toNodeSelectorRequirements(nodeAffinityTerm.GetMatchExpressions()),
+				MatchFields:      toNodeSelectorRequirements(nodeAffinityTerm.GetMatchFields()),
+			}
+			if nodeAffinityTerm.Weight != nil {
+				preferredTerms = append(preferredTerms, k8score.PreferredSchedulingTerm{
+					Weight:     nodeAffinityTerm.GetWeight(),
+					Preference: nodeSelectorTerm,
+				})
+			} else {
+				requiredTerms = append(requiredTerms, nodeSelectorTerm)
+			}
+		}
+
+		if len(requiredTerms) > 0 || len(preferredTerms) > 0 {
+			nodeAffinity := &k8score.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: preferredTerms,
+			}
+			if len(requiredTerms) > 0 {
+				nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8score.NodeSelector{
+					NodeSelectorTerms: requiredTerms,
+				}
+			}
+			podSpecAffinity(podSpec).NodeAffinity = nodeAffinity
+		}
+	}
+
+	// Get pod affinity information
+	for _, podAffinityTerm := range kubernetesExecutorConfig.GetPodAffinity() {
+		k8sPodAffinityTerm := k8score.PodAffinityTerm{
+			LabelSelector: toLabelSelector(podAffinityTerm.GetMatchPodLabels(),
+				podAffinityTerm.GetMatchPodExpressions()),
+			Namespaces: podAffinityTerm.GetNamespaces(),
+			NamespaceSelector: toLabelSelector(podAffinityTerm.GetMatchNamespaceLabels(),
+				podAffinityTerm.GetMatchNamespaceExpressions()),
+			TopologyKey: podAffinityTerm.GetTopologyKey(),
+		}
+		if k8sPodAffinityTerm.TopologyKey == "" {
+			return fmt.Errorf("missing topology key for pod affinity term in executor config")
+		}
+
+		affinity := podSpecAffinity(podSpec)
+		if podAffinityTerm.GetAnti() {
+			if affinity.PodAntiAffinity == nil {
+				affinity.PodAntiAffinity = &k8score.PodAntiAffinity{}
+			}
+			appendPodAffinityTerm(
+				&affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+				&affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+				k8sPodAffinityTerm, podAffinityTerm.Weight)
+		} else {
+			if affinity.PodAffinity == nil {
+				affinity.PodAffinity = &k8score.PodAffinity{}
+			}
+			appendPodAffinityTerm(
+				&affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+				&affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+				k8sPodAffinityTerm, podAffinityTerm.Weight)
+		}
+	}
+
+	// Get shared memory information
+	if sharedMemory := kubernetesExecutorConfig.GetEnabledSharedMemory(); sharedMemory != nil {
+		volumeName := sharedMemory.GetVolumeName()
+		if volumeName == "" {
+			volumeName = sharedMemoryVolumeName
+		}
+		size := sharedMemory.GetSize()
+		var sizeLimit *k8sres.Quantity
+		if size != "" {
+			quantity, err := k8sres.ParseQuantity(size)
+			if err != nil {
+				return fmt.Errorf("invalid shared memory size %q: %w", size, err)
+			}
+			sizeLimit = &quantity
+		}
+
+		sharedMemoryVolume := k8score.Volume{
+			Name: volumeName,
+			VolumeSource: k8score.VolumeSource{
+				EmptyDir: &k8score.EmptyDirVolumeSource{
+					Medium:    k8score.StorageMediumMemory,
+					SizeLimit: sizeLimit,
+				},
+			},
+		}
+		sharedMemoryVolumeMount := k8score.VolumeMount{
+			Name:      volumeName,
+			MountPath: sharedMemoryMountPath,
+		}
+		podSpec.Volumes = append(podSpec.Volumes, sharedMemoryVolume)
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, sharedMemoryVolumeMount)
+	}
+
 	// Get secret mount information
 	for _, secretAsVolume := range kubernetesExecutorConfig.GetSecretAsVolume() {
 		var secretName string
@@ -485,6 +595,60 @@ func extendPodSpecPatch(
 	return nil
 }
 
+// podSpecAffinity returns the affinity of podSpec, initializing it if unset.
+func podSpecAffinity(podSpec *k8score.PodSpec) *k8score.Affinity {
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &k8score.Affinity{}
+	}
+	return podSpec.Affinity
+}
+
+// appendPodAffinityTerm appends term to the preferred terms when a weight is
+// set, and to the required terms otherwise.
+func appendPodAffinityTerm(
+	required *[]k8score.PodAffinityTerm,
+	preferred *[]k8score.WeightedPodAffinityTerm,
+	term k8score.PodAffinityTerm,
+	weight *int32,
+) {
+	if weight != nil {
+		*preferred = append(*preferred, k8score.WeightedPodAffinityTerm{
+			Weight:          *weight,
+			PodAffinityTerm: term,
+		})
+		return
+	}
+	*required = append(*required, term)
+}
+
+func toNodeSelectorRequirements(requirements []*kubernetesplatform.SelectorRequirement) []k8score.NodeSelectorRequirement {
+	var nodeSelectorRequirements []k8score.NodeSelectorRequirement
+	for _, requirement := range requirements {
+		nodeSelectorRequirements = append(nodeSelectorRequirements, k8score.NodeSelectorRequirement{
+			Key:      requirement.GetKey(),
+			Operator: k8score.NodeSelectorOperator(requirement.GetOperator()),
+			Values:   requirement.GetValues(),
+		})
+	}
+	return nodeSelectorRequirements
+}
+
+// toLabelSelector returns nil when neither labels nor expressions are set.
+func toLabelSelector(labels map[string]string, expressions []*kubernetesplatform.SelectorRequirement) *metav1.LabelSelector {
+	if len(labels) == 0 && len(expressions) == 0 {
+		return nil
+	}
+	labelSelector := &metav1.LabelSelector{MatchLabels: labels}
+	for _, expression := range expressions {
+		labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      expression.GetKey(),
+			Operator: metav1.LabelSelectorOperator(expression.GetOperator()),
+			Values:   expression.GetValues(),
+		})
+	}
+	return labelSelector
+}
+
 // execution is passed by value because we make changes to it to generate  fingerprint
 func createPVC(
 	ctx context.Context,
@@ -2197,6 +2197,292 @@ func Test_extendPodSpecPatch_GenericEphemeralVolume(t *testing.T) {
 	}
 }
 
+func Test_extendPodSpecPatch_NodeAffinity(t *testing.T) {
+	tests := []struct {
+		name        string
+		k8sExecCfg  *kubernetesplatform.KubernetesExecutorConfig
+		expected    *k8score.Affinity
+		inputParams map[string]*structpb.Value
+	}{
+		{
+			"Valid - required and preferred",
+			&kubernetesplatform.KubernetesExecutorConfig{
+				NodeAffinity: []*kubernetesplatform.NodeAffinityTerm{
+					{
+						MatchExpressions: []*kubernetesplatform.SelectorRequirement{
+							{Key: "kubernetes.io/os", Operator: "In", Values: []string{"linux"}},
+						},
+					},
+					{
+						MatchFields: []*kubernetesplatform.SelectorRequirement{
+							{Key: "metadata.name", Operator: "NotIn", Values: []string{"node-1"}},
+						},
+						Weight: int32Ptr(10),
+					},
+				},
+			},
+			&k8score.Affinity{
+				NodeAffinity: &k8score.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8score.NodeSelector{
+						NodeSelectorTerms: []k8score.NodeSelectorTerm{
+							{
+								MatchExpressions: []k8score.NodeSelectorRequirement{
+									{Key: "kubernetes.io/os", Operator: k8score.NodeSelectorOpIn, Values: []string{"linux"}},
+								},
+							},
+						},
+					},
+					PreferredDuringSchedulingIgnoredDuringExecution: []k8score.PreferredSchedulingTerm{
+						{
+							Weight: 10,
+							Preference: k8score.NodeSelectorTerm{
+								MatchFields: []k8score.NodeSelectorRequirement{
+									{Key: "metadata.name", Operator: k8score.NodeSelectorOpNotIn, Values: []string{"node-1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			nil,
+		},
+		{
+			"Valid - empty term skipped",
+			&kubernetesplatform.KubernetesExecutorConfig{
+				NodeAffinity: []*kubernetesplatform.NodeAffinityTerm{
+					{},
+					{Weight: int32Ptr(10)},
+				},
+			},
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &k8score.PodSpec{Containers: []k8score.Container{
+				{
+					Name: "main",
+				},
+			}}
+			err := extendPodSpecPatch(
+				context.Background(),
+				got,
+				Options{KubernetesExecutorConfig: tt.k8sExecCfg},
+				nil,
+				nil,
+				nil,
+				tt.inputParams,
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, got.Affinity)
+		})
+	}
+}
+
+func Test_extendPodSpecPatch_PodAffinity(t *testing.T) {
+	tests := []struct {
+		name        string
+		k8sExecCfg  *kubernetesplatform.KubernetesExecutorConfig
+		expected    *k8score.Affinity
+		inputParams map[string]*structpb.Value
+		wantErr     bool
+	}{
+		{
+			"Valid - affinity and anti-affinity",
+			&kubernetesplatform.KubernetesExecutorConfig{
+				PodAffinity: []*kubernetesplatform.PodAffinityTerm{
+					{
+						MatchPodLabels: map[string]string{"app": "trainer"},
+						TopologyKey:    "kubernetes.io/hostname",
+					},
+					{
+						MatchPodExpressions: []*kubernetesplatform.SelectorRequirement{
+							{Key: "app", Operator: "In", Values: []string{"trainer"}},
+						},
+						MatchNamespaceLabels: map[string]string{"team": "ml"},
+						Namespaces:           []string{"kubeflow"},
+						TopologyKey:          "topology.kubernetes.io/zone",
+						Weight:               int32Ptr(50),
+						Anti:                 boolPtr(true),
+					},
+				},
+			},
+			&k8score.Affinity{
+				PodAffinity: &k8score.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []k8score.PodAffinityTerm{
+						{
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "trainer"}},
+							TopologyKey:   "kubernetes.io/hostname",
+						},
+					},
+				},
+				PodAntiAffinity: &k8score.PodAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []k8score.WeightedPodAffinityTerm{
+						{
+							Weight: 50,
+							PodAffinityTerm: k8score.PodAffinityTerm{
+								LabelSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"trainer"}},
+									},
+								},
+								Namespaces:        []string{"kubeflow"},
+								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ml"}},
+								TopologyKey:       "topology.kubernetes.io/zone",
+							},
+						},
+					},
+				},
+			},
+			nil,
+			false,
+		},
+		{
+			"Invalid - missing topology key",
+			&kubernetesplatform.KubernetesExecutorConfig{
+				PodAffinity: []*kubernetesplatform.PodAffinityTerm{
+					{MatchPodLabels: map[string]string{"app": "trainer"}},
+				},
+			},
+			nil,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &k8score.PodSpec{Containers: []k8score.Container{
+				{
+					Name: "main",
+				},
+			}}
+			err := extendPodSpecPatch(
+				context.Background(),
+				got,
+				Options{KubernetesExecutorConfig: tt.k8sExecCfg},
+				nil,
+				nil,
+				nil,
+				tt.inputParams,
+			)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, got.Affinity)
+		})
+	}
+}
+
+func Test_extendPodSpecPatch_EnabledSharedMemory(t *testing.T) {
+	sizeLimit := k8sres.MustParse("2Gi")
+	tests := []struct {
+		name        string
+		k8sExecCfg  *kubernetesplatform.KubernetesExecutorConfig
+		expected    *k8score.PodSpec
+		inputParams map[string]*structpb.Value
+	}{
+		{
+			"Valid - default volume name and no size",
+			&kubernetesplatform.KubernetesExecutorConfig{
+				EnabledSharedMemory: &kubernetesplatform.EnabledSharedMemory{},
+			},
+			&k8score.PodSpec{
+				Containers: []k8score.Container{
+					{
+						Name: "main",
+						VolumeMounts: []k8score.VolumeMount{
+							{Name: "shm", MountPath: "/dev/shm"},
+						},
+					},
+				},
+				Volumes: []k8score.Volume{
+					{
+						Name: "shm",
+						VolumeSource: k8score.VolumeSource{
+							EmptyDir: &k8score.EmptyDirVolumeSource{Medium: k8score.StorageMediumMemory},
+						},
+					},
+				},
+			},
+			nil,
+		},
+		{
+			"Valid - volume name and size",
+			&kubernetesplatform.KubernetesExecutorConfig{
+				EnabledSharedMemory: &kubernetesplatform.EnabledSharedMemory{
+					VolumeName: "dshm",
+					Size:       "2Gi",
+				},
+			},
+			&k8score.PodSpec{
+				Containers: []k8score.Container{
+					{
+						Name: "main",
+						VolumeMounts: []k8score.VolumeMount{
+							{Name: "dshm", MountPath: "/dev/shm"},
+						},
+					},
+				},
+				Volumes: []k8score.Volume{
+					{
+						Name: "dshm",
+						VolumeSource: k8score.VolumeSource{
+							EmptyDir: &k8score.EmptyDirVolumeSource{
+								Medium:    k8score.StorageMediumMemory,
+								SizeLimit: &sizeLimit,
+							},
+						},
+					},
+				},
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &k8score.PodSpec{Containers: []k8score.Container{
+				{
+					Name: "main",
+				},
+			}}
+			err := extendPodSpecPatch(
+				context.Background(),
+				got,
+				Options{KubernetesExecutorConfig: tt.k8sExecCfg},
+				nil,
+				nil,
+				nil,
+				tt.inputParams,
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_extendPodSpecPatch_EnabledSharedMemory_InvalidSize(t *testing.T) {
+	got := &k8score.PodSpec{Containers: []k8score.Container{
+		{
+			Name: "main",
+		},
+	}}
+	err := extendPodSpecPatch(
+		context.Background(),
+		got,
+		Options{KubernetesExecutorConfig: &kubernetesplatform.KubernetesExecutorConfig{
+			EnabledSharedMemory: &kubernetesplatform.EnabledSharedMemory{Size: "lots"},
+		}},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	assert.NotNil(t, err)
+}
+
 func validListOfStructsOrPanic(data []map[string]interface{}) *structpb.Value {
 	var listValues []*structpb.Value
 	for _, item := range data {
@@ -2232,3 +2518,11 @@ func structInputParamConstant(value map[string]interface{}) *pipelinespec.TaskIn
 func int64Ptr(val int64) *int64 {
 	return &val
 }
+
+func int32Ptr(val int32) *int32 {
+	return &val
+}
+
+func boolPtr(val bool) *bool {
+	return &val
+}
@@ -252,31 +252,8 @@ func resolveInputs(
 		}
 		inputs.ParameterValues = inputParams
 		inputs.Artifacts = artifacts
-		switch {
-		case task.GetArtifactIterator() != nil:
-			return nil, fmt.Errorf("artifact iterator not implemented yet")
-		case task.GetParameterIterator() != nil:
-			var itemsInput string
-			if task.GetParameterIterator().GetItems().GetInputParameter() != "" {
-				// input comes from outside the component
-				itemsInput = task.GetParameterIterator().GetItems().GetInputParameter()
-			} else if task.GetParameterIterator().GetItemInput() != "" {
-				// input comes from static input
-				itemsInput = task.GetParameterIterator().GetItemInput()
-			} else {
-				return nil, fmt.Errorf("cannot retrieve parameter iterator")
-			}
-			items, err := getItems(inputs.ParameterValues[itemsInput])
-			if err != nil {
-				return nil, err
-			}
-			if *iterationIndex >= len(items) {
-				return nil, fmt.Errorf("bug: %v items found, but getting index %v", len(items), *iterationIndex)
-			}
-			delete(inputs.ParameterValues, itemsInput)
-			inputs.ParameterValues[task.GetParameterIterator().GetItemInput()] = items[*iterationIndex]
-		default:
-			return nil, fmt.Errorf("bug: iteration_index>=0, but task iterator is empty")
+		if err := resolveIterationItem(task, inputs, *iterationIndex); err != nil {
+			return nil, err
 		}
 		return inputs, nil
 	}
@@ -1082,3 +1059,46 @@ func getParallelForIterationCount(task *metadata.Execution, dag *metadata.Execut
 	}
 	return iterations.GetIntValue()
 }
+
+// resolveIterationItem replaces the items input of the iterator of a task with
+// the item of the given iteration.
+func resolveIterationItem(task *pipelinespec.PipelineTaskSpec, inputs *pipelinespec.ExecutorInput_Inputs, iterationIndex int) error {
+	switch {
+	case task.GetArtifactIterator() != nil:
+		itemsInput := task.GetArtifactIterator().GetItems().GetInputArtifact()
+		if itemsInput == "" {
+			return fmt.Errorf("cannot retrieve artifact iterator")
+		}
+		items := inputs.Artifacts[itemsInput].GetArtifacts()
+		if iterationIndex >= len(items) {
+			return fmt.Errorf("bug: %v items found, but getting index %v", len(items), iterationIndex)
+		}
+		delete(inputs.Artifacts, itemsInput)
+		inputs.Artifacts[task.GetArtifactIterator().GetItemInput()] = &pipelinespec.ArtifactList{
+			Artifacts: []*pipelinespec.RuntimeArtifact{items[iterationIndex]},
+		}
+	case task.GetParameterIterator() != nil:
+		var itemsInput string
+		if task.GetParameterIterator().GetItems().GetInputParameter() != "" {
+			// input comes from outside the component
+			itemsInput = task.GetParameterIterator().GetItems().GetInputParameter()
+		} else if task.GetParameterIterator().GetItemInput() != "" {
+			// input comes from static input
+			itemsInput = task.GetParameterIterator().GetItemInput()
+		} else {
+			return fmt.Errorf("cannot retrieve parameter iterator")
+		}
+		items, err := getItems(inputs.ParameterValues[itemsInput])
+		if err != nil {
+			return err
+		}
+		if iterationIndex >= len(items) {
+			return fmt.Errorf("bug: %v items found, but getting index %v", len(items), iterationIndex)
+		}
+		delete(inputs.ParameterValues, itemsInput)
+		inputs.ParameterValues[task.GetParameterIterator().GetItemInput()] = items[iterationIndex]
+	default:
+		return fmt.Errorf("bug: iteration_index>=0, but task iterator is empty")
+	}
+	return nil
+}
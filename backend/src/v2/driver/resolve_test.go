@@ -0,0 +1,100 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func artifactIteratorTask() *pipelinespec.PipelineTaskSpec {
+	return &pipelinespec.PipelineTaskSpec{
+		Iterator: &pipelinespec.PipelineTaskSpec_ArtifactIterator{
+			ArtifactIterator: &pipelinespec.ArtifactIteratorSpec{
+				Items:     &pipelinespec.ArtifactIteratorSpec_ItemsSpec{InputArtifact: "pipelinechannel--datasets"},
+				ItemInput: "pipelinechannel--datasets-loop-item",
+			},
+		},
+	}
+}
+
+func runtimeArtifacts(uris ...string) *pipelinespec.ArtifactList {
+	artifacts := &pipelinespec.ArtifactList{}
+	for _, uri := range uris {
+		artifacts.Artifacts = append(artifacts.Artifacts, &pipelinespec.RuntimeArtifact{Uri: uri})
+	}
+	return artifacts
+}
+
+func Test_resolveIterationItem_artifactIterator(t *testing.T) {
+	inputs := &pipelinespec.ExecutorInput_Inputs{
+		ParameterValues: map[string]*structpb.Value{},
+		Artifacts: map[string]*pipelinespec.ArtifactList{
+			"pipelinechannel--datasets": runtimeArtifacts("s3://bucket/a", "s3://bucket/b"),
+			"pipelinechannel--model":    runtimeArtifacts("s3://bucket/model"),
+		},
+	}
+
+	err := resolveIterationItem(artifactIteratorTask(), inputs, 1)
+	require.Nil(t, err)
+	assert.Len(t, inputs.Artifacts, 2)
+	assert.NotContains(t, inputs.Artifacts, "pipelinechannel--datasets")
+	assert.True(t, proto.Equal(runtimeArtifacts("s3://bucket/b"), inputs.Artifacts["pipelinechannel--datasets-loop-item"]))
+	assert.True(t, proto.Equal(runtimeArtifacts("s3://bucket/model"), inputs.Artifacts["pipelinechannel--model"]))
+}
+
+func Test_resolveIterationItem_artifactIterator_indexOutOfRange(t *testing.T) {
+	inputs := &pipelinespec.ExecutorInput_Inputs{
+		Artifacts: map[string]*pipelinespec.ArtifactList{
+			"pipelinechannel--datasets": runtimeArtifacts("s3://bucket/a"),
+		},
+	}
+
+	err := resolveIterationItem(artifactIteratorTask(), inputs, 1)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "1 items found, but getting index 1")
+}
+
+func Test_resolveIterationItem_parameterIterator(t *testing.T) {
+	task := &pipelinespec.PipelineTaskSpec{
+		Iterator: &pipelinespec.PipelineTaskSpec_ParameterIterator{
+			ParameterIterator: &pipelinespec.ParameterIteratorSpec{
+				Items:     &pipelinespec.ParameterIteratorSpec_ItemsSpec{Kind: &pipelinespec.ParameterIteratorSpec_ItemsSpec_InputParameter{InputParameter: "pipelinechannel--texts"}},
+				ItemInput: "pipelinechannel--texts-loop-item",
+			},
+		},
+	}
+	texts, err := structpb.NewList([]interface{}{"a", "b"})
+	require.Nil(t, err)
+	inputs := &pipelinespec.ExecutorInput_Inputs{
+		ParameterValues: map[string]*structpb.Value{"pipelinechannel--texts": structpb.NewListValue(texts)},
+	}
+
+	err = resolveIterationItem(task, inputs, 0)
+	require.Nil(t, err)
+	assert.Len(t, inputs.ParameterValues, 1)
+	assert.Equal(t, "a", inputs.ParameterValues["pipelinechannel--texts-loop-item"].GetStringValue())
+}
+
+func Test_resolveIterationItem_noIterator(t *testing.T) {
+	err := resolveIterationItem(&pipelinespec.PipelineTaskSpec{}, &pipelinespec.ExecutorInput_Inputs{}, 0)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "task iterator is empty")
+}
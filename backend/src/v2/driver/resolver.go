@@ -0,0 +1,151 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+)
+
+// defaultResolverQueryLimit is the number of artifacts returned by an
+// artifact query that does not specify a limit.
+const defaultResolverQueryLimit = 1
+
+func validateResolver(opts Options) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("invalid resolver driver args: %w", err)
+		}
+	}()
+	if opts.Resolver == nil {
+		return fmt.Errorf("resolver spec is required")
+	}
+	if opts.Container != nil {
+		return fmt.Errorf("container spec is unnecessary")
+	}
+	if len(opts.Resolver.GetOutputArtifactQueries()) == 0 {
+		return fmt.Errorf("resolver spec must define at least one output artifact query")
+	}
+	return validateNonRoot(opts)
+}
+
+// Resolver resolves the output artifacts of a resolver task by querying MLMD
+// for existing artifacts. There is no executor for a resolver task, so the
+// driver publishes the execution itself, with the resolved artifacts recorded
+// as its outputs so that downstream tasks can consume them.
+func Resolver(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *Execution, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("driver.Resolver(%s) failed: %w", opts.info(), err)
+		}
+	}()
+	b, _ := json.Marshal(opts)
+	glog.V(4).Info("Resolver opts: ", string(b))
+	err = validateResolver(opts)
+	if err != nil {
+		return nil, err
+	}
+	var iterationIndex *int
+	if opts.IterationIndex >= 0 {
+		index := opts.IterationIndex
+		iterationIndex = &index
+	}
+	pipeline, err := mlmd.GetPipeline(ctx, opts.PipelineName, opts.RunID, "", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	dag, err := mlmd.GetDAG(ctx, opts.DAGExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("parent DAG: %+v", dag.Execution)
+
+	ecfg := &metadata.ExecutionConfig{
+		TaskName:       opts.Task.GetTaskInfo().GetTaskName(),
+		DisplayName:    opts.Task.GetTaskInfo().GetName(),
+		ExecutionType:  metadata.ResolverExecutionTypeName,
+		ParentDagID:    dag.Execution.GetID(),
+		IterationIndex: iterationIndex,
+	}
+	createdExecution, err := mlmd.CreateExecution(ctx, pipeline, ecfg)
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("Created execution: %s", createdExecution)
+	execution = &Execution{ID: createdExecution.GetID()}
+
+	outputArtifacts, err := resolveArtifactQueries(ctx, opts, mlmd)
+	if err != nil {
+		if perr := mlmd.PublishExecution(ctx, createdExecution, nil, nil, pb.Execution_FAILED); perr != nil {
+			return execution, fmt.Errorf("failed to publish execution: %s. Also failed to resolve artifacts: %w", perr.Error(), err)
+		}
+		return execution, err
+	}
+	if err := mlmd.PublishExecution(ctx, createdExecution, nil, outputArtifacts, pb.Execution_COMPLETE); err != nil {
+		return execution, fmt.Errorf("failed to publish execution: %w", err)
+	}
+	return execution, nil
+}
+
+// resolveArtifactQueries runs the output artifact queries of the resolver spec
+// and returns the matched artifacts keyed by output name. Every query must
+// match at least one artifact.
+func resolveArtifactQueries(ctx context.Context, opts Options, mlmd metadata.ClientInterface) ([]*metadata.OutputArtifact, error) {
+	queries := opts.Resolver.GetOutputArtifactQueries()
+	outputNames := make([]string, 0, len(queries))
+	for name := range queries {
+		outputNames = append(outputNames, name)
+	}
+	sort.Strings(outputNames)
+
+	var outputArtifacts []*metadata.OutputArtifact
+	for _, name := range outputNames {
+		query := queries[name]
+		outputSpec, ok := opts.Component.GetOutputDefinitions().GetArtifacts()[name]
+		if !ok {
+			return nil, fmt.Errorf("output artifact %q is not defined in the component spec", name)
+		}
+		filterQuery, err := metadata.ArtifactQueryToFilterQuery(query.GetFilter(), opts.PipelineName)
+		if err != nil {
+			return nil, fmt.Errorf("output artifact %q: %w", name, err)
+		}
+		limit := query.GetLimit()
+		if limit <= 0 {
+			limit = defaultResolverQueryLimit
+		}
+		glog.Infof("Resolving output artifact %q with filter query %q and limit %v", name, filterQuery, limit)
+		artifacts, err := mlmd.QueryArtifacts(ctx, filterQuery, limit)
+		if err != nil {
+			return nil, fmt.Errorf("output artifact %q: %w", name, err)
+		}
+		if len(artifacts) == 0 {
+			return nil, fmt.Errorf("output artifact %q: no artifact matches filter %q", name, query.GetFilter())
+		}
+		for _, artifact := range artifacts {
+			outputArtifacts = append(outputArtifacts, &metadata.OutputArtifact{
+				Name:     name,
+				Artifact: artifact,
+				Schema:   outputSpec.GetArtifactType().GetInstanceSchema(),
+			})
+		}
+	}
+	return outputArtifacts, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type queryArtifactsClient struct {
+	*metadata.FakeClient
+	artifacts map[string][]*pb.Artifact
+	limits    map[string]int32
+}
+
+func (c *queryArtifactsClient) QueryArtifacts(ctx context.Context, filterQuery string, limit int32) ([]*pb.Artifact, error) {
+	c.limits[filterQuery] = limit
+	return c.artifacts[filterQuery], nil
+}
+
+func resolverOptions(queries map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec_ArtifactQuerySpec) Options {
+	artifacts := map[string]*pipelinespec.ComponentOutputsSpec_ArtifactSpec{}
+	for name := range queries {
+		artifacts[name] = &pipelinespec.ComponentOutputsSpec_ArtifactSpec{
+			ArtifactType: &pipelinespec.ArtifactTypeSchema{
+				Kind: &pipelinespec.ArtifactTypeSchema_InstanceSchema{InstanceSchema: "title: system.Model\ntype: object\n"},
+			},
+		}
+	}
+	return Options{
+		PipelineName: "my-pipeline",
+		Component: &pipelinespec.ComponentSpec{
+			OutputDefinitions: &pipelinespec.ComponentOutputsSpec{Artifacts: artifacts},
+		},
+		Resolver: &pipelinespec.PipelineDeploymentConfig_ResolverSpec{OutputArtifactQueries: queries},
+	}
+}
+
+func Test_resolveArtifactQueries(t *testing.T) {
+	approvedQuery := `type = "system.Model" AND custom_properties.approved.int_value = 1 AND contexts_a.type = "system.Pipeline" AND contexts_a.name = "my-pipeline"`
+	client := &queryArtifactsClient{
+		FakeClient: metadata.NewFakeClient(),
+		artifacts: map[string][]*pb.Artifact{
+			approvedQuery: {{Id: proto.Int64(2), Uri: proto.String("gs://bucket/model-2")}},
+		},
+		limits: map[string]int32{},
+	}
+	opts := resolverOptions(map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec_ArtifactQuerySpec{
+		"model": {Filter: `artifact_type="system.Model" AND metadata.approved=true`},
+	})
+
+	outputs, err := resolveArtifactQueries(context.Background(), opts, client)
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+	assert.Equal(t, "model", outputs[0].Name)
+	assert.Equal(t, int64(2), outputs[0].Artifact.GetId())
+	assert.Equal(t, "title: system.Model\ntype: object\n", outputs[0].Schema)
+	assert.Equal(t, int32(defaultResolverQueryLimit), client.limits[approvedQuery])
+}
+
+func Test_resolveArtifactQueries_NoMatch(t *testing.T) {
+	client := &queryArtifactsClient{
+		FakeClient: metadata.NewFakeClient(),
+		limits:     map[string]int32{},
+	}
+	opts := resolverOptions(map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec_ArtifactQuerySpec{
+		"model": {Filter: `artifact_type="system.Model"`, Limit: 3},
+	})
+
+	_, err := resolveArtifactQueries(context.Background(), opts, client)
+	assert.ErrorContains(t, err, `no artifact matches filter`)
+	for _, limit := range client.limits {
+		assert.Equal(t, int32(3), limit)
+	}
+}
+
+func Test_resolveArtifactQueries_UndefinedOutput(t *testing.T) {
+	opts := resolverOptions(nil)
+	opts.Resolver.OutputArtifactQueries = map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec_ArtifactQuerySpec{
+		"model": {Filter: `artifact_type="system.Model"`},
+	}
+
+	_, err := resolveArtifactQueries(context.Background(), opts, metadata.NewFakeClient())
+	assert.ErrorContains(t, err, `output artifact "model" is not defined`)
+}
@@ -49,6 +49,9 @@ const (
 	pipelineRunContextTypeName = "system.PipelineRun"
 	ImporterExecutionTypeName  = "system.ImporterExecution"
 	mlmdClientSideMaxRetries   = 3
+	// ArtifactDigestKey is the artifact custom property holding the content
+	// digest of the artifact. Cache keys use it in place of the artifact name.
+	ArtifactDigestKey = "content_digest"
 )
 
 type ExecutionType string
@@ -56,6 +59,7 @@ type ExecutionType string
 const (
 	ContainerExecutionTypeName ExecutionType = "system.ContainerExecution"
 	DagExecutionTypeName       ExecutionType = "system.DAGExecution"
+	ResolverExecutionTypeName  ExecutionType = "system.ResolverExecution"
 )
 
 var (
@@ -99,6 +103,7 @@ type ClientInterface interface {
 	RecordArtifact(ctx context.Context, outputName, schema string, runtimeArtifact *pipelinespec.RuntimeArtifact, state pb.Artifact_State, bucketConfig *objectstore.Config) (*OutputArtifact, error)
 	GetOrInsertArtifactType(ctx context.Context, schema string) (typeID int64, err error)
 	FindMatchedArtifact(ctx context.Context, artifactToMatch *pb.Artifact, pipelineContextId int64) (matchedArtifact *pb.Artifact, err error)
+	QueryArtifacts(ctx context.Context, filterQuery string, limit int32) ([]*pb.Artifact, error)
 }
 
 // Client is an MLMD service client.
@@ -1003,34 +1008,41 @@ func (c *Client) GetInputArtifactsByExecutionID(ctx context.Context, executionID
 		return nil, err
 	}
 	var artifactIDs []int64
-	nameByID := make(map[int64]string)
+	var inputEvents []*pb.Event
 	for _, event := range eventsRes.Events {
 		if *event.Type == pb.Event_INPUT {
 			artifactIDs = append(artifactIDs, event.GetArtifactId())
-			name, err := getArtifactName(event.Path)
-			if err != nil {
-				return nil, err
-			}
-			nameByID[event.GetArtifactId()] = name
+			inputEvents = append(inputEvents, event)
 		}
 	}
 	artifacts, err := c.GetArtifacts(ctx, artifactIDs)
 	if err != nil {
 		return nil, err
 	}
-	inputs = make(map[string]*pipelinespec.ArtifactList)
+	artifactByID := make(map[int64]*pb.Artifact)
 	for _, artifact := range artifacts {
-		name, ok := nameByID[artifact.GetId()]
+		artifactByID[artifact.GetId()] = artifact
+	}
+	inputs = make(map[string]*pipelinespec.ArtifactList)
+	// An input may be a list of artifacts (e.g. the items of an artifact
+	// iterator), so keep all artifacts of the same name in event order.
+	for _, event := range inputEvents {
+		name, err := getArtifactName(event.Path)
+		if err != nil {
+			return nil, err
+		}
+		artifact, ok := artifactByID[event.GetArtifactId()]
 		if !ok {
-			return nil, fmt.Errorf("failed to get name of artifact with id %v", artifact.GetId())
+			return nil, fmt.Errorf("failed to get artifact with id %v of input %q", event.GetArtifactId(), name)
 		}
 		runtimeArtifact, err := toRuntimeArtifact(artifact)
 		if err != nil {
 			return nil, err
 		}
-		inputs[name] = &pipelinespec.ArtifactList{
-			Artifacts: []*pipelinespec.RuntimeArtifact{runtimeArtifact},
+		if _, ok := inputs[name]; !ok {
+			inputs[name] = &pipelinespec.ArtifactList{}
 		}
+		inputs[name].Artifacts = append(inputs[name].Artifacts, runtimeArtifact)
 	}
 	return inputs, nil
 }
@@ -1168,6 +1180,44 @@ func (c *Client) FindMatchedArtifact(ctx context.Context, artifactToMatch *pb.Ar
 
 }
 
+// QueryArtifacts returns at most limit artifacts matching the MLMD filter
+// query, most recently created first.
+func (c *Client) QueryArtifacts(ctx context.Context, filterQuery string, limit int32) (artifacts []*pb.Artifact, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("QueryArtifacts(filterQuery=%q) failed: %w", filterQuery, err)
+		}
+	}()
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive, got %v", limit)
+	}
+	nextPageToken := ""
+	for {
+		// Documentation on query syntax:
+		// https://github.com/google/ml-metadata/blob/839c3501a195d340d2855b6ffdb2c4b0b49862c9/ml_metadata/proto/metadata_store.proto#L831
+		res, err := c.svc.GetArtifacts(ctx, &pb.GetArtifactsRequest{
+			Options: &pb.ListOperationOptions{
+				MaxResultSize: proto.Int32(limit - int32(len(artifacts))),
+				OrderByField: &pb.ListOperationOptions_OrderByField{
+					Field: pb.ListOperationOptions_OrderByField_CREATE_TIME.Enum(),
+					IsAsc: proto.Bool(false),
+				},
+				FilterQuery:   proto.String(filterQuery),
+				NextPageToken: proto.String(nextPageToken),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, res.GetArtifacts()...)
+		nextPageToken = res.GetNextPageToken()
+		if nextPageToken == "" || int32(len(artifacts)) >= limit {
+			break
+		}
+	}
+	return artifacts, nil
+}
+
 func (c *Client) matchedArtifactOrNot(ctx context.Context, target *pb.Artifact, candidate *pb.Artifact, pipelineContextId int64) (bool, error) {
 	if target.GetTypeId() != candidate.GetTypeId() || target.GetState() != candidate.GetState() || target.GetUri() != candidate.GetUri() {
 		return false, nil
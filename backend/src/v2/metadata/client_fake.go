@@ -100,3 +100,7 @@ func (c *FakeClient) GetOrInsertArtifactType(ctx context.Context, schema string)
 func (c *FakeClient) FindMatchedArtifact(ctx context.Context, artifactToMatch *pb.Artifact, pipelineContextId int64) (matchedArtifact *pb.Artifact, err error) {
 	return nil, nil
 }
+
+func (c *FakeClient) QueryArtifacts(ctx context.Context, filterQuery string, limit int32) ([]*pb.Artifact, error) {
+	return nil, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+)
+
+// metadataFilterPrefix selects an artifact custom property in a resolver
+// artifact query, e.g. `metadata.approved=true`.
+const metadataFilterPrefix = "metadata."
+
+var (
+	inContextPattern    = regexp.MustCompile(`^in_context\(\s*("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*')\s*\)$`)
+	customPropertyRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// ArtifactQueryToFilterQuery translates the filter of a resolver
+// ArtifactQuerySpec into an MLMD filter query.
+//
+// The supported syntax is the one documented on ArtifactQuerySpec:
+// `in_context("<context name>")`, `artifact_type="<type>"`, `uri="<uri>"`,
+// `state=<state>` and `name="<name>"`, combined with `AND`. In addition,
+// `metadata.<key>=<value>` matches an artifact custom property, where value is
+// a quoted string, a number or a boolean.
+//
+// When the filter has no `in_context` condition, the query is scoped to the
+// pipeline context named pipelineName.
+func ArtifactQueryToFilterQuery(filter string, pipelineName string) (string, error) {
+	conditions, err := splitArtifactQuery(filter)
+	if err != nil {
+		return "", fmt.Errorf("invalid artifact query %q: %w", filter, err)
+	}
+	var clauses []string
+	hasContext := false
+	for _, condition := range conditions {
+		if m := inContextPattern.FindStringSubmatch(condition); m != nil {
+			if hasContext {
+				return "", fmt.Errorf("invalid artifact query %q: in_context can only be specified once", filter)
+			}
+			hasContext = true
+			contextName, err := unquoteQueryValue(m[1])
+			if err != nil {
+				return "", fmt.Errorf("invalid artifact query %q: %w", filter, err)
+			}
+			clauses = append(clauses, fmt.Sprintf("contexts_a.name = %s", strconv.Quote(contextName)))
+			continue
+		}
+		clause, err := artifactQueryCondition(condition)
+		if err != nil {
+			return "", fmt.Errorf("invalid artifact query %q: %w", filter, err)
+		}
+		clauses = append(clauses, clause)
+	}
+	if !hasContext {
+		clauses = append(clauses,
+			fmt.Sprintf("contexts_a.type = %s", strconv.Quote(pipelineContextTypeName)),
+			fmt.Sprintf("contexts_a.name = %s", strconv.Quote(pipelineName)),
+		)
+	}
+	return strings.Join(clauses, " AND "), nil
+}
+
+func artifactQueryCondition(condition string) (string, error) {
+	key, value, found := strings.Cut(condition, "=")
+	if !found {
+		return "", fmt.Errorf("condition %q must be of the form key=value", condition)
+	}
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("condition %q has an empty value", condition)
+	}
+	switch key {
+	case "artifact_type", "uri", "name":
+		s, err := unquoteQueryValue(value)
+		if err != nil {
+			return "", err
+		}
+		field := key
+		if key == "artifact_type" {
+			field = "type"
+		}
+		return fmt.Sprintf("%s = %s", field, strconv.Quote(s)), nil
+	case "state":
+		state, err := unquoteQueryValue(value)
+		if err != nil {
+			state = value
+		}
+		if _, ok := pb.Artifact_State_value[state]; !ok {
+			return "", fmt.Errorf("unknown artifact state %q", state)
+		}
+		return fmt.Sprintf("state = %s", state), nil
+	}
+	if !strings.HasPrefix(key, metadataFilterPrefix) {
+		return "", fmt.Errorf("unsupported filter key %q", key)
+	}
+	property := strings.TrimPrefix(key, metadataFilterPrefix)
+	if !customPropertyRegex.MatchString(property) {
+		return "", fmt.Errorf("invalid metadata key %q", property)
+	}
+	// Values are matched the way StructValueToMLMDValue stores artifact
+	// metadata: strings as string_value, numbers as double_value and booleans
+	// as int_value.
+	prefix := fmt.Sprintf("custom_properties.%s", property)
+	if isQuoted(value) {
+		s, err := unquoteQueryValue(value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s.string_value = %s", prefix, strconv.Quote(s)), nil
+	}
+	switch value {
+	case "true":
+		return fmt.Sprintf("%s.int_value = 1", prefix), nil
+	case "false":
+		return fmt.Sprintf("%s.int_value = 0", prefix), nil
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return fmt.Sprintf("%s.double_value = %s", prefix, value), nil
+	}
+	return "", fmt.Errorf("value %q of %q must be a quoted string, a number or a boolean", value, key)
+}
+
+// splitArtifactQuery splits the filter on the AND keyword, ignoring keywords
+// inside quoted values.
+func splitArtifactQuery(filter string) ([]string, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	var conditions []string
+	var current strings.Builder
+	flush := func() error {
+		condition := strings.TrimSpace(current.String())
+		if condition == "" {
+			return fmt.Errorf("empty condition")
+		}
+		conditions = append(conditions, condition)
+		current.Reset()
+		return nil
+	}
+	runes := []rune(filter)
+	var quote rune
+	escaped := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0 && r == '\\':
+			escaped = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '"' || r == '\''):
+			quote = r
+		case quote == 0 && isAndKeyword(runes, i):
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			i += len("AND") - 1
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quoted value")
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+// isAndKeyword reports whether an AND keyword surrounded by spaces starts at
+// runes[i].
+func isAndKeyword(runes []rune, i int) bool {
+	end := i + len("AND")
+	return i > 0 && end < len(runes) &&
+		string(runes[i:end]) == "AND" &&
+		unicode.IsSpace(runes[i-1]) && unicode.IsSpace(runes[end])
+}
+
+func isQuoted(value string) bool {
+	return len(value) >= 2 &&
+		((value[0] == '"' && value[len(value)-1] == '"') || (value[0] == '\'' && value[len(value)-1] == '\''))
+}
+
+func unquoteQueryValue(value string) (string, error) {
+	if !isQuoted(value) {
+		return "", fmt.Errorf("value %s must be quoted", value)
+	}
+	if value[0] == '\'' {
+		// strconv.Unquote only accepts single characters between single quotes.
+		value = `"` + strings.ReplaceAll(value[1:len(value)-1], `"`, `\"`) + `"`
+	}
+	s, err := strconv.Unquote(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid quoted value %s: %w", value, err)
+	}
+	return s, nil
+}
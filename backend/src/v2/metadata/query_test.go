@@ -0,0 +1,108 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ArtifactQueryToFilterQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "empty filter is scoped to the pipeline context",
+			filter: "",
+			want:   `contexts_a.type = "system.Pipeline" AND contexts_a.name = "my-pipeline"`,
+		},
+		{
+			name:   "latest approved model",
+			filter: `artifact_type="system.Model" AND metadata.approved=true`,
+			want:   `type = "system.Model" AND custom_properties.approved.int_value = 1 AND contexts_a.type = "system.Pipeline" AND contexts_a.name = "my-pipeline"`,
+		},
+		{
+			name:   "in_context replaces the pipeline context",
+			filter: `in_context("other-pipeline") AND artifact_type="system.Dataset" AND state=LIVE`,
+			want:   `contexts_a.name = "other-pipeline" AND type = "system.Dataset" AND state = LIVE`,
+		},
+		{
+			name:   "uri, name and metadata values",
+			filter: `uri='gs://bucket/AND/model' AND name="model" AND metadata.framework="tf" AND metadata.accuracy=0.9`,
+			want:   `uri = "gs://bucket/AND/model" AND name = "model" AND custom_properties.framework.string_value = "tf" AND custom_properties.accuracy.double_value = 0.9 AND contexts_a.type = "system.Pipeline" AND contexts_a.name = "my-pipeline"`,
+		},
+		{
+			name:   "AND inside quoted values is not a separator",
+			filter: `name="a AND b"`,
+			want:   `name = "a AND b" AND contexts_a.type = "system.Pipeline" AND contexts_a.name = "my-pipeline"`,
+		},
+		{
+			name:    "unknown key",
+			filter:  `owner="me"`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown state",
+			filter:  `state=ALIVE`,
+			wantErr: true,
+		},
+		{
+			name:    "unquoted string value",
+			filter:  `artifact_type=system.Model`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid metadata value",
+			filter:  `metadata.approved=yes`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid metadata key",
+			filter:  `metadata.a.b=1`,
+			wantErr: true,
+		},
+		{
+			name:    "empty condition",
+			filter:  `name="model" AND  AND uri="gs://a"`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quote",
+			filter:  `name="model`,
+			wantErr: true,
+		},
+		{
+			name:    "multiple in_context",
+			filter:  `in_context("a") AND in_context("b")`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := metadata.ArtifactQueryToFilterQuery(tt.filter, "my-pipeline")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeyNativeRunOwner is the annotation of the runs with the
+	// identity of the client whose runner holds the lease of the run.
+	AnnotationKeyNativeRunOwner = "pipelines.kubeflow.org/native-run-owner"
+	// AnnotationKeyNativeRunLeaseRenewTime is the annotation of the runs with
+	// the time the lease of the run was last renewed.
+	AnnotationKeyNativeRunLeaseRenewTime = "pipelines.kubeflow.org/native-run-lease-renew-time"
+)
+
+// leaseDuration is how long the lease of a run is held without being renewed
+// before another client may take the run over. The runner of a run renews
+// its lease three times per lease duration.
+var leaseDuration = 30 * time.Second
+
+// errLeaseLost is returned when a client changes a run whose lease is held by
+// another client.
+var errLeaseLost = errors.New("the lease of the native run is held by another API server")
+
+// Reporter is called with the latest state of a native run after it changed.
+// It plays the part of the persistence agent for Argo Workflows.
+type Reporter func(ctx context.Context, execSpec util.ExecutionSpec) error
+
+// Client is the ExecutionClient of the native execution backend. The runs
+// are NativeRun custom resources, which the client runs in-process.
+//
+// Every API server replica has a client, so each run is only run by the
+// client that holds its lease. The lease is claimed and renewed by updates of
+// the annotations of the run, which conflict with the updates of the other
+// clients.
+type Client struct {
+	// identity identifies the client in the leases of the runs.
+	identity  string
+	k8sClient ctrlclient.Client
+	podClient func(namespace string) corev1client.PodInterface
+	driver    Driver
+
+	mu       sync.Mutex
+	reporter Reporter
+	// runners cancel the runners of the runs this process is running.
+	runners map[types.NamespacedName]context.CancelFunc
+	// pending are the runs that changed since they were last reported.
+	pending map[types.NamespacedName]struct{}
+	notify  chan struct{}
+}
+
+// NewClient creates a Client that stores the runs with the Kubernetes client,
+// whose scheme must include the NativeRun custom resource, and runs their
+// tasks in pods.
+func NewClient(k8sClient ctrlclient.Client, podClient func(namespace string) corev1client.PodInterface, driver Driver) *Client {
+	hostname, _ := os.Hostname()
+	c := &Client{
+		identity:  hostname + "_" + uuid.NewString(),
+		k8sClient: k8sClient,
+		podClient: podClient,
+		driver:    driver,
+		runners:   make(map[types.NamespacedName]context.CancelFunc),
+		pending:   make(map[types.NamespacedName]struct{}),
+		notify:    make(chan struct{}, 1),
+	}
+	go c.reportChanges()
+	return c
+}
+
+// SetReporter sets the function the changes of the runs are reported to.
+func (c *Client) SetReporter(reporter Reporter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reporter = reporter
+}
+
+// Resume starts the runners of the unfinished runs of all namespaces, which
+// were stopped by a restart of the API server. Until the context is done, it
+// then keeps taking over the runs whose lease expired, e.g. because the API
+// server replica running them stopped.
+func (c *Client) Resume(ctx context.Context) error {
+	if err := c.resume(ctx); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(leaseDuration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := c.resume(ctx); err != nil {
+				glog.Warningf("Failed to resume the native runs: %v", err)
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *Client) resume(ctx context.Context) error {
+	list := &util.NativeRunManifestList{}
+	if err := c.k8sClient.List(ctx, list); err != nil {
+		return fmt.Errorf("failed to list the native runs: %w", err)
+	}
+	for i := range list.Items {
+		manifest := &list.Items[i]
+		if !util.NewNativeRun(manifest).IsInFinalState() && !c.leasedByOther(manifest) {
+			c.start(manifest)
+		}
+	}
+	return nil
+}
+
+// leasedByOther returns true if another client holds the lease of a run.
+func (c *Client) leasedByOther(manifest *util.NativeRunManifest) bool {
+	owner := manifest.Annotations[AnnotationKeyNativeRunOwner]
+	if owner == "" || owner == c.identity {
+		return false
+	}
+	renewTime, err := time.Parse(time.RFC3339Nano, manifest.Annotations[AnnotationKeyNativeRunLeaseRenewTime])
+	return err == nil && time.Since(renewTime) < leaseDuration
+}
+
+// claim takes the lease of a run, unless another client holds it.
+func (c *Client) claim(ctx context.Context, key types.NamespacedName) bool {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		manifest := &util.NativeRunManifest{}
+		if err := c.k8sClient.Get(ctx, key, manifest); err != nil {
+			return err
+		}
+		if c.leasedByOther(manifest) {
+			return errLeaseLost
+		}
+		if manifest.Annotations == nil {
+			manifest.Annotations = make(map[string]string)
+		}
+		if manifest.Annotations[AnnotationKeyNativeRunOwner] != c.identity {
+			glog.Infof("Running native run %s", key)
+		}
+		manifest.Annotations[AnnotationKeyNativeRunOwner] = c.identity
+		manifest.Annotations[AnnotationKeyNativeRunLeaseRenewTime] = time.Now().UTC().Format(time.RFC3339Nano)
+		return c.k8sClient.Update(ctx, manifest)
+	})
+	if err != nil && !errors.Is(err, errLeaseLost) && !k8serrors.IsNotFound(err) && ctx.Err() == nil {
+		glog.Errorf("Failed to claim the lease of native run %s: %v", key, err)
+	}
+	return err == nil
+}
+
+// release gives up the lease of a run after its runner stopped, so that
+// another client can run it again right away once it is retried.
+func (c *Client) release(key types.NamespacedName) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		manifest := &util.NativeRunManifest{}
+		if err := c.k8sClient.Get(context.Background(), key, manifest); err != nil {
+			return err
+		}
+		if manifest.Annotations[AnnotationKeyNativeRunOwner] != c.identity {
+			return nil
+		}
+		delete(manifest.Annotations, AnnotationKeyNativeRunOwner)
+		delete(manifest.Annotations, AnnotationKeyNativeRunLeaseRenewTime)
+		return c.k8sClient.Update(context.Background(), manifest)
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		glog.Warningf("Failed to release the lease of native run %s: %v", key, err)
+	}
+}
+
+// renewLease renews the lease of a run until the context is done, and calls
+// cancel once the lease was taken over by another client.
+func (c *Client) renewLease(ctx context.Context, key types.NamespacedName, cancel context.CancelFunc) {
+	ticker := time.NewTicker(leaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			manifest := &util.NativeRunManifest{}
+			if err := c.k8sClient.Get(ctx, key, manifest); err != nil {
+				return err
+			}
+			if manifest.Annotations[AnnotationKeyNativeRunOwner] != c.identity {
+				return errLeaseLost
+			}
+			manifest.Annotations[AnnotationKeyNativeRunLeaseRenewTime] = time.Now().UTC().Format(time.RFC3339Nano)
+			return c.k8sClient.Update(ctx, manifest)
+		})
+		switch {
+		case errors.Is(err, errLeaseLost):
+			glog.Warningf("Stopping native run %s, whose lease was taken over by another API server", key)
+			cancel()
+			return
+		case k8serrors.IsNotFound(err):
+			cancel()
+			return
+		case err != nil && ctx.Err() == nil:
+			glog.Warningf("Failed to renew the lease of native run %s: %v", key, err)
+		}
+	}
+}
+
+func (c *Client) Execution(namespace string) util.ExecutionInterface {
+	return &executionInterface{client: c, namespace: namespace}
+}
+
+func (c *Client) Compare(old, new interface{}) bool {
+	oldRun, ok := old.(util.ExecutionSpec)
+	if !ok {
+		return false
+	}
+	newRun, ok := new.(util.ExecutionSpec)
+	if !ok {
+		return false
+	}
+	return oldRun.Version() != newRun.Version()
+}
+
+// queueReport queues a run to be reported.
+func (c *Client) queueReport(key types.NamespacedName) {
+	c.mu.Lock()
+	c.pending[key] = struct{}{}
+	c.mu.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// reportChanges reports the latest state of the changed runs, outside of the
+// lock of the client because the reporter calls back into the client.
+func (c *Client) reportChanges() {
+	for range c.notify {
+		c.mu.Lock()
+		reporter := c.reporter
+		pending := c.pending
+		c.pending = make(map[types.NamespacedName]struct{})
+		c.mu.Unlock()
+
+		if reporter == nil {
+			continue
+		}
+		for key := range pending {
+			manifest := &util.NativeRunManifest{}
+			if err := c.k8sClient.Get(context.Background(), key, manifest); err != nil {
+				if !k8serrors.IsNotFound(err) {
+					glog.Errorf("Failed to get native run %s to report it: %v", key, err)
+				}
+				continue
+			}
+			if err := reporter(context.Background(), util.NewNativeRun(manifest)); err != nil {
+				glog.Errorf("Failed to report native run %s: %v", key, err)
+			}
+		}
+	}
+}
+
+// start starts the runner of a run unless it is running or finished.
+func (c *Client) start(manifest *util.NativeRunManifest) {
+	if util.NewNativeRun(manifest).IsInFinalState() {
+		return
+	}
+	key := types.NamespacedName{Namespace: manifest.Namespace, Name: manifest.Name}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.runners[key]; ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.runners[key] = cancel
+	r := newRunner(c, key)
+	go func() {
+		defer func() {
+			cancel()
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			delete(c.runners, key)
+		}()
+		if !c.claim(ctx, key) {
+			return
+		}
+		go c.renewLease(ctx, key, cancel)
+		r.run(ctx)
+		c.release(key)
+	}()
+}
+
+// stop cancels the runner of a run, if it is running.
+func (c *Client) stop(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cancel, ok := c.runners[key]; ok {
+		cancel()
+	}
+}
+
+// updateStatus applies a change to the latest version of a run, retrying on
+// conflicts with the changes of the API server. It fails with errLeaseLost
+// if the client no longer holds the lease of the run.
+func (c *Client) updateStatus(ctx context.Context, key types.NamespacedName, change func(manifest *util.NativeRunManifest)) (*util.NativeRunManifest, error) {
+	manifest := &util.NativeRunManifest{}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		manifest = &util.NativeRunManifest{}
+		if err := c.k8sClient.Get(ctx, key, manifest); err != nil {
+			return err
+		}
+		if manifest.Annotations[AnnotationKeyNativeRunOwner] != c.identity {
+			return errLeaseLost
+		}
+		change(manifest)
+		return c.k8sClient.Update(ctx, manifest)
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.queueReport(key)
+	return manifest, nil
+}
+
+type executionInterface struct {
+	client    *Client
+	namespace string
+}
+
+func (e *executionInterface) key(name string) types.NamespacedName {
+	return types.NamespacedName{Namespace: e.namespace, Name: name}
+}
+
+func toManifest(execution util.ExecutionSpec) (*util.NativeRunManifest, error) {
+	run, ok := execution.(*util.NativeRun)
+	if !ok {
+		return nil, fmt.Errorf("execution spec of type %T is not a native run", execution)
+	}
+	manifest := run.NativeRunManifest.DeepCopy()
+	manifest.APIVersion = util.NativeRunAPIVersion
+	manifest.Kind = util.NativeRunKind
+	return manifest, nil
+}
+
+func (e *executionInterface) Create(ctx context.Context, execution util.ExecutionSpec, opts k8smeta.CreateOptions) (util.ExecutionSpec, error) {
+	manifest, err := toManifest(execution)
+	if err != nil {
+		return nil, err
+	}
+	manifest.Namespace = e.namespace
+	manifest.ResourceVersion = ""
+	if err := e.client.k8sClient.Create(ctx, manifest); err != nil {
+		return nil, err
+	}
+	e.client.queueReport(e.key(manifest.Name))
+	e.client.start(manifest)
+	return util.NewNativeRun(manifest.DeepCopy()), nil
+}
+
+func (e *executionInterface) Update(ctx context.Context, execution util.ExecutionSpec, opts k8smeta.UpdateOptions) (util.ExecutionSpec, error) {
+	manifest, err := toManifest(execution)
+	if err != nil {
+		return nil, err
+	}
+	manifest.Namespace = e.namespace
+	if err := e.client.k8sClient.Update(ctx, manifest); err != nil {
+		return nil, err
+	}
+	e.client.queueReport(e.key(manifest.Name))
+	e.client.start(manifest)
+	return util.NewNativeRun(manifest.DeepCopy()), nil
+}
+
+func (e *executionInterface) Delete(ctx context.Context, name string, opts k8smeta.DeleteOptions) error {
+	manifest := &util.NativeRunManifest{}
+	manifest.Namespace = e.namespace
+	manifest.Name = name
+	// The pods of the run are deleted by the garbage collector.
+	if err := e.client.k8sClient.Delete(ctx, manifest); err != nil {
+		return err
+	}
+	e.client.stop(e.key(name))
+	return nil
+}
+
+func (e *executionInterface) DeleteCollection(ctx context.Context, opts k8smeta.DeleteOptions, listOpts k8smeta.ListOptions) error {
+	list, err := e.list(ctx, listOpts)
+	if err != nil {
+		return err
+	}
+	for i := range list.Items {
+		err := e.client.k8sClient.Delete(ctx, &list.Items[i])
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		e.client.stop(e.key(list.Items[i].Name))
+	}
+	return nil
+}
+
+func (e *executionInterface) Get(ctx context.Context, name string, opts k8smeta.GetOptions) (util.ExecutionSpec, error) {
+	manifest := &util.NativeRunManifest{}
+	if err := e.client.k8sClient.Get(ctx, e.key(name), manifest); err != nil {
+		return nil, err
+	}
+	return util.NewNativeRun(manifest), nil
+}
+
+func (e *executionInterface) list(ctx context.Context, opts k8smeta.ListOptions) (*util.NativeRunManifestList, error) {
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+	list := &util.NativeRunManifestList{}
+	err = e.client.k8sClient.List(ctx, list, ctrlclient.InNamespace(e.namespace), ctrlclient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (e *executionInterface) List(ctx context.Context, opts k8smeta.ListOptions) (*util.ExecutionSpecList, error) {
+	list, err := e.list(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	specs := util.ExecutionSpecList{}
+	for i := range list.Items {
+		specs = append(specs, util.NewNativeRun(&list.Items[i]))
+	}
+	return &specs, nil
+}
+
+func (e *executionInterface) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts k8smeta.PatchOptions, subresources ...string) (util.ExecutionSpec, error) {
+	manifest := &util.NativeRunManifest{}
+	// A patch without a resource version is not expected to conflict, but
+	// it is retried in case the client does not retry it like the API
+	// server.
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		manifest = &util.NativeRunManifest{}
+		manifest.Namespace = e.namespace
+		manifest.Name = name
+		return e.client.k8sClient.Patch(ctx, manifest, ctrlclient.RawPatch(pt, data))
+	})
+	if err != nil {
+		return nil, err
+	}
+	e.client.queueReport(e.key(name))
+	if manifest.Spec.Terminate {
+		e.client.stop(e.key(name))
+	}
+	return util.NewNativeRun(manifest), nil
+}
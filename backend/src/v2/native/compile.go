@@ -0,0 +1,322 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package native implements the native execution backend, which runs v2
+// PipelineJobs from the API server instead of compiling them to Argo
+// Workflows.
+//
+// Runs are NativeRun custom resources. The API server walks their DAGs and
+// calls the v2 driver in-process for every DAG and task, so that inputs,
+// outputs, conditions, iterators and caching work like they do with Argo.
+// The API server therefore needs to reach ML Metadata and the task cache.
+// The container and importer tasks run the v2 launcher in pods; running them
+// as local subprocesses instead is not supported. Each run is run by the API
+// server replica holding its lease, see Client.
+package native
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
+	"github.com/kubeflow/pipelines/kubernetes_platform/go/kubernetesplatform"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	k8score "k8s.io/api/core/v1"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Options are the options of the compilation of a PipelineJob to a NativeRun.
+type Options struct {
+	// CacheDisabled disables the cache of all tasks of the run.
+	CacheDisabled bool
+}
+
+// Here is the collection of all special dummy images that the backend
+// recognizes, like argocompiler does. Their tasks create and delete PVCs in
+// the driver, which needs the pod it runs in.
+var dummyImages = map[string]bool{
+	"argostub/createpvc": true,
+	"argostub/deletepvc": true,
+}
+
+// Compile validates that a PipelineJob can be run by the native execution
+// backend and wraps it in a NativeRun manifest.
+func Compile(jobArg *pipelinespec.PipelineJob, kubernetesSpec *pipelinespec.SinglePlatformSpec, opts *Options) (*util.NativeRunManifest, error) {
+	// clone jobArg, because we don't want to change it
+	job, ok := proto.Clone(jobArg).(*pipelinespec.PipelineJob)
+	if !ok {
+		return nil, fmt.Errorf("bug: cloned pipeline job message does not have expected type")
+	}
+	if job.RuntimeConfig == nil {
+		job.RuntimeConfig = &pipelinespec.PipelineJob_RuntimeConfig{}
+	}
+	if job.GetRuntimeConfig().GetParameterValues() == nil {
+		job.RuntimeConfig.ParameterValues = map[string]*structpb.Value{}
+	}
+	p, err := newPipeline(job, kubernetesSpec)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	// fill root component default parameters to PipelineJob
+	for name, param := range p.spec.GetRoot().GetInputDefinitions().GetParameters() {
+		if _, ok := job.RuntimeConfig.ParameterValues[name]; ok {
+			continue
+		}
+		if param.GetDefaultValue() != nil {
+			job.RuntimeConfig.ParameterValues[name] = param.GetDefaultValue()
+		} else if param.IsOptional {
+			job.RuntimeConfig.ParameterValues[name] = structpb.NewNullValue()
+		}
+	}
+	jobJSON, err := protojson.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the pipeline job: %w", err)
+	}
+	manifest := &util.NativeRunManifest{
+		TypeMeta: k8smeta.TypeMeta{
+			APIVersion: util.NativeRunAPIVersion,
+			Kind:       util.NativeRunKind,
+		},
+		ObjectMeta: k8smeta.ObjectMeta{
+			GenerateName: retrieveLastValidString(p.spec.GetPipelineInfo().GetName()) + "-",
+		},
+		Spec: util.NativeRunSpec{
+			PipelineJob:        jobJSON,
+			ServiceAccountName: common.GetStringConfigWithDefault(common.DefaultPipelineRunnerServiceAccountFlag, common.DefaultPipelineRunnerServiceAccount),
+			PodMetadata: util.NativeRunPodMetadata{
+				Annotations: map[string]string{
+					"pipelines.kubeflow.org/v2_component": "true",
+				},
+				Labels: map[string]string{
+					"pipelines.kubeflow.org/v2_component": "true",
+				},
+			},
+		},
+	}
+	if kubernetesSpec != nil {
+		if manifest.Spec.KubernetesSpec, err = protojson.Marshal(kubernetesSpec); err != nil {
+			return nil, fmt.Errorf("failed to marshal the Kubernetes platform spec: %w", err)
+		}
+	}
+	if runAsUser := argocompiler.GetPipelineRunAsUser(); runAsUser != nil {
+		manifest.Spec.SecurityContext = &k8score.PodSecurityContext{RunAsUser: runAsUser}
+	}
+	if opts != nil {
+		manifest.Spec.CacheDisabled = opts.CacheDisabled
+	}
+	return manifest, nil
+}
+
+func retrieveLastValidString(s string) string {
+	sections := strings.Split(s, "/")
+	return sections[len(sections)-1]
+}
+
+// pipeline is a parsed PipelineJob.
+type pipeline struct {
+	job            *pipelinespec.PipelineJob
+	spec           *pipelinespec.PipelineSpec
+	deploy         *pipelinespec.PipelineDeploymentConfig
+	kubernetesSpec *pipelinespec.SinglePlatformSpec
+}
+
+func newPipeline(job *pipelinespec.PipelineJob, kubernetesSpec *pipelinespec.SinglePlatformSpec) (*pipeline, error) {
+	spec, err := compiler.GetPipelineSpec(job)
+	if err != nil {
+		return nil, err
+	}
+	if spec.GetPipelineInfo().GetName() == "" {
+		return nil, fmt.Errorf("pipelineInfo.name is empty")
+	}
+	deploy, err := compiler.GetDeploymentConfig(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &pipeline{job: job, spec: spec, deploy: deploy, kubernetesSpec: kubernetesSpec}, nil
+}
+
+func parsePipeline(spec util.NativeRunSpec) (*pipeline, error) {
+	job := &pipelinespec.PipelineJob{}
+	if err := protojson.Unmarshal(spec.PipelineJob, job); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the pipeline job: %w", err)
+	}
+	var kubernetesSpec *pipelinespec.SinglePlatformSpec
+	if len(spec.KubernetesSpec) > 0 {
+		kubernetesSpec = &pipelinespec.SinglePlatformSpec{}
+		if err := protojson.Unmarshal(spec.KubernetesSpec, kubernetesSpec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the Kubernetes platform spec: %w", err)
+		}
+	}
+	return newPipeline(job, kubernetesSpec)
+}
+
+func (p *pipeline) component(task *pipelinespec.PipelineTaskSpec) (*pipelinespec.ComponentSpec, error) {
+	name := task.GetComponentRef().GetName()
+	component, ok := p.spec.GetComponents()[name]
+	if !ok {
+		return nil, fmt.Errorf("task %q: component %q not found", task.GetTaskInfo().GetName(), name)
+	}
+	return component, nil
+}
+
+func (p *pipeline) executor(component *pipelinespec.ComponentSpec) (*pipelinespec.PipelineDeploymentConfig_ExecutorSpec, error) {
+	executor, ok := p.deploy.GetExecutors()[component.GetExecutorLabel()]
+	if !ok {
+		return nil, fmt.Errorf("executor %q not found", component.GetExecutorLabel())
+	}
+	return executor, nil
+}
+
+// kubernetesExecutorConfig returns the Kubernetes platform config of the
+// executor of a component, or nil if it has none.
+func (p *pipeline) kubernetesExecutorConfig(component *pipelinespec.ComponentSpec) (*kubernetesplatform.KubernetesExecutorConfig, error) {
+	spec, ok := p.kubernetesSpec.GetDeploymentSpec().GetExecutors()[component.GetExecutorLabel()]
+	if !ok {
+		return nil, nil
+	}
+	specJSON, err := protojson.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the Kubernetes config of executor %q: %w", component.GetExecutorLabel(), err)
+	}
+	config := &kubernetesplatform.KubernetesExecutorConfig{}
+	if err := protojson.Unmarshal(specJSON, config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the Kubernetes config of executor %q: %w", component.GetExecutorLabel(), err)
+	}
+	return config, nil
+}
+
+// validate returns an error for the first pipeline feature the native
+// execution backend does not support.
+func (p *pipeline) validate() error {
+	if p.kubernetesSpec.GetPipelineConfig() != nil {
+		return unsupported("Kubernetes pipeline config")
+	}
+	if p.spec.GetRoot().GetDag() == nil {
+		return unsupported("root component without a DAG")
+	}
+	return p.validateDAG(p.spec.GetRoot().GetDag())
+}
+
+func (p *pipeline) validateDAG(dag *pipelinespec.DagSpec) error {
+	tasks := dag.GetTasks()
+	for name, task := range tasks {
+		if task.GetParameterIterator() != nil && task.GetArtifactIterator() != nil {
+			return fmt.Errorf("invalid task %q: parameterIterator and artifactIterator cannot be specified at the same time", name)
+		}
+		for _, upstream := range upstreamTasks(task) {
+			if _, ok := tasks[upstream]; !ok {
+				return fmt.Errorf("task %q depends on unknown task %q", name, upstream)
+			}
+		}
+		component, err := p.component(task)
+		if err != nil {
+			return err
+		}
+		if component.GetDag() != nil {
+			if err := p.validateDAG(component.GetDag()); err != nil {
+				return err
+			}
+			continue
+		}
+		executor, err := p.executor(component)
+		if err != nil {
+			return fmt.Errorf("task %q: %w", name, err)
+		}
+		switch {
+		case executor.GetContainer() != nil:
+			if dummyImages[executor.GetContainer().GetImage()] {
+				return unsupported(fmt.Sprintf("Kubernetes PVC task %q", name))
+			}
+		case executor.GetImporter() != nil:
+			if task.GetTriggerPolicy().GetCondition() != "" {
+				return fmt.Errorf("triggerPolicy.condition on importer task %q is not supported", name)
+			}
+		case executor.GetResolver() != nil:
+			if task.GetTriggerPolicy().GetCondition() != "" {
+				return fmt.Errorf("triggerPolicy.condition on resolver task %q is not supported", name)
+			}
+		default:
+			return unsupported(fmt.Sprintf("executor of task %q", name))
+		}
+	}
+	return checkDependencyCycles(tasks)
+}
+
+func unsupported(feature string) error {
+	return fmt.Errorf("%s is not supported by the native execution backend", feature)
+}
+
+// upstreamTasks returns the tasks a task depends on, including the producers
+// of its inputs.
+func upstreamTasks(task *pipelinespec.PipelineTaskSpec) []string {
+	seen := make(map[string]bool)
+	var upstreams []string
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			upstreams = append(upstreams, name)
+		}
+	}
+	for _, dependency := range task.GetDependentTasks() {
+		add(dependency)
+	}
+	for _, input := range task.GetInputs().GetParameters() {
+		add(input.GetTaskOutputParameter().GetProducerTask())
+	}
+	for _, input := range task.GetInputs().GetArtifacts() {
+		add(input.GetTaskOutputArtifact().GetProducerTask())
+	}
+	return upstreams
+}
+
+// checkDependencyCycles returns an error if the task dependencies have a cycle.
+func checkDependencyCycles(tasks map[string]*pipelinespec.PipelineTaskSpec) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(tasks))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("task %q is part of a dependency cycle", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dependency := range upstreamTasks(tasks[name]) {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for name := range tasks {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
+	"github.com/kubeflow/pipelines/backend/src/v2/driver"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+)
+
+// Driver runs the v2 driver of the DAGs and tasks of native runs, which
+// resolves their inputs, evaluates their conditions and iterators, and
+// records them in ML Metadata.
+type Driver interface {
+	RootDAG(ctx context.Context, opts driver.Options) (*driver.Execution, error)
+	DAG(ctx context.Context, opts driver.Options) (*driver.Execution, error)
+	Container(ctx context.Context, opts driver.Options) (*driver.Execution, error)
+	Resolver(ctx context.Context, opts driver.Options) (*driver.Execution, error)
+}
+
+// metadataDriver calls the driver package with the clients of ML Metadata and
+// of the task cache of the API server.
+type metadataDriver struct {
+	mlmd          *metadata.Client
+	cache         cacheutils.Client
+	disabledCache cacheutils.Client
+}
+
+// NewDriver creates a Driver that records the executions in the ML Metadata
+// service at the address.
+func NewDriver(mlmdAddress string, mlmdPort string) (Driver, error) {
+	mlmd, err := metadata.NewClient(mlmdAddress, mlmdPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the ML Metadata client: %w", err)
+	}
+	cache, err := cacheutils.NewClient(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cache client: %w", err)
+	}
+	disabledCache, err := cacheutils.NewClient(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cache client: %w", err)
+	}
+	return &metadataDriver{mlmd: mlmd, cache: cache, disabledCache: disabledCache}, nil
+}
+
+func (d *metadataDriver) RootDAG(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	return driver.RootDAG(ctx, opts, d.mlmd)
+}
+
+func (d *metadataDriver) DAG(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	return driver.DAG(ctx, opts, d.mlmd)
+}
+
+func (d *metadataDriver) Container(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	cache := d.cache
+	if opts.CacheDisabled {
+		cache = d.disabledCache
+	}
+	return driver.Container(ctx, opts, d.mlmd, cache)
+}
+
+func (d *metadataDriver) Resolver(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	return driver.Resolver(ctx, opts, d.mlmd)
+}
@@ -0,0 +1,755 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	exec "github.com/kubeflow/pipelines/backend/src/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	k8score "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const producerConsumerJob = `{
+  "pipelineSpec": {
+    "pipelineInfo": {"name": "namespace/producer-consumer"},
+    "components": {
+      "comp-producer": {
+        "executorLabel": "exec-producer",
+        "inputDefinitions": {"parameters": {"text": {"parameterType": "STRING"}}},
+        "outputDefinitions": {"parameters": {"length": {"parameterType": "NUMBER_INTEGER"}}}
+      },
+      "comp-consumer": {
+        "executorLabel": "exec-consumer",
+        "inputDefinitions": {"parameters": {"length": {"parameterType": "NUMBER_INTEGER"}}}
+      }
+    },
+    "deploymentSpec": {"executors": {
+      "exec-producer": {"container": {"image": "python:3.9", "command": ["produce"]}},
+      "exec-consumer": {"container": {"image": "python:3.9", "command": ["consume"]}}
+    }},
+    "root": {
+      "inputDefinitions": {"parameters": {"text": {"parameterType": "STRING", "defaultValue": "hello"}}},
+      "dag": {"tasks": {
+        "producer": {
+          "taskInfo": {"name": "producer"},
+          "componentRef": {"name": "comp-producer"},
+          "inputs": {"parameters": {"text": {"componentInputParameter": "text"}}},
+          "retryPolicy": {"maxRetryCount": 1}
+        },
+        "consumer": {
+          "taskInfo": {"name": "consumer"},
+          "componentRef": {"name": "comp-consumer"},
+          "inputs": {"parameters": {"length": {"taskOutputParameter": {"producerTask": "producer", "outputParameterKey": "length"}}}}
+        }
+      }}
+    },
+    "schemaVersion": "2.1.0"
+  }
+}`
+
+// controlFlowJob has an exit handler around an iterator, a skipped task and
+// a cached task.
+const controlFlowJob = `{
+  "pipelineSpec": {
+    "pipelineInfo": {"name": "control-flow"},
+    "components": {
+      "comp-body": {"executorLabel": "exec-body"},
+      "comp-for-loop": {"dag": {"tasks": {
+        "body": {"taskInfo": {"name": "body"}, "componentRef": {"name": "comp-body"}}
+      }}},
+      "comp-exit-handler": {"dag": {"tasks": {
+        "loop": {
+          "taskInfo": {"name": "loop"},
+          "componentRef": {"name": "comp-for-loop"},
+          "parameterIterator": {"itemInput": "item", "items": {"raw": "[1, 2, 3]"}},
+          "iteratorPolicy": {"parallelismLimit": 1}
+        },
+        "skipped": {
+          "taskInfo": {"name": "skipped"},
+          "componentRef": {"name": "comp-body"},
+          "triggerPolicy": {"condition": "false"}
+        },
+        "after-skipped": {
+          "taskInfo": {"name": "after-skipped"},
+          "componentRef": {"name": "comp-body"},
+          "dependentTasks": ["skipped"]
+        },
+        "cached": {"taskInfo": {"name": "cached"}, "componentRef": {"name": "comp-body"}}
+      }}}
+    },
+    "deploymentSpec": {"executors": {
+      "exec-body": {"container": {"image": "python:3.9", "command": ["body"]}}
+    }},
+    "root": {"dag": {"tasks": {
+      "exit-handler": {"taskInfo": {"name": "exit-handler"}, "componentRef": {"name": "comp-exit-handler"}},
+      "cleanup": {
+        "taskInfo": {"name": "cleanup"},
+        "componentRef": {"name": "comp-body"},
+        "dependentTasks": ["exit-handler"],
+        "triggerPolicy": {"strategy": "ALL_UPSTREAM_TASKS_COMPLETED"}
+      }
+    }}},
+    "schemaVersion": "2.1.0"
+  }
+}`
+
+func TestMain(m *testing.M) {
+	suspendPollInterval = 10 * time.Millisecond
+	podPollInterval = 10 * time.Millisecond
+	os.Exit(m.Run())
+}
+
+func loadJob(t *testing.T, jobJSON string) *pipelinespec.PipelineJob {
+	t.Helper()
+	job := &pipelinespec.PipelineJob{}
+	require.Nil(t, protojson.Unmarshal([]byte(jobJSON), job))
+	return job
+}
+
+// fakeDriver returns new executions and records the options it was called
+// with. The executions of container tasks run the task name.
+type fakeDriver struct {
+	mu     sync.Mutex
+	nextID int64
+	calls  []driverCall
+	// configure changes the executions before they are returned.
+	configure func(opts driver.Options, execution *driver.Execution)
+}
+
+type driverCall struct {
+	driverType  string
+	opts        driver.Options
+	executionID int64
+}
+
+func (d *fakeDriver) execute(driverType string, opts driver.Options) (*driver.Execution, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.nextID++
+	execution := &driver.Execution{ID: d.nextID}
+	if driverType == driverTypeContainer {
+		execution.PodSpecPatch = fmt.Sprintf(`{"containers":[{"name":"main","image":"python:3.9","command":["run",%q,"%d"]}]}`,
+			opts.Task.GetTaskInfo().GetName(), opts.IterationIndex)
+	}
+	if d.configure != nil {
+		d.configure(opts, execution)
+	}
+	d.calls = append(d.calls, driverCall{driverType: driverType, opts: opts, executionID: execution.ID})
+	return execution, nil
+}
+
+func (d *fakeDriver) RootDAG(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	return d.execute(driverTypeRootDAG, opts)
+}
+
+func (d *fakeDriver) DAG(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	return d.execute(driverTypeDAG, opts)
+}
+
+func (d *fakeDriver) Container(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	return d.execute(driverTypeContainer, opts)
+}
+
+func (d *fakeDriver) Resolver(ctx context.Context, opts driver.Options) (*driver.Execution, error) {
+	return d.execute(driverTypeResolver, opts)
+}
+
+// callsOf returns the calls of a driver for a task, or for the root DAG if
+// the task name is empty.
+func (d *fakeDriver) callsOf(driverType string, taskName string) []driverCall {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var calls []driverCall
+	for _, call := range d.calls {
+		if call.driverType == driverType && call.opts.Task.GetTaskInfo().GetName() == taskName {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// fakeCluster is a client with fake Kubernetes clients, whose pods get the
+// phase returned by podPhase when they are created.
+type fakeCluster struct {
+	client    *Client
+	driver    *fakeDriver
+	k8sClient ctrlclient.Client
+	pods      *fake.Clientset
+}
+
+func newFakeCluster(t *testing.T, podPhase func(pod *k8score.Pod) k8score.PodPhase, objects ...*util.NativeRunManifest) *fakeCluster {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.Nil(t, util.AddNativeRunToScheme(scheme))
+	builder := ctrlfake.NewClientBuilder().WithScheme(scheme)
+	for _, object := range objects {
+		builder = builder.WithObjects(object)
+	}
+	pods := fake.NewSimpleClientset()
+	var created int32
+	pods.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*k8score.Pod)
+		if pod.Name == "" {
+			pod.Name = fmt.Sprintf("%s%d", pod.GenerateName, atomic.AddInt32(&created, 1))
+		}
+		pod.Status.Phase = podPhase(pod)
+		if pod.Status.Phase == k8score.PodFailed {
+			pod.Status.ContainerStatuses = []k8score.ContainerStatus{{
+				Name:  mainContainerName,
+				State: k8score.ContainerState{Terminated: &k8score.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+			}}
+		}
+		return false, nil, nil
+	})
+	c := &fakeCluster{k8sClient: builder.Build(), pods: pods}
+	c.client, c.driver = c.newClient()
+	return c
+}
+
+// newClient creates another client of the cluster, like the one of another
+// API server replica.
+func (c *fakeCluster) newClient() (*Client, *fakeDriver) {
+	d := &fakeDriver{}
+	return NewClient(c.k8sClient, c.pods.CoreV1().Pods, d), d
+}
+
+// taskOf returns the task a pod runs, from the command of the fake driver.
+func taskOf(pod *k8score.Pod) string {
+	return pod.Spec.Containers[0].Command[1]
+}
+
+func alwaysSucceeds(pod *k8score.Pod) k8score.PodPhase {
+	return k8score.PodSucceeded
+}
+
+func alwaysRuns(pod *k8score.Pod) k8score.PodPhase {
+	return k8score.PodRunning
+}
+
+// podsOf returns the pods of a node.
+func (c *fakeCluster) podsOf(t *testing.T, nodeID string) []k8score.Pod {
+	t.Helper()
+	list, err := c.pods.CoreV1().Pods("ns").List(context.Background(), k8smeta.ListOptions{})
+	require.Nil(t, err)
+	var pods []k8score.Pod
+	for _, pod := range list.Items {
+		if pod.Annotations[AnnotationKeyNativeRunNode] == nodeID {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+func createRun(t *testing.T, client *Client, job *pipelinespec.PipelineJob) *util.NativeRun {
+	t.Helper()
+	manifest, err := Compile(job, nil, nil)
+	require.Nil(t, err)
+	run := util.NewNativeRun(manifest)
+	run.SetLabels(util.LabelKeyWorkflowRunId, "run-1")
+	created, err := client.Execution("ns").Create(context.Background(), run, k8smeta.CreateOptions{})
+	require.Nil(t, err)
+	return created.(*util.NativeRun)
+}
+
+func mergePatchOf(t *testing.T, patch interface{}) []byte {
+	t.Helper()
+	data, err := json.Marshal(patch)
+	require.Nil(t, err)
+	return data
+}
+
+func waitForPhase(t *testing.T, client *Client, name string, phase exec.ExecutionPhase) *util.NativeRun {
+	t.Helper()
+	var run util.ExecutionSpec
+	require.Eventually(t, func() bool {
+		var err error
+		run, err = client.Execution("ns").Get(context.Background(), name, k8smeta.GetOptions{})
+		require.Nil(t, err)
+		return run.ExecutionStatus().Condition() == phase
+	}, 10*time.Second, 10*time.Millisecond)
+	return run.(*util.NativeRun)
+}
+
+// path returns the node ID of a path below the root DAG.
+func path(runName string, nodePath string) string {
+	return nodeID(runName, rootPath+"."+nodePath)
+}
+
+func TestCompile(t *testing.T) {
+	manifest, err := Compile(loadJob(t, producerConsumerJob), nil, &Options{CacheDisabled: true})
+	require.Nil(t, err)
+	assert.Equal(t, util.NativeRunKind, manifest.Kind)
+	assert.Equal(t, "producer-consumer-", manifest.GenerateName)
+	assert.Equal(t, "pipeline-runner", manifest.Spec.ServiceAccountName)
+	assert.True(t, manifest.Spec.CacheDisabled)
+
+	p, err := parsePipeline(manifest.Spec)
+	require.Nil(t, err)
+	// The root defaults are filled into the runtime config.
+	assert.Equal(t, "hello", p.job.GetRuntimeConfig().GetParameterValues()["text"].GetStringValue())
+}
+
+func TestCompile_ControlFlow(t *testing.T) {
+	for _, jobPath := range []string{
+		"../compiler/testdata/importer.json",
+		"../compiler/testdata/exit_handler.json",
+		"../compiler/testdata/multiple_parallel_loops.json",
+		"../compiler/testdata/nested_pipeline_all_level_retry.json",
+		"../compiler/testdata/pipeline_with_parallelfor_list_artifacts.json",
+	} {
+		t.Run(jobPath, func(t *testing.T) {
+			content, err := os.ReadFile(jobPath)
+			require.Nil(t, err)
+			_, err = Compile(loadJob(t, string(content)), nil, nil)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestCompile_Unsupported(t *testing.T) {
+	content, err := os.ReadFile("../compiler/testdata/create_mount_delete_dynamic_pvc.json")
+	require.Nil(t, err)
+	job := loadJob(t, string(content))
+	content, err = os.ReadFile("../compiler/testdata/create_mount_delete_dynamic_pvc_platform.json")
+	require.Nil(t, err)
+	platformSpec := &pipelinespec.PlatformSpec{}
+	require.Nil(t, protojson.Unmarshal(content, platformSpec))
+
+	_, err = Compile(job, platformSpec.Platforms["kubernetes"], nil)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Kubernetes PVC task")
+	assert.Contains(t, err.Error(), "not supported by the native execution backend")
+
+	kubernetesSpec := &pipelinespec.SinglePlatformSpec{PipelineConfig: &pipelinespec.PipelineConfig{}}
+	_, err = Compile(loadJob(t, producerConsumerJob), kubernetesSpec, nil)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Kubernetes pipeline config is not supported")
+}
+
+func TestCompile_DependencyCycle(t *testing.T) {
+	manifest, err := Compile(loadJob(t, producerConsumerJob), nil, nil)
+	require.Nil(t, err)
+	p, err := parsePipeline(manifest.Spec)
+	require.Nil(t, err)
+	p.spec.GetRoot().GetDag().GetTasks()["producer"].DependentTasks = []string{"consumer"}
+	err = p.validate()
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle")
+}
+
+func TestRun_Succeeded(t *testing.T) {
+	c := newFakeCluster(t, alwaysSucceeds)
+	var reported sync.Map
+	c.client.SetReporter(func(ctx context.Context, execSpec util.ExecutionSpec) error {
+		reported.Store(execSpec.ExecutionStatus().Condition(), true)
+		return nil
+	})
+	created := createRun(t, c.client, loadJob(t, producerConsumerJob))
+	assert.Equal(t, "ns", created.ExecutionNamespace())
+
+	run := waitForPhase(t, c.client, created.ExecutionName(), exec.ExecutionSucceeded)
+	rootID := nodeID(run.Name, rootPath)
+	producerID := path(run.Name, "producer")
+	consumerID := path(run.Name, "consumer")
+	assert.Equal(t, []string{producerID}, run.Status.Nodes[rootID].Children)
+	assert.Equal(t, []string{consumerID}, run.Status.Nodes[producerID].Children)
+	for _, id := range []string{rootID, producerID, consumerID} {
+		assert.Equal(t, util.NativeNodeSucceeded, run.Status.Nodes[id].Phase, id)
+	}
+
+	roots := c.driver.callsOf(driverTypeRootDAG, "")
+	require.Len(t, roots, 1)
+	assert.Equal(t, "run-1", roots[0].opts.RunID)
+	assert.Equal(t, "namespace/producer-consumer", roots[0].opts.PipelineName)
+	assert.Equal(t, "hello", roots[0].opts.RuntimeConfig.GetParameterValues()["text"].GetStringValue())
+	producers := c.driver.callsOf(driverTypeContainer, "producer")
+	require.Len(t, producers, 1)
+	assert.Equal(t, roots[0].executionID, producers[0].opts.DAGExecutionID)
+	assert.Equal(t, -1, producers[0].opts.IterationIndex)
+	assert.Equal(t, []string{"produce"}, producers[0].opts.Container.GetCommand())
+	assert.Equal(t, producers[0].executionID, run.Status.Nodes[producerID].ExecutionID)
+
+	pods := c.podsOf(t, producerID)
+	require.Len(t, pods, 1)
+	pod := pods[0]
+	assert.Equal(t, pod.Name, run.Status.Nodes[producerID].PodName)
+	assert.Equal(t, producerID+"-", pod.GenerateName)
+	require.Len(t, pod.OwnerReferences, 1)
+	assert.Equal(t, util.NativeRunKind, pod.OwnerReferences[0].Kind)
+	assert.Equal(t, run.Name, pod.OwnerReferences[0].Name)
+	assert.True(t, *pod.OwnerReferences[0].Controller)
+	assert.Equal(t, "run-1", pod.Labels[util.LabelKeyWorkflowRunId])
+	assert.Equal(t, run.Name, pod.Labels[LabelKeyNativeRun])
+	assert.Equal(t, "pipeline-runner", pod.Spec.ServiceAccountName)
+	assert.Equal(t, k8score.RestartPolicyNever, pod.Spec.RestartPolicy)
+	require.Len(t, pod.Spec.InitContainers, 1)
+	assert.Equal(t, "kfp-launcher", pod.Spec.InitContainers[0].Name)
+	assert.Equal(t, []string{"run", "producer", "-1"}, pod.Spec.Containers[0].Command)
+
+	assert.Eventually(t, func() bool {
+		_, ok := reported.Load(exec.ExecutionSucceeded)
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestRun_ControlFlow(t *testing.T) {
+	var bodyPods int32
+	c := newFakeCluster(t, func(pod *k8score.Pod) k8score.PodPhase {
+		// The iterations run one after another, and the second one fails.
+		if taskOf(pod) == "body" && atomic.AddInt32(&bodyPods, 1) == 2 {
+			return k8score.PodFailed
+		}
+		return k8score.PodSucceeded
+	})
+	c.driver.configure = func(opts driver.Options, execution *driver.Execution) {
+		switch opts.Task.GetTaskInfo().GetName() {
+		case "loop":
+			if opts.IterationIndex < 0 {
+				count := 3
+				execution.IterationCount = &count
+			}
+		case "skipped":
+			condition := false
+			execution.Condition = &condition
+		case "cached":
+			cached := true
+			execution.Cached = &cached
+		}
+	}
+	created := createRun(t, c.client, loadJob(t, controlFlowJob))
+
+	run := waitForPhase(t, c.client, created.ExecutionName(), exec.ExecutionFailed)
+	assert.Contains(t, run.Message(), "task body: pod ")
+	assert.Contains(t, run.Message(), "failed with exit code 1: Error")
+	phases := map[string]util.NativeNodePhase{
+		"exit-handler":               util.NativeNodeFailed,
+		"exit-handler.loop":          util.NativeNodeFailed,
+		"exit-handler.loop.0":        util.NativeNodeSucceeded,
+		"exit-handler.loop.0.body":   util.NativeNodeSucceeded,
+		"exit-handler.loop.1":        util.NativeNodeFailed,
+		"exit-handler.loop.1.body":   util.NativeNodeFailed,
+		"exit-handler.loop.2":        util.NativeNodeOmitted,
+		"exit-handler.skipped":       util.NativeNodeSkipped,
+		"exit-handler.after-skipped": util.NativeNodeOmitted,
+		"exit-handler.cached":        util.NativeNodeSucceeded,
+		"cleanup":                    util.NativeNodeSucceeded,
+	}
+	for nodePath, phase := range phases {
+		assert.Equal(t, phase, run.Status.Nodes[path(run.Name, nodePath)].Phase, nodePath)
+	}
+	loopID := path(run.Name, "exit-handler.loop")
+	assert.Equal(t, "loop(1)", run.Status.Nodes[path(run.Name, "exit-handler.loop.1")].TaskName)
+	assert.Equal(t, []string{path(run.Name, "exit-handler.loop.0"), path(run.Name, "exit-handler.loop.1"), path(run.Name, "exit-handler.loop.2")},
+		run.Status.Nodes[loopID].Children)
+	assert.Equal(t, 3, *run.Status.Nodes[loopID].IterationCount)
+	assert.Empty(t, c.podsOf(t, path(run.Name, "exit-handler.cached")))
+	assert.Empty(t, c.podsOf(t, path(run.Name, "exit-handler.skipped")))
+
+	// The iterations are driven as tasks of the DAG of the iterator.
+	loops := c.driver.callsOf(driverTypeDAG, "loop")
+	require.Len(t, loops, 3)
+	assert.Equal(t, -1, loops[0].opts.IterationIndex)
+	assert.Equal(t, loops[0].executionID, loops[1].opts.DAGExecutionID)
+	assert.Equal(t, 0, loops[1].opts.IterationIndex)
+	assert.Equal(t, 1, loops[2].opts.IterationIndex)
+	bodies := c.driver.callsOf(driverTypeContainer, "body")
+	require.Len(t, bodies, 2)
+	assert.Equal(t, loops[1].executionID, bodies[0].opts.DAGExecutionID)
+}
+
+func TestRun_FailedAndRetried(t *testing.T) {
+	var mu sync.Mutex
+	attempts := make(map[string]int)
+	failConsumer := true
+	c := newFakeCluster(t, func(pod *k8score.Pod) k8score.PodPhase {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[taskOf(pod)]++
+		// The first attempt of the producer fails, which its retry policy
+		// retries.
+		if taskOf(pod) == "producer" && attempts["producer"] == 1 || taskOf(pod) == "consumer" && failConsumer {
+			return k8score.PodFailed
+		}
+		return k8score.PodSucceeded
+	})
+	created := createRun(t, c.client, loadJob(t, producerConsumerJob))
+
+	run := waitForPhase(t, c.client, created.ExecutionName(), exec.ExecutionFailed)
+	producerID := path(run.Name, "producer")
+	consumerID := path(run.Name, "consumer")
+	assert.Equal(t, util.NativeNodeSucceeded, run.Status.Nodes[producerID].Phase)
+	assert.Equal(t, int32(1), run.Status.Nodes[producerID].Retries)
+	assert.Len(t, c.podsOf(t, producerID), 2)
+	assert.Equal(t, util.NativeNodeFailed, run.Status.Nodes[consumerID].Phase)
+	assert.Contains(t, run.Message(), "task consumer: pod "+run.Status.Nodes[consumerID].PodName+" failed with exit code 1")
+
+	mu.Lock()
+	failConsumer = false
+	mu.Unlock()
+	retry, podsToDelete, err := run.GenerateRetryExecution()
+	require.Nil(t, err)
+	assert.Empty(t, podsToDelete)
+	_, err = c.client.Execution("ns").Update(context.Background(), retry, k8smeta.UpdateOptions{})
+	require.Nil(t, err)
+
+	run = waitForPhase(t, c.client, created.ExecutionName(), exec.ExecutionSucceeded)
+	assert.Equal(t, util.NativeNodeSucceeded, run.Status.Nodes[consumerID].Phase)
+	// The consumer is retried with a new pod of the same execution.
+	assert.Len(t, c.podsOf(t, consumerID), 2)
+	assert.Len(t, c.driver.callsOf(driverTypeContainer, "consumer"), 1)
+	assert.Len(t, c.driver.callsOf(driverTypeRootDAG, ""), 1)
+}
+
+func TestRun_Terminated(t *testing.T) {
+	c := newFakeCluster(t, alwaysRuns)
+	created := createRun(t, c.client, loadJob(t, producerConsumerJob))
+	name := created.ExecutionName()
+	producerID := path(name, "producer")
+	require.Eventually(t, func() bool {
+		return len(c.podsOf(t, producerID)) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err := c.client.Execution("ns").Patch(context.Background(), name, types.MergePatchType,
+		mergePatchOf(t, util.GetTerminatePatch(util.NativeExecution)), k8smeta.PatchOptions{})
+	require.Nil(t, err)
+
+	run := waitForPhase(t, c.client, name, exec.ExecutionFailed)
+	assert.True(t, run.Spec.Terminate)
+	assert.Equal(t, terminatedMessage, run.Message())
+	assert.Equal(t, util.NativeNodeFailed, run.Status.Nodes[producerID].Phase)
+	assert.Equal(t, terminatedMessage, run.Status.Nodes[producerID].Message)
+	assert.Equal(t, util.NativeNodeOmitted, run.Status.Nodes[path(name, "consumer")].Phase)
+	// The running pod is deleted.
+	assert.Empty(t, c.podsOf(t, producerID))
+}
+
+func TestRun_SuspendAndResume(t *testing.T) {
+	c := newFakeCluster(t, alwaysRuns)
+	created := createRun(t, c.client, loadJob(t, producerConsumerJob))
+	execution := c.client.Execution("ns")
+	name := created.ExecutionName()
+	producerID := path(name, "producer")
+	require.Eventually(t, func() bool {
+		return len(c.podsOf(t, producerID)) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	_, err := execution.Patch(context.Background(), name, types.MergePatchType, mergePatchOf(t, util.GetPausePatch(util.NativeExecution)), k8smeta.PatchOptions{})
+	require.Nil(t, err)
+	time.Sleep(5 * suspendPollInterval)
+
+	// The running producer finishes, but the consumer does not start while
+	// the run is paused.
+	pod := c.podsOf(t, producerID)[0]
+	pod.Status.Phase = k8score.PodSucceeded
+	_, err = c.pods.CoreV1().Pods("ns").UpdateStatus(context.Background(), &pod, k8smeta.UpdateOptions{})
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		run, err := execution.Get(context.Background(), name, k8smeta.GetOptions{})
+		require.Nil(t, err)
+		return run.(*util.NativeRun).Status.Nodes[producerID].Phase == util.NativeNodeSucceeded
+	}, 10*time.Second, 10*time.Millisecond)
+	time.Sleep(5 * suspendPollInterval)
+	run, err := execution.Get(context.Background(), name, k8smeta.GetOptions{})
+	require.Nil(t, err)
+	assert.True(t, run.IsPaused())
+	assert.Equal(t, exec.ExecutionRunning, run.ExecutionStatus().Condition())
+	assert.Empty(t, c.driver.callsOf(driverTypeContainer, "consumer"))
+
+	_, err = execution.Patch(context.Background(), name, types.MergePatchType, mergePatchOf(t, util.GetResumePatch(util.NativeExecution)), k8smeta.PatchOptions{})
+	require.Nil(t, err)
+	consumerID := path(name, "consumer")
+	require.Eventually(t, func() bool {
+		return len(c.podsOf(t, consumerID)) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	pod = c.podsOf(t, consumerID)[0]
+	pod.Status.Phase = k8score.PodSucceeded
+	_, err = c.pods.CoreV1().Pods("ns").UpdateStatus(context.Background(), &pod, k8smeta.UpdateOptions{})
+	require.Nil(t, err)
+	waitForPhase(t, c.client, name, exec.ExecutionSucceeded)
+}
+
+func TestResume(t *testing.T) {
+	manifest, err := Compile(loadJob(t, producerConsumerJob), nil, nil)
+	require.Nil(t, err)
+	manifest.Name = "resumed"
+	manifest.Namespace = "ns"
+	manifest.Labels = map[string]string{util.LabelKeyWorkflowRunId: "run-1"}
+	rootID := nodeID(manifest.Name, rootPath)
+	producerID := path(manifest.Name, "producer")
+	consumerID := path(manifest.Name, "consumer")
+	// The API server stopped while the consumer was running.
+	manifest.Status = util.NativeRunStatus{
+		Phase: exec.ExecutionRunning,
+		Nodes: map[string]util.NativeRunNodeStatus{
+			rootID:     {ID: rootID, TaskName: "resumed", Phase: util.NativeNodeRunning, ExecutionID: 1, Children: []string{producerID}},
+			producerID: {ID: producerID, TaskName: "producer", Phase: util.NativeNodeSucceeded, ExecutionID: 2, Children: []string{consumerID}},
+			consumerID: {ID: consumerID, TaskName: "consumer", Phase: util.NativeNodeRunning, ExecutionID: 3, PodName: "consumer-pod"},
+		},
+	}
+	c := newFakeCluster(t, alwaysRuns, manifest)
+	_, err = c.pods.CoreV1().Pods("ns").Create(context.Background(), &k8score.Pod{
+		ObjectMeta: k8smeta.ObjectMeta{Name: "consumer-pod", Namespace: "ns"},
+	}, k8smeta.CreateOptions{})
+	require.Nil(t, err)
+
+	require.Nil(t, c.client.Resume(context.Background()))
+	time.Sleep(5 * podPollInterval)
+	pod, err := c.pods.CoreV1().Pods("ns").Get(context.Background(), "consumer-pod", k8smeta.GetOptions{})
+	require.Nil(t, err)
+	pod.Status.Phase = k8score.PodSucceeded
+	_, err = c.pods.CoreV1().Pods("ns").UpdateStatus(context.Background(), pod, k8smeta.UpdateOptions{})
+	require.Nil(t, err)
+
+	run := waitForPhase(t, c.client, "resumed", exec.ExecutionSucceeded)
+	assert.Equal(t, util.NativeNodeSucceeded, run.Status.Nodes[consumerID].Phase)
+	// The run continues with the executions and the pod of the nodes.
+	c.driver.mu.Lock()
+	assert.Empty(t, c.driver.calls)
+	c.driver.mu.Unlock()
+}
+
+func TestResume_LeasedByOtherClient(t *testing.T) {
+	c := newFakeCluster(t, alwaysRuns)
+	other, otherDriver := c.newClient()
+	created := createRun(t, c.client, loadJob(t, producerConsumerJob))
+	name := created.ExecutionName()
+	producerID := path(name, "producer")
+	consumerID := path(name, "consumer")
+	require.Eventually(t, func() bool {
+		return len(c.podsOf(t, producerID)) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The other client does not run the run, whose lease the first one holds.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.Nil(t, other.Resume(ctx))
+	time.Sleep(5 * podPollInterval)
+	for _, id := range []string{producerID, consumerID} {
+		require.Eventually(t, func() bool {
+			pods := c.podsOf(t, id)
+			if len(pods) != 1 {
+				return false
+			}
+			pod := pods[0]
+			pod.Status.Phase = k8score.PodSucceeded
+			_, err := c.pods.CoreV1().Pods("ns").UpdateStatus(context.Background(), &pod, k8smeta.UpdateOptions{})
+			require.Nil(t, err)
+			return true
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+	run := waitForPhase(t, c.client, name, exec.ExecutionSucceeded)
+
+	assert.Len(t, c.podsOf(t, producerID), 1)
+	assert.Len(t, c.podsOf(t, consumerID), 1)
+	assert.Len(t, c.driver.callsOf(driverTypeContainer, "producer"), 1)
+	assert.Len(t, c.driver.callsOf(driverTypeContainer, "consumer"), 1)
+	otherDriver.mu.Lock()
+	assert.Empty(t, otherDriver.calls)
+	otherDriver.mu.Unlock()
+	// The lease is released once the run finished.
+	assert.Empty(t, run.Annotations[AnnotationKeyNativeRunOwner])
+}
+
+func TestResume_ExpiredLease(t *testing.T) {
+	newManifest := func(name string, owner string, renewTime time.Time) *util.NativeRunManifest {
+		manifest, err := Compile(loadJob(t, producerConsumerJob), nil, nil)
+		require.Nil(t, err)
+		manifest.Name = name
+		manifest.Namespace = "ns"
+		manifest.Annotations = map[string]string{
+			AnnotationKeyNativeRunOwner:          owner,
+			AnnotationKeyNativeRunLeaseRenewTime: renewTime.UTC().Format(time.RFC3339Nano),
+		}
+		manifest.Status.Phase = exec.ExecutionRunning
+		return manifest
+	}
+	c := newFakeCluster(t, alwaysSucceeds,
+		newManifest("stopped", "stopped-replica", time.Now().Add(-time.Hour)),
+		newManifest("running", "running-replica", time.Now()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.Nil(t, c.client.Resume(ctx))
+	waitForPhase(t, c.client, "stopped", exec.ExecutionSucceeded)
+	assert.NotEmpty(t, c.driver.callsOf(driverTypeContainer, "producer"))
+
+	// The run of the replica that renews its lease is left to it.
+	run, err := c.client.Execution("ns").Get(context.Background(), "running", k8smeta.GetOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "running-replica", run.(*util.NativeRun).Annotations[AnnotationKeyNativeRunOwner])
+	assert.Empty(t, run.(*util.NativeRun).Status.Nodes)
+}
+
+func TestExecutionInterface(t *testing.T) {
+	c := newFakeCluster(t, alwaysRuns)
+	first := createRun(t, c.client, loadJob(t, producerConsumerJob))
+	second := createRun(t, c.client, loadJob(t, producerConsumerJob))
+	execution := c.client.Execution("ns")
+
+	_, err := execution.Create(context.Background(), first, k8smeta.CreateOptions{})
+	assert.True(t, k8serrors.IsAlreadyExists(err))
+
+	list, err := execution.List(context.Background(), k8smeta.ListOptions{LabelSelector: util.LabelKeyWorkflowRunId + "=run-1"})
+	require.Nil(t, err)
+	assert.Len(t, *list, 2)
+	list, err = c.client.Execution("other").List(context.Background(), k8smeta.ListOptions{})
+	require.Nil(t, err)
+	assert.Empty(t, *list)
+
+	patched, err := execution.Patch(context.Background(), first.ExecutionName(), types.MergePatchType,
+		[]byte(fmt.Sprintf(`{"metadata":{"labels":{%q:"true"}}}`, util.LabelKeyWorkflowPersistedFinalState)), k8smeta.PatchOptions{})
+	require.Nil(t, err)
+	assert.True(t, patched.PersistedFinalState())
+	assert.Equal(t, first.ExecutionUID(), patched.ExecutionUID())
+	assert.True(t, c.client.Compare(first, patched))
+
+	require.Nil(t, execution.Delete(context.Background(), first.ExecutionName(), k8smeta.DeleteOptions{}))
+	_, err = execution.Get(context.Background(), first.ExecutionName(), k8smeta.GetOptions{})
+	assert.True(t, util.IsNotFound(err))
+	assert.True(t, util.IsNotFound(execution.Delete(context.Background(), first.ExecutionName(), k8smeta.DeleteOptions{})))
+
+	require.Nil(t, execution.DeleteCollection(context.Background(), k8smeta.DeleteOptions{}, k8smeta.ListOptions{}))
+	_, err = execution.Get(context.Background(), second.ExecutionName(), k8smeta.GetOptions{})
+	assert.True(t, util.IsNotFound(err))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &pipelinespec.PipelineTaskSpec_RetryPolicy{}
+	require.Nil(t, protojson.Unmarshal([]byte(`{"backoffDuration": "1s", "backoffFactor": 2, "backoffMaxDuration": "3s"}`), policy))
+	assert.Equal(t, time.Second, retryBackoff(policy, 0))
+	assert.Equal(t, 2*time.Second, retryBackoff(policy, 1))
+	assert.Equal(t, 3*time.Second, retryBackoff(policy, 2))
+	assert.Equal(t, time.Duration(0), retryBackoff(nil, 3))
+}
@@ -0,0 +1,329 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/component"
+	"github.com/kubeflow/pipelines/kubernetes_platform/go/kubernetesplatform"
+	k8score "k8s.io/api/core/v1"
+	k8sres "k8s.io/apimachinery/pkg/api/resource"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+const (
+	// LabelKeyNativeRun is the label of the task pods with the name of their
+	// native run.
+	LabelKeyNativeRun = "pipelines.kubeflow.org/native-run"
+	// AnnotationKeyNativeRunNode is the annotation of the task pods with the
+	// ID of their node, which can be longer than a label value.
+	AnnotationKeyNativeRunNode = "pipelines.kubeflow.org/native-run-node"
+	// annotationKeyPodSpecPatch is the annotation of the executor pods with
+	// the pod spec patch of the driver, from which the pod of a retry is
+	// created.
+	annotationKeyPodSpecPatch = "pipelines.kubeflow.org/pod-spec-patch"
+
+	mainContainerName     = "main"
+	volumeNameKFPLauncher = "kfp-launcher"
+	volumeNameCABundle    = "ca-bundle"
+)
+
+// The scratch directories of the executor, which are mounted as emptyDir
+// volumes like in the Argo executor template.
+var scratchVolumes = []struct {
+	name string
+	path string
+}{
+	{"gcs-scratch", "/gcs"},
+	{"s3-scratch", "/s3"},
+	{"minio-scratch", "/minio"},
+	{"dot-local-scratch", "/.local"},
+	{"dot-cache-scratch", "/.cache"},
+	{"dot-config-scratch", "/.config"},
+}
+
+// env vars in metadata-grpc-configmap is defined in component package
+var metadataConfigIsOptional = true
+var metadataEnvFrom = k8score.EnvFromSource{
+	ConfigMapRef: &k8score.ConfigMapEnvSource{
+		LocalObjectReference: k8score.LocalObjectReference{
+			Name: "metadata-grpc-configmap",
+		},
+		Optional: &metadataConfigIsOptional,
+	},
+}
+
+var commonEnvs = []k8score.EnvVar{{
+	Name: component.EnvPodName,
+	ValueFrom: &k8score.EnvVarSource{
+		FieldRef: &k8score.ObjectFieldSelector{
+			FieldPath: "metadata.name",
+		},
+	},
+}, {
+	Name: component.EnvPodUID,
+	ValueFrom: &k8score.EnvVarSource{
+		FieldRef: &k8score.ObjectFieldSelector{
+			FieldPath: "metadata.uid",
+		},
+	},
+}}
+
+// Launcher only copies the binary into the volume, so it needs minimal resources.
+var launcherResources = k8score.ResourceRequirements{
+	Limits: map[k8score.ResourceName]k8sres.Quantity{
+		k8score.ResourceMemory: k8sres.MustParse("128Mi"),
+		k8score.ResourceCPU:    k8sres.MustParse("0.5"),
+	},
+	Requests: map[k8score.ResourceName]k8sres.Quantity{
+		k8score.ResourceCPU: k8sres.MustParse("0.1"),
+	},
+}
+
+// The importer runs the driver and the importer in one container, like the
+// drivers of Argo.
+var importerResources = k8score.ResourceRequirements{
+	Limits: map[k8score.ResourceName]k8sres.Quantity{
+		k8score.ResourceMemory: k8sres.MustParse("0.5Gi"),
+		k8score.ResourceCPU:    k8sres.MustParse("0.5"),
+	},
+	Requests: map[k8score.ResourceName]k8sres.Quantity{
+		k8score.ResourceMemory: k8sres.MustParse("64Mi"),
+		k8score.ResourceCPU:    k8sres.MustParse("0.1"),
+	},
+}
+
+// launcherArgs returns the flags of the launcher that come from the
+// environment of the API server, like argocompiler does.
+func launcherArgs(cacheDisabled bool) []string {
+	var args []string
+	if cacheDisabled {
+		args = append(args, "--cache_disabled")
+	}
+	if value, ok := os.LookupEnv(argocompiler.PipelineLogLevelEnvVar); ok {
+		args = append(args, "--log_level", value)
+	}
+	if value, ok := os.LookupEnv(argocompiler.PublishLogsEnvVar); ok {
+		args = append(args, "--publish_logs", value)
+	}
+	return args
+}
+
+// newPod returns a pod of a node, owned by the run so that it is deleted with
+// the run. The pod gets a generated name, so that the retries of the node do
+// not collide.
+func newPod(manifest *util.NativeRunManifest, nodeID string, spec k8score.PodSpec, executorConfig *kubernetesplatform.KubernetesExecutorConfig) *k8score.Pod {
+	pod := &k8score.Pod{
+		ObjectMeta: k8smeta.ObjectMeta{
+			GenerateName: nodeID + "-",
+			Namespace:    manifest.Namespace,
+			Labels:       map[string]string{},
+			Annotations:  map[string]string{},
+			OwnerReferences: []k8smeta.OwnerReference{
+				*k8smeta.NewControllerRef(manifest, util.NativeRunGroupVersion.WithKind(util.NativeRunKind)),
+			},
+		},
+		Spec: spec,
+	}
+	// The labels and annotations of the executor config have a lower
+	// priority than the ones of the run, which are set by the API server.
+	for key, value := range executorConfig.GetPodMetadata().GetLabels() {
+		pod.Labels[key] = value
+	}
+	for key, value := range executorConfig.GetPodMetadata().GetAnnotations() {
+		pod.Annotations[key] = value
+	}
+	for key, value := range manifest.Spec.PodMetadata.Labels {
+		pod.Labels[key] = value
+	}
+	if runID, ok := manifest.Labels[util.LabelKeyWorkflowRunId]; ok {
+		pod.Labels[util.LabelKeyWorkflowRunId] = runID
+	}
+	for key, value := range manifest.Spec.PodMetadata.Annotations {
+		pod.Annotations[key] = value
+	}
+	pod.Labels[LabelKeyNativeRun] = manifest.Name
+	pod.Annotations[AnnotationKeyNativeRunNode] = nodeID
+	pod.Spec.RestartPolicy = k8score.RestartPolicyNever
+	pod.Spec.ServiceAccountName = manifest.Spec.ServiceAccountName
+	pod.Spec.SecurityContext = manifest.Spec.SecurityContext
+	return pod
+}
+
+// executorPodSpec returns the pod spec of a container task: the launcher is
+// copied into the pod by an init container, and the pod spec patch of the
+// driver sets the image, command and resources of the main container. It is
+// applied as a strategic merge patch, like Argo does.
+func executorPodSpec(podSpecPatch string, cacheDisabled bool) (k8score.PodSpec, error) {
+	spec := k8score.PodSpec{
+		Volumes: []k8score.Volume{{
+			Name:         volumeNameKFPLauncher,
+			VolumeSource: k8score.VolumeSource{EmptyDir: &k8score.EmptyDirVolumeSource{}},
+		}},
+		InitContainers: []k8score.Container{{
+			Name:    "kfp-launcher",
+			Image:   argocompiler.GetLauncherImage(),
+			Command: argocompiler.GetLauncherCommand(),
+			Args:    append([]string{"--copy", component.KFPLauncherPath}, launcherArgs(cacheDisabled)...),
+			VolumeMounts: []k8score.VolumeMount{{
+				Name:      volumeNameKFPLauncher,
+				MountPath: component.VolumePathKFPLauncher,
+			}},
+			Resources: launcherResources,
+		}},
+		Containers: []k8score.Container{{
+			Name: mainContainerName,
+			// The placeholder image and command are always overridden in
+			// the pod spec patch.
+			Image:   "gcr.io/ml-pipeline/should-be-overridden-during-runtime",
+			Command: []string{"should-be-overridden-during-runtime"},
+			VolumeMounts: []k8score.VolumeMount{{
+				Name:      volumeNameKFPLauncher,
+				MountPath: component.VolumePathKFPLauncher,
+			}},
+			EnvFrom: []k8score.EnvFromSource{metadataEnvFrom},
+			Env:     append([]k8score.EnvVar{}, commonEnvs...),
+		}},
+	}
+	for _, scratch := range scratchVolumes {
+		spec.Volumes = append(spec.Volumes, k8score.Volume{
+			Name:         scratch.name,
+			VolumeSource: k8score.VolumeSource{EmptyDir: &k8score.EmptyDirVolumeSource{}},
+		})
+		spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, k8score.VolumeMount{
+			Name:      scratch.name,
+			MountPath: scratch.path,
+		})
+	}
+	addCABundle(&spec)
+	if podSpecPatch == "" {
+		return spec, nil
+	}
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return spec, fmt.Errorf("failed to marshal the executor pod spec: %w", err)
+	}
+	patchedJSON, err := strategicpatch.StrategicMergePatch(specJSON, []byte(podSpecPatch), k8score.PodSpec{})
+	if err != nil {
+		return spec, fmt.Errorf("failed to apply the pod spec patch: %w", err)
+	}
+	patched := k8score.PodSpec{}
+	if err := json.Unmarshal(patchedJSON, &patched); err != nil {
+		return spec, fmt.Errorf("failed to unmarshal the patched pod spec: %w", err)
+	}
+	return patched, nil
+}
+
+// importerPodSpec returns the pod spec of an importer task, which runs the
+// launcher as an importer.
+func importerPodSpec(p *pipeline, manifest *util.NativeRunManifest, taskJSON, componentJSON, importerJSON string, parentDagID int64) k8score.PodSpec {
+	args := []string{
+		"--executor_type", "importer",
+		"--task_spec", taskJSON,
+		"--component_spec", componentJSON,
+		"--importer_spec", importerJSON,
+		"--pipeline_name", p.spec.GetPipelineInfo().GetName(),
+		"--run_id", manifest.Labels[util.LabelKeyWorkflowRunId],
+		"--parent_dag_id", fmt.Sprint(parentDagID),
+		"--pod_name",
+		fmt.Sprintf("$(%s)", component.EnvPodName),
+		"--pod_uid",
+		fmt.Sprintf("$(%s)", component.EnvPodUID),
+		"--mlmd_server_address",
+		fmt.Sprintf("$(%s)", component.EnvMetadataHost),
+		"--mlmd_server_port",
+		fmt.Sprintf("$(%s)", component.EnvMetadataPort),
+	}
+	args = append(args, launcherArgs(manifest.Spec.CacheDisabled)...)
+	if value, ok := os.LookupEnv(argocompiler.HashImportedArtifactsEnvVar); ok {
+		args = append(args, "--hash_imported_artifacts", value)
+	}
+	spec := k8score.PodSpec{
+		Containers: []k8score.Container{{
+			Name:      mainContainerName,
+			Image:     argocompiler.GetLauncherImage(),
+			Command:   argocompiler.GetLauncherCommand(),
+			Args:      args,
+			EnvFrom:   []k8score.EnvFromSource{metadataEnvFrom},
+			Env:       append([]k8score.EnvVar{}, commonEnvs...),
+			Resources: importerResources,
+		}},
+	}
+	addCABundle(&spec)
+	return spec
+}
+
+// addCABundle mounts the CA bundle config map of the executors into the main
+// container, if one is configured.
+func addCABundle(spec *k8score.PodSpec) {
+	caBundleCfgMapName := os.Getenv("EXECUTOR_CABUNDLE_CONFIGMAP_NAME")
+	caBundleCfgMapKey := os.Getenv("EXECUTOR_CABUNDLE_CONFIGMAP_KEY")
+	caBundleMountPath := os.Getenv("EXECUTOR_CABUNDLE_MOUNTPATH")
+	if caBundleCfgMapName == "" || caBundleCfgMapKey == "" {
+		return
+	}
+	caFile := fmt.Sprintf("%s/%s", caBundleMountPath, caBundleCfgMapKey)
+	certDirectories := []string{
+		caBundleMountPath,
+		"/etc/ssl/certs",
+		"/etc/pki/tls/certs",
+	}
+	container := &spec.Containers[0]
+	container.Env = append(container.Env,
+		// For the python requests library.
+		k8score.EnvVar{Name: "REQUESTS_CA_BUNDLE", Value: caFile},
+		// For AWS utilities like cli, and packages.
+		k8score.EnvVar{Name: "AWS_CA_BUNDLE", Value: caFile},
+		// OpenSSL default cert file env variable.
+		k8score.EnvVar{Name: "SSL_CERT_FILE", Value: caFile},
+		k8score.EnvVar{Name: "SSL_CERT_DIR", Value: strings.Join(certDirectories, ":")},
+	)
+	spec.Volumes = append(spec.Volumes, k8score.Volume{
+		Name: volumeNameCABundle,
+		VolumeSource: k8score.VolumeSource{
+			ConfigMap: &k8score.ConfigMapVolumeSource{
+				LocalObjectReference: k8score.LocalObjectReference{
+					Name: caBundleCfgMapName,
+				},
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, k8score.VolumeMount{
+		Name:      volumeNameCABundle,
+		MountPath: caFile,
+		SubPath:   caBundleCfgMapKey,
+	})
+}
+
+// podFailure returns the reason a pod failed.
+func podFailure(pod *k8score.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == mainContainerName && status.State.Terminated != nil {
+			terminated := status.State.Terminated
+			return fmt.Sprintf("pod %s failed with exit code %d: %s", pod.Name, terminated.ExitCode, terminated.Reason)
+		}
+	}
+	if pod.Status.Message != "" {
+		return fmt.Sprintf("pod %s failed: %s", pod.Name, pod.Status.Message)
+	}
+	return fmt.Sprintf("pod %s failed", pod.Name)
+}
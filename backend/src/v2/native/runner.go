@@ -0,0 +1,879 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	exec "github.com/kubeflow/pipelines/backend/src/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/driver"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/kubernetes_platform/go/kubernetesplatform"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	k8score "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	terminatedMessage = "Stopped with strategy 'Terminate'"
+
+	// rootPath is the path of the node of the root DAG. The paths of the
+	// other nodes append the task names and iteration indexes to the path of
+	// their DAG or iterator, separated by dots.
+	rootPath = "root"
+
+	// The driver types of the driver options, which match the ones of the
+	// driver command.
+	driverTypeRootDAG   = "ROOT_DAG"
+	driverTypeDAG       = "DAG"
+	driverTypeContainer = "CONTAINER"
+	driverTypeResolver  = "RESOLVER"
+)
+
+var (
+	// suspendPollInterval is how often a runner reads its run to notice that
+	// it was suspended, resumed, terminated or deleted.
+	suspendPollInterval = time.Second
+	// podPollInterval is how often a runner reads the pods of its tasks.
+	podPollInterval = 2 * time.Second
+)
+
+// runner runs a native run the way the Argo workflow of the run would: the
+// driver of each DAG and task is called in-process, and containers and
+// importers run in launcher pods. A task starts once all its upstream tasks
+// succeeded; after a task of a DAG failed, only the exit tasks of the DAG
+// are started. The state of the nodes is kept in the status of the run, from
+// which the run is resumed after a restart of the API server or a retry of
+// the run.
+type runner struct {
+	client *Client
+	key    types.NamespacedName
+
+	// Set when the run starts.
+	manifest         *util.NativeRunManifest
+	p                *pipeline
+	runID            string
+	pipelineLogLevel string
+	publishLogs      string
+
+	suspended atomic.Bool
+
+	// mu guards the status, which only the runner changes, and serializes
+	// its updates.
+	mu     sync.Mutex
+	status util.NativeRunStatus
+	// failure is the message of the first task that failed.
+	failure string
+}
+
+func newRunner(c *Client, key types.NamespacedName) *runner {
+	return &runner{client: c, key: key}
+}
+
+// taskRun is a task of a DAG, or an iteration of a task, to run.
+type taskRun struct {
+	// path identifies the node of the task, see nodeID.
+	path        string
+	displayName string
+	spec        *pipelinespec.PipelineTaskSpec
+	// parents are the nodes the node of the task is a child of.
+	parents        []string
+	dagExecutionID int64
+	// iterationIndex is the index of an iteration, or -1.
+	iterationIndex int
+}
+
+func (r *runner) run(ctx context.Context) {
+	manifest := &util.NativeRunManifest{}
+	if err := r.client.k8sClient.Get(ctx, r.key, manifest); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			glog.Errorf("Failed to get native run %s: %v", r.key, err)
+		}
+		return
+	}
+	r.manifest = manifest
+	r.status = manifest.Status
+	if r.status.Nodes == nil {
+		r.status.Nodes = make(map[string]util.NativeRunNodeStatus)
+	}
+	r.runID = manifest.Labels[util.LabelKeyWorkflowRunId]
+	if r.runID == "" {
+		r.runID = string(manifest.UID)
+	}
+	r.pipelineLogLevel = getEnvOrDefault(argocompiler.PipelineLogLevelEnvVar, "1")
+	r.publishLogs = getEnvOrDefault(argocompiler.PublishLogsEnvVar, "true")
+	r.suspended.Store(manifest.Spec.Suspend)
+	if manifest.Spec.Terminate {
+		r.finish(exec.ExecutionFailed, terminatedMessage)
+		return
+	}
+	p, err := parsePipeline(manifest.Spec)
+	if err != nil {
+		r.finish(exec.ExecutionError, err.Error())
+		return
+	}
+	r.p = p
+	runtimeConfig, err := r.runtimeConfig()
+	if err != nil {
+		r.finish(exec.ExecutionError, err.Error())
+		return
+	}
+	r.update(func(status *util.NativeRunStatus) bool {
+		status.Phase = exec.ExecutionRunning
+		if status.StartedAt.IsZero() {
+			status.StartedAt = k8smeta.Now()
+		}
+		return true
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go r.watch(ctx, cancel)
+	phase := r.runRoot(ctx, runtimeConfig)
+	cancel()
+
+	latest := &util.NativeRunManifest{}
+	if err := r.client.k8sClient.Get(context.Background(), r.key, latest); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return
+		}
+		glog.Errorf("Failed to get native run %s: %v", r.key, err)
+	} else if latest.Spec.Terminate {
+		r.finish(exec.ExecutionFailed, terminatedMessage)
+		return
+	}
+	if phase == util.NativeNodeSucceeded {
+		r.finish(exec.ExecutionSucceeded, "")
+		return
+	}
+	r.mu.Lock()
+	message := r.failure
+	r.mu.Unlock()
+	r.finish(exec.ExecutionFailed, message)
+}
+
+// watch reads the run until the context is done, to suspend and resume the
+// run, and cancels the context once the run is terminated or deleted.
+func (r *runner) watch(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(suspendPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		manifest := &util.NativeRunManifest{}
+		if err := r.client.k8sClient.Get(ctx, r.key, manifest); err != nil {
+			if k8serrors.IsNotFound(err) {
+				cancel()
+				return
+			}
+			if ctx.Err() == nil {
+				glog.Warningf("Failed to get native run %s: %v", r.key, err)
+			}
+			continue
+		}
+		if manifest.Spec.Terminate {
+			cancel()
+			return
+		}
+		r.suspended.Store(manifest.Spec.Suspend)
+	}
+}
+
+// runtimeConfig returns the runtime config of the pipeline job, with the
+// parameters of the run.
+func (r *runner) runtimeConfig() (*pipelinespec.PipelineJob_RuntimeConfig, error) {
+	params, err := pipelineParameters(r.p, r.manifest.Spec.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	config := &pipelinespec.PipelineJob_RuntimeConfig{}
+	if r.p.job.GetRuntimeConfig() != nil {
+		config = proto.Clone(r.p.job.GetRuntimeConfig()).(*pipelinespec.PipelineJob_RuntimeConfig)
+	}
+	config.ParameterValues = params
+	return config, nil
+}
+
+func (r *runner) runRoot(ctx context.Context, runtimeConfig *pipelinespec.PipelineJob_RuntimeConfig) util.NativeNodePhase {
+	id := nodeID(r.key.Name, rootPath)
+	node := r.ensureNode(id, r.key.Name, nil)
+	if node.Fulfilled() {
+		return node.Phase
+	}
+	r.startNode(id)
+	if node.ExecutionID == 0 {
+		opts := r.driverOptions(taskRun{iterationIndex: -1}, r.p.spec.GetRoot(), driverTypeRootDAG)
+		opts.RuntimeConfig = runtimeConfig
+		execution, err := r.client.driver.RootDAG(ctx, opts)
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("root DAG driver failed: %v", err))
+		}
+		node = r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+			node.ExecutionID = execution.ID
+		})
+	}
+	phase := r.runDAG(ctx, rootPath, id, r.p.spec.GetRoot().GetDag(), node.ExecutionID)
+	return r.finishNode(id, phase, "")
+}
+
+type taskResult struct {
+	name  string
+	phase util.NativeNodePhase
+}
+
+// runDAG runs the tasks of a DAG and returns the phase of the DAG.
+func (r *runner) runDAG(ctx context.Context, dagPath string, dagID string, dag *pipelinespec.DagSpec, executionID int64) util.NativeNodePhase {
+	tasks := dag.GetTasks()
+	names := make([]string, 0, len(tasks))
+	upstreams := make(map[string][]string, len(tasks))
+	for name, task := range tasks {
+		names = append(names, name)
+		upstreams[name] = upstreamTasks(task)
+		sort.Strings(upstreams[name])
+	}
+	sort.Strings(names)
+	newTaskRun := func(name string) taskRun {
+		parents := []string{dagID}
+		if len(upstreams[name]) > 0 {
+			parents = nil
+			for _, upstream := range upstreams[name] {
+				parents = append(parents, nodeID(r.key.Name, dagPath+"."+upstream))
+			}
+		}
+		return taskRun{
+			path:           dagPath + "." + name,
+			displayName:    name,
+			spec:           tasks[name],
+			parents:        parents,
+			dagExecutionID: executionID,
+			iterationIndex: -1,
+		}
+	}
+
+	// The tasks that finished before the run was resumed are not run again.
+	phases := make(map[string]util.NativeNodePhase, len(tasks))
+	failed := false
+	for _, name := range names {
+		node, ok := r.node(nodeID(r.key.Name, dagPath+"."+name))
+		if ok && node.Fulfilled() {
+			phases[name] = node.Phase
+			failed = failed || isFailure(node.Phase)
+		}
+	}
+
+	results := make(chan taskResult)
+	running := make(map[string]bool)
+	ticker := time.NewTicker(suspendPollInterval)
+	defer ticker.Stop()
+	for {
+		for changed := ctx.Err() == nil && !r.suspended.Load(); changed; {
+			changed = false
+			for _, name := range names {
+				if _, done := phases[name]; done || running[name] {
+					continue
+				}
+				start, omit := readyToStart(tasks[name], upstreams[name], phases, failed)
+				switch {
+				case omit:
+					t := newTaskRun(name)
+					id := nodeID(r.key.Name, t.path)
+					r.ensureNode(id, t.displayName, t.parents)
+					phases[name] = r.finishNode(id, util.NativeNodeOmitted, "")
+					changed = true
+				case start:
+					running[name] = true
+					go func(t taskRun) {
+						results <- taskResult{name: t.displayName, phase: r.runTask(ctx, t)}
+					}(newTaskRun(name))
+				}
+			}
+		}
+		if len(running) == 0 && (len(phases) == len(tasks) || ctx.Err() != nil || !r.suspended.Load()) {
+			break
+		}
+		select {
+		case result := <-results:
+			delete(running, result.name)
+			phases[result.name] = result.phase
+			failed = failed || isFailure(result.phase)
+		case <-ticker.C:
+		}
+	}
+
+	for _, name := range names {
+		if _, done := phases[name]; !done {
+			t := newTaskRun(name)
+			id := nodeID(r.key.Name, t.path)
+			r.ensureNode(id, t.displayName, t.parents)
+			r.finishNode(id, util.NativeNodeOmitted, "")
+		}
+	}
+	if failed || ctx.Err() != nil {
+		return util.NativeNodeFailed
+	}
+	return util.NativeNodeSucceeded
+}
+
+// readyToStart reports whether a task can start, and whether it is omitted
+// because of its upstream tasks. Like the dependencies of Argo Workflows, a
+// task is omitted unless its upstream tasks succeeded, and an exit task runs
+// once its upstream tasks completed in any phase. After a task of the DAG
+// failed, only exit tasks are started.
+func readyToStart(task *pipelinespec.PipelineTaskSpec, upstreams []string, phases map[string]util.NativeNodePhase, failed bool) (start bool, omit bool) {
+	exitTask := task.GetTriggerPolicy().GetStrategy() == pipelinespec.PipelineTaskSpec_TriggerPolicy_ALL_UPSTREAM_TASKS_COMPLETED
+	for _, upstream := range upstreams {
+		phase, done := phases[upstream]
+		if !done {
+			return false, false
+		}
+		if phase != util.NativeNodeSucceeded && !exitTask {
+			return false, true
+		}
+	}
+	return exitTask || !failed, false
+}
+
+func isFailure(phase util.NativeNodePhase) bool {
+	return phase == util.NativeNodeFailed || phase == util.NativeNodeError
+}
+
+// runTask runs a task or an iteration of a task and returns the phase of its
+// node.
+func (r *runner) runTask(ctx context.Context, t taskRun) util.NativeNodePhase {
+	id := nodeID(r.key.Name, t.path)
+	node := r.ensureNode(id, t.displayName, t.parents)
+	if node.Fulfilled() {
+		return node.Phase
+	}
+	component, err := r.p.component(t.spec)
+	if err != nil {
+		return r.finishNode(id, util.NativeNodeFailed, err.Error())
+	}
+	if t.iterationIndex < 0 && (t.spec.GetParameterIterator() != nil || t.spec.GetArtifactIterator() != nil) {
+		return r.runIterator(ctx, id, node, t, component)
+	}
+	if component.GetDag() != nil {
+		return r.runSubDAG(ctx, id, node, t, component)
+	}
+	executor, err := r.p.executor(component)
+	if err != nil {
+		return r.finishNode(id, util.NativeNodeFailed, err.Error())
+	}
+	switch {
+	case executor.GetContainer() != nil:
+		return r.runContainer(ctx, id, node, t, component, executor.GetContainer())
+	case executor.GetImporter() != nil:
+		return r.runImporter(ctx, id, node, t, component, executor.GetImporter())
+	case executor.GetResolver() != nil:
+		return r.runResolver(ctx, id, t, component, executor.GetResolver())
+	default:
+		return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("executor %q is not supported", component.GetExecutorLabel()))
+	}
+}
+
+// runSubDAG runs the driver of a DAG task and then its tasks.
+func (r *runner) runSubDAG(ctx context.Context, id string, node util.NativeRunNodeStatus, t taskRun, component *pipelinespec.ComponentSpec) util.NativeNodePhase {
+	r.startNode(id)
+	if node.ExecutionID == 0 {
+		execution, err := r.client.driver.DAG(ctx, r.driverOptions(t, component, driverTypeDAG))
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("DAG driver failed: %v", err))
+		}
+		if !execution.WillTrigger() {
+			return r.finishNode(id, util.NativeNodeSkipped, "")
+		}
+		node = r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+			node.ExecutionID = execution.ID
+		})
+	}
+	phase := r.runDAG(ctx, t.path, id, component.GetDag(), node.ExecutionID)
+	return r.finishNode(id, phase, "")
+}
+
+// runIterator runs the driver of an iterator, which resolves the items, and
+// then runs each iteration as a task of the DAG of the iterator.
+func (r *runner) runIterator(ctx context.Context, id string, node util.NativeRunNodeStatus, t taskRun, component *pipelinespec.ComponentSpec) util.NativeNodePhase {
+	r.startNode(id)
+	if node.ExecutionID == 0 || node.IterationCount == nil {
+		execution, err := r.client.driver.DAG(ctx, r.driverOptions(t, component, driverTypeDAG))
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("iterator driver failed: %v", err))
+		}
+		if !execution.WillTrigger() {
+			return r.finishNode(id, util.NativeNodeSkipped, "")
+		}
+		count := 0
+		if execution.IterationCount != nil {
+			count = *execution.IterationCount
+		}
+		node = r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+			node.ExecutionID = execution.ID
+			node.IterationCount = &count
+		})
+	}
+	count := *node.IterationCount
+	parallelism := int(t.spec.GetIteratorPolicy().GetParallelismLimit())
+	if parallelism <= 0 || parallelism > count {
+		parallelism = count
+	}
+	iteration := func(i int) taskRun {
+		return taskRun{
+			path:           t.path + "." + strconv.Itoa(i),
+			displayName:    fmt.Sprintf("%s(%d)", t.displayName, i),
+			spec:           t.spec,
+			parents:        []string{id},
+			dagExecutionID: node.ExecutionID,
+			iterationIndex: i,
+		}
+	}
+
+	results := make(chan util.NativeNodePhase)
+	ticker := time.NewTicker(suspendPollInterval)
+	defer ticker.Stop()
+	next, running, failed := 0, 0, false
+	for {
+		for next < count && running < parallelism && !failed && ctx.Err() == nil && !r.suspended.Load() {
+			running++
+			go func(t taskRun) {
+				results <- r.runTask(ctx, t)
+			}(iteration(next))
+			next++
+		}
+		if running == 0 && (next == count || failed || ctx.Err() != nil || !r.suspended.Load()) {
+			break
+		}
+		select {
+		case phase := <-results:
+			running--
+			failed = failed || isFailure(phase)
+		case <-ticker.C:
+		}
+	}
+
+	for i := next; i < count; i++ {
+		t := iteration(i)
+		iterationID := nodeID(r.key.Name, t.path)
+		r.ensureNode(iterationID, t.displayName, t.parents)
+		r.finishNode(iterationID, util.NativeNodeOmitted, "")
+	}
+	if failed || ctx.Err() != nil {
+		return r.finishNode(id, util.NativeNodeFailed, "")
+	}
+	return r.finishNode(id, util.NativeNodeSucceeded, "")
+}
+
+// runContainer runs the driver of a container task and its executor pod,
+// unless the task is skipped or cached. A task that already has a pod, because
+// the run was resumed or retried, continues with that pod.
+func (r *runner) runContainer(ctx context.Context, id string, node util.NativeRunNodeStatus, t taskRun, component *pipelinespec.ComponentSpec, container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec) util.NativeNodePhase {
+	executorConfig, err := r.p.kubernetesExecutorConfig(component)
+	if err != nil {
+		return r.finishNode(id, util.NativeNodeFailed, err.Error())
+	}
+	pods := r.client.podClient(r.key.Namespace)
+	var pod *k8score.Pod
+	if node.PodName != "" {
+		pod, err = pods.Get(ctx, node.PodName, k8smeta.GetOptions{})
+		switch {
+		case k8serrors.IsNotFound(err):
+			pod = nil
+		case err != nil:
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("failed to get pod %s: %v", node.PodName, err))
+		case node.Phase == util.NativeNodePending && pod.Status.Phase == k8score.PodFailed:
+			// The run was retried.
+			r.startNode(id)
+			if pod, err = r.replacePod(ctx, id, pod, executorConfig); err != nil {
+				return r.finishNode(id, util.NativeNodeFailed, err.Error())
+			}
+		}
+	}
+	if pod == nil {
+		r.startNode(id)
+		opts := r.driverOptions(t, component, driverTypeContainer)
+		opts.Container = container
+		opts.KubernetesExecutorConfig = executorConfig
+		execution, err := r.client.driver.Container(ctx, opts)
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("container driver failed: %v", err))
+		}
+		r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+			node.ExecutionID = execution.ID
+		})
+		if !execution.WillTrigger() {
+			return r.finishNode(id, util.NativeNodeSkipped, "")
+		}
+		if execution.Cached != nil && *execution.Cached {
+			return r.finishNode(id, util.NativeNodeSucceeded, "")
+		}
+		spec, err := executorPodSpec(execution.PodSpecPatch, r.manifest.Spec.CacheDisabled)
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, err.Error())
+		}
+		pod = newPod(r.manifest, id, spec, executorConfig)
+		pod.Annotations[annotationKeyPodSpecPatch] = execution.PodSpecPatch
+		if pod, err = r.createPod(ctx, id, pod); err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, err.Error())
+		}
+	}
+
+	policy := t.spec.GetRetryPolicy()
+	for {
+		succeeded, message := r.waitForPod(ctx, pod.Name)
+		if succeeded {
+			return r.finishNode(id, util.NativeNodeSucceeded, "")
+		}
+		node, _ = r.node(id)
+		if ctx.Err() != nil || node.Retries >= policy.GetMaxRetryCount() {
+			return r.finishNode(id, util.NativeNodeFailed, message)
+		}
+		glog.Infof("Retrying task %s of native run %s after %s", node.TaskName, r.key, message)
+		select {
+		case <-ctx.Done():
+			return r.finishNode(id, util.NativeNodeFailed, terminatedMessage)
+		case <-time.After(retryBackoff(policy, node.Retries)):
+		}
+		if pod, err = r.replacePod(ctx, id, pod, executorConfig); err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, err.Error())
+		}
+		r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+			node.Retries++
+		})
+	}
+}
+
+// runImporter runs the pod of an importer task, which runs the driver and the
+// importer of the launcher.
+func (r *runner) runImporter(ctx context.Context, id string, node util.NativeRunNodeStatus, t taskRun, component *pipelinespec.ComponentSpec, importer *pipelinespec.PipelineDeploymentConfig_ImporterSpec) util.NativeNodePhase {
+	pods := r.client.podClient(r.key.Namespace)
+	var pod *k8score.Pod
+	if node.PodName != "" && node.Phase == util.NativeNodeRunning {
+		var err error
+		pod, err = pods.Get(ctx, node.PodName, k8smeta.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("failed to get pod %s: %v", node.PodName, err))
+		}
+		if err != nil {
+			pod = nil
+		}
+	}
+	if pod == nil {
+		r.startNode(id)
+		taskJSON, err := protojson.Marshal(t.spec)
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("failed to marshal the task spec: %v", err))
+		}
+		componentJSON, err := protojson.Marshal(component)
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("failed to marshal the component spec: %v", err))
+		}
+		importerJSON, err := protojson.Marshal(importer)
+		if err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("failed to marshal the importer spec: %v", err))
+		}
+		spec := importerPodSpec(r.p, r.manifest, string(taskJSON), string(componentJSON), string(importerJSON), t.dagExecutionID)
+		if pod, err = r.createPod(ctx, id, newPod(r.manifest, id, spec, nil)); err != nil {
+			return r.finishNode(id, util.NativeNodeFailed, err.Error())
+		}
+	}
+	if succeeded, message := r.waitForPod(ctx, pod.Name); !succeeded {
+		return r.finishNode(id, util.NativeNodeFailed, message)
+	}
+	return r.finishNode(id, util.NativeNodeSucceeded, "")
+}
+
+// runResolver runs the driver of a resolver task, which has no executor.
+func (r *runner) runResolver(ctx context.Context, id string, t taskRun, component *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) util.NativeNodePhase {
+	r.startNode(id)
+	opts := r.driverOptions(t, component, driverTypeResolver)
+	opts.Resolver = resolver
+	execution, err := r.client.driver.Resolver(ctx, opts)
+	if err != nil {
+		return r.finishNode(id, util.NativeNodeFailed, fmt.Sprintf("resolver driver failed: %v", err))
+	}
+	r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+		node.ExecutionID = execution.ID
+	})
+	return r.finishNode(id, util.NativeNodeSucceeded, "")
+}
+
+func (r *runner) driverOptions(t taskRun, component *pipelinespec.ComponentSpec, driverType string) driver.Options {
+	return driver.Options{
+		PipelineName:     r.p.spec.GetPipelineInfo().GetName(),
+		RunID:            r.runID,
+		RunName:          r.key.Name,
+		RunDisplayName:   r.p.job.GetDisplayName(),
+		Namespace:        r.key.Namespace,
+		Component:        component,
+		Task:             t.spec,
+		DAGExecutionID:   t.dagExecutionID,
+		IterationIndex:   t.iterationIndex,
+		PipelineLogLevel: r.pipelineLogLevel,
+		PublishLogs:      r.publishLogs,
+		CacheDisabled:    r.manifest.Spec.CacheDisabled,
+		DriverType:       driverType,
+	}
+}
+
+// createPod creates the pod of a node and records it in the node.
+func (r *runner) createPod(ctx context.Context, id string, pod *k8score.Pod) (*k8score.Pod, error) {
+	created, err := r.client.podClient(r.key.Namespace).Create(ctx, pod, k8smeta.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the pod: %w", err)
+	}
+	r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+		node.PodName = created.Name
+	})
+	return created, nil
+}
+
+// replacePod creates a new pod for a node from the pod spec patch of a failed
+// pod, which is kept for its logs.
+func (r *runner) replacePod(ctx context.Context, id string, failed *k8score.Pod, executorConfig *kubernetesplatform.KubernetesExecutorConfig) (*k8score.Pod, error) {
+	if patch, ok := failed.Annotations[annotationKeyPodSpecPatch]; ok {
+		spec, err := executorPodSpec(patch, r.manifest.Spec.CacheDisabled)
+		if err != nil {
+			return nil, err
+		}
+		pod := newPod(r.manifest, id, spec, executorConfig)
+		pod.Annotations[annotationKeyPodSpecPatch] = patch
+		return r.createPod(ctx, id, pod)
+	}
+	return nil, fmt.Errorf("pod %s has no pod spec patch to retry with", failed.Name)
+}
+
+// waitForPod waits until a pod finished and returns whether it succeeded, or
+// why it failed. The pod is deleted once the context is done.
+func (r *runner) waitForPod(ctx context.Context, name string) (bool, string) {
+	pods := r.client.podClient(r.key.Namespace)
+	ticker := time.NewTicker(podPollInterval)
+	defer ticker.Stop()
+	for {
+		pod, err := pods.Get(ctx, name, k8smeta.GetOptions{})
+		switch {
+		case k8serrors.IsNotFound(err):
+			return false, fmt.Sprintf("pod %s was deleted", name)
+		case err != nil:
+			if ctx.Err() == nil {
+				glog.Warningf("Failed to get pod %s of native run %s: %v", name, r.key, err)
+			}
+		case pod.Status.Phase == k8score.PodSucceeded:
+			return true, ""
+		case pod.Status.Phase == k8score.PodFailed:
+			return false, podFailure(pod)
+		}
+		select {
+		case <-ctx.Done():
+			err := pods.Delete(context.Background(), name, k8smeta.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				glog.Errorf("Failed to delete pod %s of native run %s: %v", name, r.key, err)
+			}
+			return false, terminatedMessage
+		case <-ticker.C:
+		}
+	}
+}
+
+// retryBackoff returns how long to wait before a retry of a task, like the
+// retry strategy Argo compiles from the retry policy.
+func retryBackoff(policy *pipelinespec.PipelineTaskSpec_RetryPolicy, retries int32) time.Duration {
+	backoff := policy.GetBackoffDuration().AsDuration()
+	if factor := policy.GetBackoffFactor(); factor > 0 {
+		backoff = time.Duration(float64(backoff) * math.Pow(factor, float64(retries)))
+	}
+	if maxBackoff := policy.GetBackoffMaxDuration().AsDuration(); maxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// update changes the status of the run and saves it unless change returns
+// false. The status is saved as a whole, so that a failed save is repaired by
+// the next one.
+func (r *runner) update(change func(status *util.NativeRunStatus) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !change(&r.status) {
+		return
+	}
+	status := (&util.NativeRunManifest{Status: r.status}).DeepCopy().Status
+	_, err := r.client.updateStatus(context.Background(), r.key, func(manifest *util.NativeRunManifest) {
+		manifest.Status = status
+	})
+	if err != nil && !k8serrors.IsNotFound(err) && !errors.Is(err, errLeaseLost) {
+		glog.Errorf("Failed to update the status of native run %s: %v", r.key, err)
+	}
+}
+
+func (r *runner) node(id string) (util.NativeRunNodeStatus, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	node, ok := r.status.Nodes[id]
+	return node, ok
+}
+
+// ensureNode returns the node with the ID, which it creates as a child of the
+// parent nodes if it does not exist.
+func (r *runner) ensureNode(id string, displayName string, parents []string) util.NativeRunNodeStatus {
+	var node util.NativeRunNodeStatus
+	r.update(func(status *util.NativeRunStatus) bool {
+		var ok bool
+		if node, ok = status.Nodes[id]; ok {
+			return false
+		}
+		node = util.NativeRunNodeStatus{ID: id, TaskName: displayName, Phase: util.NativeNodePending}
+		status.Nodes[id] = node
+		for _, parentID := range parents {
+			parent, ok := status.Nodes[parentID]
+			if !ok {
+				continue
+			}
+			children := append([]string{}, parent.Children...)
+			parent.Children = append(children, id)
+			status.Nodes[parentID] = parent
+		}
+		return true
+	})
+	return node
+}
+
+// updateNode changes a node and returns it.
+func (r *runner) updateNode(id string, change func(node *util.NativeRunNodeStatus)) util.NativeRunNodeStatus {
+	var node util.NativeRunNodeStatus
+	r.update(func(status *util.NativeRunStatus) bool {
+		node = status.Nodes[id]
+		change(&node)
+		status.Nodes[id] = node
+		return true
+	})
+	return node
+}
+
+func (r *runner) startNode(id string) {
+	r.updateNode(id, func(node *util.NativeRunNodeStatus) {
+		node.Phase = util.NativeNodeRunning
+		if node.StartedAt.IsZero() {
+			node.StartedAt = k8smeta.Now()
+		}
+		node.FinishedAt = k8smeta.Time{}
+	})
+}
+
+// finishNode sets the final phase of a node and returns it. The message of
+// the first failure is the message of the run.
+func (r *runner) finishNode(id string, phase util.NativeNodePhase, message string) util.NativeNodePhase {
+	r.update(func(status *util.NativeRunStatus) bool {
+		node := status.Nodes[id]
+		now := k8smeta.Now()
+		node.Phase = phase
+		node.Message = message
+		if node.StartedAt.IsZero() {
+			node.StartedAt = now
+		}
+		node.FinishedAt = now
+		status.Nodes[id] = node
+		if isFailure(phase) && message != "" && r.failure == "" {
+			r.failure = fmt.Sprintf("task %s: %s", node.TaskName, message)
+		}
+		return true
+	})
+	return phase
+}
+
+// finish sets the final phase of the run and omits the nodes that did not
+// finish.
+func (r *runner) finish(phase exec.ExecutionPhase, message string) {
+	r.update(func(status *util.NativeRunStatus) bool {
+		now := k8smeta.Now()
+		for id, node := range status.Nodes {
+			if !node.Fulfilled() {
+				node.Phase = util.NativeNodeOmitted
+				status.Nodes[id] = node
+			}
+		}
+		status.Phase = phase
+		status.Message = message
+		if status.StartedAt.IsZero() {
+			status.StartedAt = now
+		}
+		status.FinishedAt = now
+		return true
+	})
+}
+
+// nodeID returns the ID of the node at a path, derived like the node IDs of
+// Argo Workflows, so that it is the same when the run is resumed.
+func nodeID(runName string, path string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(path))
+	return fmt.Sprintf("%s-%v", runName, h.Sum32())
+}
+
+func getEnvOrDefault(key string, defaultValue string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// pipelineParameters returns the runtime config parameters of the pipeline
+// job, overridden by the parameters of the native run.
+func pipelineParameters(p *pipeline, overrides []util.NativeRunParameter) (map[string]*structpb.Value, error) {
+	params := make(map[string]*structpb.Value)
+	for name, value := range p.job.GetRuntimeConfig().GetParameterValues() {
+		params[name] = value
+	}
+	definitions := p.spec.GetRoot().GetInputDefinitions().GetParameters()
+	for _, override := range overrides {
+		if override.Value == nil {
+			continue
+		}
+		value, err := textToValue(*override.Value, definitions[override.Name].GetParameterType())
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of parameter %q: %w", override.Name, err)
+		}
+		params[override.Name] = value
+	}
+	return params, nil
+}
+
+// textToValue parses a parameter value, taking parameters of pipelines
+// compiled before parameter types were added as strings.
+func textToValue(text string, parameterType pipelinespec.ParameterType_ParameterTypeEnum) (*structpb.Value, error) {
+	if parameterType == pipelinespec.ParameterType_PARAMETER_TYPE_ENUM_UNSPECIFIED {
+		parameterType = pipelinespec.ParameterType_STRING
+	}
+	return metadata.TextToPbValue(text, parameterType)
+}
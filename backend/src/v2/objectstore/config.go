@@ -50,6 +50,20 @@ type GCSParams struct {
 	TokenKey   string
 }
 
+type AzureBlobParams struct {
+	FromEnv bool
+	// The storage account the containers belong to, required unless FromEnv.
+	AccountName string
+	// optional, the blob service endpoint, e.g. for Azurite or sovereign clouds.
+	// Defaults to https://<AccountName>.blob.core.windows.net
+	Endpoint   string
+	SecretName string
+	// The k8s secret "Key" for the storage account key or a SAS token, one of
+	// both is required.
+	AccountKeyKey string
+	SASTokenKey   string
+}
+
 type S3Params struct {
 	FromEnv    bool
 	SecretName string
@@ -96,10 +110,42 @@ func (b *Config) KeyFromURI(uri string) (string, error) {
 	return key, nil
 }
 
+// ContainsURI returns whether the URI is under the prefixed bucket once its
+// "." and ".." path segments are resolved.
+func (b *Config) ContainsURI(uri string) bool {
+	key, err := b.KeyFromURI(uri)
+	if err != nil || !strings.HasPrefix(strings.TrimPrefix(uri, b.PrefixedBucket()), "/") {
+		return false
+	}
+	key = path.Clean(key)
+	return key != "." && key != ".." && !strings.HasPrefix(key, "../")
+}
+
 func (b *Config) UriFromKey(blobKey string) string {
 	return b.Scheme + path.Join(b.BucketName, b.Prefix, blobKey)
 }
 
+// FileScheme is the scheme of pipeline roots on a filesystem mounted into the
+// pods, such as a PVC or an NFS share. The first path segment is used as the
+// bucket, e.g. file:///mnt/kfp/root is bucket "mnt" with prefix "kfp/root/".
+const FileScheme = "file:///"
+
+// AzureBlobScheme is the scheme of pipeline roots on Azure Blob Storage, the
+// container is used as the bucket. The shorter AzureBlobShortScheme is
+// accepted as well.
+const (
+	AzureBlobScheme      = "azblob://"
+	AzureBlobShortScheme = "az://"
+)
+
+func isSupportedScheme(scheme string) bool {
+	switch scheme {
+	case "gs://", "s3://", "minio://", "mem://", FileScheme, AzureBlobScheme, AzureBlobShortScheme:
+		return true
+	}
+	return false
+}
+
 var bucketPattern = regexp.MustCompile(`(^[a-z][a-z0-9]+:///?)([^/?]+)(/[^?]*)?(\?.+)?$`)
 
 func ParseBucketConfig(path string, sess *SessionInfo) (*Config, error) {
@@ -118,8 +164,7 @@ func ParseBucketPathToConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("parse bucket config failed: unrecognized pipeline root format: %q", path)
 	}
 
-	// TODO: Verify/add support for file:///.
-	if ms[1] != "gs://" && ms[1] != "s3://" && ms[1] != "minio://" && ms[1] != "mem://" {
+	if !isSupportedScheme(ms[1]) {
 		return nil, fmt.Errorf("parse bucket config failed: unsupported Cloud bucket: %q", path)
 	}
 
@@ -142,8 +187,7 @@ func ParseBucketConfigForArtifactURI(uri string) (*Config, error) {
 		return nil, fmt.Errorf("parse bucket config failed: unrecognized uri format: %q", uri)
 	}
 
-	// TODO: Verify/add support for file:///.
-	if ms[1] != "gs://" && ms[1] != "s3://" && ms[1] != "minio://" && ms[1] != "mem://" {
+	if !isSupportedScheme(ms[1]) {
 		return nil, fmt.Errorf("parse bucket config failed: unsupported Cloud bucket: %q", uri)
 	}
 
@@ -160,7 +204,10 @@ func ParseProviderFromPath(uri string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return strings.TrimSuffix(bucketConfig.Scheme, "://"), nil
+	if bucketConfig.Scheme == AzureBlobShortScheme {
+		return "azblob", nil
+	}
+	return strings.TrimSuffix(strings.TrimRight(bucketConfig.Scheme, "/"), ":"), nil
 }
 
 func MinioDefaultEndpoint() string {
@@ -252,3 +299,30 @@ func StructuredGCSParams(p map[string]string) (*GCSParams, error) {
 	}
 	return sparams, nil
 }
+
+func StructuredAzureBlobParams(p map[string]string) (*AzureBlobParams, error) {
+	sparams := &AzureBlobParams{}
+	if val, ok := p["fromEnv"]; ok {
+		boolVal, err := strconv.ParseBool(val)
+		if err != nil {
+			return nil, err
+		}
+		sparams.FromEnv = boolVal
+	}
+	if val, ok := p["accountName"]; ok {
+		sparams.AccountName = val
+	}
+	if val, ok := p["endpoint"]; ok {
+		sparams.Endpoint = val
+	}
+	if val, ok := p["secretName"]; ok {
+		sparams.SecretName = val
+	}
+	if val, ok := p["accountKeyKey"]; ok {
+		sparams.AccountKeyKey = val
+	}
+	if val, ok := p["sasTokenKey"]; ok {
+		sparams.SASTokenKey = val
+	}
+	return sparams, nil
+}
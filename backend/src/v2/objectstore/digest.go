@@ -0,0 +1,168 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gocloud.dev/blob"
+)
+
+// digestPrefix identifies the hash algorithm used to compute artifact digests.
+const digestPrefix = "sha256:"
+
+// digestEntry is the digest of a single file within an artifact, keyed by its
+// slash-separated path relative to the artifact root.
+type digestEntry struct {
+	path   string
+	digest string
+}
+
+// LocalDigest computes the content digest of a local artifact. A file is
+// digested by its contents. A directory is digested by the relative paths and
+// contents of every file beneath it, so the result matches BlobDigest of the
+// same tree once uploaded.
+func LocalDigest(localPath string) (string, error) {
+	fileInfo, err := os.Stat(localPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to stat local filepath %q: %w", localPath, err)
+	}
+	if !fileInfo.IsDir() {
+		f, err := os.Open(localPath)
+		if err != nil {
+			return "", fmt.Errorf("unable to open local file %q for reading: %w", localPath, err)
+		}
+		defer f.Close()
+		sum, err := hashReader(f)
+		if err != nil {
+			return "", fmt.Errorf("unable to digest local file %q: %w", localPath, err)
+		}
+		return digestPrefix + sum, nil
+	}
+
+	var entries []digestEntry
+	err = filepath.WalkDir(localPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		relativePath, err := filepath.Rel(localPath, path)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		sum, err := hashReader(f)
+		if err != nil {
+			return fmt.Errorf("unable to digest local file %q: %w", path, err)
+		}
+		entries = append(entries, digestEntry{path: filepath.ToSlash(relativePath), digest: sum})
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to digest local directory %q: %w", localPath, err)
+	}
+	return digestOfEntries(entries), nil
+}
+
+// BlobDigest computes the content digest of the artifact stored at blobPath by
+// reading it back from the bucket. blobPath may point at a single object or at
+// a prefix holding a directory tree.
+func BlobDigest(ctx context.Context, bucket *blob.Bucket, blobPath string) (string, error) {
+	dirPrefix := strings.TrimSuffix(blobPath, "/") + "/"
+	var entries []digestEntry
+	iter := bucket.List(&blob.ListOptions{Prefix: blobPath})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", fmt.Errorf("failed to list objects in remote storage %q: %w", blobPath, err)
+		}
+		if obj.IsDir {
+			continue
+		}
+		if obj.Key == blobPath {
+			sum, err := hashBlob(ctx, bucket, obj.Key)
+			if err != nil {
+				return "", err
+			}
+			return digestPrefix + sum, nil
+		}
+		if !strings.HasPrefix(obj.Key, dirPrefix) {
+			// A sibling object that merely shares the prefix, e.g. "a/bc" when digesting "a/b".
+			continue
+		}
+		sum, err := hashBlob(ctx, bucket, obj.Key)
+		if err != nil {
+			return "", err
+		}
+		entries = append(entries, digestEntry{path: strings.TrimPrefix(obj.Key, dirPrefix), digest: sum})
+	}
+	if len(entries) == 0 {
+		return "", fmt.Errorf("no objects found in remote storage %q", blobPath)
+	}
+	return digestOfEntries(entries), nil
+}
+
+func hashBlob(ctx context.Context, bucket *blob.Bucket, key string) (string, error) {
+	r, err := bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to open reader for remote object %q: %w", key, err)
+	}
+	defer r.Close()
+	sum, err := hashReader(r)
+	if err != nil {
+		return "", fmt.Errorf("unable to digest remote object %q: %w", key, err)
+	}
+	return sum, nil
+}
+
+func hashReader(r io.Reader) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func digestOfEntries(entries []digestEntry) string {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].path < entries[j].path
+	})
+	hash := sha256.New()
+	for _, entry := range entries {
+		hash.Write([]byte(entry.path))
+		hash.Write([]byte{0})
+		hash.Write([]byte(entry.digest))
+		hash.Write([]byte{'\n'})
+	}
+	return digestPrefix + hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob/memblob"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.Nil(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestLocalDigest(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"model.txt":          "weights",
+		"dir/a.txt":          "a",
+		"dir/nested/b.txt":   "b",
+		"other/dir/a.txt":    "a",
+		"other/dir/nested/b": "b",
+	})
+
+	fileDigest, err := LocalDigest(filepath.Join(root, "model.txt"))
+	require.Nil(t, err)
+	assert.Equal(t, "sha256:9a129038d9a00aed0cf6a7ea059ca50a813449061ab87848cf1a13eafdf33b2c", fileDigest)
+
+	dirDigest, err := LocalDigest(filepath.Join(root, "dir"))
+	require.Nil(t, err)
+	again, err := LocalDigest(filepath.Join(root, "dir"))
+	require.Nil(t, err)
+	assert.Equal(t, dirDigest, again)
+
+	// Same contents under different file names must not collide.
+	renamedDigest, err := LocalDigest(filepath.Join(root, "other", "dir"))
+	require.Nil(t, err)
+	assert.NotEqual(t, dirDigest, renamedDigest)
+
+	_, err = LocalDigest(filepath.Join(root, "missing"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestBlobDigest_MatchesLocalDigest(t *testing.T) {
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"artifact/a.txt":        "a",
+		"artifact/nested/b.txt": "b",
+		"single.txt":            "single",
+	})
+	require.Nil(t, UploadBlob(ctx, bucket, filepath.Join(root, "artifact"), "run/artifact"))
+	require.Nil(t, UploadBlob(ctx, bucket, filepath.Join(root, "single.txt"), "run/single.txt"))
+	// Shares the "run/artifact" prefix but is not part of the artifact.
+	require.Nil(t, bucket.WriteAll(ctx, "run/artifact2/c.txt", []byte("c"), nil))
+
+	localDirDigest, err := LocalDigest(filepath.Join(root, "artifact"))
+	require.Nil(t, err)
+	blobDirDigest, err := BlobDigest(ctx, bucket, "run/artifact")
+	require.Nil(t, err)
+	assert.Equal(t, localDirDigest, blobDirDigest)
+
+	localFileDigest, err := LocalDigest(filepath.Join(root, "single.txt"))
+	require.Nil(t, err)
+	blobFileDigest, err := BlobDigest(ctx, bucket, "run/single.txt")
+	require.Nil(t, err)
+	assert.Equal(t, localFileDigest, blobFileDigest)
+
+	// Changing the contents behind the same key changes the digest.
+	require.Nil(t, bucket.WriteAll(ctx, "run/single.txt", []byte("changed"), nil))
+	changedDigest, err := BlobDigest(ctx, bucket, "run/single.txt")
+	require.Nil(t, err)
+	assert.NotEqual(t, blobFileDigest, changedDigest)
+
+	_, err = BlobDigest(ctx, bucket, "run/missing")
+	assert.NotNil(t, err)
+}
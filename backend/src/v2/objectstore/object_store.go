@@ -23,11 +23,14 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/golang/glog"
 	"gocloud.dev/blob"
+	"gocloud.dev/blob/azureblob"
+	"gocloud.dev/blob/fileblob"
 	"gocloud.dev/blob/gcsblob"
 	_ "gocloud.dev/blob/gcsblob"
 	"gocloud.dev/blob/s3blob"
@@ -43,6 +46,19 @@ func OpenBucket(ctx context.Context, k8sClient kubernetes.Interface, namespace s
 			err = fmt.Errorf("Failed to open bucket %q: %w", config.BucketName, err)
 		}
 	}()
+	if config.Scheme == FileScheme {
+		// Artifacts on a shared filesystem are also read as plain files, so no
+		// metadata sidecar files are written next to them. Temporary files are
+		// kept next to the final ones as the filesystem is usually a separate mount.
+		openedBucket, err1 := fileblob.OpenBucket("/"+config.BucketName, &fileblob.Options{
+			NoTempDir: true,
+			Metadata:  fileblob.MetadataDontWrite,
+		})
+		if err1 != nil {
+			return nil, err1
+		}
+		return blob.PrefixedBucket(openedBucket, config.Prefix), nil
+	}
 	if config.SessionInfo != nil {
 		if config.SessionInfo.Provider == "minio" || config.SessionInfo.Provider == "s3" {
 			sess, err1 := createS3BucketSession(ctx, namespace, config.SessionInfo, k8sClient)
@@ -70,6 +86,18 @@ func OpenBucket(ctx context.Context, k8sClient kubernetes.Interface, namespace s
 				}
 				return blob.PrefixedBucket(openedBucket, config.Prefix), nil
 			}
+		} else if config.SessionInfo.Provider == "azblob" {
+			client, err1 := getAzureBlobContainerClient(ctx, namespace, config, k8sClient)
+			if err1 != nil {
+				return nil, fmt.Errorf("Failed to retrieve credentials for bucket %s: %w", config.BucketName, err1)
+			}
+			if client != nil {
+				openedBucket, err2 := azureblob.OpenBucket(ctx, client, nil)
+				if err2 != nil {
+					return nil, err2
+				}
+				return blob.PrefixedBucket(openedBucket, config.Prefix), nil
+			}
 		}
 	}
 
@@ -80,6 +108,10 @@ func OpenBucket(ctx context.Context, k8sClient kubernetes.Interface, namespace s
 	if len(config.QueryString) > 0 && strings.HasPrefix(bucketURL, "minio://") {
 		bucketURL = strings.Replace(bucketURL, "minio://", "s3://", 1)
 	}
+	// The short az:// scheme is not registered with gocloud, which only knows azblob://
+	if strings.HasPrefix(bucketURL, AzureBlobShortScheme) {
+		bucketURL = strings.Replace(bucketURL, AzureBlobShortScheme, AzureBlobScheme, 1)
+	}
 
 	// When no provider config is provided, or "FromEnv" is specified, use default credentials from the environment
 	return blob.OpenBucket(ctx, bucketURL)
@@ -239,6 +271,43 @@ func getGCSTokenClient(ctx context.Context, namespace string, sessionInfo *Sessi
 	return client, nil
 }
 
+// getAzureBlobContainerClient returns a client for the container of the bucket
+// authenticated with the storage account key or SAS token from the configured
+// secret, or nil if the credentials come from the environment.
+func getAzureBlobContainerClient(ctx context.Context, namespace string, config *Config, clientSet kubernetes.Interface) (*container.Client, error) {
+	params, err := StructuredAzureBlobParams(config.SessionInfo.Params)
+	if err != nil {
+		return nil, err
+	}
+	if params.FromEnv {
+		return nil, nil
+	}
+	if params.AccountName == "" {
+		return nil, fmt.Errorf("missing storage account name")
+	}
+	endpoint := params.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", params.AccountName)
+	}
+	containerURL := strings.TrimRight(endpoint, "/") + "/" + config.BucketName
+
+	secret, err := clientSet.CoreV1().Secrets(namespace).Get(ctx, params.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get Bucket credentials from secret name=%q namespace=%q: %w", params.SecretName, namespace, err)
+	}
+	if accountKey := string(secret.Data[params.AccountKeyKey]); params.AccountKeyKey != "" && accountKey != "" {
+		cred, err := container.NewSharedKeyCredential(params.AccountName, accountKey)
+		if err != nil {
+			return nil, err
+		}
+		return container.NewClientWithSharedKeyCredential(containerURL, cred, nil)
+	}
+	if sasToken := string(secret.Data[params.SASTokenKey]); params.SASTokenKey != "" && sasToken != "" {
+		return container.NewClientWithNoCredential(containerURL+"?"+strings.TrimPrefix(sasToken, "?"), nil)
+	}
+	return nil, fmt.Errorf("could not find specified keys '%s' or '%s' in secret %q", params.AccountKeyKey, params.SASTokenKey, params.SecretName)
+}
+
 func createS3BucketSession(ctx context.Context, namespace string, sessionInfo *SessionInfo, client kubernetes.Interface) (*session.Session, error) {
 	if sessionInfo == nil {
 		return nil, nil
@@ -16,8 +16,10 @@ package objectstore
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -129,6 +131,36 @@ func Test_parseCloudBucket(t *testing.T) {
 				QueryString: "",
 			},
 			wantErr: false,
+		}, {
+			name: "Parses File - Directory with prefix",
+			path: "file:///mnt/kfp/root",
+			want: &Config{
+				Scheme:      "file:///",
+				BucketName:  "mnt",
+				Prefix:      "kfp/root/",
+				QueryString: "",
+			},
+			wantErr: false,
+		}, {
+			name: "Parses Azure Blob - Container with prefix",
+			path: "azblob://my-container/my-path",
+			want: &Config{
+				Scheme:      "azblob://",
+				BucketName:  "my-container",
+				Prefix:      "my-path/",
+				QueryString: "",
+			},
+			wantErr: false,
+		}, {
+			name: "Parses Azure Blob - Container with prefix and short scheme",
+			path: "az://my-container/my-path/123",
+			want: &Config{
+				Scheme:      "az://",
+				BucketName:  "my-container",
+				Prefix:      "my-path/123/",
+				QueryString: "",
+			},
+			wantErr: false,
 		}, {
 			name: "Parses S3 - Bucket with session",
 			path: "s3://my-bucket/my-path/123",
@@ -168,6 +200,57 @@ func Test_parseCloudBucket(t *testing.T) {
 	}
 }
 
+func Test_ParseProviderFromPath(t *testing.T) {
+	for path, want := range map[string]string{
+		"gs://my-bucket/my-path":    "gs",
+		"minio://my-bucket":         "minio",
+		"s3://my-bucket/my-path":    "s3",
+		"file:///mnt/kfp/my-path/":  "file",
+		"azblob://my-container":     "azblob",
+		"az://my-container/my-path": "azblob",
+	} {
+		got, err := ParseProviderFromPath(path)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got, path)
+	}
+}
+
+func Test_OpenBucket_File(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	config, err := ParseBucketPathToConfig("file://" + root + "/pipeline-root")
+	assert.Nil(t, err)
+	bucket, err := OpenBucket(ctx, nil, "", config)
+	assert.Nil(t, err)
+	defer bucket.Close()
+
+	localDir := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(localDir, "sub"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(localDir, "data.txt"), []byte("data"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(localDir, "sub", "nested.txt"), []byte("nested"), 0644))
+
+	uri := "file://" + root + "/pipeline-root/task/output"
+	key, err := config.KeyFromURI(uri)
+	assert.Nil(t, err)
+	assert.Nil(t, UploadBlob(ctx, bucket, localDir, key))
+
+	// The artifacts are plain files on the filesystem without metadata sidecars.
+	content, err := os.ReadFile(filepath.Join(root, "pipeline-root", "task", "output", "sub", "nested.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "nested", string(content))
+	_, err = os.Stat(filepath.Join(root, "pipeline-root", "task", "output", "data.txt.attrs"))
+	assert.True(t, os.IsNotExist(err))
+
+	downloadDir := t.TempDir()
+	assert.Nil(t, DownloadBlob(ctx, bucket, downloadDir, key))
+	content, err = os.ReadFile(filepath.Join(downloadDir, "data.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "data", string(content))
+	content, err = os.ReadFile(filepath.Join(downloadDir, "sub", "nested.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "nested", string(content))
+}
+
 func Test_bucketConfig_KeyFromURI(t *testing.T) {
 	type fields struct {
 		scheme     string
@@ -211,6 +294,22 @@ func Test_bucketConfig_KeyFromURI(t *testing.T) {
 	}
 }
 
+func Test_bucketConfig_ContainsURI(t *testing.T) {
+	bucketConfig := &Config{Scheme: FileScheme, BucketName: "mnt", Prefix: "kfp/root/"}
+	for uri, want := range map[string]bool{
+		"file:///mnt/kfp/root/run/task/output":     true,
+		"file:///mnt/kfp/root/run/../task/output":  true,
+		"file:///mnt/kfp/root/../../etc/passwd":    false,
+		"file:///mnt/kfp/root/..":                  false,
+		"file:///mnt/kfp/root-other/task/output":   false,
+		"file:///etc/passwd":                       false,
+		"gs://mnt/kfp/root/run/task/output":        false,
+		"file:///mnt/kfp/root/run/task/output/../": true,
+	} {
+		assert.Equal(t, want, bucketConfig.ContainsURI(uri), uri)
+	}
+}
+
 func Test_GetMinioDefaultEndpoint(t *testing.T) {
 	defer func() {
 		os.Unsetenv("MINIO_SERVICE_SERVICE_HOST")
@@ -385,3 +484,105 @@ func Test_createS3BucketSession(t *testing.T) {
 		})
 	}
 }
+
+func Test_getAzureBlobContainerClient(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "azblob-provider-secret", Namespace: "testnamespace"},
+		Data: map[string][]byte{
+			"test_account_key": []byte(base64.StdEncoding.EncodeToString([]byte("accountKey"))),
+			"test_sas_token":   []byte("?sv=2022-11-02&sig=signature"),
+		},
+	}
+	tt := []struct {
+		msg         string
+		params      map[string]string
+		expectedURL string
+		wantErr     bool
+		errorMsg    string
+	}{
+		{
+			msg: "account key",
+			params: map[string]string{
+				"fromEnv":       "false",
+				"accountName":   "kfpartifacts",
+				"secretName":    "azblob-provider-secret",
+				"accountKeyKey": "test_account_key",
+			},
+			expectedURL: "https://kfpartifacts.blob.core.windows.net/my-container",
+		},
+		{
+			msg: "sas token with custom endpoint",
+			params: map[string]string{
+				"fromEnv":     "false",
+				"accountName": "kfpartifacts",
+				"endpoint":    "http://azurite.kubeflow:10000/kfpartifacts/",
+				"secretName":  "azblob-provider-secret",
+				"sasTokenKey": "test_sas_token",
+			},
+			expectedURL: "http://azurite.kubeflow:10000/kfpartifacts/my-container?sv=2022-11-02&sig=signature",
+		},
+		{
+			msg:    "credentials from env",
+			params: map[string]string{"fromEnv": "true"},
+		},
+		{
+			msg: "missing account name",
+			params: map[string]string{
+				"fromEnv":       "false",
+				"secretName":    "azblob-provider-secret",
+				"accountKeyKey": "test_account_key",
+			},
+			wantErr:  true,
+			errorMsg: "missing storage account name",
+		},
+		{
+			msg: "secret doesn't exist",
+			params: map[string]string{
+				"fromEnv":       "false",
+				"accountName":   "kfpartifacts",
+				"secretName":    "does-not-exist",
+				"accountKeyKey": "test_account_key",
+			},
+			wantErr:  true,
+			errorMsg: "secrets \"does-not-exist\" not found",
+		},
+		{
+			msg: "secret exists but key mismatch",
+			params: map[string]string{
+				"fromEnv":       "false",
+				"accountName":   "kfpartifacts",
+				"secretName":    "azblob-provider-secret",
+				"accountKeyKey": "does_not_exist_account_key",
+				"sasTokenKey":   "does_not_exist_sas_token",
+			},
+			wantErr:  true,
+			errorMsg: "could not find specified keys",
+		},
+	}
+	for _, test := range tt {
+		t.Run(test.msg, func(t *testing.T) {
+			ctx := context.Background()
+			fakeKubernetesClientset := fake.NewSimpleClientset()
+			_, err := fakeKubernetesClientset.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+			assert.Nil(t, err)
+
+			config := &Config{
+				Scheme:      AzureBlobScheme,
+				BucketName:  "my-container",
+				SessionInfo: &SessionInfo{Provider: "azblob", Params: test.params},
+			}
+			client, err := getAzureBlobContainerClient(ctx, secret.Namespace, config, fakeKubernetesClientset)
+			if test.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.errorMsg)
+				return
+			}
+			assert.Nil(t, err)
+			if test.expectedURL == "" {
+				assert.Nil(t, client)
+			} else {
+				assert.Equal(t, test.expectedURL, client.URL())
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runmetrics reports the scalar metrics of v2 tasks to the KFP API
+// server, which stores them as run metrics so that runs can be sorted and
+// filtered by them.
+package runmetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// metricsSchemaTitle is the schema title of Metrics artifacts, whose
+	// metadata holds scalar metrics.
+	metricsSchemaTitle = "system.Metrics"
+	// The service account token is projected to the same path as for the KFP
+	// SDK client, so that the API server can authorize the reports in
+	// multi-user mode.
+	saDefaultTokenPath = "/var/run/secrets/kubeflow/pipelines/token"
+	saTokenPathEnvVar  = "KF_PIPELINES_SA_TOKEN_PATH"
+)
+
+// Client reports run metrics to the KFP API server.
+type Client interface {
+	ReportRunMetrics(ctx context.Context, runID string, metrics []*api.RunMetric) error
+}
+
+type client struct {
+	svc api.RunServiceClient
+}
+
+var _ Client = &client{}
+
+// NewClient creates a Client.
+func NewClient() (Client, error) {
+	endpoint := cacheutils.KfpApiEndpoint()
+	glog.Infof("Connecting to KFP API endpoint %s to report run metrics", endpoint)
+	conn, err := grpc.Dial(endpoint,
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cacheutils.MaxClientGRPCMessageSize)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the KFP API server: %w", err)
+	}
+	return &client{svc: api.NewRunServiceClient(conn)}, nil
+}
+
+// ReportRunMetrics reports metrics of a run. Metrics that were reported
+// before are ignored, so that retried tasks can report their metrics again.
+func (c *client) ReportRunMetrics(ctx context.Context, runID string, metrics []*api.RunMetric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+	token, err := readServiceAccountToken()
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", "Bearer "+token)
+	}
+	response, err := c.svc.ReportRunMetricsV1(ctx, &api.ReportRunMetricsRequest{RunId: runID, Metrics: metrics})
+	if err != nil {
+		return fmt.Errorf("failed to report the metrics of run %s: %w", runID, err)
+	}
+	var failures []string
+	for _, result := range response.GetResults() {
+		switch result.GetStatus() {
+		case api.ReportRunMetricsResponse_ReportRunMetricResult_OK,
+			api.ReportRunMetricsResponse_ReportRunMetricResult_DUPLICATE_REPORTING:
+		default:
+			failures = append(failures, fmt.Sprintf("%s: %s", result.GetMetricName(), result.GetMessage()))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to report metrics of run %s: %s", runID, strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// readServiceAccountToken returns an empty token if no token is projected,
+// which is fine unless the API server runs in multi-user mode.
+func readServiceAccountToken() (string, error) {
+	path := os.Getenv(saTokenPathEnvVar)
+	if path == "" {
+		path = saDefaultTokenPath
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read the service account token at %s: %w", path, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// FromArtifacts returns the scalar metrics in the metadata of the Metrics
+// artifacts, named after the metadata keys, with nodeID as their node ID.
+// Metadata values that are not finite numbers are skipped, like the display
+// name of the artifact.
+func FromArtifacts(artifacts map[string]*pipelinespec.ArtifactList, nodeID string) []*api.RunMetric {
+	var metrics []*api.RunMetric
+	seen := make(map[string]bool)
+	names := make([]string, 0, len(artifacts))
+	for name := range artifacts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, artifact := range artifacts[name].GetArtifacts() {
+			if artifact.GetType().GetSchemaTitle() != metricsSchemaTitle {
+				continue
+			}
+			fields := artifact.GetMetadata().GetFields()
+			keys := make([]string, 0, len(fields))
+			for key := range fields {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				value, ok := fields[key].GetKind().(*structpb.Value_NumberValue)
+				if !ok {
+					continue
+				}
+				number := value.NumberValue
+				if math.IsNaN(number) || math.IsInf(number, 0) {
+					continue
+				}
+				// A task can only report one value per metric name.
+				if seen[key] {
+					glog.Warningf("Skipping duplicate metric %q of output artifact %q", key, name)
+					continue
+				}
+				seen[key] = true
+				metrics = append(metrics, &api.RunMetric{
+					Name:   key,
+					NodeId: nodeID,
+					Value:  &api.RunMetric_NumberValue{NumberValue: number},
+					Format: api.RunMetric_RAW,
+				})
+			}
+		}
+	}
+	return metrics
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runmetrics
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+)
+
+// FakeClient records the reported metrics by run ID.
+type FakeClient struct {
+	Reported map[string][]*api.RunMetric
+}
+
+var _ Client = &FakeClient{}
+
+func NewFakeClient() *FakeClient {
+	return &FakeClient{Reported: make(map[string][]*api.RunMetric)}
+}
+
+func (c *FakeClient) ReportRunMetrics(ctx context.Context, runID string, metrics []*api.RunMetric) error {
+	c.Reported[runID] = append(c.Reported[runID], metrics...)
+	return nil
+}
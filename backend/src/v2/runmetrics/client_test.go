@@ -0,0 +1,74 @@
+// Copyright 2025 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runmetrics
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	api "github.com/kubeflow/pipelines/backend/api/v1beta1/go_client"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func metricsArtifact(fields map[string]*structpb.Value) *pipelinespec.RuntimeArtifact {
+	return &pipelinespec.RuntimeArtifact{
+		Type:     &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: metricsSchemaTitle}},
+		Metadata: &structpb.Struct{Fields: fields},
+	}
+}
+
+func TestFromArtifacts(t *testing.T) {
+	artifacts := map[string]*pipelinespec.ArtifactList{
+		"eval": {Artifacts: []*pipelinespec.RuntimeArtifact{metricsArtifact(map[string]*structpb.Value{
+			"accuracy":     structpb.NewNumberValue(0.9),
+			"loss":         structpb.NewNumberValue(0.1),
+			"display_name": structpb.NewStringValue("eval"),
+			"invalid":      structpb.NewNumberValue(math.NaN()),
+		})}},
+		"train": {Artifacts: []*pipelinespec.RuntimeArtifact{metricsArtifact(map[string]*structpb.Value{
+			// Duplicates the metric of the "eval" artifact.
+			"accuracy": structpb.NewNumberValue(0.95),
+		})}},
+		"dataset": {Artifacts: []*pipelinespec.RuntimeArtifact{{
+			Type:     &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "system.Dataset"}},
+			Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{"rows": structpb.NewNumberValue(10)}},
+		}}},
+	}
+
+	metrics := FromArtifacts(artifacts, "pod-1")
+	assert.Equal(t, []*api.RunMetric{
+		{Name: "accuracy", NodeId: "pod-1", Value: &api.RunMetric_NumberValue{NumberValue: 0.9}, Format: api.RunMetric_RAW},
+		{Name: "loss", NodeId: "pod-1", Value: &api.RunMetric_NumberValue{NumberValue: 0.1}, Format: api.RunMetric_RAW},
+	}, metrics)
+	assert.Empty(t, FromArtifacts(nil, "pod-1"))
+}
+
+func TestReadServiceAccountToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	t.Setenv(saTokenPathEnvVar, path)
+
+	token, err := readServiceAccountToken()
+	assert.Nil(t, err)
+	assert.Empty(t, token)
+
+	assert.Nil(t, os.WriteFile(path, []byte("my-token\n"), 0o600))
+	token, err = readServiceAccountToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "my-token", token)
+}
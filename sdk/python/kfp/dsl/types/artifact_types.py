@@ -22,6 +22,9 @@ _GCS_LOCAL_MOUNT_PREFIX = '/gcs/'
 _MINIO_LOCAL_MOUNT_PREFIX = '/minio/'
 _S3_LOCAL_MOUNT_PREFIX = '/s3/'
 _OCI_LOCAL_MOUNT_PREFIX = '/oci/'
+_FILE_LOCAL_MOUNT_PREFIX = '/file/'
+_AZURE_BLOB_LOCAL_MOUNT_PREFIX = '/azblob/'
+_AZURE_BLOB_SHORT_LOCAL_MOUNT_PREFIX = '/az/'
 
 
 class RemotePrefix(enum.Enum):
@@ -29,6 +32,9 @@ class RemotePrefix(enum.Enum):
     MINIO = 'minio://'
     S3 = 's3://'
     OCI = 'oci://'
+    FILE = 'file:///'
+    AZURE_BLOB = 'azblob://'
+    AZURE_BLOB_SHORT = 'az://'
 
 
 class Artifact:
@@ -105,6 +111,15 @@ class Artifact:
             escaped_uri = self.uri[len(RemotePrefix.OCI.value):].replace(
                 '/', '_')
             return _OCI_LOCAL_MOUNT_PREFIX + escaped_uri
+        if self.uri.startswith(RemotePrefix.FILE.value):
+            return _FILE_LOCAL_MOUNT_PREFIX + self.uri[len(RemotePrefix.FILE
+                                                           .value):]
+        if self.uri.startswith(RemotePrefix.AZURE_BLOB.value):
+            return _AZURE_BLOB_LOCAL_MOUNT_PREFIX + self.uri[len(
+                RemotePrefix.AZURE_BLOB.value):]
+        if self.uri.startswith(RemotePrefix.AZURE_BLOB_SHORT.value):
+            return _AZURE_BLOB_SHORT_LOCAL_MOUNT_PREFIX + self.uri[len(
+                RemotePrefix.AZURE_BLOB_SHORT.value):]
         # uri == path for local execution
         return self.uri
 
@@ -125,6 +140,14 @@ def convert_local_path_to_remote_path(path: str) -> str:
             remote_path = remote_path[:-len("/models")]
 
         return RemotePrefix.OCI.value + remote_path
+    elif path.startswith(_FILE_LOCAL_MOUNT_PREFIX):
+        return RemotePrefix.FILE.value + path[len(_FILE_LOCAL_MOUNT_PREFIX):]
+    elif path.startswith(_AZURE_BLOB_LOCAL_MOUNT_PREFIX):
+        return RemotePrefix.AZURE_BLOB.value + path[
+            len(_AZURE_BLOB_LOCAL_MOUNT_PREFIX):]
+    elif path.startswith(_AZURE_BLOB_SHORT_LOCAL_MOUNT_PREFIX):
+        return RemotePrefix.AZURE_BLOB_SHORT.value + path[
+            len(_AZURE_BLOB_SHORT_LOCAL_MOUNT_PREFIX):]
 
     return path
 
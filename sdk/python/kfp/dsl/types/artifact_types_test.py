@@ -102,6 +102,14 @@ class TestGetUri(unittest.TestCase):
                 'minio://my_bucket/123456789/abc-09-14-2023-14-21-53/foo_123456789/Output',
                 dsl.get_uri())
 
+    def test_default_file(self):
+        with set_temporary_task_root(
+                '/file/mnt/kfp/123456789/abc-09-14-2023-14-21-53/foo_123456789'
+        ):
+            self.assertEqual(
+                'file:///mnt/kfp/123456789/abc-09-14-2023-14-21-53/foo_123456789/Output',
+                dsl.get_uri())
+
     def test_suffix_arg_gcs(self):
         with set_temporary_task_root(
                 '/gcs/my_bucket/123456789/abc-09-14-2023-14-21-53/foo_123456789'
@@ -138,6 +146,9 @@ class TestConvertLocalPathToRemotePath(parameterized.TestCase):
         ('/gcs/foo/bar', 'gs://foo/bar'),
         ('/minio/foo/bar', 'minio://foo/bar'),
         ('/s3/foo/bar', 's3://foo/bar'),
+        ('/file/mnt/foo/bar', 'file:///mnt/foo/bar'),
+        ('/azblob/foo/bar', 'azblob://foo/bar'),
+        ('/az/foo/bar', 'az://foo/bar'),
         ('/oci/quay.io_org_repo:latest/models',
          'oci://quay.io/org/repo:latest'),
         ('/oci/quay.io_org_repo:latest', 'oci://quay.io/org/repo:latest'),